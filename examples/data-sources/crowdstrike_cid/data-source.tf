@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+data "crowdstrike_cid" "example" {}
+
+# Render user data that installs and registers the Falcon sensor on Linux.
+output "user_data" {
+  sensitive = true
+  value     = <<-EOT
+    #!/bin/bash
+    /opt/CrowdStrike/falconctl -s --cid=${data.crowdstrike_cid.example.cid}%{if data.crowdstrike_cid.example.provisioning_token != null} --provisioning-token=${data.crowdstrike_cid.example.provisioning_token}%{endif}
+    systemctl start falcon-sensor
+  EOT
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# All registered AWS accounts
+data "crowdstrike_cloud_accounts" "aws" {}
+
+# Specific accounts across cloud providers
+data "crowdstrike_cloud_accounts" "production" {
+  aws_account_ids  = ["123456789012"]
+  azure_tenant_ids = ["00000000-0000-0000-0000-000000000003"]
+}
+
+output "aws_registration_status" {
+  value = {
+    for account in data.crowdstrike_cloud_accounts.aws.accounts :
+    account.account_id => account.registration_status
+  }
+}
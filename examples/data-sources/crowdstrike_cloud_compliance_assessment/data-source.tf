@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+data "crowdstrike_cloud_compliance_benchmarks" "cis_aws" {
+  authority        = "CIS"
+  name             = "CIS 3.0.0 AWS*"
+  include_controls = false
+}
+
+# assess an AWS account against the CIS benchmark
+data "crowdstrike_cloud_compliance_assessment" "production" {
+  framework_id = one(data.crowdstrike_cloud_compliance_benchmarks.cis_aws.ids)
+  account_id   = "123456789012"
+  max_items    = 100
+}
+
+output "cis_aws_score" {
+  value = data.crowdstrike_cloud_compliance_assessment.production.score
+}
+
+# warn in plans when resources of the account fail the benchmark
+check "cis_aws_compliance" {
+  assert {
+    condition     = data.crowdstrike_cloud_compliance_assessment.production.failing == 0
+    error_message = "The account fails ${data.crowdstrike_cloud_compliance_assessment.production.failing} evaluations of the CIS benchmark."
+  }
+}
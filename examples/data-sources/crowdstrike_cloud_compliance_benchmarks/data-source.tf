@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# retrieve the CIS benchmarks for AWS with their sections and controls
+data "crowdstrike_cloud_compliance_benchmarks" "cis_aws" {
+  authority = "CIS"
+  name      = "*AWS*"
+}
+
+# list every benchmark without reading its controls
+data "crowdstrike_cloud_compliance_benchmarks" "all" {
+  include_controls = false
+}
+
+# build a crosswalk of the control IDs of each section of the CIS AWS benchmarks
+output "cis_aws_control_ids" {
+  value = {
+    for benchmark in data.crowdstrike_cloud_compliance_benchmarks.cis_aws.benchmarks :
+    "${benchmark.name} ${benchmark.version}" => {
+      for section, content in benchmark.sections :
+      section => [for control in content.controls : control.id]
+    }
+  }
+}
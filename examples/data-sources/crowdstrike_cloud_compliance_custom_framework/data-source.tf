@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# look up a custom framework by name
+data "crowdstrike_cloud_compliance_custom_framework" "by_name" {
+  name = "Storage Framework"
+}
+
+# look up a custom framework by ID
+data "crowdstrike_cloud_compliance_custom_framework" "by_id" {
+  id = "a1b2c3d4-e5f6-7890-abcd-ef1234567890"
+}
+
+# section and control keys are generated from their names
+output "s3_control_rules" {
+  value = data.crowdstrike_cloud_compliance_custom_framework.by_name.sections["storage"].controls["s3-buckets-are-protected"].rules
+}
+
+# the definition of the framework, to create it in another CID with the
+# import_json attribute of the crowdstrike_cloud_compliance_custom_framework resource
+output "storage_framework_json" {
+  value = data.crowdstrike_cloud_compliance_custom_framework.by_name.export_json
+}
@@ -0,0 +1,47 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# retrieve the high severity AWS S3 rules
+data "crowdstrike_cloud_compliance_rules" "s3" {
+  cloud_provider = "AWS"
+  service        = "S3"
+  severity       = "high"
+}
+
+# retrieve the rules of a benchmark
+data "crowdstrike_cloud_compliance_rules" "cis" {
+  benchmark = "CIS 1.0.0 AWS*"
+}
+
+# query by FQL filter
+data "crowdstrike_cloud_compliance_rules" "fql" {
+  fql = "rule_provider:'Azure'+rule_name:*'*storage*'"
+}
+
+# assign the rules to a control of a custom framework
+resource "crowdstrike_cloud_compliance_custom_framework" "example" {
+  name        = "Storage Framework"
+  description = "Rules for storage services"
+
+  sections = {
+    "storage" = {
+      name = "Storage"
+      controls = {
+        "s3" = {
+          name        = "S3 buckets are protected"
+          description = "High severity S3 rules"
+          rules       = data.crowdstrike_cloud_compliance_rules.s3.ids
+        }
+      }
+    }
+  }
+}
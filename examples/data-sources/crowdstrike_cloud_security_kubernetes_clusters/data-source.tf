@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# All Kubernetes clusters hosted in AWS
+data "crowdstrike_cloud_security_kubernetes_clusters" "aws" {
+  filter = "cloud_name:'aws'"
+  sort   = "cluster_name.asc"
+}
+
+output "aws_cluster_ids" {
+  value = {
+    for cluster in data.crowdstrike_cloud_security_kubernetes_clusters.aws.clusters :
+    cluster.cluster_name => cluster.cluster_id
+  }
+}
+
+# Clusters without an active admission controller
+output "clusters_without_kac" {
+  value = [
+    for cluster in data.crowdstrike_cloud_security_kubernetes_clusters.aws.clusters :
+    cluster.cluster_name if cluster.kac_agent_active != true
+  ]
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+data "crowdstrike_discover_applications" "chrome" {
+  vendor            = "Google*"
+  name              = "Google Chrome"
+  include_host_info = true
+}
+
+# Number of installations per Chrome version.
+output "chrome_versions" {
+  value = {
+    for version, apps in {
+      for a in data.crowdstrike_discover_applications.chrome.applications : a.version => a...
+    } : version => length(apps)
+  }
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+data "crowdstrike_external_assets" "critical" {
+  domain      = "*.example.com"
+  criticality = ["Critical", "High"]
+}
+
+# Exposed IP addresses that are not managed in this configuration.
+output "unmanaged_exposed_ips" {
+  value = setsubtract(
+    [for a in data.crowdstrike_external_assets.critical.assets : a.ip_address if a.ip_address != null],
+    ["203.0.113.10", "203.0.113.11"],
+  )
+}
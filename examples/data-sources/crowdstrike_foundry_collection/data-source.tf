@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+data "crowdstrike_foundry_collection" "example" {
+  name = "approved_domains"
+}
+
+output "collection_schema" {
+  value = jsondecode(data.crowdstrike_foundry_collection.example.schema)
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Get the IDs of all host groups
+data "crowdstrike_host_group_ids" "all" {}
+
+# Adopt every existing host group into Terraform. Run
+# `terraform plan -generate-config-out=generated.tf` to generate their configuration.
+import {
+  for_each = toset(data.crowdstrike_host_group_ids.all.ids)
+  to       = crowdstrike_host_group.adopted[each.key]
+  id       = each.key
+}
@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+resource "crowdstrike_host_group" "linux" {
+  name            = "Linux servers"
+  description     = "All Linux servers"
+  type            = "dynamic"
+  assignment_rule = "platform_name:'Linux'+product_type_desc:'Server'"
+}
+
+# Get the hosts currently matching the assignment rule of the host group
+data "crowdstrike_host_group_members" "linux" {
+  host_group_id = crowdstrike_host_group.linux.id
+}
+
+# Only the members of the host group seen in the last day
+data "crowdstrike_host_group_members" "linux_online" {
+  host_group_id = crowdstrike_host_group.linux.id
+  filter        = "last_seen:>'now-1d'"
+}
+
+output "linux_server_count" {
+  value = length(data.crowdstrike_host_group_members.linux.ids)
+}
@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Linux web servers seen in the last week
+data "crowdstrike_hosts" "web" {
+  filter = "platform_name:'Linux'+tags:'SensorGroupingTags/web'+last_seen:>'now-7d'"
+  sort   = "hostname.asc"
+}
+
+# Seed a static host group with the hosts found
+resource "crowdstrike_host_group" "web" {
+  name        = "Web servers"
+  description = "Linux web servers seen in the last week"
+  type        = "staticByID"
+  host_ids    = data.crowdstrike_hosts.web.ids
+}
+
+# Windows hosts of an organizational unit
+data "crowdstrike_hosts" "finance" {
+  filter    = "platform_name:'Windows'+ou:'Finance'"
+  max_items = 500
+}
+
+output "finance_hostnames" {
+  value = [for host in data.crowdstrike_hosts.finance.hosts : host.hostname]
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Actors that target the financial services industry.
+data "crowdstrike_intel_actors" "financial" {
+  target_industries = ["Financial Services"]
+  filter            = "status:'Active'"
+}
+
+# A single actor by name.
+data "crowdstrike_intel_actors" "fancy_bear" {
+  name = "FANCY BEAR"
+}
+
+output "financial_actor_names" {
+  value = [for a in data.crowdstrike_intel_actors.financial.actors : a.name]
+}
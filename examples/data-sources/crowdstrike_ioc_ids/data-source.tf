@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Get the IDs of the indicators published by a source
+data "crowdstrike_ioc_ids" "threat_intel" {
+  filter = "source:'threat-intel'"
+}
+
+output "threat_intel_indicator_count" {
+  value = length(data.crowdstrike_ioc_ids.threat_intel.ids)
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Get the IDs of the Windows prevention policies, excluding the default policy
+data "crowdstrike_prevention_policy_ids" "windows" {
+  filter = "platform_name:'Windows'+name.raw:!'platform_default'"
+}
+
+# Adopt the existing Windows prevention policies into Terraform
+import {
+  for_each = toset(data.crowdstrike_prevention_policy_ids.windows.ids)
+  to       = crowdstrike_prevention_policy_windows.adopted[each.key]
+  id       = each.key
+}
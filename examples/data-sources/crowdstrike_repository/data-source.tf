@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Warn during plan when the repository reaches 90% of its storage or daily ingest limits.
+data "crowdstrike_repository" "third_party" {
+  name                      = "3pi_parsers"
+  include_daily_ingest      = true
+  storage_limit_bytes       = 5 * 1024 * 1024 * 1024 * 1024
+  daily_ingest_limit_bytes  = 50 * 1024 * 1024 * 1024
+  warning_threshold_percent = 90
+}
+
+output "repository_usage" {
+  value = {
+    storage_percent      = data.crowdstrike_repository.third_party.storage_usage_percent
+    daily_ingest_percent = data.crowdstrike_repository.third_party.daily_ingest_usage_percent
+  }
+}
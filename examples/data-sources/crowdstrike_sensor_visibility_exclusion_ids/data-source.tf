@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Get the IDs of the sensor visibility exclusions applied globally
+data "crowdstrike_sensor_visibility_exclusion_ids" "global" {
+  filter = "applied_globally:true"
+}
+
+# Adopt the existing exclusions into Terraform
+import {
+  for_each = toset(data.crowdstrike_sensor_visibility_exclusion_ids.global.ids)
+  to       = crowdstrike_sensor_visibility_exclusion.adopted[each.key]
+  id       = each.key
+}
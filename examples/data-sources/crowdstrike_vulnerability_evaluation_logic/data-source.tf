@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+data "crowdstrike_vulnerability_evaluation_logic" "example" {
+  ids = [
+    "00000000000000000000000000000000_00000000000000000000000000000000",
+  ]
+}
+
+output "detection_methods" {
+  value = {
+    for v in data.crowdstrike_vulnerability_evaluation_logic.example.vulnerabilities : v.cve_id => distinct(flatten([
+      for p in v.affected_products : [for c in p.checks : c.type]
+    ]))
+  }
+}
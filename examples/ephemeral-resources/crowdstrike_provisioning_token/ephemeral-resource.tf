@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+resource "crowdstrike_provisioning_token" "example" {
+  label = "autoscaling"
+}
+
+ephemeral "crowdstrike_provisioning_token" "example" {
+  id = crowdstrike_provisioning_token.example.id
+}
+
+# Pass the token to a provider or write-only attribute that accepts
+# ephemeral values, such as a secret stored in a secrets manager.
+resource "aws_secretsmanager_secret_version" "provisioning_token" {
+  secret_id                = "falcon-provisioning-token"
+  secret_string_wo         = ephemeral.crowdstrike_provisioning_token.example.value
+  secret_string_wo_version = 1
+}
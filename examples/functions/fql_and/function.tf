@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+resource "crowdstrike_host_group" "windows_web" {
+  name        = "Windows web servers"
+  description = "Windows hosts in the web tier"
+  type        = "dynamic"
+
+  assignment_rule = provider::crowdstrike::fql_and({
+    platform_name = "Windows"
+    tags          = "SensorGroupingTags/web"
+  })
+}
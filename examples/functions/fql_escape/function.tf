@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+variable "site" {
+  type    = string
+  default = "O'Hare"
+}
+
+resource "crowdstrike_host_group" "site" {
+  name            = "Site ${var.site}"
+  description     = "Hosts tagged with the site name"
+  type            = "dynamic"
+  assignment_rule = "tags:'SensorGroupingTags/${provider::crowdstrike::fql_escape(var.site)}'"
+}
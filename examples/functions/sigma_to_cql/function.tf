@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+resource "crowdstrike_correlation_rule" "whoami" {
+  name     = "Whoami execution"
+  severity = 50
+  status   = "active"
+
+  search = {
+    query = join("\n| ", [
+      "#event_simpleName=ProcessRollup2",
+      provider::crowdstrike::sigma_to_cql(
+        file("${path.module}/rules/proc_creation_win_whoami_execution.yml"),
+        {
+          Image = "ImageFileName"
+        },
+      ),
+    ])
+    lookback     = "1h0m"
+    outcome      = "detection"
+    trigger_mode = "verbose"
+  }
+
+  schedule = {
+    definition = "@every 1h0m"
+  }
+}
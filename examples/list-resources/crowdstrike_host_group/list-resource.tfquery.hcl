@@ -0,0 +1,9 @@
+# List dynamic host groups to generate import blocks with:
+# terraform query -generate-config-out=generated.tf
+list "crowdstrike_host_group" "dynamic" {
+  provider = crowdstrike
+
+  config {
+    filter = "group_type:'dynamic'"
+  }
+}
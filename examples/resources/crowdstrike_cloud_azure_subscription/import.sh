@@ -0,0 +1,2 @@
+# An enrolled Azure subscription can be imported by its tenant ID and subscription ID.
+terraform import crowdstrike_cloud_azure_subscription.production 00000000-0000-0000-0000-000000000003/00000000-0000-0000-0000-000000000002
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+resource "crowdstrike_cloud_azure_tenant" "tenant" {
+  tenant_id                      = "00000000-0000-0000-0000-000000000003"
+  microsoft_graph_permission_ids = ["9a5d68dd-52b0-4cc2-bd40-abcf44ac3a30"]
+
+  # Subscriptions are enrolled with crowdstrike_cloud_azure_subscription.
+  lifecycle {
+    ignore_changes = [subscription_ids]
+  }
+}
+
+resource "crowdstrike_cloud_azure_subscription" "production" {
+  tenant_id       = crowdstrike_cloud_azure_tenant.tenant.tenant_id
+  subscription_id = "00000000-0000-0000-0000-000000000002"
+}
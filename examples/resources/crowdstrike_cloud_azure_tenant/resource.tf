@@ -16,6 +16,11 @@ resource "crowdstrike_cloud_azure_tenant" "org" {
   realtime_visibility = {
     enabled = true
   }
+  dspm = {
+    enabled              = true
+    regions              = ["eastus", "westeurope"]
+    host_subscription_id = "00000000-0000-0000-0000-000000000002"
+  }
   resource_name_prefix = "1234567"
   environment          = "123"
   management_group_ids = []
@@ -30,3 +35,7 @@ resource "crowdstrike_cloud_azure_tenant" "org" {
 output "tenant_registration" {
   value = crowdstrike_cloud_azure_tenant.org.cs_azure_client_id
 }
+
+output "admin_consent_url" {
+  value = crowdstrike_cloud_azure_tenant.org.admin_consent_url
+}
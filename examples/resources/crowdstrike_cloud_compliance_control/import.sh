@@ -0,0 +1,2 @@
+# Cloud Compliance Control can be imported by specifying the id.
+terraform import crowdstrike_cloud_compliance_control.example 123e4567-e89b-12d3-a456-426614174000
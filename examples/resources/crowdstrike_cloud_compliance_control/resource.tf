@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# the framework does not set sections, so its controls can be managed individually
+resource "crowdstrike_cloud_compliance_custom_framework" "example" {
+  name        = "example-framework"
+  description = "An example framework with controls managed by other modules"
+}
+
+# only CSPM IOM rules can be assigned to controls
+data "crowdstrike_cloud_security_rules" "s3" {
+  fql = "rule_provider:'AWS'+rule_service:'S3'+rule_domain:'CSPM'+rule_subdomain:'IOM'"
+}
+
+resource "crowdstrike_cloud_compliance_control" "storage_encryption" {
+  framework_id = crowdstrike_cloud_compliance_custom_framework.example.id
+  section      = "Storage"
+  name         = "Buckets are encrypted"
+  description  = "S3 buckets must be encrypted at rest"
+  rules        = [for rule in data.crowdstrike_cloud_security_rules.s3.rules : rule.id]
+}
+
+resource "crowdstrike_cloud_compliance_control" "storage_review" {
+  framework_id = crowdstrike_cloud_compliance_custom_framework.example.id
+  section      = "Storage"
+  name         = "Buckets are reviewed"
+  description  = "S3 buckets are reviewed quarterly"
+}
@@ -1,2 +1,5 @@
 # Cloud Compliance Custom Framework can be imported by specifying the id.
 terraform import crowdstrike_cloud_compliance_custom_framework.example 123e4567-e89b-12d3-a456-426614174000
+
+# Alternatively, import it by its name.
+terraform import crowdstrike_cloud_compliance_custom_framework.example 'name=My Custom Framework'
@@ -10,6 +10,24 @@ provider "crowdstrike" {
   cloud = "us-2"
 }
 
+# custom rules are assigned to controls by their ID
+resource "crowdstrike_cloud_security_custom_rule" "tagged_instances" {
+  resource_type  = "AWS::EC2::Instance"
+  name           = "Instances are tagged"
+  description    = "EC2 instances must have an owner tag"
+  cloud_provider = "AWS"
+  severity       = "medium"
+  remediation_info = [
+    "Add an owner tag to the instance",
+  ]
+  logic = <<EOF
+package crowdstrike
+default result = "pass"
+result = "fail" if {
+  not input.tags.owner
+}
+EOF
+}
 
 resource "crowdstrike_cloud_compliance_custom_framework" "example" {
   name        = "example-framework"
@@ -28,6 +46,14 @@ resource "crowdstrike_cloud_compliance_custom_framework" "example" {
           description = "This is another control in section 1"
           rules       = ["id4", "id5"]
         }
+        "control-1c" = {
+          name        = "Control 1c"
+          description = "The AWS S3 rules, resolved when the filter changes"
+          # rules_filter is an alternative to rules
+          rules_filter = "rule_provider:'AWS'+rule_service:'S3'"
+          # resolve the filter on each plan to assign new matching rules
+          refresh_rules = true
+        }
       }
     }
     "section-2" = {
@@ -36,13 +62,70 @@ resource "crowdstrike_cloud_compliance_custom_framework" "example" {
         "control-2" = {
           name        = "Control 2"
           description = "This is the second control"
-          rules       = []
+          rules       = [crowdstrike_cloud_security_custom_rule.tagged_instances.id]
         }
       }
     }
   }
 }
 
+# copy the sections, controls and rules of a benchmark into a new framework,
+# allowing more time to create the hundreds of controls of the benchmark
+data "crowdstrike_cloud_compliance_benchmarks" "cis_aws" {
+  name             = "CIS 1.0.0 AWS*"
+  include_controls = false
+}
+
+resource "crowdstrike_cloud_compliance_custom_framework" "cis_copy" {
+  name                    = "CIS AWS (tailored)"
+  description             = "A copy of the CIS AWS benchmark managed with Terraform"
+  clone_from_framework_id = one(data.crowdstrike_cloud_compliance_benchmarks.cis_aws.ids)
+
+  timeouts {
+    create = "45m"
+    update = "45m"
+  }
+}
+
+# create a framework from a definition exported by the export_json attribute of
+# the crowdstrike_cloud_compliance_custom_framework data source
+resource "crowdstrike_cloud_compliance_custom_framework" "imported" {
+  name        = "Storage Framework"
+  description = "Storage framework shared from another CID"
+  import_json = file("${path.module}/storage-framework.json")
+}
+
+# keep sections and controls in the order auditors expect with ordered_sections
+resource "crowdstrike_cloud_compliance_custom_framework" "ordered" {
+  name        = "Ordered Framework"
+  description = "Framework with numbered sections and controls"
+
+  ordered_sections = [
+    {
+      name = "1. Identity"
+      controls = [
+        {
+          name        = "1.1 MFA"
+          description = "Users have MFA enabled"
+        },
+        {
+          name        = "1.2 Root Account"
+          description = "The root account is not used"
+        },
+      ]
+    },
+    {
+      name = "2. Storage"
+      controls = [
+        {
+          name        = "2.1 Encryption"
+          description = "Storage is encrypted at rest"
+        },
+      ]
+    },
+  ]
+}
+
 output "cloud_compliance_custom_framework" {
   value = crowdstrike_cloud_compliance_custom_framework.example
 }
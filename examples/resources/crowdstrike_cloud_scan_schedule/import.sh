@@ -0,0 +1,2 @@
+# The scan schedule of a cloud platform can be imported by the name of the platform.
+terraform import crowdstrike_cloud_scan_schedule.aws aws
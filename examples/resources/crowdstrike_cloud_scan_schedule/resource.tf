@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+resource "crowdstrike_cloud_scan_schedule" "aws" {
+  cloud_platform = "aws"
+  scan_schedule  = "24h"
+}
+
+output "next_aws_scan" {
+  value = crowdstrike_cloud_scan_schedule.aws.next_scan_timestamp
+}
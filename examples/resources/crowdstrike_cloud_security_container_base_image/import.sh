@@ -0,0 +1,2 @@
+# Base Image can be imported by specifying the id.
+terraform import crowdstrike_cloud_security_container_base_image.example 7fb858a949034a0cbca175f660f1e769
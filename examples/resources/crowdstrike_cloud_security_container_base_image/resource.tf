@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Base image identified by its registry, repository and tag
+resource "crowdstrike_cloud_security_container_base_image" "alpine" {
+  registry   = "docker.io"
+  repository = "library/alpine"
+  tag        = "3.20"
+}
+
+# Base image identified by its digest
+resource "crowdstrike_cloud_security_container_base_image" "debian" {
+  registry     = "docker.io"
+  repository   = "library/debian"
+  image_digest = "sha256:0bb606aad3307370c8b4502eff11fde298e5b7721e59a0da3ce9b30cb92045ed"
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+resource "crowdstrike_cloud_security_image_assessment_exclusions" "example" {
+  conditions = [
+    {
+      property    = "cve_id"
+      values      = ["CVE-2023-44487", "CVE-2024-3094"]
+      description = "Not exploitable in our images, see SEC-1234"
+      ttl         = 90
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# The settings of an IOM policy for all accounts can be imported by policy ID.
+terraform import crowdstrike_cloud_security_policy_setting.all_accounts 13
@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Lower the severity of a policy for all accounts.
+resource "crowdstrike_cloud_security_policy_setting" "all_accounts" {
+  policy_id = 13
+  enabled   = true
+  severity  = "informational"
+}
+
+# Disable a policy for sandbox accounts in a single region.
+resource "crowdstrike_cloud_security_policy_setting" "sandbox" {
+  policy_id   = 42
+  enabled     = false
+  account_ids = ["123456789012"]
+  regions     = ["us-east-1"]
+}
+
+# Raise the severity of a behavioral (IOA) policy.
+resource "crowdstrike_cloud_security_policy_setting" "ioa" {
+  policy_id = 320
+  enabled   = true
+  severity  = "critical"
+}
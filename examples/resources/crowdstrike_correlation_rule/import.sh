@@ -0,0 +1,2 @@
+# Correlation rules can be imported by specifying the rule ID.
+terraform import crowdstrike_correlation_rule.example <correlation_rule_id>
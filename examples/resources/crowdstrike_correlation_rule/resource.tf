@@ -0,0 +1,45 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+resource "crowdstrike_correlation_rule" "example" {
+  name        = "Brute force logons"
+  description = "Alert on repeated failed logons for a single user"
+  severity    = 70
+  status      = "active"
+
+  search = {
+    query        = <<-EOT
+      #event_simpleName=UserLogonFailed2
+      | groupBy([UserName], function=count())
+      | _count > 10
+    EOT
+    lookback     = "1h0m"
+    outcome      = "detection"
+    trigger_mode = "summary"
+  }
+
+  schedule = {
+    definition = "@every 1h0m"
+  }
+
+  notifications = [
+    {
+      type       = "email"
+      recipients = ["soc@example.com"]
+    },
+    {
+      type      = "webhook"
+      config_id = "00000000000000000000000000000000"
+      plugin_id = "webhook"
+    },
+  ]
+}
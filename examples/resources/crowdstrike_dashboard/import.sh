@@ -0,0 +1,3 @@
+#!/bin/sh
+# The import ID is the search domain and dashboard ID separated by a forward slash.
+terraform import crowdstrike_dashboard.example all/<dashboard_id>
@@ -0,0 +1,51 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Manage a dashboard exported from the Falcon console.
+resource "crowdstrike_dashboard" "soc_overview" {
+  search_domain = "all"
+  definition    = file("${path.module}/dashboards/soc_overview.json")
+}
+
+# Dashboards can also be defined inline.
+resource "crowdstrike_dashboard" "failed_logons" {
+  search_domain = "all"
+  definition = jsonencode({
+    name            = "Failed logons"
+    updateFrequency = "never"
+    timeSelector    = {}
+    sharedTimeInterval = {
+      enabled = true
+      isLive  = false
+      start   = "1d"
+    }
+    widgets = {
+      total = {
+        x             = 0
+        y             = 0
+        height        = 4
+        width         = 6
+        title         = "Failed logons"
+        type          = "query"
+        queryString   = "#event_simpleName=UserLogonFailed2 | count()"
+        visualization = "single-value"
+        start         = "1d"
+        end           = "now"
+        isLive        = false
+      }
+    }
+  })
+}
+
+output "dashboard" {
+  value = crowdstrike_dashboard.failed_logons
+}
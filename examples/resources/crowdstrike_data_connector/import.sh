@@ -0,0 +1,2 @@
+# Data connectors can be imported by specifying the data connection ID.
+terraform import crowdstrike_data_connector.example <data_connection_id>
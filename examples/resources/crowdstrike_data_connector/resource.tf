@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+variable "okta_api_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "crowdstrike_data_connector" "okta" {
+  name         = "Okta system log"
+  description  = "Okta system log events"
+  connector_id = "okta-sso"
+  parser       = "okta-sso"
+
+  enable_user_enrichment = true
+
+  config = {
+    name = "okta"
+    params = jsonencode({
+      domain           = "example.okta.com"
+      polling_interval = 300
+    })
+  }
+
+  credentials_wo = jsonencode({
+    api_token = var.okta_api_token
+  })
+  credentials_wo_version = 1
+}
+
+output "data_connector" {
+  value = crowdstrike_data_connector.okta
+}
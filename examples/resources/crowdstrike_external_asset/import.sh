@@ -0,0 +1,2 @@
+# External assets can be imported by specifying the asset ID.
+terraform import crowdstrike_external_asset.example 00000000000000000000000000000000
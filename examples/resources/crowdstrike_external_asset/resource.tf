@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+variable "acquired_domains" {
+  type    = set(string)
+  default = ["example.com", "example.net"]
+}
+
+resource "crowdstrike_external_asset" "acquired" {
+  for_each = var.acquired_domains
+
+  value         = each.value
+  subsidiary_id = "00000000000000000000000000000000"
+  criticality   = "High"
+}
@@ -0,0 +1,2 @@
+# Foundry collection objects can be imported by specifying the collection name and object key.
+terraform import crowdstrike_foundry_collection_object.example <collection_name>/<object_key>
@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+data "crowdstrike_foundry_collection" "approved_domains" {
+  name = "approved_domains"
+}
+
+resource "crowdstrike_foundry_collection_object" "example" {
+  collection_name = data.crowdstrike_foundry_collection.approved_domains.name
+  object_key      = "example-com"
+
+  value = jsonencode({
+    domain   = "example.com"
+    approved = true
+    owner    = "secops"
+  })
+}
+
+output "foundry_collection_object" {
+  value = crowdstrike_foundry_collection_object.example
+}
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Hide the hosts of a decommissioned fleet by ID
+resource "crowdstrike_hidden_hosts" "decommissioned" {
+  host_ids = [
+    "1d7b9c8e2a3f4e5d6c7b8a9f0e1d2c3b",
+    "2e8c0d9f3b4a5f6e7d8c9b0a1f2e3d4c",
+  ]
+}
+
+# Hide hosts tagged for retirement, and restore them if the resource is destroyed
+resource "crowdstrike_hidden_hosts" "retired" {
+  filter            = "tags:'SensorGroupingTags/retired'"
+  unhide_on_destroy = true
+}
@@ -0,0 +1,2 @@
+# IOC feeds can be imported by specifying the source shared by their indicators.
+terraform import crowdstrike_ioc_feed.example <source>
@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# One indicator per line, for example exported from an internal threat feed.
+locals {
+  blocked_domains = compact(split("\n", file("${path.module}/blocked_domains.txt")))
+}
+
+resource "crowdstrike_ioc_feed" "internal" {
+  source      = "internal-threat-feed"
+  action      = "detect"
+  severity    = "high"
+  description = "Domains from the internal threat intelligence feed"
+  platforms   = ["windows", "mac", "linux"]
+  tags        = ["internal-feed"]
+
+  indicators = [
+    for domain in local.blocked_domains : {
+      type  = "domain"
+      value = lower(domain)
+    }
+  ]
+}
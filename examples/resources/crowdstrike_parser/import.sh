@@ -0,0 +1,3 @@
+#!/bin/sh
+# The import ID is the repository and parser ID separated by a forward slash.
+terraform import crowdstrike_parser.example parsers-repository/<parser_id>
@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+resource "crowdstrike_parser" "example" {
+  name       = "acme-vpn"
+  repository = "parsers-repository"
+  script     = <<-EOT
+    parseJson()
+    | event.module := "acme-vpn"
+    | rename(field="user", as="user.name")
+  EOT
+
+  fields_to_tag = ["event.module"]
+
+  # Test cases run against the planned script during terraform plan.
+  test_cases = [
+    {
+      event = jsonencode({ user = "alice", action = "login", result = "failure" })
+      assertions = [
+        {
+          expected_fields = {
+            "user.name"    = "alice"
+            "event.module" = "acme-vpn"
+          }
+          absent_fields = ["user"]
+        }
+      ]
+    }
+  ]
+}
+
+output "parser" {
+  value = crowdstrike_parser.example
+}
@@ -1,2 +1,5 @@
 # prevention policy can be imported by specifying the policy id.
 terraform import crowdstrike_prevention_policy_linux.example 7fb858a949034a0cbca175f660f1e769
+
+# The policy of a member CID can be imported by prefixing the id with the member CID.
+terraform import crowdstrike_prevention_policy_linux.example 0123456789abcdef0123456789abcdef/7fb858a949034a0cbca175f660f1e769
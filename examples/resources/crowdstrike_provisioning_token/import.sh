@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing installation token by ID
+terraform import crowdstrike_provisioning_token.example <token-id>
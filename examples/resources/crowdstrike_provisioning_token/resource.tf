@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+    time = {
+      source = "hashicorp/time"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+# Rotate the token every 30 days.
+resource "time_rotating" "token" {
+  rotation_days = 30
+}
+
+resource "crowdstrike_provisioning_token" "example" {
+  label = "image-build"
+
+  # Keep the previous token valid for a week after rotation so instances
+  # launched from older images can still register.
+  expires_at = timeadd(time_rotating.token.rotation_rfc3339, "168h")
+
+  lifecycle {
+    create_before_destroy = true
+    replace_triggered_by  = [time_rotating.token]
+  }
+}
+
+# Read the token value without storing it in state (Terraform 1.10 or later).
+ephemeral "crowdstrike_provisioning_token" "example" {
+  id = crowdstrike_provisioning_token.example.id
+}
@@ -1 +1,4 @@
 terraform import crowdstrike_response_policy.example abc123
+
+# The response policy of a member CID can be imported by prefixing the id with the member CID.
+terraform import crowdstrike_response_policy.example 0123456789abcdef0123456789abcdef/abc123
@@ -0,0 +1,2 @@
+# Response Policy Attachment can be imported by specifying the id.
+terraform import crowdstrike_response_policy_attachment.example 7fb858a949034a0cbca175f660f1e769
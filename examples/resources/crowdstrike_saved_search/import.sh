@@ -0,0 +1,3 @@
+#!/bin/sh
+# The import ID is the search domain and saved search ID separated by a forward slash.
+terraform import crowdstrike_saved_search.example all/<saved_search_id>
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    crowdstrike = {
+      source = "registry.terraform.io/crowdstrike/crowdstrike"
+    }
+  }
+}
+
+provider "crowdstrike" {
+  cloud = "us-2"
+}
+
+resource "crowdstrike_saved_search" "example" {
+  name          = "Failed logins by user"
+  description   = "Failed logon attempts grouped by user name"
+  search_domain = "all"
+  query         = <<-EOT
+    #event_simpleName=UserLogonFailed2
+    | groupBy([UserName])
+  EOT
+}
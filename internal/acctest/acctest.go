@@ -27,8 +27,12 @@ provider "crowdstrike" {}
 type OptionalEnvVar string
 
 const (
-	RequireHostGroupID    OptionalEnvVar = "HOST_GROUP_ID"
-	RequireIOARuleGroupID OptionalEnvVar = "IOA_RULE_GROUP_ID"
+	RequireHostGroupID       OptionalEnvVar = "HOST_GROUP_ID"
+	RequireIOARuleGroupID    OptionalEnvVar = "IOA_RULE_GROUP_ID"
+	RequireFoundryCollection OptionalEnvVar = "FOUNDRY_COLLECTION_NAME"
+	RequireVulnerabilityID   OptionalEnvVar = "SPOTLIGHT_VULNERABILITY_ID"
+	RequireSubsidiaryID      OptionalEnvVar = "EXPOSURE_MANAGEMENT_SUBSIDIARY_ID"
+	RequireCloudAccountID    OptionalEnvVar = "CLOUD_COMPLIANCE_ACCOUNT_ID"
 )
 
 // ConfigCompose can be called to concatenate multiple strings to build test configurations.
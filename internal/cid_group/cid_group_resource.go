@@ -13,6 +13,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                = &cidGroupResource{}
 	_ resource.ResourceWithConfigure   = &cidGroupResource{}
 	_ resource.ResourceWithImportState = &cidGroupResource{}
+	_ resource.ResourceWithIdentity    = &cidGroupResource{}
 )
 
 var apiScopes = []scopes.Scope{
@@ -47,11 +49,12 @@ type cidGroupResource struct {
 }
 
 type CIDGroupResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	CIDs        types.Set    `tfsdk:"cids"`
-	CID         types.String `tfsdk:"cid"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	CIDs        types.Set      `tfsdk:"cids"`
+	CID         types.String   `tfsdk:"cid"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *cidGroupResource) Configure(
@@ -78,6 +81,7 @@ func (r *cidGroupResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
 }
 
 func (r *cidGroupResource) Metadata(
@@ -89,7 +93,7 @@ func (r *cidGroupResource) Metadata(
 }
 
 func (r *cidGroupResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -137,9 +141,21 @@ func (r *cidGroupResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *cidGroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 func (m *CIDGroupResourceModel) wrap(cidGroup *models.DomainCIDGroup) {
 	if cidGroup.CidGroupID != nil {
 		m.ID = types.StringValue(*cidGroup.CidGroupID)
@@ -162,6 +178,9 @@ func (r *cidGroupResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	res, multi, err := r.client.Mssp.CreateCIDGroups(&mssp.CreateCIDGroupsParams{
 		Context: ctx,
 		Body: &models.DomainCIDGroupsRequestV1{
@@ -230,6 +249,7 @@ func (r *cidGroupResource) Create(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cidGroupResource) Read(
@@ -243,6 +263,9 @@ func (r *cidGroupResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	cidGroupID := state.ID.ValueString()
 
 	cidGroup, diag := r.getCIDGroupByID(ctx, cidGroupID)
@@ -271,6 +294,7 @@ func (r *cidGroupResource) Read(
 	state.CIDs = cidsSet
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *cidGroupResource) Update(
@@ -285,6 +309,9 @@ func (r *cidGroupResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	res, multi, err := r.client.Mssp.UpdateCIDGroups(&mssp.UpdateCIDGroupsParams{
 		Context: ctx,
 		Body: &models.DomainCIDGroupsRequestV1{
@@ -358,6 +385,7 @@ func (r *cidGroupResource) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cidGroupResource) Delete(
@@ -371,6 +399,9 @@ func (r *cidGroupResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	if !state.CIDs.IsNull() && len(state.CIDs.Elements()) > 0 {
 		var cids []string
 		resp.Diagnostics.Append(state.CIDs.ElementsAs(ctx, &cids, false)...)
@@ -413,7 +444,7 @@ func (r *cidGroupResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *cidGroupResource) getCIDGroupByID(ctx context.Context, cidGroupID string) (*models.DomainCIDGroup, diag.Diagnostic) {
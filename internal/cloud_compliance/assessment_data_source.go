@@ -0,0 +1,645 @@
+package cloudcompliance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon"
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/cloud_security_assets"
+	"github.com/crowdstrike/gofalcon/falcon/client/cloud_security_compliance"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &cloudComplianceAssessmentDataSource{}
+	_ datasource.DataSourceWithConfigure = &cloudComplianceAssessmentDataSource{}
+)
+
+// cloudComplianceAssessmentQueryLimit is the page size used when querying the
+// failing resources.
+const cloudComplianceAssessmentQueryLimit = 500
+
+var cloudComplianceAssessmentScopes = []scopes.Scope{
+	{
+		Name:  "Cloud Security Policies",
+		Read:  true,
+		Write: false,
+	},
+	{
+		Name:  "Cloud Security Assets",
+		Read:  true,
+		Write: false,
+	},
+}
+
+func NewCloudComplianceAssessmentDataSource() datasource.DataSource {
+	return &cloudComplianceAssessmentDataSource{}
+}
+
+type cloudComplianceAssessmentDataSource struct {
+	client    *client.CrowdStrikeAPISpecification
+	readCache *config.ReadCache
+}
+
+type cloudComplianceAssessmentDataSourceModel struct {
+	FrameworkID             types.String `tfsdk:"framework_id"`
+	AccountID               types.String `tfsdk:"account_id"`
+	IncludeFailingResources types.Bool   `tfsdk:"include_failing_resources"`
+	Limit                   types.Int64  `tfsdk:"limit"`
+	MaxItems                types.Int64  `tfsdk:"max_items"`
+	Name                    types.String `tfsdk:"name"`
+	Authority               types.String `tfsdk:"authority"`
+	Version                 types.String `tfsdk:"version"`
+	Score                   types.Int64  `tfsdk:"score"`
+	Passing                 types.Int64  `tfsdk:"passing"`
+	Failing                 types.Int64  `tfsdk:"failing"`
+	Suppressed              types.Int64  `tfsdk:"suppressed"`
+	Sections                types.List   `tfsdk:"sections"`
+	FailingResources        types.List   `tfsdk:"failing_resources"`
+}
+
+type cloudComplianceAssessmentSectionModel struct {
+	Name         types.String `tfsdk:"name"`
+	Score        types.Int64  `tfsdk:"score"`
+	Passing      types.Int64  `tfsdk:"passing"`
+	Failing      types.Int64  `tfsdk:"failing"`
+	Suppressed   types.Int64  `tfsdk:"suppressed"`
+	Requirements types.List   `tfsdk:"requirements"`
+}
+
+func (m cloudComplianceAssessmentSectionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":         types.StringType,
+		"score":        types.Int64Type,
+		"passing":      types.Int64Type,
+		"failing":      types.Int64Type,
+		"suppressed":   types.Int64Type,
+		"requirements": types.ListType{ElemType: types.ObjectType{AttrTypes: cloudComplianceAssessmentRequirementModel{}.AttributeTypes()}},
+	}
+}
+
+type cloudComplianceAssessmentRequirementModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Score      types.Int64  `tfsdk:"score"`
+	Passing    types.Int64  `tfsdk:"passing"`
+	Failing    types.Int64  `tfsdk:"failing"`
+	Suppressed types.Int64  `tfsdk:"suppressed"`
+}
+
+func (m cloudComplianceAssessmentRequirementModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"name":       types.StringType,
+		"score":      types.Int64Type,
+		"passing":    types.Int64Type,
+		"failing":    types.Int64Type,
+		"suppressed": types.Int64Type,
+	}
+}
+
+type cloudComplianceFailingResourceModel struct {
+	ControlName      types.String `tfsdk:"control_name"`
+	CloudProvider    types.String `tfsdk:"cloud_provider"`
+	Region           types.String `tfsdk:"region"`
+	Service          types.String `tfsdk:"service"`
+	ResourceType     types.String `tfsdk:"resource_type"`
+	ResourceTypeName types.String `tfsdk:"resource_type_name"`
+	NonCompliant     types.Int64  `tfsdk:"non_compliant"`
+	Total            types.Int64  `tfsdk:"total"`
+	Severities       types.List   `tfsdk:"severities"`
+	RuleIDs          types.Set    `tfsdk:"rule_ids"`
+	LastEvaluated    types.String `tfsdk:"last_evaluated"`
+}
+
+func (m cloudComplianceFailingResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"control_name":       types.StringType,
+		"cloud_provider":     types.StringType,
+		"region":             types.StringType,
+		"service":            types.StringType,
+		"resource_type":      types.StringType,
+		"resource_type_name": types.StringType,
+		"non_compliant":      types.Int64Type,
+		"total":              types.Int64Type,
+		"severities":         types.ListType{ElemType: types.StringType},
+		"rule_ids":           types.SetType{ElemType: types.StringType},
+		"last_evaluated":     types.StringType,
+	}
+}
+
+// postureAttributes returns the attributes of the posture counts, shared by
+// the framework, its sections and their requirements.
+func postureAttributes(object string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"score": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Compliance score of the %s, as a percentage.", object),
+		},
+		"passing": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Number of passing evaluations of the %s.", object),
+		},
+		"failing": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Number of failing evaluations of the %s.", object),
+		},
+		"suppressed": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Number of suppressed evaluations of the %s.", object),
+		},
+	}
+}
+
+// posture returns the score and counts of posture, which are zero when the
+// API returns no posture.
+func posture(posture *models.CompliancePosture) (score, passing, failing, suppressed types.Int64) {
+	if posture == nil {
+		return types.Int64Value(0), types.Int64Value(0), types.Int64Value(0), types.Int64Value(0)
+	}
+
+	score = types.Int64Value(0)
+	if posture.Score != nil {
+		score = types.Int64Value(int64(*posture.Score))
+	}
+
+	return score,
+		types.Int64Value(int64(posture.Passing)),
+		types.Int64Value(int64(posture.Failing)),
+		types.Int64Value(int64(posture.Suppressed))
+}
+
+func (d *cloudComplianceAssessmentDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = config.Client
+	d.readCache = config.ReadCache
+	resp.Diagnostics.Append(config.CheckScopes(cloudComplianceAssessmentScopes)...)
+}
+
+func (d *cloudComplianceAssessmentDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_compliance_assessment"
+}
+
+func (d *cloudComplianceAssessmentDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	requirementAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Identifier of the requirement.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Name of the requirement.",
+		},
+	}
+	for name, attribute := range postureAttributes("requirement") {
+		requirementAttributes[name] = attribute
+	}
+
+	sectionAttributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Name of the section.",
+		},
+		"requirements": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The assessment of each requirement of the section.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: requirementAttributes,
+			},
+		},
+	}
+	for name, attribute := range postureAttributes("section") {
+		sectionAttributes[name] = attribute
+	}
+
+	attributes := map[string]schema.Attribute{
+		"framework_id": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Identifier of the compliance framework to assess, such as a benchmark from the `crowdstrike_cloud_compliance_benchmarks` data source or a custom compliance framework.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"account_id": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Identifier of the cloud account to assess, such as an AWS account ID, an Azure subscription ID or a GCP project ID.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"include_failing_resources": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "Whether to read `failing_resources`. Set to `false` to only read the compliance counts, which is faster. Defaults to `true`.",
+		},
+		"limit":     utils.PageLimitAttribute("failing resources", cloudComplianceAssessmentQueryLimit, cloudComplianceAssessmentQueryLimit),
+		"max_items": utils.MaxItemsAttribute("failing resources"),
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Name of the compliance framework.",
+		},
+		"authority": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Authority publishing the compliance framework.",
+		},
+		"version": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Version of the compliance framework.",
+		},
+		"sections": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The assessment of each section of the framework.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: sectionAttributes,
+			},
+		},
+		"failing_resources": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The resources failing the controls of the framework, grouped by control, region and resource type, up to `max_items`. Null when `include_failing_resources` is `false`.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"control_name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the failing control.",
+					},
+					"cloud_provider": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Cloud provider of the resources.",
+					},
+					"region": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Region of the resources.",
+					},
+					"service": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Cloud service of the resources.",
+					},
+					"resource_type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Type of the resources. Example: `AWS::S3::Bucket`.",
+					},
+					"resource_type_name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Display name of the type of the resources.",
+					},
+					"non_compliant": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of resources failing the control.",
+					},
+					"total": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of resources evaluated by the control.",
+					},
+					"severities": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Severities of the failing rules.",
+					},
+					"rule_ids": schema.SetAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "IDs of the rules evaluating the control.",
+					},
+					"last_evaluated": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "When the resources were last evaluated, in RFC 3339 format.",
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range postureAttributes("framework") {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Falcon Cloud Security",
+			"This data source reads the compliance assessment of a cloud account against a compliance framework: the passing and failing counts of the framework, its sections and their requirements, and the resources failing its controls. Use it to surface compliance in dashboards or to check it in CI.",
+			cloudComplianceAssessmentScopes,
+		),
+		Attributes: attributes,
+	}
+}
+
+func (d *cloudComplianceAssessmentDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data cloudComplianceAssessmentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	frameworkID := data.FrameworkID.ValueString()
+	accountID := data.AccountID.ValueString()
+	includeFailingResources := data.IncludeFailingResources.ValueBool() || data.IncludeFailingResources.IsNull()
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, cloudComplianceAssessmentQueryLimit)
+
+	type assessment struct {
+		summary          *models.ComplianceFrameworkSummary
+		failingResources []*models.ResourcesComplianceControl
+		total            int64
+	}
+
+	key := fmt.Sprintf(
+		"cloud_compliance_assessment/%s/%s/%t/%d/%d",
+		frameworkID,
+		accountID,
+		includeFailingResources,
+		pagination.Limit,
+		pagination.MaxItems,
+	)
+	result, diags := config.Cached(d.readCache, key, func() (assessment, diag.Diagnostics) {
+		summary, diags := d.getPostureSummary(ctx, frameworkID, accountID)
+		if diags.HasError() || !includeFailingResources {
+			return assessment{summary: summary}, diags
+		}
+
+		failing, total, failingDiags := d.queryFailingResources(ctx, summary, accountID, pagination)
+		diags.Append(failingDiags...)
+
+		return assessment{summary: summary, failingResources: failing, total: total}, diags
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	summary := result.summary
+	data.Name = types.StringPointerValue(summary.Name)
+	data.Authority = types.StringPointerValue(summary.Authority)
+	data.Version = types.StringPointerValue(summary.Version)
+	data.Score, data.Passing, data.Failing, data.Suppressed = posture(summary.Posture)
+
+	data.Sections, diags = assessmentSections(ctx, summary.Sections)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	failingType := types.ObjectType{AttrTypes: cloudComplianceFailingResourceModel{}.AttributeTypes()}
+	if !includeFailingResources {
+		data.FailingResources = types.ListNull(failingType)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	failingResources := make([]cloudComplianceFailingResourceModel, 0, len(result.failingResources))
+	for _, resource := range result.failingResources {
+		model, diags := failingResourceToModel(ctx, resource)
+		resp.Diagnostics.Append(diags...)
+		failingResources = append(failingResources, model)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.FailingResources, diags = types.ListValueFrom(ctx, failingType, failingResources)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("failing resources", len(failingResources), result.total)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getPostureSummary returns the posture of the account for the framework with
+// the given ID.
+func (d *cloudComplianceAssessmentDataSource) getPostureSummary(
+	ctx context.Context,
+	frameworkID string,
+	accountID string,
+) (*models.ComplianceFrameworkSummary, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := cloud_security_compliance.NewCloudComplianceFrameworkPostureSummariesParamsWithContext(ctx)
+	params.SetIds([]string{frameworkID})
+	params.SetFilter(utils.Addr(fmt.Sprintf("account_id:%s", utils.FQLQuote(accountID))))
+
+	res, err := d.client.CloudSecurityCompliance.CloudComplianceFrameworkPostureSummaries(params)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, cloudComplianceAssessmentScopes))
+		return nil, diags
+	}
+
+	if res == nil || res.Payload == nil {
+		diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+		return nil, diags
+	}
+
+	if err := falcon.AssertNoError(res.Payload.Errors); err != nil {
+		diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+		return nil, diags
+	}
+
+	for _, summary := range res.Payload.Resources {
+		if summary != nil && summary.ID != nil && *summary.ID == frameworkID {
+			return summary, diags
+		}
+	}
+
+	diags.Append(tferrors.NewNotFoundError(
+		fmt.Sprintf("No compliance assessment found for framework %q and account %q.", frameworkID, accountID),
+	))
+	return nil, diags
+}
+
+// queryFailingResources returns the resources of the account failing the
+// controls of the framework of summary, with the most failing resources
+// first, and the total number of them.
+func (d *cloudComplianceAssessmentDataSource) queryFailingResources(
+	ctx context.Context,
+	summary *models.ComplianceFrameworkSummary,
+	accountID string,
+	pagination utils.Pagination,
+) ([]*models.ResourcesComplianceControl, int64, diag.Diagnostics) {
+	filter := fmt.Sprintf(
+		"account_id:%s+control.benchmark.name:%s+compliant:false",
+		utils.FQLQuote(accountID),
+		utils.FQLQuote(*summary.Name),
+	)
+	if summary.Version != nil && *summary.Version != "" {
+		filter += fmt.Sprintf("+control.benchmark.version:%s", utils.FQLQuote(*summary.Version))
+	}
+	sort := "resource_counts.non_compliant|desc"
+
+	return utils.QueryPages(pagination, func(offset, limit int64) ([]*models.ResourcesComplianceControl, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		params := cloud_security_assets.NewCloudSecurityAssetsCombinedComplianceByAccountParamsWithContext(ctx)
+		params.SetFilter(&filter)
+		params.SetSort(&sort)
+		params.SetLimit(&limit)
+		params.SetOffset(&offset)
+
+		res, err := d.client.CloudSecurityAssets.CloudSecurityAssetsCombinedComplianceByAccount(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, cloudComplianceAssessmentScopes))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			return nil, 0, diags
+		}
+
+		if err := falcon.AssertNoError(res.Payload.Errors); err != nil {
+			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+			return nil, 0, diags
+		}
+
+		total := int64(-1)
+		if meta := res.Payload.Meta; meta != nil && meta.Pagination != nil && meta.Pagination.Total != nil {
+			total = *meta.Pagination.Total
+		}
+
+		return res.Payload.Resources, total, diags
+	})
+}
+
+// assessmentSections converts the section summaries to their Terraform models.
+func assessmentSections(
+	ctx context.Context,
+	sections []*models.ComplianceSectionSummary,
+) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	sectionType := types.ObjectType{AttrTypes: cloudComplianceAssessmentSectionModel{}.AttributeTypes()}
+	requirementType := types.ObjectType{AttrTypes: cloudComplianceAssessmentRequirementModel{}.AttributeTypes()}
+
+	sectionModels := make([]cloudComplianceAssessmentSectionModel, 0, len(sections))
+	for _, section := range sections {
+		if section == nil {
+			continue
+		}
+
+		requirements := make([]cloudComplianceAssessmentRequirementModel, 0, len(section.Requirements))
+		for _, requirement := range section.Requirements {
+			if requirement == nil {
+				continue
+			}
+
+			model := cloudComplianceAssessmentRequirementModel{
+				ID:   types.StringPointerValue(requirement.ID),
+				Name: types.StringPointerValue(requirement.Name),
+			}
+			model.Score, model.Passing, model.Failing, model.Suppressed = posture(requirement.Posture)
+			requirements = append(requirements, model)
+		}
+
+		requirementList, listDiags := types.ListValueFrom(ctx, requirementType, requirements)
+		diags.Append(listDiags...)
+
+		model := cloudComplianceAssessmentSectionModel{
+			Name:         types.StringPointerValue(section.Name),
+			Requirements: requirementList,
+		}
+		model.Score, model.Passing, model.Failing, model.Suppressed = posture(section.Posture)
+		sectionModels = append(sectionModels, model)
+	}
+	if diags.HasError() {
+		return types.ListNull(sectionType), diags
+	}
+
+	return types.ListValueFrom(ctx, sectionType, sectionModels)
+}
+
+// failingResourceToModel converts the compliance of a group of resources to
+// its Terraform model.
+func failingResourceToModel(
+	ctx context.Context,
+	resource *models.ResourcesComplianceControl,
+) (cloudComplianceFailingResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := cloudComplianceFailingResourceModel{
+		CloudProvider:    types.StringPointerValue(resource.CloudProvider),
+		Region:           types.StringPointerValue(resource.Region),
+		Service:          types.StringPointerValue(resource.Service),
+		ResourceType:     types.StringPointerValue(resource.ResourceType),
+		ResourceTypeName: types.StringPointerValue(resource.ResourceTypeName),
+		NonCompliant:     types.Int64Value(0),
+		Total:            types.Int64Value(0),
+		LastEvaluated:    types.StringNull(),
+		ControlName:      types.StringNull(),
+	}
+
+	if resource.Control != nil {
+		model.ControlName = types.StringPointerValue(resource.Control.Name)
+	}
+
+	if counts := resource.ResourceCounts; counts != nil {
+		if counts.NonCompliant != nil {
+			model.NonCompliant = types.Int64Value(int64(*counts.NonCompliant))
+		}
+		if counts.Total != nil {
+			model.Total = types.Int64Value(int64(*counts.Total))
+		}
+	}
+
+	if resource.LastEvaluated != nil {
+		model.LastEvaluated = types.StringValue(resource.LastEvaluated.String())
+	}
+
+	ruleIDs := make([]string, 0, len(resource.Rules))
+	for _, rule := range resource.Rules {
+		if rule != nil && rule.ID != nil {
+			ruleIDs = append(ruleIDs, *rule.ID)
+		}
+	}
+
+	severities := resource.Severities
+	if severities == nil {
+		severities = []string{}
+	}
+
+	var listDiags diag.Diagnostics
+	model.Severities, listDiags = types.ListValueFrom(ctx, types.StringType, severities)
+	diags.Append(listDiags...)
+	model.RuleIDs, listDiags = types.SetValueFrom(ctx, types.StringType, ruleIDs)
+	diags.Append(listDiags...)
+
+	return model, diags
+}
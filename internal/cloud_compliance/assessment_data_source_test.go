@@ -0,0 +1,67 @@
+package cloudcompliance_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccCloudComplianceAssessmentDataSource(t *testing.T) {
+	dataSourceName := "data.crowdstrike_cloud_compliance_assessment.test"
+	accountID := os.Getenv(string(acctest.RequireCloudAccountID))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t, acctest.RequireCloudAccountID) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + fmt.Sprintf(`
+data "crowdstrike_cloud_compliance_benchmarks" "cis" {
+  authority        = "CIS"
+  name             = "*AWS*"
+  include_controls = false
+  max_items        = 1
+}
+
+data "crowdstrike_cloud_compliance_assessment" "test" {
+  framework_id = one(data.crowdstrike_cloud_compliance_benchmarks.cis.ids)
+  account_id   = %q
+  max_items    = 10
+}
+`, accountID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("authority"), knownvalue.StringExact("CIS")),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("score"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("sections"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("failing_resources"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + fmt.Sprintf(`
+data "crowdstrike_cloud_compliance_benchmarks" "cis" {
+  authority        = "CIS"
+  name             = "*AWS*"
+  include_controls = false
+  max_items        = 1
+}
+
+data "crowdstrike_cloud_compliance_assessment" "test" {
+  framework_id              = one(data.crowdstrike_cloud_compliance_benchmarks.cis.ids)
+  account_id                = %q
+  include_failing_resources = false
+}
+`, accountID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("failing"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("failing_resources"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,586 @@
+package cloudcompliance
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon"
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/cloud_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &cloudComplianceBenchmarksDataSource{}
+	_ datasource.DataSourceWithConfigure = &cloudComplianceBenchmarksDataSource{}
+)
+
+const (
+	// cloudComplianceFrameworksQueryLimit is the largest page size of the frameworks query.
+	cloudComplianceFrameworksQueryLimit = 500
+
+	// cloudComplianceControlsQueryLimit is the largest page size of the controls query.
+	cloudComplianceControlsQueryLimit = 500
+
+	// cloudComplianceFrameworksGetLimit is how many frameworks are requested by ID at once.
+	cloudComplianceFrameworksGetLimit = 100
+
+	// cloudComplianceControlsGetLimit is how many controls are requested by ID at once.
+	cloudComplianceControlsGetLimit = 100
+
+	// filterBuiltInFrameworks excludes the custom frameworks from framework queries.
+	filterBuiltInFrameworks = "compliance_framework_authority:!'Custom'"
+)
+
+func NewCloudComplianceBenchmarksDataSource() datasource.DataSource {
+	return &cloudComplianceBenchmarksDataSource{}
+}
+
+type cloudComplianceBenchmarksDataSource struct {
+	client    *client.CrowdStrikeAPISpecification
+	readCache *config.ReadCache
+}
+
+type cloudComplianceBenchmarksDataSourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	Authority       types.String `tfsdk:"authority"`
+	Version         types.String `tfsdk:"version"`
+	FQL             types.String `tfsdk:"fql"`
+	IncludeControls types.Bool   `tfsdk:"include_controls"`
+	Limit           types.Int64  `tfsdk:"limit"`
+	MaxItems        types.Int64  `tfsdk:"max_items"`
+	IDs             types.Set    `tfsdk:"ids"`
+	Benchmarks      types.Set    `tfsdk:"benchmarks"`
+}
+
+type cloudComplianceBenchmarkModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Version     types.String `tfsdk:"version"`
+	Authority   types.String `tfsdk:"authority"`
+	Description types.String `tfsdk:"description"`
+	Active      types.Bool   `tfsdk:"active"`
+	Sections    types.Map    `tfsdk:"sections"`
+}
+
+func (m cloudComplianceBenchmarkModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"version":     types.StringType,
+		"authority":   types.StringType,
+		"description": types.StringType,
+		"active":      types.BoolType,
+		"sections":    types.MapType{ElemType: types.ObjectType{AttrTypes: cloudComplianceBenchmarkSectionModel{}.AttributeTypes()}},
+	}
+}
+
+type cloudComplianceBenchmarkSectionModel struct {
+	Controls types.Set `tfsdk:"controls"`
+}
+
+func (m cloudComplianceBenchmarkSectionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"controls": types.SetType{ElemType: types.ObjectType{AttrTypes: cloudComplianceBenchmarkControlModel{}.AttributeTypes()}},
+	}
+}
+
+type cloudComplianceBenchmarkControlModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Code        types.String `tfsdk:"code"`
+	Requirement types.String `tfsdk:"requirement"`
+}
+
+func (m cloudComplianceBenchmarkControlModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"code":        types.StringType,
+		"requirement": types.StringType,
+	}
+}
+
+// cloudComplianceBenchmark is a benchmark with the controls of each of its sections.
+type cloudComplianceBenchmark struct {
+	framework *models.ApimodelsSecurityFramework
+	sections  map[string][]*models.ApimodelsControl
+}
+
+func (r *cloudComplianceBenchmarksDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	r.readCache = config.ReadCache
+	resp.Diagnostics.Append(config.CheckScopes(cloudComplianceFrameworkScopes)...)
+}
+
+func (r *cloudComplianceBenchmarksDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_compliance_benchmarks"
+}
+
+func (r *cloudComplianceBenchmarksDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	conflictsWithFQL := []validator.String{
+		stringvalidator.ConflictsWith(path.MatchRoot("fql")),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Falcon Cloud Security",
+			"This data source retrieves the compliance benchmarks managed by CrowdStrike, such as CIS, NIST and PCI, with the controls of each of their sections. Custom compliance frameworks are not returned. All non-FQL fields can accept wildcards `*` and query Falcon using logical AND.",
+			cloudComplianceFrameworkScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the benchmarks. Examples: `CIS 1.0.0 AWS*`, `*PCI*`.",
+				Validators:          conflictsWithFQL,
+			},
+			"authority": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Authority publishing the benchmarks. Examples: `CIS`, `NIST`, `PCI`.",
+				Validators:          conflictsWithFQL,
+			},
+			"version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Version of the benchmarks. Examples: `1.0.0`, `3.2.*`.",
+				Validators:          conflictsWithFQL,
+			},
+			"fql": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Falcon Query Language (FQL) filter for advanced benchmark searches. Allowed properties: `compliance_framework_name`, `compliance_framework_version`, `compliance_framework_authority`.",
+			},
+			"include_controls": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to read the sections and controls of each benchmark. Set to `false` to only list the benchmarks without reading their controls, which is faster. Defaults to `true`.",
+			},
+			"limit":     utils.PageLimitAttribute("benchmarks", cloudComplianceFrameworksQueryLimit, cloudComplianceFrameworksQueryLimit),
+			"max_items": utils.MaxItemsAttribute("benchmarks"),
+			"ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the matching benchmarks.",
+			},
+			"benchmarks": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The compliance benchmarks matching the filter criteria, up to `max_items`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique identifier of the benchmark.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the benchmark.",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Version of the benchmark.",
+						},
+						"authority": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Authority publishing the benchmark.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Description of the benchmark.",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the benchmark is active.",
+						},
+						"sections": schema.MapNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The sections of the benchmark, keyed by section name. Null when `include_controls` is `false`.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"controls": schema.SetNestedAttribute{
+										Computed:            true,
+										MarkdownDescription: "The controls of the section.",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"id": schema.StringAttribute{
+													Computed:            true,
+													MarkdownDescription: "Unique identifier of the control.",
+												},
+												"name": schema.StringAttribute{
+													Computed:            true,
+													MarkdownDescription: "Name of the control.",
+												},
+												"code": schema.StringAttribute{
+													Computed:            true,
+													MarkdownDescription: "Code of the control.",
+												},
+												"requirement": schema.StringAttribute{
+													Computed:            true,
+													MarkdownDescription: "Requirement of the control within the benchmark. Example: `1.4`.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *cloudComplianceBenchmarksDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data cloudComplianceBenchmarksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := data.filter()
+	includeControls := data.IncludeControls.ValueBool() || data.IncludeControls.IsNull()
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, cloudComplianceFrameworksQueryLimit)
+
+	type queryResult struct {
+		benchmarks []cloudComplianceBenchmark
+		total      int64
+	}
+
+	key := fmt.Sprintf(
+		"cloud_compliance_benchmarks/%s/%t/%d/%d",
+		filter,
+		includeControls,
+		pagination.Limit,
+		pagination.MaxItems,
+	)
+	result, diags := config.Cached(r.readCache, key, func() (queryResult, diag.Diagnostics) {
+		benchmarks, total, diags := r.queryBenchmarks(ctx, filter, includeControls, pagination)
+		return queryResult{benchmarks: benchmarks, total: total}, diags
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	benchmarks := make([]cloudComplianceBenchmarkModel, 0, len(result.benchmarks))
+	ids := make([]string, 0, len(result.benchmarks))
+	for _, benchmark := range result.benchmarks {
+		model, diags := benchmarkToModel(ctx, benchmark, includeControls)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		benchmarks = append(benchmarks, model)
+		ids = append(ids, benchmark.framework.UUID)
+	}
+
+	data.Benchmarks, diags = types.SetValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: cloudComplianceBenchmarkModel{}.AttributeTypes()},
+		benchmarks,
+	)
+	resp.Diagnostics.Append(diags...)
+
+	data.IDs, diags = types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("benchmarks", len(benchmarks), result.total)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filter returns the FQL filter combining the filter fields of m, restricted
+// to the frameworks managed by CrowdStrike.
+func (m cloudComplianceBenchmarksDataSourceModel) filter() string {
+	if m.FQL.ValueString() != "" {
+		return fmt.Sprintf("(%s)+%s", m.FQL.ValueString(), filterBuiltInFrameworks)
+	}
+
+	filters := []string{filterBuiltInFrameworks}
+	for property, value := range map[string]types.String{
+		"compliance_framework_name":      m.Name,
+		"compliance_framework_authority": m.Authority,
+		"compliance_framework_version":   m.Version,
+	} {
+		if value.ValueString() != "" {
+			filters = append(filters, fmt.Sprintf("%s:*%s", property, utils.FQLQuote(value.ValueString())))
+		}
+	}
+
+	// Map iteration order is random, so sort the filters to keep the filter,
+	// and the key it is cached by, stable.
+	slices.Sort(filters)
+
+	return strings.Join(filters, "+")
+}
+
+// queryBenchmarks returns the benchmarks matching filter and the total number
+// of benchmarks matching it. The controls of each benchmark are read
+// concurrently when includeControls is set.
+func (r *cloudComplianceBenchmarksDataSource) queryBenchmarks(
+	ctx context.Context,
+	filter string,
+	includeControls bool,
+	pagination utils.Pagination,
+) ([]cloudComplianceBenchmark, int64, diag.Diagnostics) {
+	ids, total, diags := utils.QueryPages(pagination, func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		params := cloud_policies.NewQueryComplianceFrameworksParamsWithContext(ctx)
+		params.SetFilter(&filter)
+		params.SetLimit(&limit)
+		params.SetOffset(&offset)
+
+		res, err := r.client.CloudPolicies.QueryComplianceFrameworks(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, cloudComplianceFrameworkScopes))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			return nil, 0, diags
+		}
+
+		if err := falcon.AssertNoError(res.Payload.Errors); err != nil {
+			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+			return nil, 0, diags
+		}
+
+		return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+	})
+	if diags.HasError() || len(ids) == 0 {
+		return nil, 0, diags
+	}
+
+	frameworks := make([]*models.ApimodelsSecurityFramework, 0, len(ids))
+	for batch := range slices.Chunk(ids, cloudComplianceFrameworksGetLimit) {
+		params := cloud_policies.NewGetComplianceFrameworksParamsWithContext(ctx)
+		params.SetIds(batch)
+
+		res, err := r.client.CloudPolicies.GetComplianceFrameworks(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, cloudComplianceFrameworkScopes))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
+		}
+
+		if err := falcon.AssertNoError(res.Payload.Errors); err != nil {
+			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+			return nil, 0, diags
+		}
+
+		for _, framework := range res.Payload.Resources {
+			if framework != nil && framework.Name != nil && framework.Version != nil {
+				frameworks = append(frameworks, framework)
+			}
+		}
+	}
+
+	// Frameworks deleted between the two requests are not returned, and do
+	// not count toward the frameworks matching the filter.
+	total -= int64(len(ids) - len(frameworks))
+
+	benchmarks, benchmarkDiags := utils.ConcurrentMap(
+		ctx,
+		frameworks,
+		utils.DefaultReadConcurrency,
+		func(ctx context.Context, framework *models.ApimodelsSecurityFramework) (cloudComplianceBenchmark, diag.Diagnostics) {
+			benchmark := cloudComplianceBenchmark{framework: framework}
+			if !includeControls {
+				return benchmark, nil
+			}
+
+			var diags diag.Diagnostics
+			benchmark.sections, diags = readFrameworkSections(ctx, r.client, framework)
+			return benchmark, diags
+		},
+	)
+	diags.Append(benchmarkDiags...)
+	if diags.HasError() {
+		return nil, 0, diags
+	}
+
+	return benchmarks, total, diags
+}
+
+// readFrameworkSections returns the controls of framework grouped by section
+// name.
+func readFrameworkSections(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	framework *models.ApimodelsSecurityFramework,
+) (map[string][]*models.ApimodelsControl, diag.Diagnostics) {
+	filter := fmt.Sprintf(
+		"compliance_control_benchmark_name:%s+compliance_control_benchmark_version:%s",
+		utils.FQLQuote(*framework.Name),
+		utils.FQLQuote(*framework.Version),
+	)
+
+	// Every control of the benchmark is read, so the number of controls is
+	// not limited by max_items.
+	all := utils.Pagination{Limit: cloudComplianceControlsQueryLimit, MaxItems: math.MaxInt64}
+	ids, _, diags := utils.QueryPages(all, func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		params := cloud_policies.NewQueryComplianceControlsParamsWithContext(ctx)
+		params.SetFilter(&filter)
+		params.SetLimit(&limit)
+		params.SetOffset(&offset)
+
+		res, err := client.CloudPolicies.QueryComplianceControls(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, cloudComplianceFrameworkScopes))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			return nil, 0, diags
+		}
+
+		if err := falcon.AssertNoError(res.Payload.Errors); err != nil {
+			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+			return nil, 0, diags
+		}
+
+		return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	sections := make(map[string][]*models.ApimodelsControl)
+	for batch := range slices.Chunk(ids, cloudComplianceControlsGetLimit) {
+		params := cloud_policies.NewGetComplianceControlsParamsWithContext(ctx)
+		params.SetIds(batch)
+
+		res, err := client.CloudPolicies.GetComplianceControls(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, cloudComplianceFrameworkScopes))
+			return nil, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, diags
+		}
+
+		if err := falcon.AssertNoError(res.Payload.Errors); err != nil {
+			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+			return nil, diags
+		}
+
+		for _, control := range res.Payload.Resources {
+			if control == nil || control.UUID == nil {
+				continue
+			}
+			sections[control.SectionName] = append(sections[control.SectionName], control)
+		}
+	}
+
+	return sections, diags
+}
+
+// benchmarkToModel converts benchmark to its Terraform model. The sections are
+// null when the controls were not read.
+func benchmarkToModel(
+	ctx context.Context,
+	benchmark cloudComplianceBenchmark,
+	includeControls bool,
+) (cloudComplianceBenchmarkModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	framework := benchmark.framework
+
+	model := cloudComplianceBenchmarkModel{
+		ID:          types.StringValue(framework.UUID),
+		Name:        types.StringPointerValue(framework.Name),
+		Version:     types.StringPointerValue(framework.Version),
+		Authority:   types.StringPointerValue(framework.Authority),
+		Description: types.StringValue(framework.Description),
+		Active:      types.BoolValue(framework.Active),
+	}
+
+	sectionType := types.ObjectType{AttrTypes: cloudComplianceBenchmarkSectionModel{}.AttributeTypes()}
+	if !includeControls {
+		model.Sections = types.MapNull(sectionType)
+		return model, diags
+	}
+
+	sections := make(map[string]cloudComplianceBenchmarkSectionModel, len(benchmark.sections))
+	for name, controls := range benchmark.sections {
+		controlModels := make([]cloudComplianceBenchmarkControlModel, 0, len(controls))
+		for _, control := range controls {
+			controlModels = append(controlModels, cloudComplianceBenchmarkControlModel{
+				ID:          types.StringPointerValue(control.UUID),
+				Name:        types.StringPointerValue(control.Name),
+				Code:        types.StringPointerValue(control.Code),
+				Requirement: types.StringValue(control.Requirement),
+			})
+		}
+
+		controlSet, setDiags := types.SetValueFrom(
+			ctx,
+			types.ObjectType{AttrTypes: cloudComplianceBenchmarkControlModel{}.AttributeTypes()},
+			controlModels,
+		)
+		diags.Append(setDiags...)
+		sections[name] = cloudComplianceBenchmarkSectionModel{Controls: controlSet}
+	}
+
+	sectionMap, mapDiags := types.MapValueFrom(ctx, sectionType, sections)
+	diags.Append(mapDiags...)
+	model.Sections = sectionMap
+
+	return model, diags
+}
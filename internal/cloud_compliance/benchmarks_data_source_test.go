@@ -0,0 +1,75 @@
+package cloudcompliance_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccCloudComplianceBenchmarksDataSource(t *testing.T) {
+	dataSourceName := "data.crowdstrike_cloud_compliance_benchmarks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_cloud_compliance_benchmarks" "test" {
+  authority = "CIS"
+  name      = "*AWS*"
+  max_items = 2
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						dataSourceName,
+						tfjsonpath.New("benchmarks"),
+						knownvalue.SetPartial([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"authority": knownvalue.StringExact("CIS"),
+								"sections":  knownvalue.NotNull(),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("ids"), knownvalue.SetSizeExact(2)),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_cloud_compliance_benchmarks" "test" {
+  fql              = "compliance_framework_authority:'CIS'"
+  include_controls = false
+  max_items        = 1
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						dataSourceName,
+						tfjsonpath.New("benchmarks"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"authority": knownvalue.StringExact("CIS"),
+								"sections":  knownvalue.Null(),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_cloud_compliance_benchmarks" "test" {
+  fql       = "compliance_framework_authority:'CIS'"
+  authority = "CIS"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
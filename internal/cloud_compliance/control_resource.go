@@ -0,0 +1,519 @@
+package cloudcompliance
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/crowdstrike/gofalcon/falcon"
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/cloud_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &cloudComplianceControlResource{}
+	_ resource.ResourceWithConfigure   = &cloudComplianceControlResource{}
+	_ resource.ResourceWithImportState = &cloudComplianceControlResource{}
+	_ resource.ResourceWithIdentity    = &cloudComplianceControlResource{}
+	_ resource.ResourceWithModifyPlan  = &cloudComplianceControlResource{}
+)
+
+var controlResourceMarkdownDescription = "This resource allows managing a single control of a custom compliance framework in the CrowdStrike Falcon Platform. " +
+	"Managing controls individually lets the content of a framework be split across modules. " +
+	"The framework is managed with the `crowdstrike_cloud_compliance_custom_framework` resource, which must not set `sections` when its controls are managed with this resource, unless it sets `unmanaged_controls` to `ignore`."
+
+func NewCloudComplianceControlResource() resource.Resource {
+	return &cloudComplianceControlResource{}
+}
+
+type cloudComplianceControlResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type cloudComplianceControlResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	FrameworkID types.String   `tfsdk:"framework_id"`
+	Section     types.String   `tfsdk:"section"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Rules       types.Set      `tfsdk:"rules"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// wrap transforms API response values to their terraform model values.
+func (m *cloudComplianceControlResourceModel) wrap(control *models.ApimodelsControl) {
+	m.ID = types.StringPointerValue(control.UUID)
+	m.Name = types.StringPointerValue(control.Name)
+	m.Description = types.StringValue(control.Description)
+	m.Section = types.StringValue(control.SectionName)
+
+	if len(control.SecurityFramework) > 0 && control.SecurityFramework[0] != nil {
+		m.FrameworkID = types.StringValue(control.SecurityFramework[0].UUID)
+	}
+}
+
+// frameworks returns the framework resource, which manages the controls of
+// frameworks the same way.
+func (r *cloudComplianceControlResource) frameworks() *cloudComplianceCustomFrameworkResource {
+	return &cloudComplianceCustomFrameworkResource{client: r.client}
+}
+
+func (r *cloudComplianceControlResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(cloudComplianceCustomFrameworkScopes)...)
+}
+
+// Metadata returns the resource type name.
+func (r *cloudComplianceControlResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_compliance_control"
+}
+
+// Schema defines the schema for the resource.
+func (r *cloudComplianceControlResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			customFrameworkDocumentationSection,
+			controlResourceMarkdownDescription,
+			cloudComplianceCustomFrameworkScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier for the compliance framework control.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"framework_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Identifier of the custom compliance framework the control belongs to. Changing it replaces the control.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"section": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the framework section the control belongs to. The section is created with its first control and removed with its last. Changing it replaces the control.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Display name of the compliance framework control.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Description of the control.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"rules": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of rule IDs assigned to this control. Only existing CSPM IOM rules can be assigned, which is checked when planning. Defaults to no rules.",
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *cloudComplianceControlResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *cloudComplianceControlResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan cloudComplianceControlResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Creating compliance control", map[string]any{
+		"framework_id": plan.FrameworkID.ValueString(),
+		"section":      plan.Section.ValueString(),
+		"name":         plan.Name.ValueString(),
+	})
+
+	params := buildCreateControlParams(
+		ctx,
+		plan.FrameworkID.ValueString(),
+		plan.Section.ValueString(),
+		plan.Name.ValueString(),
+		plan.Description.ValueString(),
+	)
+	createResp, err := r.client.CloudPolicies.CreateComplianceControl(params)
+	if err != nil {
+		resp.Diagnostics.Append(handleAPIError(err, apiOperationCreateControl, "")...)
+		return
+	}
+
+	payload := createResp.GetPayload()
+	resp.Diagnostics.Append(validateAPIResponse(payload, errorCreatingControl)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the ID early so that a control whose rules fail to be assigned is
+	// tainted rather than orphaned.
+	plan.ID = types.StringPointerValue(payload.Resources[0].UUID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(plan.Rules.Elements()) > 0 {
+		resp.Diagnostics.Append(r.frameworks().updateControlRules(ctx, ControlTFModel{
+			ID:    plan.ID,
+			Name:  plan.Name,
+			Rules: plan.Rules,
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *cloudComplianceControlResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state cloudComplianceControlResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Reading compliance control", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	control, diags, notFound := r.getControl(ctx, state.ID.ValueString())
+	if notFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.wrap(control)
+	resp.Diagnostics.Append(r.readRules(ctx, &state, control)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *cloudComplianceControlResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan cloudComplianceControlResourceModel
+	var state cloudComplianceControlResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Updating compliance control", map[string]any{
+		"id": plan.ID.ValueString(),
+	})
+
+	control := ControlTFModel{
+		ID:          plan.ID,
+		Name:        plan.Name,
+		Description: plan.Description,
+		Rules:       plan.Rules,
+	}
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		resp.Diagnostics.Append(r.frameworks().updateExistingControl(ctx, control, plan.Section.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Rules.Equal(state.Rules) {
+		resp.Diagnostics.Append(r.frameworks().updateControlRules(ctx, control)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *cloudComplianceControlResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state cloudComplianceControlResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Deleting compliance control", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	params := cloud_policies.NewDeleteComplianceControlParamsWithContext(ctx).WithIds([]string{state.ID.ValueString()})
+	if _, err := r.client.CloudPolicies.DeleteComplianceControl(params); err != nil {
+		if _, ok := err.(*cloud_policies.DeleteComplianceControlNotFound); ok {
+			// Deleting the framework also deletes its controls.
+			tflog.Info(ctx, "Compliance control not found during delete, considering as already deleted", map[string]any{
+				"id": state.ID.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Compliance Control",
+			fmt.Sprintf("Failed to delete control %s: %s", state.ID.ValueString(), falcon.ErrorExplain(err)),
+		)
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *cloudComplianceControlResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// ModifyPlan checks that the rules added to the control can be assigned to it.
+func (r *cloudComplianceControlResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var rules types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rules"), &rules)...)
+	if resp.Diagnostics.HasError() || !utils.IsKnown(rules) {
+		return
+	}
+
+	var ruleIDs []string
+	resp.Diagnostics.Append(rules.ElementsAs(ctx, &ruleIDs, false)...)
+
+	var stateRuleIDs []string
+	if !req.State.Raw.IsNull() {
+		var stateRules types.Set
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rules"), &stateRules)...)
+		resp.Diagnostics.Append(stateRules.ElementsAs(ctx, &stateRuleIDs, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added := utils.MissingElements(ruleIDs, stateRuleIDs)
+	if len(added) == 0 {
+		return
+	}
+
+	invalid, diags := r.frameworks().invalidRules(ctx, added)
+	resp.Diagnostics.Append(diags...)
+	for _, id := range slices.Sorted(maps.Keys(invalid)) {
+		resp.Diagnostics.AddAttributeError(path.Root("rules"), errorInvalidRule, invalid[id])
+	}
+}
+
+// read updates model with the control and rules read back after a change.
+func (r *cloudComplianceControlResource) read(
+	ctx context.Context,
+	model *cloudComplianceControlResourceModel,
+) diag.Diagnostics {
+	control, diags, notFound := r.getControl(ctx, model.ID.ValueString())
+	if notFound {
+		diags.AddError(
+			errorGettingControls,
+			fmt.Sprintf("The compliance control %s was not found after it was saved.", model.ID.ValueString()),
+		)
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	model.wrap(control)
+	diags.Append(r.readRules(ctx, model, control)...)
+
+	return diags
+}
+
+// getControl returns the control with the given ID, and whether it was not found.
+func (r *cloudComplianceControlResource) getControl(
+	ctx context.Context,
+	id string,
+) (*models.ApimodelsControl, diag.Diagnostics, bool) {
+	var diags diag.Diagnostics
+
+	params := cloud_policies.NewGetComplianceControlsParamsWithContext(ctx).WithIds([]string{id})
+	getResp, err := r.client.CloudPolicies.GetComplianceControls(params)
+	if err != nil {
+		if _, ok := err.(*cloud_policies.GetComplianceControlsNotFound); ok {
+			return nil, diags, true
+		}
+
+		diags.Append(handleAPIError(err, apiOperationReadControls, id)...)
+		return nil, diags, false
+	}
+
+	payload := getResp.GetPayload()
+	if payload != nil && len(payload.Resources) == 0 {
+		return nil, diags, true
+	}
+
+	diags.Append(validateAPIResponse(payload, errorGettingControls)...)
+	if diags.HasError() {
+		return nil, diags, false
+	}
+
+	return payload.Resources[0], diags, false
+}
+
+// readRules sets the rules of model to the rules assigned to control, which
+// are queried by the name of its framework.
+func (r *cloudComplianceControlResource) readRules(
+	ctx context.Context,
+	model *cloudComplianceControlResourceModel,
+	control *models.ApimodelsControl,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var frameworkName string
+	if len(control.SecurityFramework) > 0 && control.SecurityFramework[0] != nil && control.SecurityFramework[0].Name != nil {
+		frameworkName = *control.SecurityFramework[0].Name
+	} else {
+		framework, frameworkDiags, _ := r.frameworks().getFramework(ctx, model.FrameworkID.ValueString())
+		diags.Append(frameworkDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		frameworkName = *framework.Name
+	}
+
+	ruleIDs, ruleDiags := r.frameworks().queryControlRules(ctx, frameworkName, control.SectionName, control.Requirement)
+	diags.Append(ruleDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.Rules, ruleDiags = convertRulesToTerraformSet(ruleIDs)
+	diags.Append(ruleDiags...)
+
+	return diags
+}
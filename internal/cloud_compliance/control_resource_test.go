@@ -0,0 +1,126 @@
+package cloudcompliance_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+const controlResourceName = "crowdstrike_cloud_compliance_control.test"
+
+func testAccControlConfig(name, section, controlName, rules string) string {
+	return getAWSRulesConfig() + fmt.Sprintf(`
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = %[1]q
+  description = "Framework with individually managed controls"
+}
+
+resource "crowdstrike_cloud_compliance_control" "test" {
+  framework_id = crowdstrike_cloud_compliance_custom_framework.test.id
+  section      = %[2]q
+  name         = %[3]q
+  description  = "Description of %[3]s"
+  rules        = %[4]s
+}
+
+resource "crowdstrike_cloud_compliance_control" "without_rules" {
+  framework_id = crowdstrike_cloud_compliance_custom_framework.test.id
+  section      = "Section 1"
+  name         = "Control Without Rules"
+  description  = "Control with rules omitted"
+}
+`, name, section, controlName, rules)
+}
+
+func TestAccCloudComplianceControlResource_Basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccControlConfig(rName, "Section 1", "Control 1", "local.rule_set_two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(controlResourceName, "id"),
+					resource.TestCheckResourceAttrPair(controlResourceName, "framework_id", customFrameworkResourceName, "id"),
+					resource.TestCheckResourceAttr(controlResourceName, "section", "Section 1"),
+					resource.TestCheckResourceAttr(controlResourceName, "name", "Control 1"),
+					resource.TestCheckResourceAttr(controlResourceName, "description", "Description of Control 1"),
+					resource.TestCheckResourceAttr(controlResourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr("crowdstrike_cloud_compliance_control.without_rules", "rules.#", "0"),
+					// The framework does not track the controls managed individually.
+					resource.TestCheckNoResourceAttr(customFrameworkResourceName, "sections.%"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccControlConfig(rName, "Section 1", "Control 1 Updated", "local.rule_set_single"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(controlResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(customFrameworkResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(controlResourceName, "name", "Control 1 Updated"),
+					resource.TestCheckResourceAttr(controlResourceName, "description", "Description of Control 1 Updated"),
+					resource.TestCheckResourceAttr(controlResourceName, "rules.#", "1"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccControlConfig(rName, "Section 2", "Control 1 Updated", "local.rule_set_empty"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(controlResourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(controlResourceName, "section", "Section 2"),
+					resource.TestCheckResourceAttr(controlResourceName, "rules.#", "0"),
+				),
+			},
+			{
+				ResourceName:      controlResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudComplianceControlResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+resource "crowdstrike_cloud_compliance_control" "test" {
+  framework_id = "123e4567-e89b-12d3-a456-426614174000"
+  section      = ""
+  name         = "Control"
+  description  = "Control with an empty section"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Length"),
+			},
+			{
+				Config: acctest.ProviderConfig + `
+resource "crowdstrike_cloud_compliance_control" "test" {
+  framework_id = "123e4567-e89b-12d3-a456-426614174000"
+  section      = "Section 1"
+  name         = "Control"
+  description  = "Control with an unknown rule"
+  rules        = ["00000000-0000-0000-0000-000000000000"]
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Compliance Rule"),
+			},
+		},
+	})
+}
@@ -0,0 +1,354 @@
+package cloudcompliance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/cloud_policies"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &cloudComplianceCustomFrameworkDataSource{}
+	_ datasource.DataSourceWithConfigure = &cloudComplianceCustomFrameworkDataSource{}
+)
+
+// dataSourceControlAttrTypes are the attributes of the controls of the data
+// source, which has no rule filter settings.
+var dataSourceControlAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"description": types.StringType,
+	"rules":       types.SetType{ElemType: types.StringType},
+}
+
+const filterCustomFrameworkByName = "compliance_framework_name:%s+compliance_framework_authority:'Custom'"
+
+func NewCloudComplianceCustomFrameworkDataSource() datasource.DataSource {
+	return &cloudComplianceCustomFrameworkDataSource{}
+}
+
+type cloudComplianceCustomFrameworkDataSource struct {
+	client    *client.CrowdStrikeAPISpecification
+	readCache *config.ReadCache
+}
+
+type cloudComplianceCustomFrameworkDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Sections    types.Map    `tfsdk:"sections"`
+	ExportJSON  types.String `tfsdk:"export_json"`
+}
+
+func (d *cloudComplianceCustomFrameworkDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = config.Client
+	d.readCache = config.ReadCache
+	resp.Diagnostics.Append(config.CheckScopes(cloudComplianceFrameworkScopes)...)
+}
+
+func (d *cloudComplianceCustomFrameworkDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_compliance_custom_framework"
+}
+
+func (d *cloudComplianceCustomFrameworkDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			customFrameworkDocumentationSection,
+			"This data source reads an existing custom compliance framework by ID or name, including its sections, controls and the rules assigned to each control. Section and control keys are generated from their names.",
+			cloudComplianceFrameworkScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Identifier of the custom compliance framework. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the custom compliance framework. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Description of the custom compliance framework.",
+			},
+			"export_json": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "JSON document defining the framework, with its sections and controls sorted by name and the rules of each control sorted by ID. Pass it to the `import_json` attribute of the `crowdstrike_cloud_compliance_custom_framework` resource to create the framework in another CID.",
+			},
+			"sections": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Map of sections within the framework, keyed by a key generated from the section name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Display name of the compliance framework section.",
+						},
+						"controls": schema.MapNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Map of controls within the section, keyed by a key generated from the control name.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Identifier for the compliance framework control.",
+									},
+									"name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Display name of the compliance framework control.",
+									},
+									"description": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Description of the control.",
+									},
+									"rules": schema.SetAttribute{
+										Computed:            true,
+										ElementType:         types.StringType,
+										MarkdownDescription: "Set of rule IDs assigned to this control.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *cloudComplianceCustomFrameworkDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data cloudComplianceCustomFrameworkDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := fmt.Sprintf("cloud_compliance_custom_framework/%s/%s", data.ID.ValueString(), data.Name.ValueString())
+	result, diags := config.Cached(
+		d.readCache,
+		key,
+		func() (cloudComplianceCustomFrameworkDataSourceModel, diag.Diagnostics) {
+			return d.readFramework(ctx, data.ID.ValueString(), data.Name.ValueString())
+		},
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+// readFramework looks up the custom framework by ID or name and reads its sections.
+func (d *cloudComplianceCustomFrameworkDataSource) readFramework(
+	ctx context.Context,
+	id string,
+	name string,
+) (cloudComplianceCustomFrameworkDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	model := cloudComplianceCustomFrameworkDataSourceModel{}
+
+	if id == "" {
+		var idDiags diag.Diagnostics
+		id, idDiags = queryCustomFrameworkID(ctx, d.client, name)
+		diags.Append(idDiags...)
+		if diags.HasError() {
+			return model, diags
+		}
+	}
+
+	// The resource reads frameworks and their controls the same way.
+	r := &cloudComplianceCustomFrameworkResource{client: d.client}
+
+	framework, frameworkDiags, notFound := r.getFramework(ctx, id)
+	if notFound {
+		diags.Append(tferrors.NewNotFoundError(
+			fmt.Sprintf("No custom compliance framework found with ID %q.", id),
+		))
+		return model, diags
+	}
+	diags.Append(frameworkDiags...)
+	if diags.HasError() {
+		return model, diags
+	}
+
+	if framework.Authority == nil || !strings.EqualFold(*framework.Authority, "Custom") {
+		diags.AddError(
+			errorReadingFramework,
+			fmt.Sprintf("The compliance framework %q is not a custom compliance framework.", id),
+		)
+		return model, diags
+	}
+
+	model.ID = types.StringValue(framework.UUID)
+	model.Name = types.StringPointerValue(framework.Name)
+	model.Description = types.StringValue(framework.Description)
+
+	sections, sectionsDiags := r.readControlsForFramework(ctx, *framework.Name, nil, nil)
+	diags.Append(sectionsDiags...)
+	if diags.HasError() {
+		return model, diags
+	}
+
+	exported, exportDiags := exportFrameworkJSON(ctx, *framework.Name, framework.Description, sections)
+	diags.Append(exportDiags...)
+	if diags.HasError() {
+		return model, diags
+	}
+	model.ExportJSON = types.StringValue(exported)
+
+	model.Sections, sectionsDiags = dataSourceSections(ctx, sections)
+	diags.Append(sectionsDiags...)
+
+	return model, diags
+}
+
+// dataSourceSections returns the sections read by the resource without the
+// rule filter settings of the controls, which only exist in the resource.
+func dataSourceSections(ctx context.Context, sections types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	controlType := types.ObjectType{AttrTypes: dataSourceControlAttrTypes}
+	sectionType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":     types.StringType,
+		"controls": types.MapType{ElemType: controlType},
+	}}
+
+	if sections.IsNull() {
+		return types.MapNull(sectionType), diags
+	}
+
+	var sectionsByKey map[string]SectionTFModel
+	diags.Append(sections.ElementsAs(ctx, &sectionsByKey, false)...)
+	if diags.HasError() {
+		return types.MapNull(sectionType), diags
+	}
+
+	sectionValues := make(map[string]attr.Value, len(sectionsByKey))
+	for sectionKey, section := range sectionsByKey {
+		var controls map[string]ControlTFModel
+		diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+
+		controlValues := make(map[string]attr.Value, len(controls))
+		for controlKey, control := range controls {
+			controlValue, controlDiags := types.ObjectValue(dataSourceControlAttrTypes, map[string]attr.Value{
+				"id":          control.ID,
+				"name":        control.Name,
+				"description": control.Description,
+				"rules":       control.Rules,
+			})
+			diags.Append(controlDiags...)
+			controlValues[controlKey] = controlValue
+		}
+
+		controlsMap, mapDiags := types.MapValue(controlType, controlValues)
+		diags.Append(mapDiags...)
+
+		sectionValue, sectionDiags := types.ObjectValue(sectionType.AttrTypes, map[string]attr.Value{
+			"name":     section.Name,
+			"controls": controlsMap,
+		})
+		diags.Append(sectionDiags...)
+		sectionValues[sectionKey] = sectionValue
+	}
+	if diags.HasError() {
+		return types.MapNull(sectionType), diags
+	}
+
+	return types.MapValue(sectionType, sectionValues)
+}
+
+// queryCustomFrameworkID returns the ID of the custom framework with the given
+// name.
+func queryCustomFrameworkID(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	name string,
+) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := cloud_policies.NewQueryComplianceFrameworksParamsWithContext(ctx)
+	params.SetFilter(utils.Addr(fmt.Sprintf(filterCustomFrameworkByName, utils.FQLQuote(name))))
+	params.SetLimit(utils.Addr(int64(2)))
+
+	queryResp, err := client.CloudPolicies.QueryComplianceFrameworks(params)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, cloudComplianceFrameworkScopes))
+		return "", diags
+	}
+
+	var ids []string
+	if payload := queryResp.GetPayload(); payload != nil {
+		ids = payload.Resources
+	}
+
+	switch len(ids) {
+	case 0:
+		diags.Append(tferrors.NewNotFoundError(
+			fmt.Sprintf("No custom compliance framework found with name %q.", name),
+		))
+	case 1:
+		return ids[0], diags
+	default:
+		diags.AddError(
+			errorReadingFramework,
+			fmt.Sprintf("Found more than one custom compliance framework with name %q. Select the framework by its ID instead.", name),
+		)
+	}
+
+	return "", diags
+}
@@ -0,0 +1,127 @@
+package cloudcompliance_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func testAccCustomFrameworkDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = %[1]q
+  description = "Framework read by the data source"
+
+  sections = {
+    "section-1" = {
+      name = "Section 1"
+      controls = {
+        "control-1a" = {
+          name        = "Control 1a"
+          description = "First control"
+        }
+      }
+    }
+  }
+}
+
+data "crowdstrike_cloud_compliance_custom_framework" "by_name" {
+  name = crowdstrike_cloud_compliance_custom_framework.test.name
+
+  depends_on = [crowdstrike_cloud_compliance_custom_framework.test]
+}
+
+data "crowdstrike_cloud_compliance_custom_framework" "by_id" {
+  id = crowdstrike_cloud_compliance_custom_framework.test.id
+}
+`, name)
+}
+
+func TestAccCloudComplianceCustomFrameworkDataSource(t *testing.T) {
+	name := sdkacctest.RandomWithPrefix("tf-acc-test")
+	sections := knownvalue.MapExact(map[string]knownvalue.Check{
+		"section-1": knownvalue.ObjectPartial(map[string]knownvalue.Check{
+			"name": knownvalue.StringExact("Section 1"),
+			"controls": knownvalue.MapExact(map[string]knownvalue.Check{
+				"control-1a": knownvalue.ObjectPartial(map[string]knownvalue.Check{
+					"id":          knownvalue.NotNull(),
+					"name":        knownvalue.StringExact("Control 1a"),
+					"description": knownvalue.StringExact("First control"),
+				}),
+			}),
+		}),
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkDataSourceConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"data.crowdstrike_cloud_compliance_custom_framework.by_name",
+						tfjsonpath.New("id"),
+						customFrameworkResourceName,
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.crowdstrike_cloud_compliance_custom_framework.by_name",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("Framework read by the data source"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.crowdstrike_cloud_compliance_custom_framework.by_name",
+						tfjsonpath.New("sections"),
+						sections,
+					),
+					statecheck.ExpectKnownValue(
+						"data.crowdstrike_cloud_compliance_custom_framework.by_id",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(name),
+					),
+					statecheck.ExpectKnownValue(
+						"data.crowdstrike_cloud_compliance_custom_framework.by_id",
+						tfjsonpath.New("sections"),
+						sections,
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudComplianceCustomFrameworkDataSource_notFound(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name = "tf-acc-test-framework-does-not-exist"
+}
+`,
+				ExpectError: regexp.MustCompile("No custom compliance framework found"),
+			},
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_cloud_compliance_custom_framework" "test" {
+  id   = "00000000-0000-0000-0000-000000000000"
+  name = "both"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
@@ -0,0 +1,124 @@
+package cloudcompliance
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// controlKeysPrivateStateKey is the private state key of the section and
+// control keys of the controls of a framework, by control ID. They let Read
+// keep the keys of controls and sections renamed outside of Terraform, which
+// are otherwise matched by name.
+const controlKeysPrivateStateKey = "controlKeys"
+
+// controlKeys locates a control in sections.
+type controlKeys struct {
+	Section string `json:"section"`
+	Control string `json:"control"`
+}
+
+// privateStateGetter is implemented by the private state of requests.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateSetter is implemented by the private state of responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getControlKeys returns the control keys recorded in private state, by
+// control ID, with the keys of the controls of sections taking precedence.
+func getControlKeys(
+	ctx context.Context,
+	private privateStateGetter,
+	sections map[string]SectionTFModel,
+) (map[string]controlKeys, diag.Diagnostics) {
+	keysByID := make(map[string]controlKeys)
+
+	value, diags := private.GetKey(ctx, controlKeysPrivateStateKey)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if len(value) > 0 {
+		if err := json.Unmarshal(value, &keysByID); err != nil {
+			diags.AddError(
+				"Internal provider error",
+				"Failed to unmarshal the control keys in private state: "+err.Error(),
+			)
+			return nil, diags
+		}
+	}
+
+	sectionKeysByID, sectionDiags := sectionControlKeys(ctx, sections)
+	diags.Append(sectionDiags...)
+	maps.Copy(keysByID, sectionKeysByID)
+
+	return keysByID, diags
+}
+
+// sectionControlKeys returns the keys of the controls of sections with known
+// IDs, by control ID.
+func sectionControlKeys(
+	ctx context.Context,
+	sections map[string]SectionTFModel,
+) (map[string]controlKeys, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	keysByID := make(map[string]controlKeys)
+
+	for sectionKey, section := range sections {
+		if !utils.IsKnown(section.Controls) {
+			continue
+		}
+
+		var controls map[string]ControlTFModel
+		diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+		for controlKey, control := range controls {
+			if utils.IsKnown(control.ID) {
+				keysByID[control.ID.ValueString()] = controlKeys{Section: sectionKey, Control: controlKey}
+			}
+		}
+	}
+
+	return keysByID, diags
+}
+
+// setControlKeys records the keys of the controls of sections in private
+// state, by control ID.
+func setControlKeys(
+	ctx context.Context,
+	private privateStateSetter,
+	sections types.Map,
+) diag.Diagnostics {
+	var sectionsByKey map[string]SectionTFModel
+	if utils.IsKnown(sections) {
+		if diags := sections.ElementsAs(ctx, &sectionsByKey, false); diags.HasError() {
+			return diags
+		}
+	}
+
+	keysByID, diags := sectionControlKeys(ctx, sectionsByKey)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(keysByID) == 0 {
+		return private.SetKey(ctx, controlKeysPrivateStateKey, nil)
+	}
+
+	value, err := json.Marshal(keysByID)
+	if err != nil {
+		diags.AddError(
+			"Internal provider error",
+			"Failed to marshal the control keys in private state: "+err.Error(),
+		)
+		return diags
+	}
+
+	return private.SetKey(ctx, controlKeysPrivateStateKey, value)
+}
@@ -2,10 +2,18 @@ package cloudcompliance
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"math"
+	"net/http"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 
 	"github.com/crowdstrike/gofalcon/falcon"
@@ -13,15 +21,23 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/client/cloud_policies"
 	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	privatestate "github.com/crowdstrike/terraform-provider-crowdstrike/internal/private_state"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/retry"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -32,15 +48,38 @@ var (
 	sortComplianceControlsByRequirementAsc = "compliance_control_requirement|asc"
 	limitComplianceControlsMax             = int64(500)
 	filterComplianceRulesByControl         = "rule_compliance_benchmark:'%s'+rule_control_section:'%s'+rule_control_requirement:'%s'+rule_domain:'CSPM'+rule_subdomain:'IOM'"
+	filterComplianceRulesByFramework       = "rule_compliance_benchmark:'%s'+rule_domain:'CSPM'+rule_subdomain:'IOM'"
+	filterComplianceRulesByFQL             = "(%s)+rule_domain:'CSPM'+rule_subdomain:'IOM'"
 	sortComplianceRulesByUpdatedAtAsc      = "rule_updated_at|asc"
 	limitComplianceRulesMax                = int64(500)
+	limitComplianceRulesGet                = int64(100)
+)
+
+// Controls written to a framework are not always visible to queries right
+// away, so they are read back until they are.
+const (
+	controlsVisibleTimeout     = 2 * time.Minute
+	controlsVisibleMinInterval = time.Second
+	controlsVisibleMaxInterval = 15 * time.Second
+)
+
+// limitComplianceControlsDelete is how many controls are deleted at once.
+const limitComplianceControlsDelete = 100
+
+// Deleting controls is retried when the API reports a retryable error.
+const (
+	deleteControlsRetryTimeout     = time.Minute
+	deleteControlsRetryMinInterval = time.Second
+	deleteControlsRetryMaxInterval = 15 * time.Second
 )
 
 var (
 	_ resource.Resource                   = &cloudComplianceCustomFrameworkResource{}
 	_ resource.ResourceWithConfigure      = &cloudComplianceCustomFrameworkResource{}
 	_ resource.ResourceWithImportState    = &cloudComplianceCustomFrameworkResource{}
+	_ resource.ResourceWithIdentity       = &cloudComplianceCustomFrameworkResource{}
 	_ resource.ResourceWithValidateConfig = &cloudComplianceCustomFrameworkResource{}
+	_ resource.ResourceWithModifyPlan     = &cloudComplianceCustomFrameworkResource{}
 )
 
 var (
@@ -49,19 +88,36 @@ var (
 	customFrameworkRequiredScopes              = cloudComplianceCustomFrameworkScopes
 )
 
+// Values of unmanaged_controls.
+const (
+	unmanagedControlsRemove = "remove"
+	unmanagedControlsIgnore = "ignore"
+	unmanagedControlsError  = "error"
+)
+
 func NewCloudComplianceCustomFrameworkResource() resource.Resource {
 	return &cloudComplianceCustomFrameworkResource{}
 }
 
 type cloudComplianceCustomFrameworkResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client           *client.CrowdStrikeAPISpecification
+	writeConcurrency int
 }
 
 type cloudComplianceCustomFrameworkResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Sections    types.Map    `tfsdk:"sections"`
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	Sections          types.Map      `tfsdk:"sections"`
+	CloneFrom         types.String   `tfsdk:"clone_from_framework_id"`
+	ImportJSON        types.String   `tfsdk:"import_json"`
+	OrderedSections   types.List     `tfsdk:"ordered_sections"`
+	UnmanagedControls types.String   `tfsdk:"unmanaged_controls"`
+	PendingChanges    types.Object   `tfsdk:"pending_changes"`
+	Active            types.Bool     `tfsdk:"active"`
+	AllowDeactivate   types.Bool     `tfsdk:"allow_deactivation"`
+	RollbackOnError   types.Bool     `tfsdk:"rollback_on_error"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 type SectionTFModel struct {
@@ -70,7 +126,20 @@ type SectionTFModel struct {
 }
 
 type ControlTFModel struct {
-	ID          types.String `tfsdk:"id"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Rules        types.Set    `tfsdk:"rules"`
+	RulesFilter  types.String `tfsdk:"rules_filter"`
+	RefreshRules types.Bool   `tfsdk:"refresh_rules"`
+}
+
+type OrderedSectionTFModel struct {
+	Name     types.String `tfsdk:"name"`
+	Controls types.List   `tfsdk:"controls"`
+}
+
+type OrderedControlTFModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Rules       types.Set    `tfsdk:"rules"`
@@ -84,6 +153,7 @@ func (d *cloudComplianceCustomFrameworkResourceModel) wrap(
 	d.ID = types.StringValue(framework.UUID)
 	d.Name = types.StringPointerValue(framework.Name)
 	d.Description = types.StringValue(framework.Description)
+	d.Active = types.BoolValue(framework.Active)
 
 	// Don't warp Sections here - it is handled by readControlsForFramework
 }
@@ -111,6 +181,8 @@ func (r *cloudComplianceCustomFrameworkResource) Configure(
 	}
 
 	r.client = config.Client
+	r.writeConcurrency = config.WriteConcurrency
+	resp.Diagnostics.Append(config.CheckScopes(customFrameworkRequiredScopes)...)
 }
 
 // Metadata returns the resource type name.
@@ -124,7 +196,7 @@ func (r *cloudComplianceCustomFrameworkResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *cloudComplianceCustomFrameworkResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -158,15 +230,19 @@ func (r *cloudComplianceCustomFrameworkResource) Schema(
 			},
 			"sections": schema.MapNestedAttribute{
 				Optional:            true,
-				MarkdownDescription: "Map of sections within the framework. Key is an immutable unique string. Changing the section key will trigger a complete delete and create of the section. Sections cannot exist without controls.",
+				Computed:            true,
+				MarkdownDescription: "Map of sections within the framework. Key is an immutable unique string. Changing the section key will trigger a complete delete and create of the section. Sections cannot exist without controls. When `clone_from_framework_id` is set, the sections are copied from that framework instead, and when `import_json` or `ordered_sections` is set they are planned from it. When none of them is set, the controls of the framework are not managed by this resource, so that they can be managed individually with the `crowdstrike_cloud_compliance_control` resource; removing `sections` from a framework that had them deletes its controls.",
 				Validators: []validator.Map{
 					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
 				},
+				PlanModifiers: []planmodifier.Map{
+					clonedSectionsModifier{},
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
 							Required:            true,
-							MarkdownDescription: "Display name of the compliance framework section.",
+							MarkdownDescription: "Display name of the compliance framework section. Must be unique within the framework.",
 							Validators: []validator.String{
 								stringvalidator.LengthAtLeast(1),
 							},
@@ -188,7 +264,100 @@ func (r *cloudComplianceCustomFrameworkResource) Schema(
 									},
 									"name": schema.StringAttribute{
 										Required:            true,
-										MarkdownDescription: "Display name of the compliance framework control.",
+										MarkdownDescription: "Display name of the compliance framework control. Must be unique within the section.",
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+									"description": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Description of the control.",
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+									"rules": schema.SetAttribute{
+										Optional:            true,
+										Computed:            true,
+										ElementType:         types.StringType,
+										MarkdownDescription: "Set of rule IDs assigned to this control. When `rules_filter` is set, the IDs of the rules matching the filter. Only existing CSPM IOM rules can be assigned, which is checked when planning.",
+										PlanModifiers: []planmodifier.Set{
+											controlRulesModifier{},
+										},
+									},
+									"rules_filter": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "Falcon Query Language (FQL) filter selecting the rules assigned to this control, as an alternative to `rules`. Example: `rule_provider:'AWS'+rule_service:'S3'`. The filter is resolved when it is applied and the IDs of the matching rules are recorded in `rules`. Only CSPM IOM rules are assigned, and the filter must match at most 500 rules.",
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+											stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("rules")),
+										},
+									},
+									"refresh_rules": schema.BoolAttribute{
+										Optional:            true,
+										MarkdownDescription: "Whether to resolve `rules_filter` again on each plan, so that rules matching the filter since it was applied are assigned to the control. Defaults to `false`, which keeps the rules recorded when the filter was applied.",
+										Validators: []validator.Bool{
+											boolvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("rules_filter")),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"clone_from_framework_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Identifier of a compliance framework, such as a benchmark from the `crowdstrike_cloud_compliance_benchmarks` data source, to copy the sections, controls and rules from when the framework is created. The section and control keys are generated from their names, and the requirement of each control prefixes its name. The copy is then managed by Terraform and tracked in `sections` without being compared to the source. To manage the copied sections in configuration instead, set `sections` and remove this attribute; removing it without setting `sections` deletes the copied controls. Changing it to another framework replaces the framework.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("sections")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsNull()
+						},
+						"Changing the framework the copy was cloned from replaces the framework.",
+						"Changing the framework the copy was cloned from replaces the framework.",
+					),
+				},
+			},
+			"import_json": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON document defining the sections, controls and rules of the framework, such as the `export_json` attribute of the `crowdstrike_cloud_compliance_custom_framework` data source read from another CID. The sections are planned from the document and tracked in `sections`, with section and control keys generated from their names, so changing the document updates the framework in place. The name and description of the document are not used. Removing it without setting `sections` deletes the controls of the framework.",
+				Validators: []validator.String{
+					fwvalidators.StringIsJSONObject(),
+					stringvalidator.ConflictsWith(path.MatchRoot("sections"), path.MatchRoot("clone_from_framework_id")),
+				},
+			},
+			"ordered_sections": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "List of the sections of the framework, as an alternative to `sections` that keeps the order in which sections and controls are written, such as the numbering auditors expect. The order is kept in configuration and state, as the API does not order sections and controls. The sections are planned from the list and tracked in `sections`, with section and control keys generated from their names, so renaming a section or control replaces its controls while reordering them changes nothing in the framework. Removing it without setting `sections` deletes the controls of the framework.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("sections"), path.MatchRoot("clone_from_framework_id"), path.MatchRoot("import_json")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Display name of the compliance framework section. Must be unique within the framework.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"controls": schema.ListNestedAttribute{
+							Required:            true,
+							MarkdownDescription: "List of the controls within the section.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Display name of the compliance framework control. Must be unique within the section.",
 										Validators: []validator.String{
 											stringvalidator.LengthAtLeast(1),
 										},
@@ -203,7 +372,7 @@ func (r *cloudComplianceCustomFrameworkResource) Schema(
 									"rules": schema.SetAttribute{
 										Optional:            true,
 										ElementType:         types.StringType,
-										MarkdownDescription: "Set of rule IDs assigned to this control.",
+										MarkdownDescription: "Set of rule IDs assigned to this control. Only existing CSPM IOM rules can be assigned, which is checked when planning.",
 									},
 								},
 							},
@@ -211,10 +380,77 @@ func (r *cloudComplianceCustomFrameworkResource) Schema(
 					},
 				},
 			},
+			"unmanaged_controls": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(unmanagedControlsRemove),
+				MarkdownDescription: "How controls added to the framework outside of Terraform, such as by auditors in the Falcon console, are handled when `sections` is set. " +
+					"`remove` tracks them in `sections` so that the next apply deletes them, " +
+					"`ignore` leaves them in place without tracking them, and " +
+					"`error` fails plans while the framework has any. " +
+					"With `ignore` and `error`, removing `sections` only deletes the controls created by Terraform. Defaults to `remove`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(unmanagedControlsRemove, unmanagedControlsIgnore, unmanagedControlsError),
+				},
+			},
+			"pending_changes": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Number of controls that a plan changing the framework adds, updates, renames and deletes in `sections`, so that large plans can be reviewed without expanding every section. Controls are matched by their section and control keys. It is only counted in plans changing the framework: apply keeps the planned counts in state until the next refresh or import sets them to zero.",
+				Attributes: map[string]schema.Attribute{
+					"add": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of controls to create.",
+					},
+					"update": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of controls whose description or rules change.",
+					},
+					"rename": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of controls whose name changes.",
+					},
+					"delete": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of controls to delete.",
+					},
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the framework is active. An active framework cannot be deactivated in place: changing `active` from `true` to `false` requires `allow_deactivation` and replaces the framework.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.StateValue.ValueBool() && utils.IsKnown(req.PlanValue) && !req.PlanValue.ValueBool()
+						},
+						"Deactivating the framework replaces it.",
+						"Deactivating the framework replaces it.",
+					),
+				},
+			},
+			"allow_deactivation": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether `active` can be changed from `true` to `false`. The API cannot deactivate a framework, so it is deleted with its controls and created again as inactive, with new IDs for the framework and its controls. Defaults to `false`, which rejects the change when planning.",
+			},
+			"rollback_on_error": utils.RollbackOnErrorAttribute("framework and controls"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
 		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *cloudComplianceCustomFrameworkResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *cloudComplianceCustomFrameworkResource) Create(
 	ctx context.Context,
@@ -227,6 +463,9 @@ func (r *cloudComplianceCustomFrameworkResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Info(ctx, "Creating custom compliance framework", map[string]any{
 		"name": plan.Name.ValueString(),
 	})
@@ -237,6 +476,17 @@ func (r *cloudComplianceCustomFrameworkResource) Create(
 		return
 	}
 
+	// Deleting the framework also deletes the controls created for it.
+	rollback := utils.NewRollback(plan.RollbackOnError)
+	rollback.Add(func(ctx context.Context) diag.Diagnostics {
+		return r.deleteFramework(ctx, framework.UUID)
+	})
+	defer func() {
+		if rollback.Run(ctx, &resp.Diagnostics) {
+			resp.State.RemoveResource(ctx)
+		}
+	}()
+
 	// Set the ID early for proper cleanup
 	plan.ID = types.StringValue(framework.UUID)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
@@ -249,6 +499,29 @@ func (r *cloudComplianceCustomFrameworkResource) Create(
 		return
 	}
 
+	// The sections of a clone are copied from the framework it is cloned from.
+	if utils.IsKnown(plan.CloneFrom) {
+		sections, cloneDiags := r.cloneSections(ctx, plan.CloneFrom.ValueString())
+		resp.Diagnostics.Append(cloneDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.Sections = sections
+	}
+
+	// The sections of a document or ordered sections unknown when planning
+	// are only known now.
+	if plan.Sections.IsUnknown() {
+		sections, planned, plannedDiags := r.plannedSections(ctx, plan.ImportJSON, plan.OrderedSections, types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes}))
+		resp.Diagnostics.Append(plannedDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if planned {
+			plan.Sections = sections
+		}
+	}
+
 	// Create controls and assign rules if sections are provided
 	var planSectionsMapByKey map[string]SectionTFModel
 	if utils.IsKnown(plan.Sections) {
@@ -257,13 +530,20 @@ func (r *cloudComplianceCustomFrameworkResource) Create(
 			return
 		}
 
+		var diags diag.Diagnostics
+		planSectionsMapByKey, _, diags = r.resolveRulesFilters(ctx, planSectionsMapByKey, unresolvedRules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		// Create controls for this framework
-		resp.Diagnostics.Append(r.createControlsForFramework(ctx, framework.UUID, planSectionsMapByKey)...)
+		resp.Diagnostics.Append(r.createControlsForFramework(ctx, framework.UUID, planSectionsMapByKey, nil)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		sections, sectionsDiags := r.readControlsForFramework(ctx, *framework.Name, planSectionsMapByKey)
+		sections, sectionsDiags := r.readWrittenControls(ctx, *framework.Name, planSectionsMapByKey, nil)
 		resp.Diagnostics.Append(sectionsDiags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -271,7 +551,15 @@ func (r *cloudComplianceCustomFrameworkResource) Create(
 		plan.Sections = sections
 	}
 
+	// Changes are only counted when planned.
+	if plan.PendingChanges.IsUnknown() {
+		plan.PendingChanges = noPendingChanges()
+	}
+
+	resp.Diagnostics.Append(setControlKeys(ctx, resp.Private, plan.Sections)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -287,6 +575,9 @@ func (r *cloudComplianceCustomFrameworkResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Info(ctx, "Reading custom compliance framework", map[string]any{
 		"id": state.ID.ValueString(),
 	})
@@ -308,17 +599,55 @@ func (r *cloudComplianceCustomFrameworkResource) Read(
 		return
 	}
 
+	state.PendingChanges = noPendingChanges()
+
+	if state.UnmanagedControls.IsNull() {
+		state.UnmanagedControls = types.StringValue(unmanagedControlsRemove)
+	}
+
+	isImport, importDiags := privatestate.IsImportRead(ctx, req, resp)
+	resp.Diagnostics.Append(importDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Frameworks without sections leave their controls to the
+	// crowdstrike_cloud_compliance_control resource, except when imported.
+	if state.Sections.IsNull() && !isImport {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
+		return
+	}
+
 	var stateSectionsMap map[string]SectionTFModel
 	resp.Diagnostics.Append(state.Sections.ElementsAs(ctx, &stateSectionsMap, false)...)
-	sectionsMap, sectionsDiags := r.readControlsForFramework(ctx, *framework.Name, stateSectionsMap)
+	keysByID, keysDiags := getControlKeys(ctx, req.Private, stateSectionsMap)
+	resp.Diagnostics.Append(keysDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sectionsMap, sectionsDiags := r.readControlsForFramework(ctx, *framework.Name, stateSectionsMap, keysByID)
 	resp.Diagnostics.Append(sectionsDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Imported frameworks have no controls in state to tell the ones managed
+	// by Terraform apart, so all of them are read.
+	if state.UnmanagedControls.ValueString() != unmanagedControlsRemove && !isImport {
+		sectionsMap, sectionsDiags = managedSections(ctx, sectionsMap, stateSectionsMap)
+		resp.Diagnostics.Append(sectionsDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	state.Sections = sectionsMap
+	resp.Diagnostics.Append(setControlKeys(ctx, resp.Private, state.Sections)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -336,11 +665,14 @@ func (r *cloudComplianceCustomFrameworkResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Info(ctx, "Updating custom compliance framework", map[string]any{
 		"id": plan.ID.ValueString(),
 	})
 
-	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) || !plan.Active.Equal(state.Active) {
 		params := buildUpdateFrameworkParams(ctx, plan)
 		updateResp, err := r.client.CloudPolicies.UpdateComplianceFramework(params)
 		if err != nil {
@@ -355,6 +687,15 @@ func (r *cloudComplianceCustomFrameworkResource) Update(
 		}
 	}
 
+	// Controls created by the update are deleted when a later call fails, and
+	// the prior state is kept as the framework is otherwise left as it was.
+	rollback := utils.NewRollback(plan.RollbackOnError)
+	defer func() {
+		if rollback.Run(ctx, &resp.Diagnostics) {
+			resp.State.Raw = req.State.Raw
+		}
+	}()
+
 	frameworkID := state.ID.ValueString()
 	framework, getFrameworkDiags, _ := r.getFramework(ctx, frameworkID)
 	resp.Diagnostics.Append(getFrameworkDiags...)
@@ -368,9 +709,28 @@ func (r *cloudComplianceCustomFrameworkResource) Update(
 		return
 	}
 
+	// The sections of a document or ordered sections unknown when planning
+	// are only known now.
+	if plan.Sections.IsUnknown() {
+		sections, planned, plannedDiags := r.plannedSections(ctx, plan.ImportJSON, plan.OrderedSections, state.Sections)
+		resp.Diagnostics.Append(plannedDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if planned {
+			plan.Sections = sections
+		}
+	}
+
+	// Changes are only counted when planned.
+	if plan.PendingChanges.IsUnknown() {
+		plan.PendingChanges = noPendingChanges()
+	}
+
 	// If the plan for sections is the same as state, set the new state without processing sections
 	if plan.Sections.Equal(state.Sections) {
 		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 		return
 	}
 
@@ -389,29 +749,66 @@ func (r *cloudComplianceCustomFrameworkResource) Update(
 			return
 		}
 
-		resp.Diagnostics.Append(r.processSectionUpdates(ctx, frameworkID, stateSections, planSections)...)
+		var diags diag.Diagnostics
+		planSections, _, diags = r.resolveRulesFilters(ctx, planSections, unresolvedRules)
+		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-	} else if utils.IsKnown(state.Sections) {
+
+		resp.Diagnostics.Append(r.processSectionUpdates(ctx, frameworkID, stateSections, planSections, rollback)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if utils.IsKnown(state.Sections) && plan.UnmanagedControls.ValueString() == unmanagedControlsRemove {
 		// If plan has no sections but state does, delete all existing controls
 		resp.Diagnostics.Append(r.deleteAllControlsForFramework(ctx, plan.Name.ValueString())...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+	} else if utils.IsKnown(state.Sections) {
+		// Controls not managed by Terraform are left in place.
+		for _, stateSection := range stateSections {
+			var stateSectionControls map[string]ControlTFModel
+			resp.Diagnostics.Append(stateSection.Controls.ElementsAs(ctx, &stateSectionControls, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(r.deleteRemovedControls(ctx, stateSectionControls, nil)...)
+		}
 	}
 
 	// Read back the controls to ensure state consistency only if sections are configured
 	if utils.IsKnown(plan.Sections) {
-		sectionsMap, sectionsDiags := r.readControlsForFramework(ctx, *framework.Name, planSections)
+		// The keys recorded in private state also locate the controls deleted by
+		// the update, which may still be returned, so only the controls of the
+		// plan are keyed by ID.
+		keysByID, keysDiags := sectionControlKeys(ctx, planSections)
+		resp.Diagnostics.Append(keysDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		sectionsMap, sectionsDiags := r.readWrittenControls(ctx, *framework.Name, planSections, keysByID)
 		resp.Diagnostics.Append(sectionsDiags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+
+		if plan.UnmanagedControls.ValueString() != unmanagedControlsRemove {
+			sectionsMap, sectionsDiags = managedSections(ctx, sectionsMap, planSections)
+			resp.Diagnostics.Append(sectionsDiags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
 		plan.Sections = sectionsMap
 	}
 
+	resp.Diagnostics.Append(setControlKeys(ctx, resp.Private, plan.Sections)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -428,49 +825,54 @@ func (r *cloudComplianceCustomFrameworkResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Info(ctx, "Deleting custom compliance framework", map[string]any{
 		"id": state.ID.ValueString(),
 	})
 
-	params := cloud_policies.NewDeleteComplianceFrameworkParamsWithContext(ctx)
-	params.SetIds(state.ID.ValueString())
-
-	deleteResp, err := r.client.CloudPolicies.DeleteComplianceFramework(params)
-	if err != nil {
-		if _, ok := err.(*cloud_policies.DeleteComplianceFrameworkNotFound); ok {
-			// Framework already deleted, consider this success
-			tflog.Info(ctx, "Custom compliance framework not found during delete, considering as already deleted", map[string]any{
-				"id": state.ID.ValueString(),
-			})
-			return
-		}
-		resp.Diagnostics.Append(handleAPIError(err, apiOperationDeleteFramework, state.ID.ValueString())...)
+	resp.Diagnostics.Append(r.deleteFramework(ctx, state.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if deleteResp != nil && deleteResp.Payload != nil {
-		payload := deleteResp.GetPayload()
-		if err := falcon.AssertNoError(payload.Errors); err != nil {
-			resp.Diagnostics.AddError(
-				errorDeletingFramework,
-				fmt.Sprintf("Failed to delete custom compliance framework: %s", falcon.ErrorExplain(err)),
-			)
-			return
-		}
-	}
-
 	tflog.Info(ctx, "Successfully deleted custom compliance framework", map[string]any{
 		"id": state.ID.ValueString(),
 	})
 }
 
-// ImportState imports the resource into Terraform state.
+// ImportState imports a framework by ID, or by name with an import ID of the
+// form name=<framework name>.
 func (r *cloudComplianceCustomFrameworkResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	name, byName := strings.CutPrefix(req.ID, "name=")
+	if !byName {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		resp.Diagnostics.Append(privatestate.MarkPrivateStateForImport(ctx, resp)...)
+		return
+	}
+
+	if name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <id> or name=<framework name>, got: %q", req.ID),
+		)
+		return
+	}
+
+	id, diags := queryCustomFrameworkID(ctx, r.client, name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, types.StringValue(id))...)
+	resp.Diagnostics.Append(privatestate.MarkPrivateStateForImport(ctx, resp)...)
 }
 
 func (r *cloudComplianceCustomFrameworkResource) ValidateConfig(
@@ -484,6 +886,15 @@ func (r *cloudComplianceCustomFrameworkResource) ValidateConfig(
 		return
 	}
 
+	sourcePath := path.Root("import_json")
+	if config.ImportJSON.IsNull() {
+		sourcePath = path.Root("ordered_sections")
+	}
+	_, _, plannedDiags := r.plannedSections(ctx, config.ImportJSON, config.OrderedSections, types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes}))
+	for _, d := range plannedDiags {
+		resp.Diagnostics.AddAttributeError(sourcePath, d.Summary(), d.Detail())
+	}
+
 	// Skip validation if sections is null or unknown
 	if config.Sections.IsNull() || config.Sections.IsUnknown() {
 		return
@@ -495,7 +906,30 @@ func (r *cloudComplianceCustomFrameworkResource) ValidateConfig(
 		return
 	}
 
-	for _, section := range sections {
+	// Sections and controls are read back by name, so two with the same name
+	// would be collapsed into one.
+	sectionKeysByName := make(map[string]string)
+	for _, sectionKey := range slices.Sorted(maps.Keys(sections)) {
+		section := sections[sectionKey]
+		sectionPath := path.Root("sections").AtMapKey(sectionKey)
+
+		if utils.IsKnown(section.Name) {
+			sectionName := section.Name.ValueString()
+			if otherKey, exists := sectionKeysByName[sectionName]; exists {
+				resp.Diagnostics.AddAttributeError(
+					sectionPath.AtName("name"),
+					"Duplicate Section Name",
+					fmt.Sprintf("Sections %q and %q are both named %q. Section names must be unique within the framework.", otherKey, sectionKey, sectionName),
+				)
+			} else {
+				sectionKeysByName[sectionName] = sectionKey
+			}
+		}
+
+		if !utils.IsKnown(section.Controls) {
+			continue
+		}
+
 		var controls map[string]ControlTFModel
 		resp.Diagnostics.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
 		if resp.Diagnostics.HasError() {
@@ -510,59 +944,644 @@ func (r *cloudComplianceCustomFrameworkResource) ValidateConfig(
 				fmt.Sprintf("Section '%s' cannot be empty. Each section must contain at least one control.", sectionName),
 			)
 		}
+
+		controlKeysByName := make(map[string]string)
+		for _, controlKey := range slices.Sorted(maps.Keys(controls)) {
+			control := controls[controlKey]
+			if !utils.IsKnown(control.Name) {
+				continue
+			}
+
+			controlName := control.Name.ValueString()
+			if otherKey, exists := controlKeysByName[controlName]; exists {
+				resp.Diagnostics.AddAttributeError(
+					sectionPath.AtName("controls").AtMapKey(controlKey).AtName("name"),
+					"Duplicate Control Name",
+					fmt.Sprintf("Controls %q and %q of section %q are both named %q. Control names must be unique within a section.", otherKey, controlKey, sectionKey, controlName),
+				)
+				continue
+			}
+			controlKeysByName[controlName] = controlKey
+		}
 	}
 }
 
-func (r *cloudComplianceCustomFrameworkResource) createFramework(
+// ModifyPlan resolves the rule filters of the controls with refresh_rules set,
+// so that the rules matching them since they were applied are planned, and
+// checks that the rules assigned to the controls can be assigned to them.
+func (r *cloudComplianceCustomFrameworkResource) ModifyPlan(
 	ctx context.Context,
-	plan cloudComplianceCustomFrameworkResourceModel,
-) (*models.ApimodelsSecurityFramework, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	params := buildCreateFrameworkParams(ctx, plan)
-	createResp, err := r.client.CloudPolicies.CreateComplianceFramework(params)
-	if err != nil {
-		diags.Append(handleAPIError(err, apiOperationCreateFramework, "")...)
-		return nil, diags
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
 	}
 
-	payload := createResp.GetPayload()
-	diags.Append(validateAPIResponse(payload, errorCreatingFramework)...)
-	if diags.HasError() {
-		return nil, diags
+	// The changes are counted once the sections are planned.
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(r.planPendingChanges(ctx, req, resp)...)
+		}
+	}()
+
+	resp.Diagnostics.Append(r.checkDeactivation(ctx, req)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return payload.Resources[0], diags
-}
+	resp.Diagnostics.Append(r.planImportedSections(ctx, req, resp)...)
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
 
-// createControlsForFramework creates controls and assigns rules for a framework.
-func (r *cloudComplianceCustomFrameworkResource) createControlsForFramework(
-	ctx context.Context,
-	frameworkID string,
-	sectionsByKey map[string]SectionTFModel,
-) diag.Diagnostics {
-	diags := diag.Diagnostics{}
+	resp.Diagnostics.Append(r.checkUnmanagedControls(ctx, req)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	var sections types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("sections"), &sections)...)
+	if resp.Diagnostics.HasError() || !utils.IsKnown(sections) {
+		return
+	}
+
+	var sectionsByKey map[string]SectionTFModel
+	resp.Diagnostics.Append(sections.ElementsAs(ctx, &sectionsByKey, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validatePlannedRules(ctx, req.State, sectionsByKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshed, resolved, diags := r.resolveRulesFilters(ctx, sectionsByKey, func(control ControlTFModel) bool {
+		return control.RefreshRules.ValueBool() && utils.IsKnown(control.RulesFilter)
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !resolved {
+		return
+	}
+
+	sections, diags = convertSectionsMapToTerraformMap(ctx, refreshed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sections"), sections)...)
+}
+
+// planPendingChanges plans pending_changes when the framework changes, which
+// is when it is unknown, and the planned sections are known.
+func (r *cloudComplianceCustomFrameworkResource) planPendingChanges(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var pending types.Object
+	var planned types.Map
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("pending_changes"), &pending)...)
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("sections"), &planned)...)
+	if diags.HasError() || !pending.IsUnknown() || planned.IsUnknown() {
+		return diags
+	}
+
+	prior := types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes})
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("sections"), &prior)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	pending, pendingDiags := pendingChanges(ctx, prior, planned)
+	diags.Append(pendingDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("pending_changes"), pending)...)
+	return diags
+}
+
+// planImportedSections plans the sections defined by import_json or
+// ordered_sections, keeping the IDs of the controls already created from them.
+func (r *cloudComplianceCustomFrameworkResource) planImportedSections(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var importJSON types.String
+	var orderedSections types.List
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("import_json"), &importJSON)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("ordered_sections"), &orderedSections)...)
+	if diags.HasError() || (importJSON.IsNull() && orderedSections.IsNull()) {
+		return diags
+	}
+
+	stateSections := types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes})
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("sections"), &stateSections)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	sections, planned, plannedDiags := r.plannedSections(ctx, importJSON, orderedSections, stateSections)
+	diags.Append(plannedDiags...)
+	if diags.HasError() || !planned {
+		return diags
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("sections"), sections)...)
+	return diags
+}
+
+// checkUnmanagedControls fails the plan when unmanaged_controls is "error" and
+// the framework has controls that are not in the sections managed by
+// Terraform.
+func (r *cloudComplianceCustomFrameworkResource) checkUnmanagedControls(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.State.Raw.IsNull() {
+		return diags
+	}
+
+	var unmanaged, name types.String
+	var sections types.Map
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("unmanaged_controls"), &unmanaged)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("sections"), &sections)...)
+	if diags.HasError() || unmanaged.ValueString() != unmanagedControlsError || !utils.IsKnown(sections) {
+		return diags
+	}
+
+	var sectionsByKey map[string]SectionTFModel
+	diags.Append(sections.ElementsAs(ctx, &sectionsByKey, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var managedIDs []string
 	for _, section := range sectionsByKey {
+		var controls map[string]ControlTFModel
+		diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+		for _, control := range controls {
+			managedIDs = append(managedIDs, control.ID.ValueString())
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	controlIDs, queryDiags := r.queryFrameworkControls(ctx, name.ValueString())
+	diags.Append(queryDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	unmanagedIDs := utils.MissingElements(controlIDs, managedIDs)
+	if len(unmanagedIDs) == 0 {
+		return diags
+	}
+
+	controls, controlDiags := r.getControlDetails(ctx, unmanagedIDs)
+	diags.Append(controlDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	names := make([]string, 0, len(controls))
+	for _, control := range controls {
+		names = append(names, fmt.Sprintf("%q in section %q", *control.Name, control.SectionName))
+	}
+	slices.Sort(names)
+
+	diags.AddAttributeError(
+		path.Root("unmanaged_controls"),
+		"Unmanaged Controls Found",
+		fmt.Sprintf(
+			"The framework has controls that are not managed by Terraform: %s. Add them to sections, delete them, or set unmanaged_controls to %q or %q.",
+			strings.Join(names, ", "),
+			unmanagedControlsIgnore,
+			unmanagedControlsRemove,
+		),
+	)
+
+	return diags
+}
+
+// checkDeactivation rejects deactivating an active framework unless
+// allow_deactivation is set, and otherwise warns that the framework is
+// replaced.
+func (r *cloudComplianceCustomFrameworkResource) checkDeactivation(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.State.Raw.IsNull() {
+		return diags
+	}
+
+	var plan, state cloudComplianceCustomFrameworkResourceModel
+	diags.Append(req.Plan.Get(ctx, &plan)...)
+	diags.Append(req.State.Get(ctx, &state)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !state.Active.ValueBool() || !utils.IsKnown(plan.Active) || plan.Active.ValueBool() {
+		return diags
+	}
+
+	if !plan.AllowDeactivate.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("active"),
+			"Framework Deactivation Not Allowed",
+			fmt.Sprintf(
+				"The custom compliance framework %q is active and cannot be deactivated in place. "+
+					"Set allow_deactivation = true to delete it and create it again as inactive.",
+				state.Name.ValueString(),
+			),
+		)
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("active"),
+		"Framework Will Be Replaced",
+		fmt.Sprintf(
+			"Deactivating the custom compliance framework %q deletes it with its controls and creates it again as inactive. "+
+				"The framework and its controls get new IDs, and controls managed with crowdstrike_cloud_compliance_control are replaced.",
+			state.Name.ValueString(),
+		),
+	)
+
+	return diags
+}
+
+// validatePlannedRules returns an error for each rule configured for the
+// controls of sectionsByKey that cannot be assigned to them, so that it is
+// reported when planning rather than after the framework is created. Rules
+// assigned to the controls in state are not checked again.
+func (r *cloudComplianceCustomFrameworkResource) validatePlannedRules(
+	ctx context.Context,
+	state tfsdk.State,
+	sectionsByKey map[string]SectionTFModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	assigned := make(map[string]bool)
+	if !state.Raw.IsNull() {
+		var stateSections types.Map
+		diags.Append(state.GetAttribute(ctx, path.Root("sections"), &stateSections)...)
+
+		var stateSectionsByKey map[string]SectionTFModel
+		if utils.IsKnown(stateSections) {
+			diags.Append(stateSections.ElementsAs(ctx, &stateSectionsByKey, false)...)
+		}
+
+		for _, section := range stateSectionsByKey {
+			var controls map[string]ControlTFModel
+			diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+			for _, control := range controls {
+				var ruleIDs []string
+				diags.Append(control.Rules.ElementsAs(ctx, &ruleIDs, false)...)
+				for _, id := range ruleIDs {
+					assigned[id] = true
+				}
+			}
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	rulePaths := make(map[string][]path.Path)
+	for sectionKey, section := range sectionsByKey {
+		var controls map[string]ControlTFModel
+		diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for controlKey, control := range controls {
+			// The rules matching a filter are CSPM IOM rules.
+			if !control.RulesFilter.IsNull() || !utils.IsKnown(control.Rules) {
+				continue
+			}
+
+			var ruleIDs []string
+			diags.Append(control.Rules.ElementsAs(ctx, &ruleIDs, false)...)
+			rulesPath := path.Root("sections").AtMapKey(sectionKey).AtName("controls").AtMapKey(controlKey).AtName("rules")
+			for _, id := range ruleIDs {
+				if !assigned[id] {
+					rulePaths[id] = append(rulePaths[id], rulesPath)
+				}
+			}
+		}
+	}
+	if diags.HasError() || len(rulePaths) == 0 {
+		return diags
+	}
+
+	invalid, invalidDiags := r.invalidRules(ctx, slices.Sorted(maps.Keys(rulePaths)))
+	diags.Append(invalidDiags...)
+	for _, id := range slices.Sorted(maps.Keys(invalid)) {
+		for _, rulesPath := range rulePaths[id] {
+			diags.AddAttributeError(rulesPath, errorInvalidRule, invalid[id])
+		}
+	}
+
+	return diags
+}
+
+// invalidRules returns the reason each of ruleIDs that does not exist or is
+// not a CSPM IOM rule cannot be assigned to a control.
+func (r *cloudComplianceCustomFrameworkResource) invalidRules(
+	ctx context.Context,
+	ruleIDs []string,
+) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	invalid := make(map[string]string)
+
+	rules := make(map[string]*models.ApimodelsRule, len(ruleIDs))
+	for batch := range slices.Chunk(ruleIDs, int(limitComplianceRulesGet)) {
+		params := cloud_policies.NewGetRuleParamsWithContext(ctx).WithIds(batch)
+		res, err := r.client.CloudPolicies.GetRule(params)
+		if err != nil {
+			// None of the rules of the batch exist.
+			if _, ok := err.(*cloud_policies.GetRuleNotFound); ok {
+				continue
+			}
+
+			diags.AddError(
+				errorQueryingRules,
+				fmt.Sprintf("Failed to get the rules assigned to controls: %s", falcon.ErrorExplain(err)),
+			)
+			return nil, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			continue
+		}
+
+		for _, rule := range res.Payload.Resources {
+			if rule != nil && rule.UUID != nil {
+				rules[*rule.UUID] = rule
+			}
+		}
+	}
+
+	for _, id := range ruleIDs {
+		rule, exists := rules[id]
+		if !exists {
+			invalid[id] = fmt.Sprintf("The rule %q does not exist.", id)
+			continue
+		}
+
+		var domain, subdomain string
+		if rule.Domain != nil {
+			domain = *rule.Domain
+		}
+		if rule.Subdomain != nil {
+			subdomain = *rule.Subdomain
+		}
+
+		if domain != "CSPM" || subdomain != "IOM" {
+			invalid[id] = fmt.Sprintf(
+				"The rule %q is a %s %s rule, but only CSPM IOM rules can be assigned to compliance controls.",
+				id,
+				domain,
+				subdomain,
+			)
+		}
+	}
+
+	return invalid, diags
+}
+
+// resolveRulesFilters returns sectionsByKey with the rules of the controls
+// selected by resolve set to the rules matching their rules_filter, and
+// whether any control was selected.
+func (r *cloudComplianceCustomFrameworkResource) resolveRulesFilters(
+	ctx context.Context,
+	sectionsByKey map[string]SectionTFModel,
+	resolve func(ControlTFModel) bool,
+) (map[string]SectionTFModel, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resolved := false
+
+	for sectionKey, section := range sectionsByKey {
+		var controls map[string]ControlTFModel
+		diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+		if diags.HasError() {
+			return nil, false, diags
+		}
+
+		sectionResolved := false
+		for controlKey, control := range controls {
+			if control.RulesFilter.IsNull() || !resolve(control) {
+				continue
+			}
+
+			ruleIDs, queryDiags := r.queryRulesByFilter(ctx, control.RulesFilter.ValueString())
+			diags.Append(queryDiags...)
+			if diags.HasError() {
+				return nil, false, diags
+			}
+
+			rules, setDiags := convertRulesToTerraformSet(ruleIDs)
+			diags.Append(setDiags...)
+			control.Rules = rules
+			controls[controlKey] = control
+			sectionResolved = true
+		}
+
+		if !sectionResolved {
+			continue
+		}
+
+		controlsMap, mapDiags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: controlAttrTypes}, controls)
+		diags.Append(mapDiags...)
+		section.Controls = controlsMap
+		sectionsByKey[sectionKey] = section
+		resolved = true
+	}
+
+	return sectionsByKey, resolved, diags
+}
+
+// unresolvedRules selects the controls whose rules_filter was not resolved
+// while planning.
+func unresolvedRules(control ControlTFModel) bool {
+	return control.Rules.IsUnknown()
+}
+
+// queryRulesByFilter returns the IDs of the CSPM IOM rules matching filter,
+// which are the rules that are read back from controls.
+func (r *cloudComplianceCustomFrameworkResource) queryRulesByFilter(
+	ctx context.Context,
+	filter string,
+) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rulesFilter := fmt.Sprintf(filterComplianceRulesByFQL, filter)
+	queryRulesParams := cloud_policies.NewQueryRuleParamsWithContext(ctx).
+		WithFilter(&rulesFilter).
+		WithSort(&sortComplianceRulesByUpdatedAtAsc).
+		WithLimit(&limitComplianceRulesMax)
+
+	queryRulesResp, err := r.client.CloudPolicies.QueryRule(queryRulesParams)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("sections"),
+			errorQueryingRules,
+			fmt.Sprintf("Failed to query rules matching rules_filter %q: %s", filter, falcon.ErrorExplain(err)),
+		)
+		return nil, diags
+	}
+
+	if queryRulesResp == nil || queryRulesResp.Payload == nil {
+		return []string{}, diags
+	}
+
+	if total := utils.ListTotal(queryRulesResp.Payload.Meta); total > limitComplianceRulesMax {
+		diags.AddAttributeError(
+			path.Root("sections"),
+			errorQueryingRules,
+			fmt.Sprintf(
+				"The rules_filter %q matches %d rules, but at most %d rules can be assigned to a control. Narrow the filter.",
+				filter,
+				total,
+				limitComplianceRulesMax,
+			),
+		)
+		return nil, diags
+	}
+
+	return queryRulesResp.Payload.Resources, diags
+}
+
+// deleteFramework deletes a framework and all of its controls. A framework that
+// does not exist is considered deleted.
+func (r *cloudComplianceCustomFrameworkResource) deleteFramework(
+	ctx context.Context,
+	id string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	params := cloud_policies.NewDeleteComplianceFrameworkParamsWithContext(ctx)
+	params.SetIds(id)
+
+	deleteResp, err := r.client.CloudPolicies.DeleteComplianceFramework(params)
+	if err != nil {
+		if _, ok := err.(*cloud_policies.DeleteComplianceFrameworkNotFound); ok {
+			// Framework already deleted, consider this success
+			tflog.Info(ctx, "Custom compliance framework not found during delete, considering as already deleted", map[string]any{
+				"id": id,
+			})
+			return diags
+		}
+		diags.Append(handleAPIError(err, apiOperationDeleteFramework, id)...)
+		return diags
+	}
+
+	if deleteResp != nil && deleteResp.Payload != nil {
+		payload := deleteResp.GetPayload()
+		if err := falcon.AssertNoError(payload.Errors); err != nil {
+			diags.AddError(
+				errorDeletingFramework,
+				fmt.Sprintf("Failed to delete custom compliance framework: %s", falcon.ErrorExplain(err)),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r *cloudComplianceCustomFrameworkResource) createFramework(
+	ctx context.Context,
+	plan cloudComplianceCustomFrameworkResourceModel,
+) (*models.ApimodelsSecurityFramework, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	params := buildCreateFrameworkParams(ctx, plan)
+	createResp, err := r.client.CloudPolicies.CreateComplianceFramework(params)
+	if err != nil {
+		diags.Append(handleAPIError(err, apiOperationCreateFramework, "")...)
+		return nil, diags
+	}
+
+	payload := createResp.GetPayload()
+	diags.Append(validateAPIResponse(payload, errorCreatingFramework)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return payload.Resources[0], diags
+}
+
+// createControlsForFramework creates controls and assigns rules for a framework.
+func (r *cloudComplianceCustomFrameworkResource) createControlsForFramework(
+	ctx context.Context,
+	frameworkID string,
+	sectionsByKey map[string]SectionTFModel,
+	rollback *utils.Rollback,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	type sectionControl struct {
+		section string
+		control ControlTFModel
+	}
+
+	var controls []sectionControl
+	for _, sectionKey := range slices.Sorted(maps.Keys(sectionsByKey)) {
+		section := sectionsByKey[sectionKey]
 		var sectionControls map[string]ControlTFModel
 		diags.Append(section.Controls.ElementsAs(ctx, &sectionControls, false)...)
 		if diags.HasError() {
 			continue
 		}
 
-		for _, control := range sectionControls {
-			diags.Append(r.createSingleControl(ctx, frameworkID, section.Name.ValueString(), control)...)
+		for _, controlKey := range slices.Sorted(maps.Keys(sectionControls)) {
+			controls = append(controls, sectionControl{section.Name.ValueString(), sectionControls[controlKey]})
 		}
 	}
+	if diags.HasError() {
+		return diags
+	}
+
+	_, createDiags := utils.ConcurrentMap(
+		ctx,
+		controls,
+		r.writeConcurrency,
+		func(ctx context.Context, c sectionControl) (struct{}, diag.Diagnostics) {
+			return struct{}{}, r.createSingleControl(ctx, frameworkID, c.section, c.control, rollback)
+		},
+	)
+	diags.Append(createDiags...)
 
 	return diags
 }
 
-// createSingleControl creates a single control.
+// createSingleControl creates a single control, recording its deletion in
+// rollback when rollback is not nil.
 func (r *cloudComplianceCustomFrameworkResource) createSingleControl(
 	ctx context.Context,
 	frameworkID string,
 	sectionName string,
 	control ControlTFModel,
+	rollback *utils.Rollback,
 ) diag.Diagnostics {
 	diags := diag.Diagnostics{}
 	controlDesc := control.Description.ValueString()
@@ -583,6 +1602,11 @@ func (r *cloudComplianceCustomFrameworkResource) createSingleControl(
 
 	// Assign rules to control if any
 	controlID := createResp.Payload.Resources[0].UUID
+	if rollback != nil {
+		rollback.Add(func(ctx context.Context) diag.Diagnostics {
+			return r.deleteControls(ctx, []string{*controlID})
+		})
+	}
 	var ruleIds []string
 	if !control.Rules.IsNull() && len(control.Rules.Elements()) > 0 {
 		diags.Append(control.Rules.ElementsAs(ctx, &ruleIds, false)...)
@@ -642,10 +1666,12 @@ func (r *cloudComplianceCustomFrameworkResource) getFramework(
 }
 
 // readControlsForFramework reads controls and rules for a framework and returns sections as terraform map.
+// Controls are keyed by keysByID, and otherwise by matching their names to sectionsMapByKey.
 func (r *cloudComplianceCustomFrameworkResource) readControlsForFramework(
 	ctx context.Context,
 	frameworkName string,
 	sectionsMapByKey map[string]SectionTFModel,
+	keysByID map[string]controlKeys,
 ) (types.Map, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -667,67 +1693,109 @@ func (r *cloudComplianceCustomFrameworkResource) readControlsForFramework(
 		return types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes}), diags
 	}
 
+	rulesByControl, rulesDiags := r.queryFrameworkRules(ctx, frameworkName)
+	diags.Append(rulesDiags...)
+	if diags.HasError() {
+		return types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes}), diags
+	}
+
+	controlModels := make([]ControlTFModel, len(apiControls))
+	for i, apiControl := range apiControls {
+		ruleIDs := rulesByControl[controlRequirement{apiControl.SectionName, apiControl.Requirement}]
+		var controlDiags diag.Diagnostics
+		controlModels[i], controlDiags = controlWithRules(apiControl, ruleIDs)
+		diags.Append(controlDiags...)
+	}
+	if diags.HasError() {
+		return types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes}), diags
+	}
+
 	sectionsDomainMapByName, sectionsDomainMapDiags := convertSectionsTFMapToDomainMapByName(ctx, sectionsMapByKey)
 	diags.Append(sectionsDomainMapDiags...)
 	if diags.HasError() {
 		return types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes}), diags
 	}
 
-	// Organize controls by section
-	nameToKey := make(map[string]string)
-	respSectionsMapByNames := make(map[string]map[string]ControlTFModel)
-	for _, apiControl := range apiControls {
-		sectionName := apiControl.SectionName
-		controlName := *apiControl.Name
-		var sectionKey string
-		var controlKey string
+	// Controls with recorded keys keep them, so that controls and sections
+	// renamed outside of Terraform are updated in place rather than replaced.
+	// The other controls are matched by name, and their sections first to the
+	// sections of the recorded controls.
+	apiControlKeys := make([]controlKeys, len(apiControls))
+	sectionKeysByName := make(map[string]string)
+	for i, apiControl := range apiControls {
+		if apiControl.UUID == nil {
+			continue
+		}
+		if keys, exists := keysByID[*apiControl.UUID]; exists {
+			apiControlKeys[i] = keys
+			if _, exists := sectionKeysByName[apiControl.SectionName]; !exists {
+				sectionKeysByName[apiControl.SectionName] = keys.Section
+			}
+		}
+	}
 
-		section, sectionExists := sectionsDomainMapByName[sectionName]
-		if !sectionExists {
-			sectionKey = r.generateKeyFromName(sectionName)
-		} else {
-			sectionKey = section.Key
+	for i, apiControl := range apiControls {
+		if apiControlKeys[i] != (controlKeys{}) {
+			continue
 		}
 
-		control, controlExists := sectionsDomainMapByName[sectionName].Controls[controlName]
-		if !controlExists {
-			controlKey = r.generateKeyFromName(controlName)
-		} else {
-			controlKey = control.Key
+		sectionName := apiControl.SectionName
+		sectionKey, sectionExists := sectionKeysByName[sectionName]
+		if !sectionExists {
+			if section, exists := sectionsDomainMapByName[sectionName]; exists {
+				sectionKey = section.Key
+			} else {
+				sectionKey = r.generateKeyFromName(sectionName)
+			}
+			sectionKeysByName[sectionName] = sectionKey
 		}
 
-		if _, exists := nameToKey[sectionName]; !exists {
-			nameToKey[sectionName] = sectionKey
+		controlName := *apiControl.Name
+		controlKey := r.generateKeyFromName(controlName)
+		if control, exists := sectionsDomainMapByName[sectionName].Controls[controlName]; exists {
+			controlKey = control.Key
 		}
 
-		nameToKey[controlName] = controlKey
+		apiControlKeys[i] = controlKeys{Section: sectionKey, Control: controlKey}
+	}
 
-		// Initialize section if it does not exist
-		if _, exists := respSectionsMapByNames[sectionName]; !exists {
-			respSectionsMapByNames[sectionName] = make(map[string]ControlTFModel)
+	// Organize controls by section
+	sectionNames := make(map[string]string)
+	controlsBySection := make(map[string]map[string]ControlTFModel)
+	for i, apiControl := range apiControls {
+		keys := apiControlKeys[i]
+
+		// The rule filter settings are only known to Terraform.
+		if section, exists := sectionsMapByKey[keys.Section]; exists && utils.IsKnown(section.Controls) {
+			var controls map[string]ControlTFModel
+			diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+			if control, exists := controls[keys.Control]; exists {
+				controlModels[i].RulesFilter = control.RulesFilter
+				controlModels[i].RefreshRules = control.RefreshRules
+			}
 		}
 
-		controlModel, controlDiags := r.readControlWithRules(ctx, apiControl, frameworkName)
-		diags.Append(controlDiags...)
-		if diags.HasError() {
-			continue
+		if _, exists := controlsBySection[keys.Section]; !exists {
+			sectionNames[keys.Section] = apiControl.SectionName
+			controlsBySection[keys.Section] = make(map[string]ControlTFModel)
 		}
-
-		respSectionsMapByNames[sectionName][controlName] = controlModel
+		controlsBySection[keys.Section][keys.Control] = controlModels[i]
+	}
+	if diags.HasError() {
+		return types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes}), diags
 	}
 
 	// Convert sections and controls to terraform maps
 	sectionsMap := make(map[string]SectionTFModel)
-	for sectionName, section := range respSectionsMapByNames {
-		controlsMap, controlsMapDiags := convertControlsMapToTerraformMap(ctx, section, nameToKey)
+	for sectionKey, controls := range controlsBySection {
+		controlsMap, controlsMapDiags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: controlAttrTypes}, controls)
 		diags.Append(controlsMapDiags...)
 		if diags.HasError() {
 			continue
 		}
 
-		sectionKey := nameToKey[sectionName]
 		sectionsMap[sectionKey] = SectionTFModel{
-			Name:     types.StringValue(sectionName),
+			Name:     types.StringValue(sectionNames[sectionKey]),
 			Controls: controlsMap,
 		}
 	}
@@ -738,107 +1806,299 @@ func (r *cloudComplianceCustomFrameworkResource) readControlsForFramework(
 	return sectionsTFMap, diags
 }
 
-func (r *cloudComplianceCustomFrameworkResource) queryFrameworkControls(
+// readWrittenControls reads the controls of a framework after they were
+// written, like readControlsForFramework. As controls are not always visible
+// to queries right away, it reads them again with exponential backoff until
+// every control of sectionsMapByKey is visible or controlsVisibleTimeout
+// elapses.
+func (r *cloudComplianceCustomFrameworkResource) readWrittenControls(
 	ctx context.Context,
 	frameworkName string,
-) ([]string, diag.Diagnostics) {
+	sectionsMapByKey map[string]SectionTFModel,
+	keysByID map[string]controlKeys,
+) (types.Map, diag.Diagnostics) {
+	var sections types.Map
 	var diags diag.Diagnostics
 
-	frameworkNameFilter := fmt.Sprintf(filterComplianceControlsByFramework, frameworkName)
-	queryControlsParams := cloud_policies.NewQueryComplianceControlsParamsWithContext(ctx).
-		WithFilter(&frameworkNameFilter).
-		WithSort(&sortComplianceControlsByRequirementAsc).
-		WithLimit(&limitComplianceControlsMax)
+	err := retry.RetryWithBackoff(ctx, controlsVisibleTimeout, controlsVisibleMinInterval, controlsVisibleMaxInterval, func() error {
+		sections, diags = r.readControlsForFramework(ctx, frameworkName, sectionsMapByKey, keysByID)
+		if diags.HasError() {
+			return nil
+		}
 
-	queryControlsResp, err := r.client.CloudPolicies.QueryComplianceControls(queryControlsParams)
+		missing, missingDiags := missingControls(ctx, sections, sectionsMapByKey)
+		diags.Append(missingDiags...)
+		if diags.HasError() || len(missing) == 0 {
+			return nil
+		}
+
+		tflog.Debug(ctx, "Waiting for framework controls to be visible", map[string]any{
+			"framework": frameworkName,
+			"missing":   missing,
+		})
+		return fmt.Errorf("%d controls of framework %q are not visible yet: %s", len(missing), frameworkName, strings.Join(missing, ", "))
+	})
 	if err != nil {
-		diags.AddError(errorQueryingControls,
-			fmt.Sprintf("Failed to query controls for framework %s: %s", frameworkName, falcon.ErrorExplain(err)))
-		return nil, diags
+		diags.AddError(errorQueryingControls, fmt.Sprintf("Failed to read back the controls written to the framework: %s", err))
 	}
 
-	if queryControlsResp == nil || queryControlsResp.Payload == nil || len(queryControlsResp.Payload.Resources) == 0 {
-		return []string{}, diags
+	return sections, diags
+}
+
+// missingControls returns the names of the controls of sectionsMapByKey that
+// are not in sections, as "<section>/<control>".
+func missingControls(
+	ctx context.Context,
+	sections types.Map,
+	sectionsMapByKey map[string]SectionTFModel,
+) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var readSections map[string]SectionTFModel
+	if !sections.IsNull() {
+		diags.Append(sections.ElementsAs(ctx, &readSections, false)...)
+	}
+
+	visible := make(map[string]bool)
+	for _, section := range readSections {
+		var controls map[string]ControlTFModel
+		diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+		for _, control := range controls {
+			visible[section.Name.ValueString()+"/"+control.Name.ValueString()] = true
+		}
+	}
+
+	var missing []string
+	for _, section := range sectionsMapByKey {
+		if !utils.IsKnown(section.Controls) {
+			continue
+		}
+
+		var controls map[string]ControlTFModel
+		diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+		for _, control := range controls {
+			name := section.Name.ValueString() + "/" + control.Name.ValueString()
+			if !visible[name] {
+				missing = append(missing, name)
+			}
+		}
+	}
+	slices.Sort(missing)
+
+	return missing, diags
+}
+
+// queryFrameworkControls returns the IDs of every custom control of the
+// framework, fetched page by page.
+func (r *cloudComplianceCustomFrameworkResource) queryFrameworkControls(
+	ctx context.Context,
+	frameworkName string,
+) ([]string, diag.Diagnostics) {
+	frameworkNameFilter := fmt.Sprintf(filterComplianceControlsByFramework, frameworkName)
+
+	all := utils.Pagination{Limit: limitComplianceControlsMax, MaxItems: math.MaxInt64}
+	controlIDs, _, diags := utils.QueryPages(all, func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		queryControlsParams := cloud_policies.NewQueryComplianceControlsParamsWithContext(ctx).
+			WithFilter(&frameworkNameFilter).
+			WithSort(&sortComplianceControlsByRequirementAsc).
+			WithLimit(&limit).
+			WithOffset(&offset)
+
+		queryControlsResp, err := r.client.CloudPolicies.QueryComplianceControls(queryControlsParams)
+		if err != nil {
+			diags.AddError(errorQueryingControls,
+				fmt.Sprintf("Failed to query controls for framework %s: %s", frameworkName, falcon.ErrorExplain(err)))
+			return nil, 0, diags
+		}
+
+		if queryControlsResp == nil || queryControlsResp.Payload == nil {
+			return nil, 0, diags
+		}
+
+		return queryControlsResp.Payload.Resources, utils.ListTotal(queryControlsResp.Payload.Meta), diags
+	})
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	return queryControlsResp.Payload.Resources, diags
+	return controlIDs, diags
 }
 
+// getControlDetails returns the controls with the given IDs, requested in
+// batches.
 func (r *cloudComplianceCustomFrameworkResource) getControlDetails(
 	ctx context.Context,
 	controlIds []string,
 ) ([]*models.ApimodelsControl, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	getControlsParams := cloud_policies.NewGetComplianceControlsParamsWithContext(ctx).WithIds(controlIds)
-	getControlsResp, err := r.client.CloudPolicies.GetComplianceControls(getControlsParams)
-	if err != nil {
-		diags.Append(handleAPIError(err, apiOperationReadControls, strings.Join(controlIds, ","))...)
-		return nil, diags
+	controls := make([]*models.ApimodelsControl, 0, len(controlIds))
+	for batch := range slices.Chunk(controlIds, cloudComplianceControlsGetLimit) {
+		getControlsParams := cloud_policies.NewGetComplianceControlsParamsWithContext(ctx).WithIds(batch)
+		getControlsResp, err := r.client.CloudPolicies.GetComplianceControls(getControlsParams)
+		if err != nil {
+			diags.Append(handleAPIError(err, apiOperationReadControls, strings.Join(batch, ","))...)
+			return nil, diags
+		}
+
+		payload := getControlsResp.GetPayload()
+		diags.Append(validateAPIResponse(payload, errorGettingControls)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		controls = append(controls, payload.Resources...)
 	}
 
-	payload := getControlsResp.GetPayload()
-	diags.Append(validateAPIResponse(payload, errorGettingControls)...)
+	return controls, diags
+}
+
+// controlWithRules returns the model of control with the given rules.
+func controlWithRules(control *models.ApimodelsControl, ruleIDs []string) (ControlTFModel, diag.Diagnostics) {
+	rulesSet, diags := convertRulesToTerraformSet(ruleIDs)
 	if diags.HasError() {
-		return nil, diags
+		return ControlTFModel{}, diags
 	}
 
-	return getControlsResp.Payload.Resources, diags
+	return ControlTFModel{
+		ID:           types.StringValue(*control.UUID),
+		Name:         types.StringValue(*control.Name),
+		Description:  types.StringValue(control.Description),
+		Rules:        rulesSet,
+		RulesFilter:  types.StringNull(),
+		RefreshRules: types.BoolNull(),
+	}, diags
+}
+
+// controlRequirement identifies a control of a framework by its section and
+// requirement, which is how rules reference the controls they are assigned to.
+type controlRequirement struct {
+	section     string
+	requirement string
 }
 
-func (r *cloudComplianceCustomFrameworkResource) readControlWithRules(
+// queryFrameworkRules returns the IDs of the rules assigned to the controls of
+// the framework, grouped by control. All rules of the framework are read
+// together rather than querying the rules of each control.
+func (r *cloudComplianceCustomFrameworkResource) queryFrameworkRules(
 	ctx context.Context,
-	control *models.ApimodelsControl,
 	frameworkName string,
-) (ControlTFModel, diag.Diagnostics) {
-	var diags diag.Diagnostics
+) (map[controlRequirement][]string, diag.Diagnostics) {
+	rulesByFrameworkFilter := fmt.Sprintf(filterComplianceRulesByFramework, frameworkName)
+
+	all := utils.Pagination{Limit: limitComplianceRulesMax, MaxItems: math.MaxInt64}
+	ruleIDs, _, diags := utils.QueryPages(all, func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		queryRulesParams := cloud_policies.NewQueryRuleParamsWithContext(ctx).
+			WithFilter(&rulesByFrameworkFilter).
+			WithSort(&sortComplianceRulesByUpdatedAtAsc).
+			WithLimit(&limit).
+			WithOffset(&offset)
+
+		queryRulesResp, err := r.client.CloudPolicies.QueryRule(queryRulesParams)
+		if err != nil {
+			diags.AddError(errorQueryingRules,
+				fmt.Sprintf("Failed to query rules for framework %s: %s", frameworkName, falcon.ErrorExplain(err)))
+			return nil, 0, diags
+		}
+
+		if queryRulesResp == nil || queryRulesResp.Payload == nil {
+			return nil, 0, diags
+		}
 
-	// Query rules for this control
-	ruleIDs, ruleDiags := r.queryControlRules(ctx, frameworkName, control.SectionName, control.Requirement)
-	diags.Append(ruleDiags...)
+		return queryRulesResp.Payload.Resources, utils.ListTotal(queryRulesResp.Payload.Meta), diags
+	})
 	if diags.HasError() {
-		return ControlTFModel{}, diags
+		return nil, diags
 	}
 
-	// Convert rules to Terraform set
-	rulesSet, setDiags := convertRulesToTerraformSet(ruleIDs)
-	diags.Append(setDiags...)
-	if diags.HasError() {
-		return ControlTFModel{}, diags
+	rulesByControl := make(map[controlRequirement][]string)
+	for batch := range slices.Chunk(ruleIDs, int(limitComplianceRulesGet)) {
+		params := cloud_policies.NewGetRuleParamsWithContext(ctx).WithIds(batch)
+		res, err := r.client.CloudPolicies.GetRule(params)
+		if err != nil {
+			diags.AddError(errorQueryingRules,
+				fmt.Sprintf("Failed to get rules for framework %s: %s", frameworkName, falcon.ErrorExplain(err)))
+			return nil, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			continue
+		}
+
+		for _, rule := range res.Payload.Resources {
+			if rule == nil || rule.UUID == nil {
+				continue
+			}
+
+			for _, control := range rule.Controls {
+				if control == nil || !controlInFramework(control, frameworkName) {
+					continue
+				}
+
+				key := controlRequirement{control.SectionName, control.Requirement}
+				if !slices.Contains(rulesByControl[key], *rule.UUID) {
+					rulesByControl[key] = append(rulesByControl[key], *rule.UUID)
+				}
+			}
+		}
 	}
 
-	return ControlTFModel{
-		ID:          types.StringValue(*control.UUID),
-		Name:        types.StringValue(*control.Name),
-		Description: types.StringValue(control.Description),
-		Rules:       rulesSet,
-	}, diags
+	return rulesByControl, diags
+}
+
+// controlInFramework reports whether control belongs to the framework. Rules
+// list the controls of every framework they are assigned to, and controls that
+// do not name their framework are assumed to belong to it.
+func controlInFramework(control *models.ApimodelsControl, frameworkName string) bool {
+	if len(control.SecurityFramework) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(control.SecurityFramework, func(framework *models.ApimodelsSecurityFramework) bool {
+		return framework != nil && framework.Name != nil && *framework.Name == frameworkName
+	})
 }
 
+// queryControlRules returns the IDs of every rule assigned to the control,
+// fetched page by page.
 func (r *cloudComplianceCustomFrameworkResource) queryControlRules(
 	ctx context.Context,
 	frameworkName, sectionName, requirement string,
 ) ([]string, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
 	rulesByControlFilter := fmt.Sprintf(filterComplianceRulesByControl, frameworkName, sectionName, requirement)
-	queryRulesParams := cloud_policies.NewQueryRuleParamsWithContext(ctx).
-		WithFilter(&rulesByControlFilter).
-		WithSort(&sortComplianceRulesByUpdatedAtAsc).
-		WithLimit(&limitComplianceRulesMax)
 
-	queryRulesResp, queryRuleErr := r.client.CloudPolicies.QueryRule(queryRulesParams)
-	if queryRuleErr != nil {
-		diags.AddError(errorQueryingRules,
-			fmt.Sprintf("Failed to query rules for control: %s", falcon.ErrorExplain(queryRuleErr)))
-		return nil, diags
-	}
+	all := utils.Pagination{Limit: limitComplianceRulesMax, MaxItems: math.MaxInt64}
+	ruleIDs, _, diags := utils.QueryPages(all, func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		queryRulesParams := cloud_policies.NewQueryRuleParamsWithContext(ctx).
+			WithFilter(&rulesByControlFilter).
+			WithSort(&sortComplianceRulesByUpdatedAtAsc).
+			WithLimit(&limit).
+			WithOffset(&offset)
+
+		queryRulesResp, queryRuleErr := r.client.CloudPolicies.QueryRule(queryRulesParams)
+		if queryRuleErr != nil {
+			diags.AddError(errorQueryingRules,
+				fmt.Sprintf("Failed to query rules for control: %s", falcon.ErrorExplain(queryRuleErr)))
+			return nil, 0, diags
+		}
 
-	if queryRulesResp == nil || queryRulesResp.Payload == nil {
-		return []string{}, diags
+		if queryRulesResp == nil || queryRulesResp.Payload == nil {
+			return nil, 0, diags
+		}
+
+		return queryRulesResp.Payload.Resources, utils.ListTotal(queryRulesResp.Payload.Meta), diags
+	})
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	return queryRulesResp.Payload.Resources, diags
+	return ruleIDs, diags
 }
 
 func (r *cloudComplianceCustomFrameworkResource) processSectionUpdates(
@@ -846,12 +2106,23 @@ func (r *cloudComplianceCustomFrameworkResource) processSectionUpdates(
 	frameworkID string,
 	stateSections map[string]SectionTFModel,
 	planSections map[string]SectionTFModel,
+	rollback *utils.Rollback,
 ) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	// Process each section in the plan
 	keyToName := make(map[string]string)
 	for sectionKey, planSection := range planSections {
+		// Sections left unprocessed when the update timeout elapses must fail
+		// the update rather than leave it partially applied.
+		if err := ctx.Err(); err != nil {
+			diags.AddError(
+				"Operation canceled",
+				"The operation was canceled before all sections were updated: "+err.Error(),
+			)
+			return diags
+		}
+
 		sectionName := planSection.Name.ValueString()
 		keyToName[sectionKey] = sectionName
 		stateSection, isSectionInState := stateSections[sectionKey]
@@ -874,7 +2145,7 @@ func (r *cloudComplianceCustomFrameworkResource) processSectionUpdates(
 			continue
 		}
 
-		diags.Append(r.updateSectionControls(ctx, frameworkID, sectionName, stateSectionControls, planSectionControls)...)
+		diags.Append(r.updateSectionControls(ctx, frameworkID, sectionName, stateSectionControls, planSectionControls, rollback)...)
 	}
 
 	for sectionKey, stateSection := range stateSections {
@@ -897,18 +2168,21 @@ func (r *cloudComplianceCustomFrameworkResource) updateSectionControls(
 	ctx context.Context,
 	frameworkID, sectionName string,
 	stateControls, planControls map[string]ControlTFModel,
+	rollback *utils.Rollback,
 ) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	for controlKey, planControl := range planControls {
-		// If state controls does not exist, create all new controls
-		if stateControls == nil {
-			diags.Append(r.createSingleControl(ctx, frameworkID, sectionName, planControl)...)
-			continue
-		}
+	_, diags := utils.ConcurrentMap(
+		ctx,
+		slices.Sorted(maps.Keys(planControls)),
+		r.writeConcurrency,
+		func(ctx context.Context, controlKey string) (struct{}, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			planControl := planControls[controlKey]
+
+			stateControl, controlExists := stateControls[controlKey]
+			if !controlExists {
+				return struct{}{}, r.createSingleControl(ctx, frameworkID, sectionName, planControl, rollback)
+			}
 
-		stateControl, controlExists := stateControls[controlKey]
-		if controlExists {
 			if !planControl.Name.Equal(stateControl.Name) || !planControl.Description.Equal(stateControl.Description) {
 				diags.Append(r.updateExistingControl(ctx, planControl, sectionName)...)
 			}
@@ -918,12 +2192,9 @@ func (r *cloudComplianceCustomFrameworkResource) updateSectionControls(
 				diags.Append(r.updateControlRules(ctx, planControl)...)
 			}
 
-			continue
-		}
-
-		diags.Append(r.createSingleControl(ctx, frameworkID, sectionName, planControl)...)
-	}
-
+			return struct{}{}, diags
+		},
+	)
 	if diags.HasError() {
 		return diags
 	}
@@ -1017,18 +2288,92 @@ func (r *cloudComplianceCustomFrameworkResource) deleteRemovedControls(
 		controlIDsToDelete = append(controlIDsToDelete, stateControl.ID.ValueString())
 	}
 
-	if len(controlIDsToDelete) > 0 {
-		deleteParams := cloud_policies.NewDeleteComplianceControlParamsWithContext(ctx).WithIds(controlIDsToDelete)
-		_, err := r.client.CloudPolicies.DeleteComplianceControl(deleteParams)
-		if err != nil {
-			diags.AddWarning("Error Deleting Control",
-				fmt.Sprintf("Failed to delete controls %s: %s", controlIDsToDelete, falcon.ErrorExplain(err)))
+	diags.Append(r.deleteControls(ctx, controlIDsToDelete)...)
+	return diags
+}
+
+// deleteControls deletes the given controls in batches of
+// limitComplianceControlsDelete, retrying each delete with exponential
+// backoff while the API reports a retryable error. The controls of a batch
+// that fails are deleted one by one, so that the error lists exactly the
+// controls that could not be deleted. Controls that are already deleted are
+// ignored.
+func (r *cloudComplianceCustomFrameworkResource) deleteControls(
+	ctx context.Context,
+	controlIDs []string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	deleteBatch := func(ids []string) error {
+		var deleteErr error
+		err := retry.RetryWithBackoff(
+			ctx,
+			deleteControlsRetryTimeout,
+			deleteControlsRetryMinInterval,
+			deleteControlsRetryMaxInterval,
+			func() error {
+				deleteParams := cloud_policies.NewDeleteComplianceControlParamsWithContext(ctx).WithIds(ids)
+				_, deleteErr = r.client.CloudPolicies.DeleteComplianceControl(deleteParams)
+				if isRetryableError(deleteErr) {
+					return deleteErr
+				}
+				return nil
+			},
+		)
+		if err == nil {
+			err = deleteErr
+		}
+
+		if _, ok := err.(*cloud_policies.DeleteComplianceControlNotFound); ok && len(ids) == 1 {
+			return nil
+		}
+		return err
+	}
+
+	var failed, failures []string
+	recordFailure := func(id string, err error) {
+		failed = append(failed, id)
+		failures = append(failures, fmt.Sprintf("%s: %s", id, falcon.ErrorExplain(err)))
+	}
+
+	for batch := range slices.Chunk(controlIDs, limitComplianceControlsDelete) {
+		err := deleteBatch(batch)
+		if err == nil {
+			continue
+		}
+		if len(batch) == 1 {
+			recordFailure(batch[0], err)
+			continue
+		}
+
+		for _, id := range batch {
+			if err := deleteBatch([]string{id}); err != nil {
+				recordFailure(id, err)
+			}
 		}
 	}
 
+	if len(failed) > 0 {
+		diags.AddError(
+			"Error Deleting Controls",
+			fmt.Sprintf(
+				"Failed to delete %d of %d controls, which remain in the framework: %s\n\n%s",
+				len(failed), len(controlIDs), strings.Join(failed, ", "), strings.Join(failures, "\n"),
+			),
+		)
+	}
+
 	return diags
 }
 
+// isRetryableError reports whether err is an API error that may succeed when
+// retried, which is a rate limit or server error.
+func isRetryableError(err error) bool {
+	var statusErr runtime.ClientResponseStatus
+	return errors.As(err, &statusErr) &&
+		(statusErr.IsCode(http.StatusTooManyRequests) || statusErr.IsServerError())
+}
+
 func (r *cloudComplianceCustomFrameworkResource) handleSectionRename(
 	ctx context.Context,
 	frameworkID, oldSectionName, newSectionName string,
@@ -1065,16 +2410,7 @@ func (r *cloudComplianceCustomFrameworkResource) deleteAllControlsForFramework(
 		return controlDiag
 	}
 
-	deleteParams := cloud_policies.NewDeleteComplianceControlParamsWithContext(ctx).WithIds(controlIds)
-	_, err := r.client.CloudPolicies.DeleteComplianceControl(deleteParams)
-	if err != nil {
-		// Continue deleting other controls even if one fails
-		diags.AddWarning(
-			"Error Deleting Controls",
-			fmt.Sprintf("Failed to delete controls %s: %s", controlIds, falcon.ErrorExplain(err)),
-		)
-	}
-
+	diags.Append(r.deleteControls(ctx, controlIds)...)
 	return diags
 }
 
@@ -1086,3 +2422,126 @@ func (r *cloudComplianceCustomFrameworkResource) generateKeyFromName(name string
 
 	return key
 }
+
+// cloneSections returns the sections of the framework with the given ID, with
+// the controls of each section and the rules assigned to them, keyed as if
+// they were read from a custom framework.
+func (r *cloudComplianceCustomFrameworkResource) cloneSections(
+	ctx context.Context,
+	frameworkID string,
+) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	nullSections := types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes})
+
+	framework, getFrameworkDiags, notFound := r.getFramework(ctx, frameworkID)
+	if notFound {
+		diags.AddAttributeError(
+			path.Root("clone_from_framework_id"),
+			errorCreatingFramework,
+			fmt.Sprintf("The compliance framework %s to clone does not exist.", frameworkID),
+		)
+		return nullSections, diags
+	}
+	diags.Append(getFrameworkDiags...)
+	if diags.HasError() {
+		return nullSections, diags
+	}
+
+	controlsBySection, sectionDiags := readFrameworkSections(ctx, r.client, framework)
+	diags.Append(sectionDiags...)
+	if diags.HasError() {
+		return nullSections, diags
+	}
+
+	type clonedControl struct {
+		section string
+		control *models.ApimodelsControl
+	}
+
+	var controls []clonedControl
+	for section, sectionControls := range controlsBySection {
+		for _, control := range sectionControls {
+			controls = append(controls, clonedControl{section: section, control: control})
+		}
+	}
+
+	tflog.Info(ctx, "Cloning compliance framework", map[string]any{
+		"id":       frameworkID,
+		"controls": len(controls),
+	})
+
+	rulesByControl, rulesDiags := r.queryFrameworkRules(ctx, *framework.Name)
+	diags.Append(rulesDiags...)
+	if diags.HasError() {
+		return nullSections, diags
+	}
+
+	controlModels := make([]ControlTFModel, len(controls))
+	for i, c := range controls {
+		rules, setDiags := convertRulesToTerraformSet(rulesByControl[controlRequirement{c.section, c.control.Requirement}])
+		diags.Append(setDiags...)
+		controlModels[i] = clonedControlModel(c.control, rules)
+	}
+	if diags.HasError() {
+		return nullSections, diags
+	}
+
+	if len(controlModels) == 0 {
+		return nullSections, diags
+	}
+
+	controlsBySectionKey := make(map[string]map[string]ControlTFModel)
+	sectionNames := make(map[string]string)
+	for i, control := range controlModels {
+		sectionName := controls[i].section
+		if sectionName == "" {
+			sectionName = *framework.Name
+		}
+
+		sectionKey := r.generateKeyFromName(sectionName)
+		if _, exists := controlsBySectionKey[sectionKey]; !exists {
+			controlsBySectionKey[sectionKey] = make(map[string]ControlTFModel)
+			sectionNames[sectionKey] = sectionName
+		}
+		controlsBySectionKey[sectionKey][r.generateKeyFromName(control.Name.ValueString())] = control
+	}
+
+	sections := make(map[string]SectionTFModel, len(controlsBySectionKey))
+	for sectionKey, sectionControls := range controlsBySectionKey {
+		controlsMap, mapDiags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: controlAttrTypes}, sectionControls)
+		diags.Append(mapDiags...)
+		sections[sectionKey] = SectionTFModel{
+			Name:     types.StringValue(sectionNames[sectionKey]),
+			Controls: controlsMap,
+		}
+	}
+	if diags.HasError() {
+		return nullSections, diags
+	}
+
+	return convertSectionsMapToTerraformMap(ctx, sections)
+}
+
+// clonedControlModel returns the control copying control and its rules. The
+// requirement of the control prefixes its name, as custom controls have no
+// requirement of their own.
+func clonedControlModel(control *models.ApimodelsControl, rules types.Set) ControlTFModel {
+	name := *control.Name
+	if control.Requirement != "" {
+		name = control.Requirement + " " + name
+	}
+
+	description := control.Description
+	if description == "" {
+		description = *control.Name
+	}
+
+	return ControlTFModel{
+		ID:           types.StringUnknown(),
+		Name:         types.StringValue(name),
+		Description:  types.StringValue(description),
+		Rules:        rules,
+		RulesFilter:  types.StringNull(),
+		RefreshRules: types.BoolNull(),
+	}
+}
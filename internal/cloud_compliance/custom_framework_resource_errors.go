@@ -28,6 +28,9 @@ const (
 	errorQueryingControls  = "Error Querying Compliance Controls"
 	errorQueryingRules     = "Error Querying Compliance Rules"
 	errorGettingControls   = "Error Getting Compliance Controls"
+	errorInvalidRule       = "Invalid Compliance Rule"
+
+	errorInvalidFrameworkDocument = "Invalid Framework Document"
 
 	// API response validation messages.
 	emptyAPIResponse      = "The API returned an empty response"
@@ -2,19 +2,25 @@ package cloudcompliance
 
 import (
 	"context"
+	"maps"
 
 	"github.com/crowdstrike/gofalcon/falcon/client/cloud_policies"
 	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var controlAttrTypes = map[string]attr.Type{
-	"id":          types.StringType,
-	"name":        types.StringType,
-	"description": types.StringType,
-	"rules":       types.SetType{ElemType: types.StringType},
+	"id":            types.StringType,
+	"name":          types.StringType,
+	"description":   types.StringType,
+	"rules":         types.SetType{ElemType: types.StringType},
+	"rules_filter":  types.StringType,
+	"refresh_rules": types.BoolType,
 }
 
 var sectionAttrTypes = map[string]attr.Type{
@@ -26,6 +32,85 @@ var sectionAttrTypes = map[string]attr.Type{
 	},
 }
 
+var pendingChangesAttrTypes = map[string]attr.Type{
+	"add":    types.Int64Type,
+	"update": types.Int64Type,
+	"rename": types.Int64Type,
+	"delete": types.Int64Type,
+}
+
+// noPendingChanges returns pending_changes counting no changes, held in state
+// by frameworks once refreshed and by applies without planned counts.
+func noPendingChanges() types.Object {
+	return types.ObjectValueMust(pendingChangesAttrTypes, map[string]attr.Value{
+		"add":    types.Int64Value(0),
+		"update": types.Int64Value(0),
+		"rename": types.Int64Value(0),
+		"delete": types.Int64Value(0),
+	})
+}
+
+// pendingChanges counts the controls that planned adds, updates, renames and
+// deletes compared to prior, matching controls by their section and control
+// keys. Controls whose name changes are counted as renamed, and the other
+// controls whose description or rules change as updated.
+func pendingChanges(ctx context.Context, prior, planned types.Map) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	controlsByKeys := func(sections types.Map) map[controlKeys]ControlTFModel {
+		controls := make(map[controlKeys]ControlTFModel)
+		if !utils.IsKnown(sections) {
+			return controls
+		}
+
+		var sectionsByKey map[string]SectionTFModel
+		diags.Append(sections.ElementsAs(ctx, &sectionsByKey, false)...)
+		for sectionKey, section := range sectionsByKey {
+			if !utils.IsKnown(section.Controls) {
+				continue
+			}
+
+			var sectionControls map[string]ControlTFModel
+			diags.Append(section.Controls.ElementsAs(ctx, &sectionControls, false)...)
+			for controlKey, control := range sectionControls {
+				controls[controlKeys{Section: sectionKey, Control: controlKey}] = control
+			}
+		}
+		return controls
+	}
+
+	priorControls := controlsByKeys(prior)
+	plannedControls := controlsByKeys(planned)
+	if diags.HasError() {
+		return types.ObjectNull(pendingChangesAttrTypes), diags
+	}
+
+	var added, updated, renamed, deleted int64
+	for keys, plannedControl := range plannedControls {
+		priorControl, exists := priorControls[keys]
+		switch {
+		case !exists:
+			added++
+		case !plannedControl.Name.Equal(priorControl.Name):
+			renamed++
+		case !plannedControl.Description.Equal(priorControl.Description) || !plannedControl.Rules.Equal(priorControl.Rules):
+			updated++
+		}
+	}
+	for keys := range priorControls {
+		if _, exists := plannedControls[keys]; !exists {
+			deleted++
+		}
+	}
+
+	return types.ObjectValue(pendingChangesAttrTypes, map[string]attr.Value{
+		"add":    types.Int64Value(added),
+		"update": types.Int64Value(updated),
+		"rename": types.Int64Value(renamed),
+		"delete": types.Int64Value(deleted),
+	})
+}
+
 // SectionDomainModel is the Go representation of SectionTFModel.
 type SectionDomainModel struct {
 	Key      string
@@ -35,11 +120,13 @@ type SectionDomainModel struct {
 
 // ControlDomainModel is the Go representation of ControlTFModel.
 type ControlDomainModel struct {
-	Key         string
-	ID          string
-	Name        string
-	Description string
-	Rules       []string
+	Key          string
+	ID           string
+	Name         string
+	Description  string
+	Rules        []string
+	RulesFilter  types.String
+	RefreshRules types.Bool
 }
 
 // API parameter building utilities
@@ -54,6 +141,7 @@ func buildCreateFrameworkParams(
 	createReq := &models.CommonCreateComplianceFrameworkRequest{
 		Name:        &name,
 		Description: &description,
+		Active:      plan.Active.ValueBool(),
 	}
 
 	params := cloud_policies.NewCreateComplianceFrameworkParamsWithContext(ctx)
@@ -71,6 +159,7 @@ func buildUpdateFrameworkParams(
 	updateReq := &models.CommonUpdateComplianceFrameworkRequest{
 		Name:        &name,
 		Description: &description,
+		Active:      plan.Active.ValueBool(),
 	}
 
 	params := cloud_policies.NewUpdateComplianceFrameworkParamsWithContext(ctx)
@@ -126,29 +215,6 @@ func convertRulesToTerraformSet(rules []string) (types.Set, diag.Diagnostics) {
 	return rulesSet, diags
 }
 
-func convertControlsMapToTerraformMap(ctx context.Context, controls map[string]ControlTFModel, nameToKey map[string]string) (types.Map, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	controlsAttrValue := make(map[string]attr.Value)
-	for controlName, control := range controls {
-		controlKey := nameToKey[controlName]
-		controlValue, controlDiags := types.ObjectValueFrom(ctx, controlAttrTypes, control)
-		diags.Append(controlDiags...)
-		if diags.HasError() {
-			continue
-		}
-		controlsAttrValue[controlKey] = controlValue
-	}
-
-	controlsMap, controlsMapDiags := types.MapValue(
-		types.ObjectType{AttrTypes: controlAttrTypes},
-		controlsAttrValue,
-	)
-	diags.Append(controlsMapDiags...)
-
-	return controlsMap, diags
-}
-
 func convertSectionsMapToTerraformMap(ctx context.Context, sections map[string]SectionTFModel) (types.Map, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -171,6 +237,64 @@ func convertSectionsMapToTerraformMap(ctx context.Context, sections map[string]S
 	return sectionsMap, diags
 }
 
+// managedSections returns the sections read from the API without the controls
+// that are not in prior, which are left alone when unmanaged_controls is
+// "ignore" or "error". Controls are matched by ID, or by section and control
+// name for the controls being created.
+func managedSections(
+	ctx context.Context,
+	sections types.Map,
+	prior map[string]SectionTFModel,
+) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if sections.IsNull() {
+		return sections, diags
+	}
+
+	type sectionControl struct{ section, control string }
+	managedIDs := make(map[string]bool)
+	managedNames := make(map[sectionControl]bool)
+	for _, section := range prior {
+		var controls map[string]ControlTFModel
+		diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+		for _, control := range controls {
+			if utils.IsKnown(control.ID) {
+				managedIDs[control.ID.ValueString()] = true
+			}
+			managedNames[sectionControl{section.Name.ValueString(), control.Name.ValueString()}] = true
+		}
+	}
+
+	var read map[string]SectionTFModel
+	diags.Append(sections.ElementsAs(ctx, &read, false)...)
+	if diags.HasError() {
+		return sections, diags
+	}
+
+	managed := make(map[string]SectionTFModel, len(read))
+	for sectionKey, section := range read {
+		var controls map[string]ControlTFModel
+		diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+		maps.DeleteFunc(controls, func(_ string, control ControlTFModel) bool {
+			return !managedIDs[control.ID.ValueString()] &&
+				!managedNames[sectionControl{section.Name.ValueString(), control.Name.ValueString()}]
+		})
+		if len(controls) == 0 {
+			continue
+		}
+
+		var mapDiags diag.Diagnostics
+		section.Controls, mapDiags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: controlAttrTypes}, controls)
+		diags.Append(mapDiags...)
+		managed[sectionKey] = section
+	}
+	if diags.HasError() {
+		return sections, diags
+	}
+
+	return convertSectionsMapToTerraformMap(ctx, managed)
+}
+
 func convertSectionsTFMapToDomainMapByName(ctx context.Context, sections map[string]SectionTFModel) (map[string]SectionDomainModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -190,14 +314,116 @@ func convertSectionsTFMapToDomainMapByName(ctx context.Context, sections map[str
 			diags.Append(control.Rules.ElementsAs(ctx, &rules, false)...)
 
 			sectionsDomainMap[section.Name.ValueString()].Controls[control.Name.ValueString()] = ControlDomainModel{
-				Key:         controlKey,
-				ID:          control.ID.ValueString(),
-				Name:        control.Name.ValueString(),
-				Description: control.Description.ValueString(),
-				Rules:       rules,
+				Key:          controlKey,
+				ID:           control.ID.ValueString(),
+				Name:         control.Name.ValueString(),
+				Description:  control.Description.ValueString(),
+				Rules:        rules,
+				RulesFilter:  control.RulesFilter,
+				RefreshRules: control.RefreshRules,
 			}
 		}
 	}
 
 	return sectionsDomainMap, diags
 }
+
+// clonedSectionsModifier plans the sections of a framework when they are not
+// configured. They are copied when a clone is created and kept afterwards,
+// and are removed from frameworks that are not clones. The sections of
+// frameworks imported from a document or defined by ordered_sections are
+// planned by ModifyPlan.
+type clonedSectionsModifier struct{}
+
+func (m clonedSectionsModifier) Description(_ context.Context) string {
+	return "Keeps the sections copied from the cloned framework when sections are not configured."
+}
+
+func (m clonedSectionsModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m clonedSectionsModifier) PlanModifyMap(
+	ctx context.Context,
+	req planmodifier.MapRequest,
+	resp *planmodifier.MapResponse,
+) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var cloneFrom, importJSON types.String
+	var orderedSections types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("clone_from_framework_id"), &cloneFrom)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("import_json"), &importJSON)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ordered_sections"), &orderedSections)...)
+	if resp.Diagnostics.HasError() || cloneFrom.IsUnknown() || !importJSON.IsNull() || !orderedSections.IsNull() {
+		return
+	}
+
+	if cloneFrom.IsNull() {
+		resp.PlanValue = types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes})
+		return
+	}
+
+	// The sections of a new clone are only known once they are copied.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// controlRulesModifier plans the rules of a control that are not configured.
+// Controls without rules_filter have no rules. The rules matching a filter are
+// kept from state until the filter changes, and are otherwise only known once
+// the filter is resolved, when it is applied or by ModifyPlan for controls
+// with refresh_rules set.
+type controlRulesModifier struct{}
+
+func (m controlRulesModifier) Description(_ context.Context) string {
+	return "Plans the rules matching rules_filter, or no rules when it is not set."
+}
+
+func (m controlRulesModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m controlRulesModifier) PlanModifySet(
+	ctx context.Context,
+	req planmodifier.SetRequest,
+	resp *planmodifier.SetResponse,
+) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	// The controls of clones are not configured, and keep their rules.
+	var configControl types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath(), &configControl)...)
+	if resp.Diagnostics.HasError() || configControl.IsNull() {
+		return
+	}
+
+	var filter types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName("rules_filter"), &filter)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if filter.IsNull() {
+		resp.PlanValue = types.SetValueMust(types.StringType, []attr.Value{})
+		return
+	}
+
+	if !req.State.Raw.IsNull() && !req.StateValue.IsNull() {
+		var stateFilter types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, req.Path.ParentPath().AtName("rules_filter"), &stateFilter)...)
+		if !resp.Diagnostics.HasError() && stateFilter.Equal(filter) {
+			resp.PlanValue = req.StateValue
+			return
+		}
+	}
+
+	resp.PlanValue = types.SetUnknown(types.StringType)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -348,6 +349,116 @@ resource "crowdstrike_cloud_compliance_custom_framework" "test" {
 `,
 			expectError: regexp.MustCompile("The argument \"description\" is required"),
 		},
+		{
+			name: "duplicate_section_name",
+			config: `
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = "Framework with duplicate sections"
+  description = "Framework with two sections of the same name"
+  sections = {
+    "section-1" = {
+      name = "Section 1"
+      controls = {
+        "control-1" = {
+          name        = "Control 1"
+          description = "Control of the first section"
+        }
+      }
+    }
+    "section-one" = {
+      name = "Section 1"
+      controls = {
+        "control-1" = {
+          name        = "Control 1"
+          description = "Control of the second section"
+        }
+      }
+    }
+  }
+}
+`,
+			expectError: regexp.MustCompile("Duplicate Section Name"),
+		},
+		{
+			name: "duplicate_control_name",
+			config: `
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = "Framework with duplicate controls"
+  description = "Framework with two controls of the same name"
+  sections = {
+    "section-1" = {
+      name = "Section 1"
+      controls = {
+        "control-1" = {
+          name        = "Control 1"
+          description = "First control"
+        }
+        "control-one" = {
+          name        = "Control 1"
+          description = "Second control"
+        }
+      }
+    }
+  }
+}
+`,
+			expectError: regexp.MustCompile("Duplicate Control Name"),
+		},
+		{
+			name: "invalid_import_json",
+			config: `
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = "Framework with an invalid document"
+  description = "Framework imported from a document with an empty section"
+  import_json = jsonencode({
+    sections = [{ name = "Section 1", controls = [] }]
+  })
+}
+`,
+			expectError: regexp.MustCompile("Invalid Framework Document"),
+		},
+		{
+			name: "duplicate_ordered_section_name",
+			config: `
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = "Framework with duplicate ordered sections"
+  description = "Framework with two ordered sections of the same name"
+  ordered_sections = [
+    {
+      name     = "Section 1"
+      controls = [{ name = "Control 1", description = "First control" }]
+    },
+    {
+      name     = "Section 1"
+      controls = [{ name = "Control 2", description = "Second control" }]
+    },
+  ]
+}
+`,
+			expectError: regexp.MustCompile("Invalid Framework Document"),
+		},
+		{
+			name: "unknown_rule",
+			config: `
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = "Framework with an unknown rule"
+  description = "Framework with an unknown rule"
+  sections = {
+    "section-1" = {
+      name = "Section 1"
+      controls = {
+        "control-1" = {
+          name        = "Control 1"
+          description = "Control with an unknown rule"
+          rules       = ["00000000-0000-0000-0000-000000000000"]
+        }
+      }
+    }
+  }
+}
+`,
+			expectError: regexp.MustCompile("Invalid Compliance Rule"),
+		},
 	}
 
 	for _, tc := range validationTests {
@@ -394,6 +505,19 @@ func TestAccCloudComplianceCustomFrameworkResource_Import(t *testing.T) {
 					return rs.Primary.Attributes["id"], nil
 				},
 			},
+			{
+				ResourceName:                         customFrameworkResourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "id",
+				ImportStateId:                        "name=" + rName,
+			},
+			{
+				ResourceName:  customFrameworkResourceName,
+				ImportState:   true,
+				ImportStateId: "name=",
+				ExpectError:   regexp.MustCompile("Invalid Import ID"),
+			},
 		},
 	})
 }
@@ -441,6 +565,18 @@ func TestAccCloudComplianceCustomFrameworkResource_CreateWithSections(t *testing
 				Check:  initialConfig.TestChecks(),
 			})
 
+			// Applying keeps the planned changes in state, and refreshing
+			// resets them to zero as importing does.
+			steps = append(steps, resource.TestStep{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "pending_changes.add", "0"),
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "pending_changes.update", "0"),
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "pending_changes.rename", "0"),
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "pending_changes.delete", "0"),
+				),
+			})
+
 			// Add import test
 			steps = append(steps, resource.TestStep{
 				ResourceName:      customFrameworkResourceName,
@@ -807,6 +943,24 @@ func TestAccCloudComplianceCustomFrameworkResource_ComprehensiveCRUD(t *testing.
 		},
 	}
 
+	// Test 4: Update the description of a control
+	updateDescriptionConfig := completeFrameworkConfig{
+		Name:        rName,
+		Description: "Framework to test comprehensive CRUD operations",
+		Sections: map[string]sectionConfig{
+			"section-2": {
+				Name: "Section 2",
+				Controls: map[string]controlConfig{
+					"control-2.2": {
+						Name:        "New Control 2.2",
+						Description: "Updated control 2.2 description",
+						Rules:       "local.rule_set_alt_single",
+					},
+				},
+			},
+		},
+	}
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
@@ -864,6 +1018,24 @@ func TestAccCloudComplianceCustomFrameworkResource_ComprehensiveCRUD(t *testing.
 				},
 				Check: deleteConfig.TestChecks(),
 			},
+			{
+				Config: acctest.ProviderConfig + updateDescriptionConfig.String(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							customFrameworkResourceName,
+							plancheck.ResourceActionUpdate,
+						),
+						// Verify the control is updated in place
+						plancheck.ExpectKnownValue(
+							customFrameworkResourceName,
+							tfjsonpath.New("sections").AtMapKey("section-2").AtMapKey("controls").AtMapKey("control-2.2").AtMapKey("id"),
+							knownvalue.NotNull(),
+						),
+					},
+				},
+				Check: updateDescriptionConfig.TestChecks(),
+			},
 		},
 	})
 }
@@ -988,3 +1160,497 @@ func TestAccCloudComplianceCustomFrameworkResource_EmptySectionsValidation(t *te
 		},
 	})
 }
+
+func testAccCustomFrameworkWithCustomRuleConfig(name, ruleDescription string) string {
+	return getAWSRulesConfig() + fmt.Sprintf(`
+resource "crowdstrike_cloud_security_custom_rule" "test" {
+  resource_type  = local.rules_list[0].resource_type
+  name           = %[1]q
+  description    = %[2]q
+  cloud_provider = "AWS"
+  severity       = "medium"
+  parent_rule_id = local.rules_list[0].id
+  controls = [
+    {
+      authority = "CIS"
+      code      = "89"
+    }
+  ]
+}
+
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = %[1]q
+  description = "Framework with a custom rule"
+  sections = {
+    "section-1" = {
+      name = "Section 1"
+      controls = {
+        "control-1" = {
+          name        = "Control 1"
+          description = "Control with a custom rule"
+          rules       = [crowdstrike_cloud_security_custom_rule.test.id]
+        }
+      }
+    }
+  }
+}
+`, name, ruleDescription)
+}
+
+// TestAccCloudComplianceCustomFrameworkResource_CustomRule assigns a custom rule
+// to a control, and checks that updating the rule keeps the assignment without
+// the control showing up in the controls of the rule.
+func TestAccCloudComplianceCustomFrameworkResource_CustomRule(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleResourceName := "crowdstrike_cloud_security_custom_rule.test"
+	checks := func(description string) resource.TestCheckFunc {
+		return resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr(ruleResourceName, "description", description),
+			resource.TestCheckResourceAttr(ruleResourceName, "controls.#", "1"),
+			resource.TestCheckResourceAttr(ruleResourceName, "controls.0.authority", "CIS"),
+			resource.TestCheckResourceAttr(customFrameworkResourceName, "sections.section-1.controls.control-1.rules.#", "1"),
+			resource.TestCheckTypeSetElemAttrPair(
+				customFrameworkResourceName,
+				"sections.section-1.controls.control-1.rules.*",
+				ruleResourceName,
+				"id",
+			),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkWithCustomRuleConfig(rName, "Custom rule"),
+				Check:  checks("Custom rule"),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkWithCustomRuleConfig(rName, "Updated custom rule"),
+				Check:  checks("Updated custom rule"),
+			},
+		},
+	})
+}
+
+func testAccCustomFrameworkCloneConfig(name, description string) string {
+	return fmt.Sprintf(`
+data "crowdstrike_cloud_compliance_benchmarks" "cis" {
+  authority        = "CIS"
+  name             = "*AWS*"
+  include_controls = false
+  max_items        = 1
+}
+
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name                    = %[1]q
+  description             = %[2]q
+  clone_from_framework_id = one(data.crowdstrike_cloud_compliance_benchmarks.cis.ids)
+}
+`, name, description)
+}
+
+func TestAccCloudComplianceCustomFrameworkResource_Clone(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkCloneConfig(rName, "Cloned framework"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(customFrameworkResourceName, tfjsonpath.New("sections"), knownvalue.NotNull()),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(customFrameworkResourceName, "clone_from_framework_id"),
+					resource.TestMatchResourceAttr(customFrameworkResourceName, "sections.%", regexp.MustCompile(`^[1-9][0-9]*$`)),
+				),
+			},
+			{
+				// Updating a clone keeps the copied sections.
+				Config: acctest.ProviderConfig + testAccCustomFrameworkCloneConfig(rName, "Updated cloned framework"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(customFrameworkResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "description", "Updated cloned framework"),
+					resource.TestMatchResourceAttr(customFrameworkResourceName, "sections.%", regexp.MustCompile(`^[1-9][0-9]*$`)),
+				),
+			},
+			{
+				ResourceName:            customFrameworkResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"clone_from_framework_id"},
+			},
+		},
+	})
+}
+
+func testAccCustomFrameworkRulesFilterConfig(name, rulesFilter string, refreshRules bool) string {
+	return fmt.Sprintf(`
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = %[1]q
+  description = "Framework with rules selected by a filter"
+  sections = {
+    "section-1" = {
+      name = "Section 1"
+      controls = {
+        "control-filtered" = {
+          name          = "Filtered Control"
+          description   = "Control with the rules matching a filter"
+          rules_filter  = %[2]q
+          refresh_rules = %[3]t
+        }
+        "control-without-rules" = {
+          name        = "Control Without Rules"
+          description = "Control with rules omitted"
+        }
+      }
+    }
+  }
+}
+`, name, rulesFilter, refreshRules)
+}
+
+func TestAccCloudComplianceCustomFrameworkResource_RulesFilter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	filteredRules := "sections.section-1.controls.control-filtered.rules.#"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkRulesFilterConfig(rName, "rule_service:'API Gateway'+rule_provider:'AWS'", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(customFrameworkResourceName, filteredRules, regexp.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "sections.section-1.controls.control-without-rules.rules.#", "0"),
+				),
+			},
+			{
+				// Resolving the filter again on each plan finds the same rules.
+				Config: acctest.ProviderConfig + testAccCustomFrameworkRulesFilterConfig(rName, "rule_service:'API Gateway'+rule_provider:'AWS'", true),
+				Check:  resource.TestMatchResourceAttr(customFrameworkResourceName, filteredRules, regexp.MustCompile(`^[1-9][0-9]*$`)),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkRulesFilterConfig(rName, "rule_name:'tf-acc-test-no-such-rule'", false),
+				Check:  resource.TestCheckResourceAttr(customFrameworkResourceName, filteredRules, "0"),
+			},
+			{
+				Config: acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = %q
+  description = "Framework with rules selected by a filter"
+  sections = {
+    "section-1" = {
+      name = "Section 1"
+      controls = {
+        "control-filtered" = {
+          name         = "Filtered Control"
+          description  = "Control with the rules matching a filter"
+          rules        = []
+          rules_filter = "rule_provider:'AWS'"
+        }
+      }
+    }
+  }
+}
+`, rName),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccCustomFrameworkActiveConfig(name string, active, allowDeactivation bool) string {
+	return fmt.Sprintf(`
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name               = %[1]q
+  description        = "Framework activated and deactivated"
+  active             = %[2]t
+  allow_deactivation = %[3]t
+}
+`, name, active, allowDeactivation)
+}
+
+func TestAccCloudComplianceCustomFrameworkResource_Deactivation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkActiveConfig(rName, true, false),
+				Check:  resource.TestCheckResourceAttr(customFrameworkResourceName, "active", "true"),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccCustomFrameworkActiveConfig(rName, false, false),
+				ExpectError: regexp.MustCompile("Framework Deactivation Not Allowed"),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkActiveConfig(rName, false, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(customFrameworkResourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(customFrameworkResourceName, "active", "false"),
+			},
+			{
+				// Activating a framework updates it in place.
+				Config: acctest.ProviderConfig + testAccCustomFrameworkActiveConfig(rName, true, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(customFrameworkResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(customFrameworkResourceName, "active", "true"),
+			},
+		},
+	})
+}
+
+func testAccCustomFrameworkImportJSONConfig(name, importJSON string) string {
+	return fmt.Sprintf(`
+resource "crowdstrike_cloud_compliance_custom_framework" "source" {
+  name        = "%[1]s-source"
+  description = "Framework exported as JSON"
+
+  sections = {
+    "section-1" = {
+      name = "Section 1"
+      controls = {
+        "control-1a" = {
+          name        = "Control 1a"
+          description = "First control"
+        }
+      }
+    }
+  }
+}
+
+data "crowdstrike_cloud_compliance_custom_framework" "source" {
+  id = crowdstrike_cloud_compliance_custom_framework.source.id
+}
+
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = %[1]q
+  description = "Framework imported from JSON"
+  import_json = %[2]s
+}
+`, name, importJSON)
+}
+
+func TestAccCloudComplianceCustomFrameworkResource_ImportJSON(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	control := tfjsonpath.New("sections").AtMapKey("section-1").AtMapKey("controls").AtMapKey("control-1a")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkImportJSONConfig(
+					rName,
+					"data.crowdstrike_cloud_compliance_custom_framework.source.export_json",
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.crowdstrike_cloud_compliance_custom_framework.source",
+						tfjsonpath.New("export_json"),
+						knownvalue.StringExact(fmt.Sprintf(`{
+  "name": "%s-source",
+  "description": "Framework exported as JSON",
+  "sections": [
+    {
+      "name": "Section 1",
+      "controls": [
+        {
+          "name": "Control 1a",
+          "description": "First control",
+          "rules": []
+        }
+      ]
+    }
+  ]
+}`, rName)),
+					),
+					statecheck.ExpectKnownValue(customFrameworkResourceName, control.AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(customFrameworkResourceName, control.AtMapKey("name"), knownvalue.StringExact("Control 1a")),
+					statecheck.ExpectKnownValue(customFrameworkResourceName, control.AtMapKey("description"), knownvalue.StringExact("First control")),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkImportJSONConfig(rName, `jsonencode({
+    sections = [{
+      name = "Section 1"
+      controls = [{
+        name        = "Control 1a"
+        description = "First control, edited in the document"
+        rules       = []
+      }]
+    }]
+  })`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(customFrameworkResourceName, plancheck.ResourceActionUpdate),
+						// The control is updated in place.
+						plancheck.ExpectKnownValue(customFrameworkResourceName, control.AtMapKey("id"), knownvalue.NotNull()),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						customFrameworkResourceName,
+						control.AtMapKey("description"),
+						knownvalue.StringExact("First control, edited in the document"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccCustomFrameworkOrderedSectionsConfig(name string, sectionOrder, controlOrder [2]int) string {
+	sections := [2]string{`
+    {
+      name = "1. Identity"
+      controls = [
+        %[1]s,
+        %[2]s,
+      ]
+    }`, `
+    {
+      name     = "2. Storage"
+      controls = [{ name = "2.1 Encryption", description = "Storage is encrypted" }]
+    }`}
+	controls := [2]string{
+		`{ name = "1.1 MFA", description = "Users have MFA enabled" }`,
+		`{ name = "1.2 Root", description = "The root account is not used" }`,
+	}
+	sections[0] = fmt.Sprintf(sections[0], controls[controlOrder[0]], controls[controlOrder[1]])
+
+	return fmt.Sprintf(`
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name        = %[1]q
+  description = "Framework with ordered sections"
+
+  ordered_sections = [%[2]s,%[3]s,
+  ]
+}
+`, name, sections[sectionOrder[0]], sections[sectionOrder[1]])
+}
+
+func TestAccCloudComplianceCustomFrameworkResource_OrderedSections(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	control := tfjsonpath.New("sections").AtMapKey("1.-identity").AtMapKey("controls").AtMapKey("1.1-mfa")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkOrderedSectionsConfig(rName, [2]int{0, 1}, [2]int{0, 1}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(customFrameworkResourceName, tfjsonpath.New("pending_changes"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"add":    knownvalue.Int64Exact(3),
+							"update": knownvalue.Int64Exact(0),
+							"rename": knownvalue.Int64Exact(0),
+							"delete": knownvalue.Int64Exact(0),
+						})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "ordered_sections.0.name", "1. Identity"),
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "ordered_sections.0.controls.0.name", "1.1 MFA"),
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "ordered_sections.0.controls.1.name", "1.2 Root"),
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "ordered_sections.1.name", "2. Storage"),
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "sections.%", "2"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(customFrameworkResourceName, control.AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(customFrameworkResourceName, control.AtMapKey("name"), knownvalue.StringExact("1.1 MFA")),
+				},
+			},
+			{
+				// Reordering sections and controls keeps the framework as it is.
+				Config: acctest.ProviderConfig + testAccCustomFrameworkOrderedSectionsConfig(rName, [2]int{1, 0}, [2]int{1, 0}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(customFrameworkResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(customFrameworkResourceName, control.AtMapKey("id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue(customFrameworkResourceName, tfjsonpath.New("pending_changes"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"add":    knownvalue.Int64Exact(0),
+							"update": knownvalue.Int64Exact(0),
+							"rename": knownvalue.Int64Exact(0),
+							"delete": knownvalue.Int64Exact(0),
+						})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "ordered_sections.0.name", "2. Storage"),
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "ordered_sections.1.controls.0.name", "1.2 Root"),
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "sections.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomFrameworkUnmanagedControlsConfig(name, unmanagedControls string) string {
+	return fmt.Sprintf(`
+resource "crowdstrike_cloud_compliance_custom_framework" "test" {
+  name               = %[1]q
+  description        = "Framework with controls added outside of its sections"
+  unmanaged_controls = %[2]q
+
+  sections = {
+    "section-1" = {
+      name = "Section 1"
+      controls = {
+        "control-1" = {
+          name        = "Control 1"
+          description = "Control managed by the framework"
+        }
+      }
+    }
+  }
+}
+
+# Stands in for a control added in the console.
+resource "crowdstrike_cloud_compliance_control" "unmanaged" {
+  framework_id = crowdstrike_cloud_compliance_custom_framework.test.id
+  section      = "Section 1"
+  name         = "Auditor Control"
+  description  = "Control not managed by the framework"
+}
+`, name, unmanagedControls)
+}
+
+func TestAccCloudComplianceCustomFrameworkResource_UnmanagedControls(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccCustomFrameworkUnmanagedControlsConfig(rName, "ignore"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "unmanaged_controls", "ignore"),
+					resource.TestCheckResourceAttr(customFrameworkResourceName, "sections.section-1.controls.%", "1"),
+				),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccCustomFrameworkUnmanagedControlsConfig(rName, "error"),
+				ExpectError: regexp.MustCompile("Unmanaged Controls Found"),
+			},
+		},
+	})
+}
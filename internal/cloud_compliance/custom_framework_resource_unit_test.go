@@ -0,0 +1,99 @@
+package cloudcompliance
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/cloud_policies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCloudPolicies deletes compliance controls with deleteControls, and
+// records the IDs of every delete request.
+type fakeCloudPolicies struct {
+	cloud_policies.ClientService
+
+	mu             sync.Mutex
+	requests       [][]string
+	deleteControls func(ids []string, attempt int) error
+}
+
+func (f *fakeCloudPolicies) DeleteComplianceControl(
+	params *cloud_policies.DeleteComplianceControlParams,
+	_ ...cloud_policies.ClientOption,
+) (*cloud_policies.DeleteComplianceControlOK, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	attempt := 0
+	for _, ids := range f.requests {
+		if slices.Equal(ids, params.Ids) {
+			attempt++
+		}
+	}
+	f.requests = append(f.requests, params.Ids)
+
+	if err := f.deleteControls(params.Ids, attempt); err != nil {
+		return nil, err
+	}
+	return cloud_policies.NewDeleteComplianceControlOK(), nil
+}
+
+func TestDeleteControls(t *testing.T) {
+	fake := &fakeCloudPolicies{
+		deleteControls: func(ids []string, attempt int) error {
+			if len(ids) > 1 {
+				return cloud_policies.NewDeleteComplianceControlBadRequest()
+			}
+
+			switch ids[0] {
+			case "deleted":
+				return cloud_policies.NewDeleteComplianceControlNotFound()
+			case "invalid":
+				return cloud_policies.NewDeleteComplianceControlBadRequest()
+			}
+			return nil
+		},
+	}
+	r := &cloudComplianceCustomFrameworkResource{
+		client: &client.CrowdStrikeAPISpecification{CloudPolicies: fake},
+	}
+
+	diags := r.deleteControls(t.Context(), []string{"a", "deleted", "invalid", "b"})
+	require.Len(t, diags.Errors(), 1)
+
+	detail := diags.Errors()[0].Detail()
+	assert.True(
+		t,
+		strings.HasPrefix(detail, "Failed to delete 1 of 4 controls, which remain in the framework: invalid\n\n"),
+		detail,
+	)
+	assert.NotContains(t, detail, "deleted:")
+	assert.Equal(
+		t,
+		[][]string{{"a", "deleted", "invalid", "b"}, {"a"}, {"deleted"}, {"invalid"}, {"b"}},
+		fake.requests,
+	)
+}
+
+func TestDeleteControls_retryable(t *testing.T) {
+	fake := &fakeCloudPolicies{
+		deleteControls: func(ids []string, attempt int) error {
+			if attempt == 0 {
+				return cloud_policies.NewDeleteComplianceControlTooManyRequests()
+			}
+			return nil
+		},
+	}
+	r := &cloudComplianceCustomFrameworkResource{
+		client: &client.CrowdStrikeAPISpecification{CloudPolicies: fake},
+	}
+
+	diags := r.deleteControls(t.Context(), []string{"a", "b"})
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, [][]string{{"a", "b"}, {"a", "b"}}, fake.requests)
+}
@@ -32,7 +32,8 @@ func NewCloudComplianceFrameworkControlDataSource() datasource.DataSource {
 }
 
 type cloudComplianceFrameworkControlDataSource struct {
-	client *client.CrowdStrikeAPISpecification
+	client    *client.CrowdStrikeAPISpecification
+	readCache *config.ReadCache
 }
 
 type cloudComplianceFrameworkControlDataSourceModel struct {
@@ -95,6 +96,8 @@ func (r *cloudComplianceFrameworkControlDataSource) Configure(
 	}
 
 	r.client = config.Client
+	r.readCache = config.ReadCache
+	resp.Diagnostics.Append(config.CheckScopes(cloudComplianceFrameworkScopes)...)
 }
 
 func (r *cloudComplianceFrameworkControlDataSource) Metadata(
@@ -223,11 +226,14 @@ func (r *cloudComplianceFrameworkControlDataSource) Read(
 		},
 	}
 
-	controls, diags = r.getControls(
-		ctx,
-		data.FQL.ValueString(),
-		fqlFilters,
-	)
+	key := fmt.Sprintf("cloud_compliance_controls/%s/%v", data.FQL.ValueString(), fqlFilters)
+	controls, diags = config.Cached(r.readCache, key, func() ([]cloudComplianceFrameworkControlModel, diag.Diagnostics) {
+		return r.getControls(
+			ctx,
+			data.FQL.ValueString(),
+			fqlFilters,
+		)
+	})
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
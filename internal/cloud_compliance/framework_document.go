@@ -0,0 +1,324 @@
+package cloudcompliance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// frameworkDocument is the machine-readable definition of a custom framework.
+// The custom framework data source renders it as export_json and the resource
+// accepts it as import_json, so that frameworks can be shared across CIDs.
+type frameworkDocument struct {
+	Name        string                     `json:"name"`
+	Description string                     `json:"description"`
+	Sections    []frameworkDocumentSection `json:"sections"`
+}
+
+type frameworkDocumentSection struct {
+	Name     string                     `json:"name"`
+	Controls []frameworkDocumentControl `json:"controls"`
+}
+
+type frameworkDocumentControl struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Rules       []string `json:"rules"`
+}
+
+// exportFrameworkJSON renders the framework as canonical JSON. Sections and
+// controls are sorted by name and rules by ID, so the document only changes
+// when the framework does.
+func exportFrameworkJSON(
+	ctx context.Context,
+	name, description string,
+	sections types.Map,
+) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	document := frameworkDocument{
+		Name:        name,
+		Description: description,
+		Sections:    []frameworkDocumentSection{},
+	}
+
+	var sectionsByKey map[string]SectionTFModel
+	if !sections.IsNull() {
+		diags.Append(sections.ElementsAs(ctx, &sectionsByKey, false)...)
+	}
+
+	for _, section := range sectionsByKey {
+		var controls map[string]ControlTFModel
+		diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+
+		documentSection := frameworkDocumentSection{
+			Name:     section.Name.ValueString(),
+			Controls: make([]frameworkDocumentControl, 0, len(controls)),
+		}
+		for _, control := range controls {
+			rules := []string{}
+			diags.Append(control.Rules.ElementsAs(ctx, &rules, false)...)
+			slices.Sort(rules)
+
+			documentSection.Controls = append(documentSection.Controls, frameworkDocumentControl{
+				Name:        control.Name.ValueString(),
+				Description: control.Description.ValueString(),
+				Rules:       rules,
+			})
+		}
+		slices.SortFunc(documentSection.Controls, func(a, b frameworkDocumentControl) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		document.Sections = append(document.Sections, documentSection)
+	}
+	if diags.HasError() {
+		return "", diags
+	}
+
+	slices.SortFunc(document.Sections, func(a, b frameworkDocumentSection) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	exported, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		diags.AddError(errorReadingFramework, fmt.Sprintf("Failed to render the framework as JSON: %s", err))
+		return "", diags
+	}
+
+	return string(exported), diags
+}
+
+// parseFrameworkDocument decodes a framework document and checks that its
+// sections and controls can be created.
+func parseFrameworkDocument(document string) (frameworkDocument, error) {
+	var parsed frameworkDocument
+
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&parsed); err != nil {
+		return parsed, err
+	}
+
+	return parsed, parsed.validate()
+}
+
+// validate checks that the sections and controls of the document can be
+// created.
+func (d frameworkDocument) validate() error {
+	sectionNames := make(map[string]bool, len(d.Sections))
+	for i, section := range d.Sections {
+		if section.Name == "" {
+			return fmt.Errorf("section %d has no name", i)
+		}
+		if sectionNames[section.Name] {
+			return fmt.Errorf("section %q is defined more than once", section.Name)
+		}
+		sectionNames[section.Name] = true
+
+		if len(section.Controls) == 0 {
+			return fmt.Errorf("section %q has no controls", section.Name)
+		}
+
+		controlNames := make(map[string]bool, len(section.Controls))
+		for j, control := range section.Controls {
+			if control.Name == "" {
+				return fmt.Errorf("control %d of section %q has no name", j, section.Name)
+			}
+			if control.Description == "" {
+				return fmt.Errorf("control %q of section %q has no description", control.Name, section.Name)
+			}
+			if controlNames[control.Name] {
+				return fmt.Errorf("control %q of section %q is defined more than once", control.Name, section.Name)
+			}
+			controlNames[control.Name] = true
+		}
+	}
+
+	return nil
+}
+
+// orderedSectionsDocument returns a framework document with the sections and
+// controls of ordered_sections, in the same order. It returns false when
+// ordered_sections is null or not fully known.
+func orderedSectionsDocument(ctx context.Context, orderedSections types.List) (frameworkDocument, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var document frameworkDocument
+
+	value, err := orderedSections.ToTerraformValue(ctx)
+	if err != nil {
+		diags.AddError(errorInvalidFrameworkDocument, fmt.Sprintf("Failed to read ordered_sections: %s", err))
+		return document, false, diags
+	}
+	if value.IsNull() || !value.IsFullyKnown() {
+		return document, false, diags
+	}
+
+	var sections []OrderedSectionTFModel
+	diags.Append(orderedSections.ElementsAs(ctx, &sections, false)...)
+	if diags.HasError() {
+		return document, false, diags
+	}
+
+	for _, section := range sections {
+		var controls []OrderedControlTFModel
+		diags.Append(section.Controls.ElementsAs(ctx, &controls, false)...)
+
+		documentSection := frameworkDocumentSection{
+			Name:     section.Name.ValueString(),
+			Controls: make([]frameworkDocumentControl, 0, len(controls)),
+		}
+		for _, control := range controls {
+			rules := []string{}
+			if !control.Rules.IsNull() {
+				diags.Append(control.Rules.ElementsAs(ctx, &rules, false)...)
+			}
+
+			documentSection.Controls = append(documentSection.Controls, frameworkDocumentControl{
+				Name:        control.Name.ValueString(),
+				Description: control.Description.ValueString(),
+				Rules:       rules,
+			})
+		}
+
+		document.Sections = append(document.Sections, documentSection)
+	}
+	if diags.HasError() {
+		return document, false, diags
+	}
+
+	return document, true, diags
+}
+
+// importedSections returns the sections defined by a framework document, keyed
+// by keys generated from their names. Controls keep their IDs from
+// stateSections so that they are updated in place.
+func (r *cloudComplianceCustomFrameworkResource) importedSections(
+	ctx context.Context,
+	document string,
+	stateSections types.Map,
+) (types.Map, diag.Diagnostics) {
+	parsed, err := parseFrameworkDocument(document)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(errorInvalidFrameworkDocument, err.Error())
+		return types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes}), diags
+	}
+
+	return r.documentSections(ctx, parsed, stateSections)
+}
+
+// documentSections returns the sections of a validated framework document,
+// keyed by keys generated from their names. Controls keep their IDs from
+// stateSections so that they are updated in place.
+func (r *cloudComplianceCustomFrameworkResource) documentSections(
+	ctx context.Context,
+	parsed frameworkDocument,
+	stateSections types.Map,
+) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	nullSections := types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes})
+
+	var stateSectionsByKey map[string]SectionTFModel
+	if utils.IsKnown(stateSections) {
+		diags.Append(stateSections.ElementsAs(ctx, &stateSectionsByKey, false)...)
+		if diags.HasError() {
+			return nullSections, diags
+		}
+	}
+
+	sections := make(map[string]SectionTFModel, len(parsed.Sections))
+	for _, section := range parsed.Sections {
+		sectionKey := r.generateKeyFromName(section.Name)
+		if _, exists := sections[sectionKey]; exists {
+			diags.AddError(
+				errorInvalidFrameworkDocument,
+				fmt.Sprintf("The names of section %q and another section both generate the key %q.", section.Name, sectionKey),
+			)
+			return nullSections, diags
+		}
+
+		var stateControls map[string]ControlTFModel
+		if stateSection, exists := stateSectionsByKey[sectionKey]; exists && utils.IsKnown(stateSection.Controls) {
+			diags.Append(stateSection.Controls.ElementsAs(ctx, &stateControls, false)...)
+		}
+
+		controls := make(map[string]ControlTFModel, len(section.Controls))
+		for _, control := range section.Controls {
+			controlKey := r.generateKeyFromName(control.Name)
+			if _, exists := controls[controlKey]; exists {
+				diags.AddError(
+					errorInvalidFrameworkDocument,
+					fmt.Sprintf("The names of control %q and another control of section %q both generate the key %q.", control.Name, section.Name, controlKey),
+				)
+				return nullSections, diags
+			}
+
+			id := types.StringUnknown()
+			if stateControl, exists := stateControls[controlKey]; exists && utils.IsKnown(stateControl.ID) {
+				id = stateControl.ID
+			}
+
+			ruleIDs := slices.Compact(slices.Sorted(slices.Values(control.Rules)))
+			rules, setDiags := convertRulesToTerraformSet(ruleIDs)
+			diags.Append(setDiags...)
+
+			controls[controlKey] = ControlTFModel{
+				ID:           id,
+				Name:         types.StringValue(control.Name),
+				Description:  types.StringValue(control.Description),
+				Rules:        rules,
+				RulesFilter:  types.StringNull(),
+				RefreshRules: types.BoolNull(),
+			}
+		}
+
+		controlsMap, mapDiags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: controlAttrTypes}, controls)
+		diags.Append(mapDiags...)
+		sections[sectionKey] = SectionTFModel{
+			Name:     types.StringValue(section.Name),
+			Controls: controlsMap,
+		}
+	}
+	if diags.HasError() {
+		return nullSections, diags
+	}
+
+	return convertSectionsMapToTerraformMap(ctx, sections)
+}
+
+// plannedSections returns the sections planned from import_json or
+// ordered_sections, keeping the IDs of the controls in stateSections. It
+// returns false when neither is set or when its value is not known yet.
+func (r *cloudComplianceCustomFrameworkResource) plannedSections(
+	ctx context.Context,
+	importJSON types.String,
+	orderedSections types.List,
+	stateSections types.Map,
+) (types.Map, bool, diag.Diagnostics) {
+	nullSections := types.MapNull(types.ObjectType{AttrTypes: sectionAttrTypes})
+
+	if utils.IsKnown(importJSON) {
+		sections, diags := r.importedSections(ctx, importJSON.ValueString(), stateSections)
+		return sections, !diags.HasError(), diags
+	}
+
+	document, known, diags := orderedSectionsDocument(ctx, orderedSections)
+	if diags.HasError() || !known {
+		return nullSections, false, diags
+	}
+
+	if err := document.validate(); err != nil {
+		diags.AddError(errorInvalidFrameworkDocument, err.Error())
+		return nullSections, false, diags
+	}
+
+	sections, sectionsDiags := r.documentSections(ctx, document, stateSections)
+	diags.Append(sectionsDiags...)
+	return sections, !diags.HasError(), diags
+}
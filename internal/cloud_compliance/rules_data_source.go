@@ -0,0 +1,403 @@
+package cloudcompliance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon"
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/cloud_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &cloudComplianceRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &cloudComplianceRulesDataSource{}
+)
+
+// cloudComplianceRulesQueryLimit is the largest page size of the rules query.
+const cloudComplianceRulesQueryLimit = 500
+
+// ruleSeverities maps the severities of rules to their values in the API.
+var ruleSeverities = map[string]int64{
+	"critical":      0,
+	"high":          1,
+	"medium":        2,
+	"informational": 3,
+}
+
+func NewCloudComplianceRulesDataSource() datasource.DataSource {
+	return &cloudComplianceRulesDataSource{}
+}
+
+type cloudComplianceRulesDataSource struct {
+	client    *client.CrowdStrikeAPISpecification
+	readCache *config.ReadCache
+}
+
+type cloudComplianceRulesDataSourceModel struct {
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Service       types.String `tfsdk:"service"`
+	Severity      types.String `tfsdk:"severity"`
+	Benchmark     types.String `tfsdk:"benchmark"`
+	Framework     types.String `tfsdk:"framework"`
+	FQL           types.String `tfsdk:"fql"`
+	Limit         types.Int64  `tfsdk:"limit"`
+	MaxItems      types.Int64  `tfsdk:"max_items"`
+	IDs           types.Set    `tfsdk:"ids"`
+	Rules         types.Set    `tfsdk:"rules"`
+}
+
+type cloudComplianceRuleModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Service       types.String `tfsdk:"service"`
+	ResourceType  types.String `tfsdk:"resource_type"`
+	Severity      types.String `tfsdk:"severity"`
+	RuleOrigin    types.String `tfsdk:"rule_origin"`
+}
+
+func (m cloudComplianceRuleModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":             types.StringType,
+		"name":           types.StringType,
+		"description":    types.StringType,
+		"cloud_provider": types.StringType,
+		"service":        types.StringType,
+		"resource_type":  types.StringType,
+		"severity":       types.StringType,
+		"rule_origin":    types.StringType,
+	}
+}
+
+func (r *cloudComplianceRulesDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	r.readCache = config.ReadCache
+	resp.Diagnostics.Append(config.CheckScopes(cloudComplianceFrameworkScopes)...)
+}
+
+func (r *cloudComplianceRulesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_compliance_rules"
+}
+
+func (r *cloudComplianceRulesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	conflictsWithFQL := []validator.String{
+		stringvalidator.ConflictsWith(path.MatchRoot("fql")),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Falcon Cloud Security",
+			"This data source looks up cloud compliance rules, so the rule IDs assigned to the controls of a `crowdstrike_cloud_compliance_custom_framework` do not need to be hardcoded. "+
+				"The `cloud_provider`, `service`, `benchmark`, and `framework` fields accept wildcards `*`, and all fields are combined using logical AND. "+
+				"For advanced queries, use a Falcon Query Language (FQL) filter instead. For additional information on FQL filtering and usage, refer to the official CrowdStrike documentation: [Falcon Query Language (FQL)](https://falcon.crowdstrike.com/documentation/page/d3c84a1b/falcon-query-language-fql)",
+			cloudComplianceFrameworkScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Cloud provider of the rules. Examples: `AWS`, `Azure`, `GCP`.",
+				Validators:          conflictsWithFQL,
+			},
+			"service": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Service within the cloud provider that the rules are for. Examples: `IAM`, `S3`, `Microsoft.Compute`.",
+				Validators:          conflictsWithFQL,
+			},
+			"severity": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Severity of the rules. Valid values are `critical`, `high`, `medium`, `informational`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("fql")),
+					stringvalidator.OneOf("critical", "high", "medium", "informational"),
+				},
+			},
+			"benchmark": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of a compliance benchmark the rules are attached to. Example: `CIS 1.0.0 AWS*`.",
+				Validators:          conflictsWithFQL,
+			},
+			"framework": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of a compliance framework the rules are attached to. Examples: `CIS`, `NIST`.",
+				Validators:          conflictsWithFQL,
+			},
+			"fql": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Falcon Query Language (FQL) filter for advanced rule searches. Allowed properties include `rule_name`, `rule_origin`, `rule_provider`, `rule_service`, `rule_severity`, `rule_resource_type`, `rule_compliance_benchmark`, `rule_compliance_framework`, `rule_control_requirement`, and `rule_control_section`.",
+			},
+			"limit":     utils.PageLimitAttribute("rules", cloudComplianceRulesQueryLimit, cloudComplianceRulesQueryLimit),
+			"max_items": utils.MaxItemsAttribute("rules"),
+			"ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the matching rules, for use in the `rules` of a control of `crowdstrike_cloud_compliance_custom_framework`.",
+			},
+			"rules": schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "The cloud compliance rules matching the filter criteria, up to `max_items`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the rule.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the rule.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the rule.",
+						},
+						"cloud_provider": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud provider of the rule.",
+						},
+						"service": schema.StringAttribute{
+							Computed:    true,
+							Description: "Service within the cloud provider that the rule is for.",
+						},
+						"resource_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Resource type the rule evaluates. Examples: `AWS::IAM::CredentialReport`, `Microsoft.Compute/virtualMachines`.",
+						},
+						"severity": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Severity of the rule. One of `critical`, `high`, `medium`, `informational`.",
+						},
+						"rule_origin": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Origin of the rule, `Default` or `Custom`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *cloudComplianceRulesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data cloudComplianceRulesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := data.FQL.ValueString()
+	if filter == "" {
+		filter = data.filter()
+	}
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, cloudComplianceRulesQueryLimit)
+
+	type queryResult struct {
+		rules []*models.ApimodelsRule
+		total int64
+	}
+
+	key := fmt.Sprintf("cloud_compliance_rules/%s/%d/%d", filter, pagination.Limit, pagination.MaxItems)
+	result, diags := config.Cached(r.readCache, key, func() (queryResult, diag.Diagnostics) {
+		rules, total, diags := r.queryRules(ctx, filter, pagination)
+		return queryResult{rules: rules, total: total}, diags
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules := make([]cloudComplianceRuleModel, 0, len(result.rules))
+	ids := make([]string, 0, len(result.rules))
+	for _, rule := range result.rules {
+		if rule == nil || rule.UUID == nil {
+			continue
+		}
+
+		model := cloudComplianceRuleModel{
+			ID:            types.StringPointerValue(rule.UUID),
+			Name:          types.StringPointerValue(rule.Name),
+			Description:   types.StringPointerValue(rule.Description),
+			CloudProvider: types.StringPointerValue(rule.Provider),
+			Service:       types.StringNull(),
+			ResourceType:  types.StringNull(),
+			Severity:      types.StringNull(),
+			RuleOrigin:    types.StringPointerValue(rule.Origin),
+		}
+
+		if len(rule.ResourceTypes) > 0 && rule.ResourceTypes[0] != nil {
+			model.Service = types.StringPointerValue(rule.ResourceTypes[0].Service)
+			model.ResourceType = types.StringPointerValue(rule.ResourceTypes[0].ResourceType)
+		}
+
+		if rule.Severity != nil {
+			for severity, value := range ruleSeverities {
+				if value == *rule.Severity {
+					model.Severity = types.StringValue(severity)
+				}
+			}
+		}
+
+		rules = append(rules, model)
+		ids = append(ids, *rule.UUID)
+	}
+
+	data.Rules, diags = types.SetValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: cloudComplianceRuleModel{}.AttributeTypes()},
+		rules,
+	)
+	resp.Diagnostics.Append(diags...)
+
+	data.IDs, diags = types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("rules", len(rules), result.total)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filter returns the FQL filter combining the filter fields of m.
+func (m cloudComplianceRulesDataSourceModel) filter() string {
+	var filters []string
+
+	for property, value := range map[string]types.String{
+		"rule_provider":             m.CloudProvider,
+		"rule_service":              m.Service,
+		"rule_compliance_benchmark": m.Benchmark,
+		"rule_compliance_framework": m.Framework,
+	} {
+		if value.ValueString() != "" {
+			filters = append(filters, fmt.Sprintf("%s:*%s", property, utils.FQLQuote(value.ValueString())))
+		}
+	}
+
+	if severity, ok := ruleSeverities[m.Severity.ValueString()]; ok {
+		filters = append(filters, fmt.Sprintf("rule_severity:'%d'", severity))
+	}
+
+	// Map iteration order is random, so sort the filters to keep the filter,
+	// and the key it is cached by, stable.
+	slices.Sort(filters)
+
+	return strings.Join(filters, "+")
+}
+
+// queryRules returns the rules matching filter and the total number of rules
+// matching it. The IDs of the rules are fetched page by page, then the rules
+// in batches of cloudComplianceRulesQueryLimit.
+func (r *cloudComplianceRulesDataSource) queryRules(
+	ctx context.Context,
+	filter string,
+	pagination utils.Pagination,
+) ([]*models.ApimodelsRule, int64, diag.Diagnostics) {
+	ids, total, diags := utils.QueryPages(pagination, func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		params := cloud_policies.NewQueryRuleParams().WithContext(ctx)
+		params.SetLimit(&limit)
+		params.SetOffset(&offset)
+		if filter != "" {
+			params.SetFilter(&filter)
+		}
+
+		res, err := r.client.CloudPolicies.QueryRule(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, cloudComplianceFrameworkScopes))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			return nil, 0, diags
+		}
+
+		if err := falcon.AssertNoError(res.Payload.Errors); err != nil {
+			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+			return nil, 0, diags
+		}
+
+		return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+	})
+	if diags.HasError() {
+		return nil, 0, diags
+	}
+
+	rules := make([]*models.ApimodelsRule, 0, len(ids))
+	for batch := range slices.Chunk(ids, cloudComplianceRulesQueryLimit) {
+		params := cloud_policies.NewGetRuleParams().WithContext(ctx)
+		params.SetIds(batch)
+
+		res, err := r.client.CloudPolicies.GetRule(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, cloudComplianceFrameworkScopes))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
+		}
+
+		if err := falcon.AssertNoError(res.Payload.Errors); err != nil {
+			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+			return nil, 0, diags
+		}
+
+		rules = append(rules, res.Payload.Resources...)
+	}
+
+	// Rules deleted between the two requests are not returned, and do not
+	// count toward the rules matching the filter.
+	total -= int64(len(ids) - len(rules))
+
+	return rules, total, diags
+}
@@ -0,0 +1,67 @@
+package cloudcompliance_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccCloudComplianceRulesDataSource(t *testing.T) {
+	dataSourceName := "data.crowdstrike_cloud_compliance_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_cloud_compliance_rules" "test" {
+  cloud_provider = "AWS"
+  service        = "S3"
+  severity       = "high"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						dataSourceName,
+						tfjsonpath.New("rules"),
+						knownvalue.SetPartial([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"cloud_provider": knownvalue.StringExact("AWS"),
+								"service":        knownvalue.StringExact("S3"),
+								"severity":       knownvalue.StringExact("high"),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("ids"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_cloud_compliance_rules" "test" {
+  fql       = "rule_provider:'AWS'"
+  max_items = 3
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("rules"), knownvalue.SetSizeExact(3)),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("ids"), knownvalue.SetSizeExact(3)),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_cloud_compliance_rules" "test" {
+  fql            = "rule_provider:'AWS'"
+  cloud_provider = "AWS"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
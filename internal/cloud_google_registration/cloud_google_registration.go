@@ -14,6 +14,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
@@ -37,6 +38,7 @@ var (
 	_ resource.Resource                     = &cloudGoogleRegistrationResource{}
 	_ resource.ResourceWithConfigure        = &cloudGoogleRegistrationResource{}
 	_ resource.ResourceWithImportState      = &cloudGoogleRegistrationResource{}
+	_ resource.ResourceWithIdentity         = &cloudGoogleRegistrationResource{}
 	_ resource.ResourceWithConfigValidators = &cloudGoogleRegistrationResource{}
 	_ resource.ResourceWithValidateConfig   = &cloudGoogleRegistrationResource{}
 	_ resource.ResourceWithModifyPlan       = &cloudGoogleRegistrationResource{}
@@ -80,28 +82,29 @@ func (r *realtimeVisibilityModel) FromObject(ctx context.Context, obj types.Obje
 }
 
 type cloudGoogleRegistrationResourceModel struct {
-	ID                          types.String `tfsdk:"id"`
-	Name                        types.String `tfsdk:"name"`
-	RegistrationScope           types.String `tfsdk:"registration_scope"`
-	Organization                types.String `tfsdk:"organization"`
-	Folders                     types.Set    `tfsdk:"folders"`
-	Projects                    types.Set    `tfsdk:"projects"`
-	DeploymentMethod            types.String `tfsdk:"deployment_method"`
-	InfrastructureManagerRegion types.String `tfsdk:"infrastructure_manager_region"`
-	InfraProjectID              types.String `tfsdk:"infra_project"`
-	WifProjectID                types.String `tfsdk:"wif_project"`
-	ExcludedProjectPatterns     types.List   `tfsdk:"excluded_project_patterns"`
-	ResourceNamePrefix          types.String `tfsdk:"resource_name_prefix"`
-	ResourceNameSuffix          types.String `tfsdk:"resource_name_suffix"`
-	Labels                      types.Map    `tfsdk:"labels"`
-	Tags                        types.Map    `tfsdk:"tags"`
-	RealtimeVisibility          types.Object `tfsdk:"realtime_visibility"`
-	Status                      types.String `tfsdk:"status"`
-	WifPoolID                   types.String `tfsdk:"wif_pool_id"`
-	WifPoolName                 types.String `tfsdk:"wif_pool_name"`
-	WifProjectNumber            types.String `tfsdk:"wif_project_number"`
-	WifProviderID               types.String `tfsdk:"wif_provider_id"`
-	WifProviderName             types.String `tfsdk:"wif_provider_name"`
+	ID                          types.String   `tfsdk:"id"`
+	Name                        types.String   `tfsdk:"name"`
+	RegistrationScope           types.String   `tfsdk:"registration_scope"`
+	Organization                types.String   `tfsdk:"organization"`
+	Folders                     types.Set      `tfsdk:"folders"`
+	Projects                    types.Set      `tfsdk:"projects"`
+	DeploymentMethod            types.String   `tfsdk:"deployment_method"`
+	InfrastructureManagerRegion types.String   `tfsdk:"infrastructure_manager_region"`
+	InfraProjectID              types.String   `tfsdk:"infra_project"`
+	WifProjectID                types.String   `tfsdk:"wif_project"`
+	ExcludedProjectPatterns     types.List     `tfsdk:"excluded_project_patterns"`
+	ResourceNamePrefix          types.String   `tfsdk:"resource_name_prefix"`
+	ResourceNameSuffix          types.String   `tfsdk:"resource_name_suffix"`
+	Labels                      types.Map      `tfsdk:"labels"`
+	Tags                        types.Map      `tfsdk:"tags"`
+	RealtimeVisibility          types.Object   `tfsdk:"realtime_visibility"`
+	Status                      types.String   `tfsdk:"status"`
+	WifPoolID                   types.String   `tfsdk:"wif_pool_id"`
+	WifPoolName                 types.String   `tfsdk:"wif_pool_name"`
+	WifProjectNumber            types.String   `tfsdk:"wif_project_number"`
+	WifProviderID               types.String   `tfsdk:"wif_provider_id"`
+	WifProviderName             types.String   `tfsdk:"wif_provider_name"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *cloudGoogleRegistrationResourceModel) getEntityIDs(ctx context.Context) ([]string, diag.Diagnostics) {
@@ -279,6 +282,7 @@ func (r *cloudGoogleRegistrationResource) Configure(
 
 	r.client = config.Client
 	r.clientId = config.ClientId
+	resp.Diagnostics.Append(config.CheckScopes(gcpRegistrationScopes)...)
 }
 
 func (r *cloudGoogleRegistrationResource) Metadata(
@@ -290,7 +294,7 @@ func (r *cloudGoogleRegistrationResource) Metadata(
 }
 
 func (r *cloudGoogleRegistrationResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -501,9 +505,21 @@ func (r *cloudGoogleRegistrationResource) Schema(
 				Description: "Workload Identity Federation provider name",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *cloudGoogleRegistrationResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 func (r *cloudGoogleRegistrationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		resourcevalidator.ExactlyOneOf(
@@ -566,6 +582,9 @@ func (r *cloudGoogleRegistrationResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	entityIDs, diags := plan.getEntityIDs(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -707,6 +726,7 @@ func (r *cloudGoogleRegistrationResource) Create(
 
 	tflog.Info(ctx, "Google Cloud registration created", map[string]interface{}{"registration_id": registration.RegistrationID})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cloudGoogleRegistrationResource) Read(
@@ -720,6 +740,9 @@ func (r *cloudGoogleRegistrationResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	params := &cloud_google_cloud_registration.CloudRegistrationGcpGetRegistrationParams{
 		Context: ctx,
 		Ids:     state.ID.ValueString(),
@@ -750,6 +773,7 @@ func (r *cloudGoogleRegistrationResource) Read(
 	registration := res.Payload.Resources[0]
 	resp.Diagnostics.Append(state.wrap(ctx, registration)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *cloudGoogleRegistrationResource) Update(
@@ -763,6 +787,9 @@ func (r *cloudGoogleRegistrationResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	entityIDs, diags := plan.getEntityIDs(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -861,6 +888,7 @@ func (r *cloudGoogleRegistrationResource) Update(
 	state := plan
 	resp.Diagnostics.Append(state.wrap(ctx, registration)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *cloudGoogleRegistrationResource) Delete(
@@ -874,6 +902,9 @@ func (r *cloudGoogleRegistrationResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	params := &cloud_google_cloud_registration.CloudRegistrationGcpDeleteRegistrationParams{
 		Context: ctx,
 		Ids:     state.ID.ValueString(),
@@ -937,5 +968,5 @@ func (r *cloudGoogleRegistrationResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
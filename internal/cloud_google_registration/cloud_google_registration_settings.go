@@ -13,6 +13,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -63,6 +64,7 @@ func (r *cloudGoogleRegistrationSettingsResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(gcpRegistrationScopes)...)
 }
 
 func (r *cloudGoogleRegistrationSettingsResource) Metadata(
@@ -74,12 +76,13 @@ func (r *cloudGoogleRegistrationSettingsResource) Metadata(
 }
 
 type cloudGoogleRegistrationSettingsModel struct {
-	RegistrationID               types.String `tfsdk:"registration_id"`
-	LogIngestionSinkName         types.String `tfsdk:"log_ingestion_sink_name"`
-	LogIngestionTopicID          types.String `tfsdk:"log_ingestion_topic_id"`
-	LogIngestionSubscriptionName types.String `tfsdk:"log_ingestion_subscription_name"`
-	WifPoolName                  types.String `tfsdk:"wif_pool_name"`
-	WifProviderName              types.String `tfsdk:"wif_provider_name"`
+	RegistrationID               types.String   `tfsdk:"registration_id"`
+	LogIngestionSinkName         types.String   `tfsdk:"log_ingestion_sink_name"`
+	LogIngestionTopicID          types.String   `tfsdk:"log_ingestion_topic_id"`
+	LogIngestionSubscriptionName types.String   `tfsdk:"log_ingestion_subscription_name"`
+	WifPoolName                  types.String   `tfsdk:"wif_pool_name"`
+	WifProviderName              types.String   `tfsdk:"wif_provider_name"`
+	Timeouts                     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *cloudGoogleRegistrationSettingsResource) Schema(
@@ -140,6 +143,9 @@ func (r *cloudGoogleRegistrationSettingsResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -178,6 +184,9 @@ func (r *cloudGoogleRegistrationSettingsResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	registration, diags := r.updateRegistration(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
@@ -218,6 +227,9 @@ func (r *cloudGoogleRegistrationSettingsResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	registration, diags := r.getRegistration(ctx, data.RegistrationID.ValueString())
 	if tferrors.HasNotFoundError(diags) {
 		tflog.Warn(
@@ -251,6 +263,9 @@ func (r *cloudGoogleRegistrationSettingsResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	registration, diags := r.updateRegistration(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -290,6 +305,9 @@ func (r *cloudGoogleRegistrationSettingsResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	data.LogIngestionSinkName = types.StringValue("")
 	data.LogIngestionTopicID = types.StringValue("")
 	data.LogIngestionSubscriptionName = types.StringValue("")
@@ -14,6 +14,7 @@ import (
 	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -34,6 +35,7 @@ var (
 	_ resource.Resource                = &cloudGroupResource{}
 	_ resource.ResourceWithConfigure   = &cloudGroupResource{}
 	_ resource.ResourceWithImportState = &cloudGroupResource{}
+	_ resource.ResourceWithIdentity    = &cloudGroupResource{}
 )
 
 // NewCloudGroupResource is a helper function to simplify the provider implementation.
@@ -279,20 +281,21 @@ func (s *imageSelectorModel) Flatten(ctx context.Context, img *models.Assetgroup
 
 // cloudGroupResourceModel describes the resource data model.
 type cloudGroupResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	BusinessImpact types.String `tfsdk:"business_impact"`
-	BusinessUnit   types.String `tfsdk:"business_unit"`
-	Environment    types.String `tfsdk:"environment"`
-	Owners         types.List   `tfsdk:"owners"`
-	AWS            types.Object `tfsdk:"aws"`
-	Azure          types.Object `tfsdk:"azure"`
-	GCP            types.Object `tfsdk:"gcp"`
-	Images         types.List   `tfsdk:"images"`
-	CreatedAt      types.String `tfsdk:"created_at"`
-	LastUpdated    types.String `tfsdk:"last_updated"`
-	CreatedBy      types.String `tfsdk:"created_by"`
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Description    types.String   `tfsdk:"description"`
+	BusinessImpact types.String   `tfsdk:"business_impact"`
+	BusinessUnit   types.String   `tfsdk:"business_unit"`
+	Environment    types.String   `tfsdk:"environment"`
+	Owners         types.List     `tfsdk:"owners"`
+	AWS            types.Object   `tfsdk:"aws"`
+	Azure          types.Object   `tfsdk:"azure"`
+	GCP            types.Object   `tfsdk:"gcp"`
+	Images         types.List     `tfsdk:"images"`
+	CreatedAt      types.String   `tfsdk:"created_at"`
+	LastUpdated    types.String   `tfsdk:"last_updated"`
+	CreatedBy      types.String   `tfsdk:"created_by"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ToCreateRequest converts the model to an API create request.
@@ -433,7 +436,7 @@ func (r *cloudGroupResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *cloudGroupResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -713,9 +716,21 @@ func (r *cloudGroupResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *cloudGroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *cloudGroupResource) Configure(
 	ctx context.Context,
@@ -754,6 +769,9 @@ func (r *cloudGroupResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	createRequest, diags := plan.ToCreateRequest(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -814,6 +832,7 @@ func (r *cloudGroupResource) Create(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cloudGroupResource) Read(
@@ -828,6 +847,9 @@ func (r *cloudGroupResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	if state.ID.ValueString() == "" {
 		resp.Diagnostics.AddError(
 			"Resource ID missing",
@@ -870,6 +892,7 @@ func (r *cloudGroupResource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -887,6 +910,9 @@ func (r *cloudGroupResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	updateRequest, diags := plan.ToUpdateRequest(ctx, state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -949,6 +975,7 @@ func (r *cloudGroupResource) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -964,6 +991,9 @@ func (r *cloudGroupResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	if state.ID.ValueString() == "" {
 		return
 	}
@@ -993,7 +1023,7 @@ func (r *cloudGroupResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // wrap converts API response to Terraform state model.
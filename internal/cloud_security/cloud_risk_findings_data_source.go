@@ -7,6 +7,7 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon"
 	"github.com/crowdstrike/gofalcon/falcon/client"
 	"github.com/crowdstrike/gofalcon/falcon/client/cloud_security"
+	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
@@ -21,6 +22,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// cloudRisksQueryLimit is the largest page size used when querying cloud risks.
+const cloudRisksQueryLimit = 500
+
 var cloudRisksScopes = []scopes.Scope{
 	{
 		Name:  "Cloud Security Risks",
@@ -96,9 +100,11 @@ type cloudRiskFindingsDataSource struct {
 }
 
 type cloudRiskFindingsDataSourceModel struct {
-	Filter types.String `tfsdk:"filter"`
-	Sort   types.String `tfsdk:"sort"`
-	Risks  types.Set    `tfsdk:"risks"`
+	Filter   types.String `tfsdk:"filter"`
+	Sort     types.String `tfsdk:"sort"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	MaxItems types.Int64  `tfsdk:"max_items"`
+	Risks    types.Set    `tfsdk:"risks"`
 }
 
 func (r *cloudRiskFindingsDataSource) Configure(
@@ -124,6 +130,7 @@ func (r *cloudRiskFindingsDataSource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(cloudRisksScopes)...)
 }
 
 func (r *cloudRiskFindingsDataSource) Metadata(
@@ -166,9 +173,11 @@ func (r *cloudRiskFindingsDataSource) Schema(
 					}),
 				},
 			},
+			"limit":     utils.PageLimitAttribute("cloud risks", cloudRisksQueryLimit, cloudRisksQueryLimit),
+			"max_items": utils.MaxItemsAttribute("cloud risks"),
 			"risks": schema.SetNestedAttribute{
 				Computed:    true,
-				Description: "Complete list of all cloud risks matching the filter criteria",
+				Description: "The cloud risks matching the filter criteria, up to `max_items`.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
@@ -272,12 +281,14 @@ func (r *cloudRiskFindingsDataSource) Read(
 		return
 	}
 
-	risks, diags := r.getAllRisks(ctx, &data)
+	risks, total, diags := r.getAllRisks(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(utils.TruncatedWarning("cloud risks", len(risks.Elements()), total)...)
+
 	data.Risks = risks
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -286,14 +297,13 @@ func (r *cloudRiskFindingsDataSource) Read(
 func (r *cloudRiskFindingsDataSource) getAllRisks(
 	ctx context.Context,
 	config *cloudRiskFindingsDataSourceModel,
-) (types.Set, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	allRisks := make([]cloudRiskModel, 0)
+) (types.Set, int64, diag.Diagnostics) {
+	risksType := types.ObjectType{AttrTypes: cloudRiskModel{}.AttributeTypes()}
+	pagination := utils.NewPagination(config.Limit, config.MaxItems, cloudRisksQueryLimit)
 
-	limit := int64(500)
-	offset := int64(0)
+	risks, total, diags := utils.QueryPages(pagination, func(offset, limit int64) ([]*models.RisksUnionCloudRisk, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
 
-	for {
 		params := cloud_security.NewCombinedCloudRisksParams().WithContext(ctx)
 		params.SetLimit(&limit)
 		params.SetOffset(&offset)
@@ -317,82 +327,80 @@ func (r *cloudRiskFindingsDataSource) getAllRisks(
 		response, err := r.client.CloudSecurity.CombinedCloudRisks(params)
 		if err != nil {
 			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
-			break
+			return nil, 0, diags
 		}
 
-		if response == nil || response.Payload == nil || len(response.Payload.Resources) == 0 {
-			break
+		if response == nil || response.Payload == nil {
+			return nil, 0, diags
 		}
 
 		payload := response.GetPayload()
 
 		if err = falcon.AssertNoError(payload.Errors); err != nil {
 			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
-			break
+			return nil, 0, diags
+		}
+
+		return payload.Resources, utils.ListTotal(payload.Meta), diags
+	})
+	if diags.HasError() {
+		return types.SetNull(risksType), 0, diags
+	}
+
+	allRisks := make([]cloudRiskModel, 0, len(risks))
+	for _, risk := range risks {
+		if risk == nil {
+			continue
+		}
+
+		riskModel := cloudRiskModel{
+			ID:              types.StringPointerValue(risk.ID),
+			AccountID:       types.StringPointerValue(risk.AccountID),
+			AccountName:     types.StringPointerValue(risk.AccountName),
+			AssetGCRN:       types.StringPointerValue(risk.AssetGcrn),
+			AssetID:         types.StringPointerValue(risk.AssetID),
+			AssetName:       types.StringPointerValue(risk.AssetName),
+			AssetRegion:     types.StringValue(risk.AssetRegion),
+			AssetType:       types.StringPointerValue(risk.AssetType),
+			AssetTags:       types.ListValueMust(types.StringType, []attr.Value{}),
+			CloudProvider:   types.StringPointerValue(risk.Provider),
+			CloudGroups:     types.ListValueMust(types.StringType, []attr.Value{}),
+			RuleID:          types.StringPointerValue(risk.RuleID),
+			RuleName:        types.StringPointerValue(risk.RuleName),
+			RuleDescription: types.StringPointerValue(risk.RuleDescription),
+			ServiceCategory: types.StringPointerValue(risk.ServiceCategory),
+			Severity:        types.StringPointerValue(risk.Severity),
+			Status:          types.StringPointerValue(risk.Status),
+			FirstSeen:       types.StringValue(risk.FirstSeen.String()),
+			LastSeen:        types.StringValue(risk.LastSeen.String()),
+			ResolvedAt:      types.StringNull(),
+		}
+
+		if !risk.ResolvedAt.IsZero() {
+			riskModel.ResolvedAt = types.StringValue(risk.ResolvedAt.String())
+		}
+
+		if len(risk.AssetTags) > 0 {
+			assetTags, diag := types.ListValueFrom(ctx, types.StringType, risk.AssetTags)
+			diags.Append(diag...)
+			riskModel.AssetTags = assetTags
 		}
 
-		for _, risk := range payload.Resources {
-			riskModel := cloudRiskModel{
-				ID:              types.StringPointerValue(risk.ID),
-				AccountID:       types.StringPointerValue(risk.AccountID),
-				AccountName:     types.StringPointerValue(risk.AccountName),
-				AssetGCRN:       types.StringPointerValue(risk.AssetGcrn),
-				AssetID:         types.StringPointerValue(risk.AssetID),
-				AssetName:       types.StringPointerValue(risk.AssetName),
-				AssetRegion:     types.StringValue(risk.AssetRegion),
-				AssetType:       types.StringPointerValue(risk.AssetType),
-				AssetTags:       types.ListValueMust(types.StringType, []attr.Value{}),
-				CloudProvider:   types.StringPointerValue(risk.Provider),
-				CloudGroups:     types.ListValueMust(types.StringType, []attr.Value{}),
-				RuleID:          types.StringPointerValue(risk.RuleID),
-				RuleName:        types.StringPointerValue(risk.RuleName),
-				RuleDescription: types.StringPointerValue(risk.RuleDescription),
-				ServiceCategory: types.StringPointerValue(risk.ServiceCategory),
-				Severity:        types.StringPointerValue(risk.Severity),
-				Status:          types.StringPointerValue(risk.Status),
-				FirstSeen:       types.StringValue(risk.FirstSeen.String()),
-				LastSeen:        types.StringValue(risk.LastSeen.String()),
-				ResolvedAt:      types.StringNull(),
-			}
-
-			if !risk.ResolvedAt.IsZero() {
-				riskModel.ResolvedAt = types.StringValue(risk.ResolvedAt.String())
-			}
-
-			if len(risk.AssetTags) > 0 {
-				assetTags, diag := types.ListValueFrom(ctx, types.StringType, risk.AssetTags)
-				diags.Append(diag...)
-				if diags.HasError() {
-					break
-				}
-				riskModel.AssetTags = assetTags
-			}
-
-			if len(risk.CloudGroups) > 0 {
-				cloudGroups, diag := types.ListValueFrom(ctx, types.StringType, risk.CloudGroups)
-				diags.Append(diag...)
-				if diags.HasError() {
-					break
-				}
-				riskModel.CloudGroups = cloudGroups
-			}
-
-			allRisks = append(allRisks, riskModel)
+		if len(risk.CloudGroups) > 0 {
+			cloudGroups, diag := types.ListValueFrom(ctx, types.StringType, risk.CloudGroups)
+			diags.Append(diag...)
+			riskModel.CloudGroups = cloudGroups
 		}
 
 		if diags.HasError() {
-			break
+			return types.SetNull(risksType), 0, diags
 		}
 
-		offset += limit
+		allRisks = append(allRisks, riskModel)
 	}
 
-	risksSet, convertDiags := types.SetValueFrom(
-		ctx,
-		types.ObjectType{AttrTypes: cloudRiskModel{}.AttributeTypes()},
-		allRisks,
-	)
+	risksSet, convertDiags := types.SetValueFrom(ctx, risksType, allRisks)
 	diags.Append(convertDiags...)
 
-	return risksSet, diags
+	return risksSet, total, diags
 }
@@ -0,0 +1,465 @@
+package cloudsecurity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon"
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/container_images"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &cloudSecurityContainerBaseImageResource{}
+	_ resource.ResourceWithConfigure        = &cloudSecurityContainerBaseImageResource{}
+	_ resource.ResourceWithImportState      = &cloudSecurityContainerBaseImageResource{}
+	_ resource.ResourceWithIdentity         = &cloudSecurityContainerBaseImageResource{}
+	_ resource.ResourceWithConfigValidators = &cloudSecurityContainerBaseImageResource{}
+)
+
+var (
+	containerBaseImageDocumentationSection        string         = "Falcon Cloud Security"
+	containerBaseImageResourceMarkdownDescription string         = "This resource declares a base image of your container images. Image assessments report the vulnerabilities inherited from base images apart from the vulnerabilities of the application layers built on top of them. Base images cannot be updated, so changing any attribute replaces the base image."
+	containerBaseImageRequiredScopes              []scopes.Scope = []scopes.Scope{
+		{
+			Name:  "Falcon Container Image",
+			Read:  true,
+			Write: true,
+		},
+	}
+)
+
+func NewCloudSecurityContainerBaseImageResource() resource.Resource {
+	return &cloudSecurityContainerBaseImageResource{}
+}
+
+type cloudSecurityContainerBaseImageResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type cloudSecurityContainerBaseImageResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Registry    types.String   `tfsdk:"registry"`
+	Repository  types.String   `tfsdk:"repository"`
+	Tag         types.String   `tfsdk:"tag"`
+	ImageDigest types.String   `tfsdk:"image_digest"`
+	ImageID     types.String   `tfsdk:"image_id"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// containerBaseImage is a base image returned by the API, which does not
+// document the type of its resources.
+type containerBaseImage struct {
+	ID          string `json:"id"`
+	Registry    string `json:"registry"`
+	Repository  string `json:"repository"`
+	Tag         string `json:"tag"`
+	ImageDigest string `json:"image_digest"`
+	ImageID     string `json:"image_id"`
+}
+
+// wrap sets the model from image. Properties the API omits are null, as their
+// attributes are computed and cannot be empty.
+func (m *cloudSecurityContainerBaseImageResourceModel) wrap(image containerBaseImage) {
+	m.ID = types.StringValue(image.ID)
+	m.Registry = utils.OptionalString(&image.Registry)
+	m.Repository = utils.OptionalString(&image.Repository)
+	m.Tag = utils.OptionalString(&image.Tag)
+	m.ImageDigest = utils.OptionalString(&image.ImageDigest)
+	m.ImageID = utils.OptionalString(&image.ImageID)
+}
+
+// matches reports whether image has the properties set in the model.
+func (m *cloudSecurityContainerBaseImageResourceModel) matches(image containerBaseImage) bool {
+	properties := []struct {
+		model types.String
+		value string
+	}{
+		{m.Registry, image.Registry},
+		{m.Repository, image.Repository},
+		{m.Tag, image.Tag},
+		{m.ImageDigest, image.ImageDigest},
+		{m.ImageID, image.ImageID},
+	}
+
+	for _, property := range properties {
+		if utils.IsKnown(property.model) && property.model.ValueString() != property.value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filter returns the FQL filter matching the properties set in the model.
+func (m *cloudSecurityContainerBaseImageResourceModel) filter() string {
+	properties := []struct {
+		name  string
+		value types.String
+	}{
+		{"registry", m.Registry},
+		{"repository", m.Repository},
+		{"tag", m.Tag},
+		{"image_digest", m.ImageDigest},
+		{"image_id", m.ImageID},
+	}
+
+	var filters []string
+	for _, property := range properties {
+		if utils.IsKnown(property.value) && property.value.ValueString() != "" {
+			filters = append(filters, fmt.Sprintf("%s:%s", property.name, utils.FQLQuote(property.value.ValueString())))
+		}
+	}
+
+	return strings.Join(filters, "+")
+}
+
+func (r *cloudSecurityContainerBaseImageResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(containerBaseImageRequiredScopes)...)
+}
+
+func (r *cloudSecurityContainerBaseImageResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_security_container_base_image"
+}
+
+func (r *cloudSecurityContainerBaseImageResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *cloudSecurityContainerBaseImageResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
+func (r *cloudSecurityContainerBaseImageResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	imageAttribute := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: description,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			containerBaseImageDocumentationSection,
+			containerBaseImageResourceMarkdownDescription,
+			containerBaseImageRequiredScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the base image.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registry":     imageAttribute("Registry of the base image, such as `docker.io`."),
+			"repository":   imageAttribute("Repository of the base image, such as `library/alpine`."),
+			"tag":          imageAttribute("Tag of the base image, such as `3.20`."),
+			"image_digest": imageAttribute("Digest of the base image, such as `sha256:...`."),
+			"image_id":     imageAttribute("ID of the base image."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *cloudSecurityContainerBaseImageResource) ConfigValidators(
+	_ context.Context,
+) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("repository"),
+			path.MatchRoot("image_digest"),
+			path.MatchRoot("image_id"),
+		),
+	}
+}
+
+func (r *cloudSecurityContainerBaseImageResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan cloudSecurityContainerBaseImageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	image, diags := r.createBaseImage(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.wrap(*image)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *cloudSecurityContainerBaseImageResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state cloudSecurityContainerBaseImageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	image, diags := r.getBaseImage(ctx, &state)
+	if diags.HasError() {
+		if tferrors.HasNotFoundError(diags) {
+			resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	state.wrap(*image)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func (r *cloudSecurityContainerBaseImageResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// Every attribute requires replacement, so only the timeouts can change.
+	var plan cloudSecurityContainerBaseImageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *cloudSecurityContainerBaseImageResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state cloudSecurityContainerBaseImageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	res, err := r.client.ContainerImages.DeleteBaseImages(
+		&container_images.DeleteBaseImagesParams{
+			Context: ctx,
+			Ids:     []string{state.ID.ValueString()},
+		},
+	)
+	if diag := tferrors.NewDiagnosticFromAPIError(tferrors.Delete, err, containerBaseImageRequiredScopes); diag != nil {
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			return
+		}
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	if res != nil && res.Payload != nil {
+		if err = falcon.AssertNoError(res.Payload.Errors); err != nil {
+			resp.Diagnostics.Append(tferrors.NewOperationError(tferrors.Delete, err))
+		}
+	}
+}
+
+func (r *cloudSecurityContainerBaseImageResource) createBaseImage(
+	ctx context.Context,
+	plan *cloudSecurityContainerBaseImageResourceModel,
+) (*containerBaseImage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := r.client.ContainerImages.CreateBaseImagesEntities(
+		&container_images.CreateBaseImagesEntitiesParams{
+			Context: ctx,
+			Body: &models.ModelsCreateBaseImagesRequest{
+				BaseImages: []*models.ModelsBaseImageRequest{
+					{
+						Registry:    plan.Registry.ValueString(),
+						Repository:  plan.Repository.ValueString(),
+						Tag:         plan.Tag.ValueString(),
+						ImageDigest: plan.ImageDigest.ValueString(),
+						ImageID:     plan.ImageID.ValueString(),
+					},
+				},
+			},
+		},
+	)
+	if diag := tferrors.NewDiagnosticFromAPIError(tferrors.Create, err, containerBaseImageRequiredScopes); diag != nil {
+		diags.Append(diag)
+		return nil, diags
+	}
+
+	if res == nil || res.Payload == nil {
+		diags.Append(tferrors.NewEmptyResponseError(tferrors.Create))
+		return nil, diags
+	}
+
+	if err = falcon.AssertNoError(res.Payload.Errors); err != nil {
+		diags.Append(tferrors.NewOperationError(tferrors.Create, err))
+		return nil, diags
+	}
+
+	images, err := decodeContainerBaseImages(res.Payload.Resources)
+	if err != nil {
+		diags.Append(tferrors.NewOperationError(tferrors.Create, err))
+		return nil, diags
+	}
+
+	for _, image := range images {
+		if image.ID != "" && plan.matches(image) {
+			return &image, diags
+		}
+	}
+
+	// The created base image is looked up when it is not returned.
+	return r.getBaseImage(ctx, plan)
+}
+
+// getBaseImage returns the base image with the ID of m, or the base image
+// matching the properties of m when its ID is not known yet.
+func (r *cloudSecurityContainerBaseImageResource) getBaseImage(
+	ctx context.Context,
+	m *cloudSecurityContainerBaseImageResourceModel,
+) (*containerBaseImage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := &container_images.CombinedBaseImagesParams{
+		Context: ctx,
+	}
+	if filter := m.filter(); filter != "" {
+		params.Filter = &filter
+	}
+
+	res, err := r.client.ContainerImages.CombinedBaseImages(params)
+	if diag := tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, containerBaseImageRequiredScopes); diag != nil {
+		diags.Append(diag)
+		return nil, diags
+	}
+
+	if res == nil || res.Payload == nil {
+		diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+		return nil, diags
+	}
+
+	if err = falcon.AssertNoError(res.Payload.Errors); err != nil {
+		diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+		return nil, diags
+	}
+
+	images, err := decodeContainerBaseImages(res.Payload.Resources)
+	if err != nil {
+		diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+		return nil, diags
+	}
+
+	for _, image := range images {
+		if utils.IsKnown(m.ID) && image.ID == m.ID.ValueString() {
+			return &image, diags
+		}
+		if !utils.IsKnown(m.ID) && m.matches(image) {
+			return &image, diags
+		}
+	}
+
+	diags.Append(tferrors.NewNotFoundError(fmt.Sprintf("Base image %s not found.", m.ID.ValueString())))
+	return nil, diags
+}
+
+// decodeContainerBaseImages decodes the untyped resources of a base images response.
+func decodeContainerBaseImages(resources models.CoreEntitiesResponseResources) ([]containerBaseImage, error) {
+	if resources == nil {
+		return nil, nil
+	}
+
+	body, err := json.Marshal(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	var images []containerBaseImage
+	if err := json.Unmarshal(body, &images); err != nil {
+		return nil, fmt.Errorf("unexpected base images in response: %w", err)
+	}
+
+	return images, nil
+}
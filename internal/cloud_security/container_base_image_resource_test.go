@@ -0,0 +1,73 @@
+package cloudsecurity_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCloudSecurityContainerBaseImageResource_Basic(t *testing.T) {
+	resourceName := "crowdstrike_cloud_security_container_base_image.test"
+	repository := fmt.Sprintf("tf-acc-test/base-%s", sdkacctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testContainerBaseImageConfig(repository, "1.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "registry", "docker.io"),
+					resource.TestCheckResourceAttr(resourceName, "repository", repository),
+					resource.TestCheckResourceAttr(resourceName, "tag", "1.0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "id",
+			},
+			{
+				Config: testContainerBaseImageConfig(repository, "2.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tag", "2.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudSecurityContainerBaseImageResource_Validation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+resource "crowdstrike_cloud_security_container_base_image" "test" {
+  registry = "docker.io"
+  tag      = "latest"
+}
+`,
+				ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+			},
+		},
+	})
+}
+
+func testContainerBaseImageConfig(repository, tag string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_cloud_security_container_base_image" "test" {
+  registry   = "docker.io"
+  repository = %[1]q
+  tag        = %[2]q
+}
+`, repository, tag)
+}
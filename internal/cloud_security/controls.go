@@ -1,10 +1,17 @@
 package cloudsecurity
 
 import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// customControlAuthority is the authority of the controls of custom compliance
+// frameworks. Rules are assigned to these controls by the
+// crowdstrike_cloud_compliance_custom_framework resource.
+const customControlAuthority = "Custom"
+
 type policyControl struct {
 	Authority types.String `tfsdk:"authority"`
 	Code      types.String `tfsdk:"code"`
@@ -16,3 +23,9 @@ func (p policyControl) AttributeTypes() map[string]attr.Type {
 		"code":      types.StringType,
 	}
 }
+
+// isCustomFrameworkControl reports whether a control of a rule belongs to a
+// custom compliance framework.
+func isCustomFrameworkControl(authority *string) bool {
+	return authority != nil && strings.EqualFold(*authority, customControlAuthority)
+}
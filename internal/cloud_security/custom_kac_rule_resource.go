@@ -14,6 +14,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -32,6 +33,7 @@ var (
 	_ resource.Resource                = &cloudSecurityKacCustomRuleResource{}
 	_ resource.ResourceWithConfigure   = &cloudSecurityKacCustomRuleResource{}
 	_ resource.ResourceWithImportState = &cloudSecurityKacCustomRuleResource{}
+	_ resource.ResourceWithIdentity    = &cloudSecurityKacCustomRuleResource{}
 )
 
 const (
@@ -57,14 +59,15 @@ type cloudSecurityKacCustomRuleResource struct {
 }
 
 type cloudSecurityKacCustomRuleResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Description     types.String `tfsdk:"description"`
-	Logic           types.String `tfsdk:"logic"`
-	Name            types.String `tfsdk:"name"`
-	Severity        types.String `tfsdk:"severity"`
-	RemediationInfo types.List   `tfsdk:"remediation_info"`
-	AttackTypes     types.Set    `tfsdk:"attack_types"`
-	AlertInfo       types.List   `tfsdk:"alert_info"`
+	ID              types.String   `tfsdk:"id"`
+	Description     types.String   `tfsdk:"description"`
+	Logic           types.String   `tfsdk:"logic"`
+	Name            types.String   `tfsdk:"name"`
+	Severity        types.String   `tfsdk:"severity"`
+	RemediationInfo types.List     `tfsdk:"remediation_info"`
+	AttackTypes     types.Set      `tfsdk:"attack_types"`
+	AlertInfo       types.List     `tfsdk:"alert_info"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *cloudSecurityKacCustomRuleResource) Configure(
@@ -91,6 +94,7 @@ func (r *cloudSecurityKacCustomRuleResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(kacRuleRequiredScopes)...)
 }
 
 func (r *cloudSecurityKacCustomRuleResource) Metadata(
@@ -102,7 +106,7 @@ func (r *cloudSecurityKacCustomRuleResource) Metadata(
 }
 
 func (r *cloudSecurityKacCustomRuleResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -181,9 +185,21 @@ func (r *cloudSecurityKacCustomRuleResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *cloudSecurityKacCustomRuleResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 func (r *cloudSecurityKacCustomRuleResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -195,6 +211,9 @@ func (r *cloudSecurityKacCustomRuleResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	rule, diags := r.createCloudPolicyRule(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if rule != nil && rule.UUID != nil {
@@ -206,6 +225,7 @@ func (r *cloudSecurityKacCustomRuleResource) Create(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, rule)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cloudSecurityKacCustomRuleResource) Read(
@@ -219,6 +239,9 @@ func (r *cloudSecurityKacCustomRuleResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	rule, diags := r.getCloudPolicyRule(ctx, state.ID.ValueString())
 
 	if diags.HasError() {
@@ -233,6 +256,7 @@ func (r *cloudSecurityKacCustomRuleResource) Read(
 
 	resp.Diagnostics.Append(state.wrap(ctx, rule)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *cloudSecurityKacCustomRuleResource) Update(
@@ -246,6 +270,9 @@ func (r *cloudSecurityKacCustomRuleResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	rule, diags := r.updateCloudPolicyRule(ctx, &plan)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -254,6 +281,7 @@ func (r *cloudSecurityKacCustomRuleResource) Update(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, rule)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cloudSecurityKacCustomRuleResource) Delete(
@@ -267,6 +295,9 @@ func (r *cloudSecurityKacCustomRuleResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	resp.Diagnostics.Append(r.deleteCloudPolicyRule(ctx, state.ID.ValueString())...)
 }
 
@@ -275,7 +306,7 @@ func (r *cloudSecurityKacCustomRuleResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (m *cloudSecurityKacCustomRuleResourceModel) wrap(
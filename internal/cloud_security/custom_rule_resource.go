@@ -15,6 +15,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -36,6 +37,7 @@ var (
 	_ resource.Resource                = &cloudSecurityCustomRuleResource{}
 	_ resource.ResourceWithConfigure   = &cloudSecurityCustomRuleResource{}
 	_ resource.ResourceWithImportState = &cloudSecurityCustomRuleResource{}
+	_ resource.ResourceWithIdentity    = &cloudSecurityCustomRuleResource{}
 	_ resource.ResourceWithModifyPlan  = &cloudSecurityCustomRuleResource{}
 )
 
@@ -44,7 +46,8 @@ var (
 	resourceMarkdownDescription string = "This resource manages custom cloud security rules. " +
 		"These rules can be created either by inheriting properties from a parent rule with minimal customization, or by fully customizing all attributes for maximum flexibility. " +
 		"To create a rule based on a parent rule, utilize the `crowdstrike_cloud_security_rules` data source to gather parent rule information to use in the new custom rule. " +
-		"The `crowdstrike_cloud_compliance_framework_controls` data source can be used to query Falcon for compliance benchmark controls to associate with custom rules created with this resource. "
+		"The `crowdstrike_cloud_compliance_framework_controls` data source can be used to query Falcon for compliance benchmark controls to associate with custom rules created with this resource. " +
+		"To add a custom rule to a control of a custom compliance framework, reference its `id` in the `rules` of the control in the `crowdstrike_cloud_compliance_custom_framework` resource. Those assignments are not part of `controls`. "
 	requiredScopes []scopes.Scope = cloudSecurityRuleScopes
 )
 
@@ -57,21 +60,22 @@ type cloudSecurityCustomRuleResource struct {
 }
 
 type cloudSecurityCustomRuleResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	AlertInfo       types.List   `tfsdk:"alert_info"`
-	Controls        types.Set    `tfsdk:"controls"`
-	Description     types.String `tfsdk:"description"`
-	Domain          types.String `tfsdk:"domain"`
-	Logic           types.String `tfsdk:"logic"`
-	Name            types.String `tfsdk:"name"`
-	AttackTypes     types.Set    `tfsdk:"attack_types"`
-	ParentRuleId    types.String `tfsdk:"parent_rule_id"`
-	CloudPlatform   types.String `tfsdk:"cloud_platform"`
-	CloudProvider   types.String `tfsdk:"cloud_provider"`
-	RemediationInfo types.List   `tfsdk:"remediation_info"`
-	ResourceType    types.String `tfsdk:"resource_type"`
-	Severity        types.String `tfsdk:"severity"`
-	Subdomain       types.String `tfsdk:"subdomain"`
+	ID              types.String   `tfsdk:"id"`
+	AlertInfo       types.List     `tfsdk:"alert_info"`
+	Controls        types.Set      `tfsdk:"controls"`
+	Description     types.String   `tfsdk:"description"`
+	Domain          types.String   `tfsdk:"domain"`
+	Logic           types.String   `tfsdk:"logic"`
+	Name            types.String   `tfsdk:"name"`
+	AttackTypes     types.Set      `tfsdk:"attack_types"`
+	ParentRuleId    types.String   `tfsdk:"parent_rule_id"`
+	CloudPlatform   types.String   `tfsdk:"cloud_platform"`
+	CloudProvider   types.String   `tfsdk:"cloud_provider"`
+	RemediationInfo types.List     `tfsdk:"remediation_info"`
+	ResourceType    types.String   `tfsdk:"resource_type"`
+	Severity        types.String   `tfsdk:"severity"`
+	Subdomain       types.String   `tfsdk:"subdomain"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *cloudSecurityCustomRuleResource) Configure(
@@ -98,6 +102,7 @@ func (r *cloudSecurityCustomRuleResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(requiredScopes)...)
 }
 
 func (r *cloudSecurityCustomRuleResource) Metadata(
@@ -109,7 +114,7 @@ func (r *cloudSecurityCustomRuleResource) Metadata(
 }
 
 func (r *cloudSecurityCustomRuleResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -145,13 +150,16 @@ func (r *cloudSecurityCustomRuleResource) Schema(
 			"controls": schema.SetNestedAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Security framework and compliance rule information. Utilize the `crowdstrike_cloud_compliance_framework_controls` data source to obtain this information. When `controls` is not defined and `parent_rule_id` is defined, this field will inherit the parent rule's `controls`.",
+				MarkdownDescription: "Security framework and compliance rule information. Utilize the `crowdstrike_cloud_compliance_framework_controls` data source to obtain this information. When `controls` is not defined and `parent_rule_id` is defined, this field will inherit the parent rule's `controls`. The controls of custom compliance frameworks are managed by the `rules` of the `crowdstrike_cloud_compliance_custom_framework` resource and cannot be set here.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"authority": schema.StringAttribute{
 							Optional:    true,
 							Computed:    true,
 							Description: "The compliance framework",
+							Validators: []validator.String{
+								stringvalidator.NoneOfCaseInsensitive(customControlAuthority),
+							},
 						},
 						"code": schema.StringAttribute{
 							Optional:    true,
@@ -261,9 +269,21 @@ func (r *cloudSecurityCustomRuleResource) Schema(
 				Default:     stringdefault.StaticString("IOM"),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *cloudSecurityCustomRuleResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 func (r *cloudSecurityCustomRuleResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -275,6 +295,9 @@ func (r *cloudSecurityCustomRuleResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	plan.CloudPlatform = plan.CloudProvider
 
 	rule, diags := r.createCloudPolicyRule(ctx, &plan)
@@ -288,6 +311,7 @@ func (r *cloudSecurityCustomRuleResource) Create(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, rule)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cloudSecurityCustomRuleResource) Read(
@@ -301,6 +325,9 @@ func (r *cloudSecurityCustomRuleResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	rule, diags := r.getCloudPolicyRule(ctx, state.ID.ValueString())
 	if diags.HasError() {
 		if tferrors.HasNotFoundError(diags) {
@@ -320,6 +347,7 @@ func (r *cloudSecurityCustomRuleResource) Read(
 
 	resp.Diagnostics.Append(state.wrap(ctx, rule)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *cloudSecurityCustomRuleResource) Update(
@@ -333,6 +361,9 @@ func (r *cloudSecurityCustomRuleResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	plan.CloudPlatform = plan.CloudProvider
 
 	rule, diags := r.updateCloudPolicyRule(ctx, &plan)
@@ -343,6 +374,7 @@ func (r *cloudSecurityCustomRuleResource) Update(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, rule)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cloudSecurityCustomRuleResource) Delete(
@@ -356,6 +388,9 @@ func (r *cloudSecurityCustomRuleResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	resp.Diagnostics.Append(r.deleteCloudPolicyRule(ctx, state.ID.ValueString())...)
 }
 
@@ -364,7 +399,7 @@ func (r *cloudSecurityCustomRuleResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r cloudSecurityCustomRuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -503,6 +538,11 @@ func (m *cloudSecurityCustomRuleResourceModel) wrap(
 	if len(rule.Controls) > 0 {
 		var policyControls []policyControl
 		for _, control := range rule.Controls {
+			// The controls of custom frameworks are managed by the framework
+			// the rule is assigned to, not by the rule.
+			if isCustomFrameworkControl(control.Authority) {
+				continue
+			}
 			policyControls = append(policyControls, policyControl{
 				Authority: types.StringPointerValue(control.Authority),
 				Code:      types.StringPointerValue(control.Code),
@@ -828,7 +868,23 @@ func (r *cloudSecurityCustomRuleResource) updateCloudPolicyRule(ctx context.Cont
 		body.AttackTypes = attackTypes
 	}
 
+	// Keep the assignments of the rule to the controls of custom frameworks,
+	// which the update would otherwise remove.
+	current, diags := r.getCloudPolicyRule(ctx, plan.ID.ValueString())
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	body.Controls = []*models.ApimodelsControlReference{}
+	for _, control := range current.Controls {
+		if isCustomFrameworkControl(control.Authority) {
+			body.Controls = append(body.Controls, &models.ApimodelsControlReference{
+				Authority: control.Authority,
+				Code:      control.Code,
+			})
+		}
+	}
+
 	if len(plan.Controls.Elements()) > 0 {
 		var controls []policyControl
 		diags = plan.Controls.ElementsAs(ctx, &controls, false)
@@ -0,0 +1,396 @@
+package cloudsecurity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon"
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/image_assessment_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &cloudSecurityImageAssessmentExclusionsResource{}
+	_ resource.ResourceWithConfigure = &cloudSecurityImageAssessmentExclusionsResource{}
+)
+
+var (
+	imageAssessmentExclusionsDocumentationSection        string         = "Falcon Cloud Security"
+	imageAssessmentExclusionsResourceMarkdownDescription string         = "This resource manages the image assessment exclusions of the CID. Image assessment policies ignore the vulnerabilities and images matching an exclusion condition, until the time to live of the condition expires.\n\n~> **Note:** The exclusions are a single setting of the CID. This resource replaces all existing exclusion conditions, and only one instance of it should be declared. Destroying the resource removes all exclusion conditions."
+	imageAssessmentExclusionsRequiredScopes              []scopes.Scope = cloudSecurityKacPolicyScopes
+)
+
+func NewCloudSecurityImageAssessmentExclusionsResource() resource.Resource {
+	return &cloudSecurityImageAssessmentExclusionsResource{}
+}
+
+type cloudSecurityImageAssessmentExclusionsResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type cloudSecurityImageAssessmentExclusionsResourceModel struct {
+	Conditions  types.Set      `tfsdk:"conditions"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+type imageAssessmentExclusionConditionModel struct {
+	Property    types.String `tfsdk:"property"`
+	Values      types.Set    `tfsdk:"values"`
+	Description types.String `tfsdk:"description"`
+	TTL         types.Int64  `tfsdk:"ttl"`
+}
+
+func (m imageAssessmentExclusionConditionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"property":    types.StringType,
+		"values":      types.SetType{ElemType: types.StringType},
+		"description": types.StringType,
+		"ttl":         types.Int64Type,
+	}
+}
+
+func (m *cloudSecurityImageAssessmentExclusionsResourceModel) wrap(
+	ctx context.Context,
+	conditions []*models.ModelsExclusionCondition,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conditionModels := make([]imageAssessmentExclusionConditionModel, 0, len(conditions))
+	for _, condition := range conditions {
+		if condition == nil {
+			continue
+		}
+
+		values, valueDiags := flex.FlattenStringValueSet(ctx, condition.Value)
+		diags.Append(valueDiags...)
+		if values.IsNull() {
+			values = types.SetValueMust(types.StringType, []attr.Value{})
+		}
+
+		description := types.StringValue("")
+		if condition.Description != nil {
+			description = types.StringValue(*condition.Description)
+		}
+
+		conditionModels = append(conditionModels, imageAssessmentExclusionConditionModel{
+			Property:    types.StringPointerValue(condition.Prop),
+			Values:      values,
+			Description: description,
+			TTL:         types.Int64Value(int64(condition.TTL)),
+		})
+	}
+
+	conditionSet, setDiags := types.SetValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: imageAssessmentExclusionConditionModel{}.AttributeTypes()},
+		conditionModels,
+	)
+	diags.Append(setDiags...)
+	m.Conditions = conditionSet
+
+	return diags
+}
+
+func (r *cloudSecurityImageAssessmentExclusionsResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(imageAssessmentExclusionsRequiredScopes)...)
+}
+
+func (r *cloudSecurityImageAssessmentExclusionsResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_security_image_assessment_exclusions"
+}
+
+func (r *cloudSecurityImageAssessmentExclusionsResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			imageAssessmentExclusionsDocumentationSection,
+			imageAssessmentExclusionsResourceMarkdownDescription,
+			imageAssessmentExclusionsRequiredScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"conditions": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "The exclusion conditions. A vulnerability or image is excluded when one of its properties matches any value of a condition.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"property": schema.StringAttribute{
+							Required:    true,
+							Description: "The property matched by the condition, such as a CVE ID, package, image digest or repository property, as named by the image assessment API.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"values": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "The values of the property that are excluded.",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+							Description: "The justification of the exclusion.",
+						},
+						"ttl": schema.Int64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(0),
+							Description: "The time to live of the condition, after which the exclusion expires. When 0, the exclusion does not expire.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the resource.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *cloudSecurityImageAssessmentExclusionsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan cloudSecurityImageAssessmentExclusionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	conditions, diags := r.updateExclusions(ctx, tferrors.Create, plan.expand(ctx, &resp.Diagnostics))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(plan.wrap(ctx, conditions)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *cloudSecurityImageAssessmentExclusionsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state cloudSecurityImageAssessmentExclusionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	conditions, diags := r.getExclusions(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(state.wrap(ctx, conditions)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *cloudSecurityImageAssessmentExclusionsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan cloudSecurityImageAssessmentExclusionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	conditions, diags := r.updateExclusions(ctx, tferrors.Update, plan.expand(ctx, &resp.Diagnostics))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(plan.wrap(ctx, conditions)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *cloudSecurityImageAssessmentExclusionsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state cloudSecurityImageAssessmentExclusionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	_, diags := r.updateExclusions(ctx, tferrors.Delete, []*models.ModelsExclusionConditionRequest{})
+	resp.Diagnostics.Append(diags...)
+}
+
+// expand returns the exclusion conditions of the model as API requests.
+func (m *cloudSecurityImageAssessmentExclusionsResourceModel) expand(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+) []*models.ModelsExclusionConditionRequest {
+	conditionModels := flex.ExpandSetAs[imageAssessmentExclusionConditionModel](ctx, m.Conditions, diags)
+
+	conditions := make([]*models.ModelsExclusionConditionRequest, 0, len(conditionModels))
+	for _, condition := range conditionModels {
+		conditions = append(conditions, &models.ModelsExclusionConditionRequest{
+			Prop:        condition.Property.ValueStringPointer(),
+			Value:       flex.ExpandSetAs[string](ctx, condition.Values, diags),
+			Description: condition.Description.ValueString(),
+			TTL:         float64(condition.TTL.ValueInt64()),
+		})
+	}
+
+	return conditions
+}
+
+func (r *cloudSecurityImageAssessmentExclusionsResource) getExclusions(
+	ctx context.Context,
+) ([]*models.ModelsExclusionCondition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := r.client.ImageAssessmentPolicies.ReadPolicyExclusions(
+		&image_assessment_policies.ReadPolicyExclusionsParams{
+			Context: ctx,
+		},
+	)
+	if diag := tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, imageAssessmentExclusionsRequiredScopes); diag != nil {
+		diags.Append(diag)
+		return nil, diags
+	}
+
+	if res == nil || res.Payload == nil {
+		diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+		return nil, diags
+	}
+
+	if err = falcon.AssertNoError(res.Payload.Errors); err != nil {
+		diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+		return nil, diags
+	}
+
+	return exclusionConditions(res.Payload.Resources), diags
+}
+
+func (r *cloudSecurityImageAssessmentExclusionsResource) updateExclusions(
+	ctx context.Context,
+	operation tferrors.Operation,
+	conditions []*models.ModelsExclusionConditionRequest,
+) ([]*models.ModelsExclusionCondition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := r.client.ImageAssessmentPolicies.UpdatePolicyExclusions(
+		&image_assessment_policies.UpdatePolicyExclusionsParams{
+			Context: ctx,
+			Body: &models.ModelsUpdateExclusionsRequest{
+				Conditions: conditions,
+			},
+		},
+	)
+	if diag := tferrors.NewDiagnosticFromAPIError(operation, err, imageAssessmentExclusionsRequiredScopes); diag != nil {
+		diags.Append(diag)
+		return nil, diags
+	}
+
+	if res == nil || res.Payload == nil {
+		diags.Append(tferrors.NewEmptyResponseError(operation))
+		return nil, diags
+	}
+
+	if err = falcon.AssertNoError(res.Payload.Errors); err != nil {
+		diags.Append(tferrors.NewOperationError(operation, err))
+		return nil, diags
+	}
+
+	return exclusionConditions(res.Payload.Resources), diags
+}
+
+// exclusionConditions returns the conditions of the exclusions of the CID.
+func exclusionConditions(exclusions []*models.ModelsAPIPolicyExclusion) []*models.ModelsExclusionCondition {
+	var conditions []*models.ModelsExclusionCondition
+	for _, exclusion := range exclusions {
+		if exclusion != nil {
+			conditions = append(conditions, exclusion.Conditions...)
+		}
+	}
+
+	return conditions
+}
@@ -0,0 +1,64 @@
+package cloudsecurity_test
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCloudSecurityImageAssessmentExclusionsResource(t *testing.T) {
+	resourceName := "crowdstrike_cloud_security_image_assessment_exclusions.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+resource "crowdstrike_cloud_security_image_assessment_exclusions" "test" {
+  conditions = [
+    {
+      property    = "cve_id"
+      values      = ["CVE-2023-44487"]
+      description = "terraform acceptance test"
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "conditions.*", map[string]string{
+						"property":    "cve_id",
+						"values.#":    "1",
+						"description": "terraform acceptance test",
+						"ttl":         "0",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + `
+resource "crowdstrike_cloud_security_image_assessment_exclusions" "test" {
+  conditions = [
+    {
+      property = "cve_id"
+      values   = ["CVE-2023-44487", "CVE-2024-3094"]
+      ttl      = 30
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "conditions.*", map[string]string{
+						"property":    "cve_id",
+						"values.#":    "2",
+						"description": "",
+						"ttl":         "30",
+					}),
+				),
+			},
+		},
+	})
+}
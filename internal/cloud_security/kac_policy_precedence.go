@@ -13,6 +13,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -42,8 +43,9 @@ type cloudSecurityKacPolicyPrecedenceResource struct {
 }
 
 type cloudSecurityKacPolicyPrecedenceResourceModel struct {
-	PolicyIds   types.List   `tfsdk:"ids"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	PolicyIds   types.List     `tfsdk:"ids"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *cloudSecurityKacPolicyPrecedenceResourceModel) wrap(
@@ -85,6 +87,7 @@ func (r *cloudSecurityKacPolicyPrecedenceResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(kacPolicyPrecedenceRequiredScopes)...)
 }
 
 func (r *cloudSecurityKacPolicyPrecedenceResource) Metadata(
@@ -96,7 +99,7 @@ func (r *cloudSecurityKacPolicyPrecedenceResource) Metadata(
 }
 
 func (r *cloudSecurityKacPolicyPrecedenceResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -121,6 +124,9 @@ func (r *cloudSecurityKacPolicyPrecedenceResource) Schema(
 				Description: "Timestamp of the last Terraform update of the resource.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -135,6 +141,9 @@ func (r *cloudSecurityKacPolicyPrecedenceResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	planPolicyIds := flex.ExpandListAs[string](ctx, plan.PolicyIds, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -164,6 +173,9 @@ func (r *cloudSecurityKacPolicyPrecedenceResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policyIds, diags := r.getKACPoliciesByPrecedence(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -187,6 +199,9 @@ func (r *cloudSecurityKacPolicyPrecedenceResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	planPolicyIds := flex.ExpandListAs[string](ctx, plan.PolicyIds, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
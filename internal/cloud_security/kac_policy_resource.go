@@ -15,6 +15,7 @@ import (
 	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -39,6 +40,7 @@ var (
 	_ resource.Resource                   = &cloudSecurityKacPolicyResource{}
 	_ resource.ResourceWithConfigure      = &cloudSecurityKacPolicyResource{}
 	_ resource.ResourceWithImportState    = &cloudSecurityKacPolicyResource{}
+	_ resource.ResourceWithIdentity       = &cloudSecurityKacPolicyResource{}
 	_ resource.ResourceWithValidateConfig = &cloudSecurityKacPolicyResource{}
 	_ resource.ResourceWithModifyPlan     = &cloudSecurityKacPolicyResource{}
 )
@@ -64,14 +66,15 @@ type cloudSecurityKacPolicyResource struct {
 }
 
 type cloudSecurityKacPolicyResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	Enabled          types.Bool   `tfsdk:"enabled"`
-	HostGroups       types.Set    `tfsdk:"host_groups"`
-	RuleGroups       types.List   `tfsdk:"rule_groups"`
-	DefaultRuleGroup types.Object `tfsdk:"default_rule_group"`
-	LastUpdated      types.String `tfsdk:"last_updated"`
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Enabled          types.Bool     `tfsdk:"enabled"`
+	HostGroups       types.Set      `tfsdk:"host_groups"`
+	RuleGroups       types.List     `tfsdk:"rule_groups"`
+	DefaultRuleGroup types.Object   `tfsdk:"default_rule_group"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ruleGroupTFModel struct {
@@ -175,6 +178,7 @@ func (r *cloudSecurityKacPolicyResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(kacPolicyRequiredScopes)...)
 }
 
 func (r *cloudSecurityKacPolicyResource) Metadata(
@@ -186,7 +190,7 @@ func (r *cloudSecurityKacPolicyResource) Metadata(
 }
 
 func (r *cloudSecurityKacPolicyResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -489,9 +493,21 @@ func (r *cloudSecurityKacPolicyResource) Schema(
 				Description: "Timestamp of the last Terraform update of the resource.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *cloudSecurityKacPolicyResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 func (r *cloudSecurityKacPolicyResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -513,6 +529,9 @@ func (r *cloudSecurityKacPolicyResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	createRequest := &models.ModelsCreatePolicyRequest{
 		Name:        plan.Name.ValueStringPointer(),
 		Description: plan.Description.ValueString(),
@@ -590,6 +609,7 @@ func (r *cloudSecurityKacPolicyResource) Create(
 	plan.LastUpdated = utils.GenerateUpdateTimestamp()
 	resp.Diagnostics.Append(plan.wrap(ctx, policy)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cloudSecurityKacPolicyResource) Read(
@@ -603,6 +623,9 @@ func (r *cloudSecurityKacPolicyResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	params := admission_control_policies.NewAdmissionControlGetPoliciesParamsWithContext(ctx).
 		WithIds([]string{state.ID.ValueString()})
 
@@ -633,6 +656,7 @@ func (r *cloudSecurityKacPolicyResource) Read(
 	policy := getResponse.Payload.Resources[0]
 	resp.Diagnostics.Append(state.wrap(ctx, policy)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *cloudSecurityKacPolicyResource) Update(
@@ -647,6 +671,9 @@ func (r *cloudSecurityKacPolicyResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var state cloudSecurityKacPolicyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -716,6 +743,7 @@ func (r *cloudSecurityKacPolicyResource) Update(
 	plan.LastUpdated = utils.GenerateUpdateTimestamp()
 	resp.Diagnostics.Append(plan.wrap(ctx, policy)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cloudSecurityKacPolicyResource) Delete(
@@ -739,6 +767,9 @@ func (r *cloudSecurityKacPolicyResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	// Disable policy if it's enabled before deletion
 	if state.Enabled.ValueBool() {
 		disableBool := false
@@ -778,7 +809,7 @@ func (r *cloudSecurityKacPolicyResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *cloudSecurityKacPolicyResource) ValidateConfig(
@@ -0,0 +1,393 @@
+package cloudsecurity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon"
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/kubernetes_protection"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// kubernetesClustersQueryLimit is the largest page size used when querying Kubernetes clusters.
+const kubernetesClustersQueryLimit = 200
+
+var kubernetesClustersScopes = []scopes.Scope{
+	{
+		Name:  "Kubernetes Protection",
+		Read:  true,
+		Write: false,
+	},
+}
+
+type kubernetesClusterModel struct {
+	ClusterID         types.String `tfsdk:"cluster_id"`
+	ClusterName       types.String `tfsdk:"cluster_name"`
+	ClusterStatus     types.String `tfsdk:"cluster_status"`
+	CloudName         types.String `tfsdk:"cloud_name"`
+	CloudAccountID    types.String `tfsdk:"cloud_account_id"`
+	CloudRegion       types.String `tfsdk:"cloud_region"`
+	CloudService      types.String `tfsdk:"cloud_service"`
+	KubernetesVersion types.String `tfsdk:"kubernetes_version"`
+	AgentStatus       types.String `tfsdk:"agent_status"`
+	ManagementStatus  types.String `tfsdk:"management_status"`
+	Agents            types.List   `tfsdk:"agents"`
+	KacAgentID        types.String `tfsdk:"kac_agent_id"`
+	KacAgentActive    types.Bool   `tfsdk:"kac_agent_active"`
+	IarCoverage       types.Bool   `tfsdk:"iar_coverage"`
+	NodeCount         types.Int32  `tfsdk:"node_count"`
+	PodCount          types.Int32  `tfsdk:"pod_count"`
+	ContainerCount    types.Int32  `tfsdk:"container_count"`
+	Tags              types.Map    `tfsdk:"tags"`
+	FirstSeen         types.String `tfsdk:"first_seen"`
+	LastSeen          types.String `tfsdk:"last_seen"`
+}
+
+func (m kubernetesClusterModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"cluster_id":         types.StringType,
+		"cluster_name":       types.StringType,
+		"cluster_status":     types.StringType,
+		"cloud_name":         types.StringType,
+		"cloud_account_id":   types.StringType,
+		"cloud_region":       types.StringType,
+		"cloud_service":      types.StringType,
+		"kubernetes_version": types.StringType,
+		"agent_status":       types.StringType,
+		"management_status":  types.StringType,
+		"agents":             types.ListType{ElemType: types.MapType{ElemType: types.StringType}},
+		"kac_agent_id":       types.StringType,
+		"kac_agent_active":   types.BoolType,
+		"iar_coverage":       types.BoolType,
+		"node_count":         types.Int32Type,
+		"pod_count":          types.Int32Type,
+		"container_count":    types.Int32Type,
+		"tags":               types.MapType{ElemType: types.StringType},
+		"first_seen":         types.StringType,
+		"last_seen":          types.StringType,
+	}
+}
+
+var (
+	_ datasource.DataSource              = &kubernetesClustersDataSource{}
+	_ datasource.DataSourceWithConfigure = &kubernetesClustersDataSource{}
+)
+
+func NewKubernetesClustersDataSource() datasource.DataSource {
+	return &kubernetesClustersDataSource{}
+}
+
+type kubernetesClustersDataSource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type kubernetesClustersDataSourceModel struct {
+	Filter   types.String `tfsdk:"filter"`
+	Sort     types.String `tfsdk:"sort"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	MaxItems types.Int64  `tfsdk:"max_items"`
+	Clusters types.Set    `tfsdk:"clusters"`
+}
+
+func (r *kubernetesClustersDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(kubernetesClustersScopes)...)
+}
+
+func (r *kubernetesClustersDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_security_kubernetes_clusters"
+}
+
+func (r *kubernetesClustersDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Falcon Cloud Security",
+			"This data source retrieves the Kubernetes clusters known to Falcon Cloud Security, with the status of their protection and the Falcon agents deployed on them. For advanced queries, use Falcon Query Language (FQL) filters.",
+			kubernetesClustersScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "FQL filter string. Supported fields: `access`, `agent_id`, `agent_status`, `agent_type`, `cid`, `cloud_account_id`, `cloud_name`, `cloud_region`, `cloud_service`, `cluster_id`, `cluster_name`, `cluster_status`, `container_count`, `iar_coverage`, `kac_agent_id`, `kubernetes_version`, `last_seen`, `management_status`, `namespace`, `node_count`, `pod_count`, `pod_name`, `tags`. Example: `cloud_name:'aws'`",
+				Validators: []validator.String{
+					validators.StringNotWhitespace(),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The field to sort on. Use `.asc` or `.desc` suffix to specify sort direction. Example: `cluster_name.asc`",
+				Validators: []validator.String{
+					validators.StringNotWhitespace(),
+				},
+			},
+			"limit":     utils.PageLimitAttribute("clusters", kubernetesClustersQueryLimit, kubernetesClustersQueryLimit),
+			"max_items": utils.MaxItemsAttribute("clusters"),
+			"clusters": schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "The Kubernetes clusters matching the filter criteria, up to `max_items`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cluster_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the cluster.",
+						},
+						"cluster_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the cluster.",
+						},
+						"cluster_status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the cluster.",
+						},
+						"cloud_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud provider hosting the cluster.",
+						},
+						"cloud_account_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud account ID hosting the cluster.",
+						},
+						"cloud_region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud region of the cluster.",
+						},
+						"cloud_service": schema.StringAttribute{
+							Computed:    true,
+							Description: "Managed Kubernetes service of the cluster (e.g., 'EKS', 'AKS', 'GKE').",
+						},
+						"kubernetes_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Kubernetes version of the cluster.",
+						},
+						"agent_status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the Falcon agents of the cluster.",
+						},
+						"management_status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Whether the cluster is managed by Falcon.",
+						},
+						"agents": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.MapType{ElemType: types.StringType},
+							Description: "The Falcon agents deployed on the cluster, with their properties, such as the agent ID, type and version.",
+						},
+						"kac_agent_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Agent ID of the Kubernetes Admission Controller (KAC) of the cluster.",
+						},
+						"kac_agent_active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the Kubernetes Admission Controller (KAC) of the cluster is active.",
+						},
+						"iar_coverage": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the images running on the cluster are assessed (Image Assessment at Runtime).",
+						},
+						"node_count": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of nodes of the cluster.",
+						},
+						"pod_count": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of pods of the cluster.",
+						},
+						"container_count": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of containers of the cluster.",
+						},
+						"tags": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Tags of the cluster.",
+						},
+						"first_seen": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the cluster was first seen.",
+						},
+						"last_seen": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the cluster was last seen.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *kubernetesClustersDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data kubernetesClustersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusters, total, diags := r.getClusters(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("clusters", len(clusters.Elements()), total)...)
+
+	data.Clusters = clusters
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *kubernetesClustersDataSource) getClusters(
+	ctx context.Context,
+	config *kubernetesClustersDataSourceModel,
+) (types.Set, int64, diag.Diagnostics) {
+	clustersType := types.ObjectType{AttrTypes: kubernetesClusterModel{}.AttributeTypes()}
+	pagination := utils.NewPagination(config.Limit, config.MaxItems, kubernetesClustersQueryLimit)
+
+	clusters, total, diags := utils.QueryPages(pagination, func(offset, limit int64) ([]*models.ModelsCluster, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		params := kubernetes_protection.NewClusterCombinedParams().WithContext(ctx)
+		params.SetLimit(&limit)
+		params.SetOffset(&offset)
+
+		if !config.Filter.IsNull() {
+			filter := config.Filter.ValueString()
+			params.SetFilter(&filter)
+		}
+
+		if !config.Sort.IsNull() {
+			sort := config.Sort.ValueString()
+			params.SetSort(&sort)
+		}
+
+		tflog.Debug(ctx, "Fetching Kubernetes clusters page", map[string]interface{}{
+			"offset": offset,
+			"limit":  limit,
+			"filter": config.Filter.ValueString(),
+		})
+
+		response, err := r.client.KubernetesProtection.ClusterCombined(params)
+		if diag := tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, kubernetesClustersScopes); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
+		}
+
+		if response == nil || response.Payload == nil {
+			return nil, 0, diags
+		}
+
+		payload := response.GetPayload()
+
+		if err = falcon.AssertNoError(payload.Errors); err != nil {
+			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+			return nil, 0, diags
+		}
+
+		return payload.Resources, utils.ListTotal(payload.Meta), diags
+	})
+	if diags.HasError() {
+		return types.SetNull(clustersType), 0, diags
+	}
+
+	clusterModels := make([]kubernetesClusterModel, 0, len(clusters))
+	for _, cluster := range clusters {
+		if cluster == nil {
+			continue
+		}
+
+		agents, agentDiags := types.ListValueFrom(ctx, types.MapType{ElemType: types.StringType}, cluster.Agents)
+		diags.Append(agentDiags...)
+		if cluster.Agents == nil {
+			agents = types.ListValueMust(types.MapType{ElemType: types.StringType}, []attr.Value{})
+		}
+
+		tags, tagDiags := types.MapValueFrom(ctx, types.StringType, cluster.Tags)
+		diags.Append(tagDiags...)
+		if cluster.Tags == nil {
+			tags = types.MapValueMust(types.StringType, map[string]attr.Value{})
+		}
+
+		if diags.HasError() {
+			return types.SetNull(clustersType), 0, diags
+		}
+
+		clusterModels = append(clusterModels, kubernetesClusterModel{
+			ClusterID:         types.StringPointerValue(cluster.ClusterID),
+			ClusterName:       types.StringPointerValue(cluster.ClusterName),
+			ClusterStatus:     types.StringPointerValue(cluster.ClusterStatus),
+			CloudName:         types.StringPointerValue(cluster.CloudName),
+			CloudAccountID:    types.StringPointerValue(cluster.CloudAccountID),
+			CloudRegion:       types.StringPointerValue(cluster.CloudRegion),
+			CloudService:      types.StringPointerValue(cluster.CloudService),
+			KubernetesVersion: types.StringPointerValue(cluster.KubernetesVersion),
+			AgentStatus:       types.StringPointerValue(cluster.AgentStatus),
+			ManagementStatus:  types.StringPointerValue(cluster.ManagementStatus),
+			Agents:            agents,
+			KacAgentID:        types.StringPointerValue(cluster.KacAgentID),
+			KacAgentActive:    types.BoolPointerValue(cluster.KacAgentActive),
+			IarCoverage:       types.BoolPointerValue(cluster.IarCoverage),
+			NodeCount:         flex.Int32PointerToFramework(cluster.NodeCount),
+			PodCount:          flex.Int32PointerToFramework(cluster.PodCount),
+			ContainerCount:    flex.Int32PointerToFramework(cluster.ContainerCount),
+			Tags:              tags,
+			FirstSeen:         types.StringPointerValue(cluster.FirstSeen),
+			LastSeen:          types.StringPointerValue(cluster.LastSeen),
+		})
+	}
+
+	clusterSet, setDiags := types.SetValueFrom(ctx, clustersType, clusterModels)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return types.SetNull(clustersType), 0, diags
+	}
+
+	return clusterSet, total, diags
+}
@@ -0,0 +1,28 @@
+package cloudsecurity_test
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKubernetesClustersDataSource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_cloud_security_kubernetes_clusters" "test" {
+  sort      = "cluster_name.asc"
+  max_items = 10
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.crowdstrike_cloud_security_kubernetes_clusters.test", "clusters.#"),
+				),
+			},
+		},
+	})
+}
@@ -33,7 +33,8 @@ func NewCloudSecurityRulesDataSource() datasource.DataSource {
 }
 
 type cloudSecurityRulesDataSource struct {
-	client *client.CrowdStrikeAPISpecification
+	client    *client.CrowdStrikeAPISpecification
+	readCache *config.ReadCache
 }
 
 type cloudSecurityRulesDataSourceModel struct {
@@ -131,6 +132,8 @@ func (r *cloudSecurityRulesDataSource) Configure(
 	}
 
 	r.client = config.Client
+	r.readCache = config.ReadCache
+	resp.Diagnostics.Append(config.CheckScopes(cloudSecurityRuleScopes)...)
 }
 
 func (r *cloudSecurityRulesDataSource) Metadata(
@@ -361,11 +364,14 @@ func (r *cloudSecurityRulesDataSource) Read(
 		},
 	}
 
-	data.Rules, diags = r.getRules(
-		ctx,
-		data.FQL.ValueString(),
-		fqlFilters,
-	)
+	key := fmt.Sprintf("cloud_security_rules/%s/%v", data.FQL.ValueString(), fqlFilters)
+	data.Rules, diags = config.Cached(r.readCache, key, func() (types.Set, diag.Diagnostics) {
+		return r.getRules(
+			ctx,
+			data.FQL.ValueString(),
+			fqlFilters,
+		)
+	})
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
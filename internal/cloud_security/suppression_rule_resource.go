@@ -17,6 +17,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
@@ -47,6 +48,7 @@ var (
 	_ resource.Resource                = &cloudSecuritySuppressionRuleResource{}
 	_ resource.ResourceWithConfigure   = &cloudSecuritySuppressionRuleResource{}
 	_ resource.ResourceWithImportState = &cloudSecuritySuppressionRuleResource{}
+	_ resource.ResourceWithIdentity    = &cloudSecuritySuppressionRuleResource{}
 	_ resource.ResourceWithModifyPlan  = &cloudSecuritySuppressionRuleResource{}
 )
 
@@ -75,6 +77,7 @@ type cloudSecuritySuppressionRuleResourceModel struct {
 	Comment             types.String      `tfsdk:"comment"`
 	ExpirationDate      timetypes.RFC3339 `tfsdk:"expiration_date"`
 	Reason              types.String      `tfsdk:"reason"`
+	Timeouts            timeouts.Value    `tfsdk:"timeouts"`
 }
 
 type ruleSelectionFilterModel struct {
@@ -147,6 +150,7 @@ func (r *cloudSecuritySuppressionRuleResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(suppressionRuleResourceRequiredScopes)...)
 }
 
 func (r *cloudSecuritySuppressionRuleResource) Metadata(
@@ -162,11 +166,11 @@ func (r *cloudSecuritySuppressionRuleResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *cloudSecuritySuppressionRuleResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -437,9 +441,21 @@ func (r *cloudSecuritySuppressionRuleResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *cloudSecuritySuppressionRuleResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 func (r *cloudSecuritySuppressionRuleResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -451,6 +467,9 @@ func (r *cloudSecuritySuppressionRuleResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	rule, diags := r.createSuppressionRule(ctx, plan)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -459,6 +478,7 @@ func (r *cloudSecuritySuppressionRuleResource) Create(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, *rule)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cloudSecuritySuppressionRuleResource) Read(
@@ -472,6 +492,9 @@ func (r *cloudSecuritySuppressionRuleResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	rule, diags := r.getSuppressionRule(ctx, state.ID.ValueString())
 	if diags.HasError() {
 		if tferrors.HasNotFoundError(diags) {
@@ -500,6 +523,7 @@ func (r *cloudSecuritySuppressionRuleResource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *cloudSecuritySuppressionRuleResource) Update(
@@ -513,6 +537,9 @@ func (r *cloudSecuritySuppressionRuleResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -532,6 +559,7 @@ func (r *cloudSecuritySuppressionRuleResource) Update(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, *rule)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *cloudSecuritySuppressionRuleResource) Delete(
@@ -545,6 +573,9 @@ func (r *cloudSecuritySuppressionRuleResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	diags := r.deleteSuppressionRule(ctx, state.ID.ValueString())
 	if tferrors.HasNotFoundError(diags) {
 		return
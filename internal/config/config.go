@@ -1,10 +1,140 @@
 package config
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// DefaultWriteConcurrency is how many API calls resources make concurrently
+// when creating or updating many child objects and the provider does not
+// configure write_concurrency.
+const DefaultWriteConcurrency = 4
+
 type ProviderConfig struct {
 	ClientId string
 	Client   *client.CrowdStrikeAPISpecification
+	// AuditComment is appended to the comments that resources record in the
+	// CrowdStrike audit log for their changes.
+	AuditComment string
+	// ValidateReferences enables plan-time validation that the objects
+	// resources reference by ID exist.
+	ValidateReferences bool
+	// MemberClients creates the clients of resources managing the objects of
+	// a member CID set by their cid attribute. It is nil when the provider
+	// credentials cannot be exchanged for tokens of member CIDs.
+	MemberClients *MemberClients
+	// ReadCache caches lookups repeated by many data sources.
+	ReadCache *ReadCache
+	// WriteConcurrency is how many API calls resources make concurrently when
+	// creating or updating many child objects in a single apply.
+	WriteConcurrency int
+	// ScopeCheck verifies the API scopes of the API client when resources and
+	// data sources are configured. It is nil when verify_scopes is disabled.
+	ScopeCheck *ScopeCheck
+}
+
+// ClientForCID returns the client managing the objects of the member CID cid,
+// or the provider client when cid is null or empty.
+func (c ProviderConfig) ClientForCID(cid types.String) (*client.CrowdStrikeAPISpecification, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if cid.ValueString() == "" {
+		return c.Client, diags
+	}
+
+	if c.MemberClients == nil {
+		diags.AddAttributeError(
+			path.Root("cid"),
+			"Member CID Not Supported",
+			"Managing the objects of a member CID requires the provider to authenticate with client_id and client_secret, "+
+				"as the provider exchanges them for a token of the member CID. Remove cid, or configure the provider with client credentials.",
+		)
+		return nil, diags
+	}
+
+	memberClient, err := c.MemberClients.Client(cid.ValueString())
+	if err != nil {
+		diags.Append(memberCIDError(cid.ValueString(), err))
+		return nil, diags
+	}
+
+	return memberClient, diags
+}
+
+// memberCIDError returns the error of a client of the member CID cid that
+// could not be created.
+func memberCIDError(cid string, err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root("cid"),
+		"Unable to Authenticate to Member CID",
+		fmt.Sprintf(
+			"The provider credentials could not be exchanged for a token of the member CID %s. "+
+				"Ensure the CID is a member of the Flight Control parent CID of the API client: %s",
+			cid,
+			err,
+		),
+	)
+}
+
+// DeferPlanForCID defers the planned change of a resource with a cid attribute
+// while its member CID is unknown or not a member of the Flight Control parent
+// CID yet, such as a child CID created in the same apply, when Terraform
+// supports deferred changes. Other errors authenticating to the member CID are
+// added to the diagnostics. It reports whether the change was deferred or
+// failed, in which case the resource stops planning.
+func (c ProviderConfig) DeferPlanForCID(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) bool {
+	if !req.ClientCapabilities.DeferralAllowed || req.Plan.Raw.IsNull() {
+		return false
+	}
+
+	if utils.DeferPlanIfUnknown(ctx, req, resp, path.Root("cid")) {
+		return true
+	}
+
+	var cid types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cid"), &cid)...)
+	if resp.Diagnostics.HasError() || cid.ValueString() == "" || c.MemberClients == nil {
+		return false
+	}
+
+	_, err := c.MemberClients.Client(cid.ValueString())
+	if err == nil {
+		return false
+	}
+
+	diags := diag.Diagnostics{memberCIDError(cid.ValueString(), err)}
+	if errors.Is(err, ErrMemberCIDNotFound) {
+		return utils.DeferPlanIfAbsent(ctx, req, resp, diags)
+	}
+
+	resp.Diagnostics.Append(diags...)
+	return true
+}
+
+// CheckScopes returns an error listing the scopes of required that the API
+// client of the provider was not granted, when verify_scopes is enabled.
+func (c ProviderConfig) CheckScopes(required []scopes.Scope) diag.Diagnostics {
+	return c.ScopeCheck.Check(required)
+}
+
+// Comment returns the audit log comment for a change made by a resource,
+// annotated with the provider audit comment when one is configured.
+func (c ProviderConfig) Comment(comment string) string {
+	if c.AuditComment == "" {
+		return comment
+	}
+	return comment + " (" + c.AuditComment + ")"
 }
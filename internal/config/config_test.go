@@ -0,0 +1,78 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeferPlanForCID(t *testing.T) {
+	ctx := context.Background()
+	config := ProviderConfig{
+		Client: &client.CrowdStrikeAPISpecification{},
+		MemberClients: NewMemberClients(func(cid string) (*client.CrowdStrikeAPISpecification, error) {
+			switch cid {
+			case "new":
+				return nil, fmt.Errorf("%w: access denied", ErrMemberCIDNotFound)
+			case "revoked":
+				return nil, errors.New("access denied")
+			}
+			return &client.CrowdStrikeAPISpecification{}, nil
+		}),
+	}
+
+	tests := map[string]struct {
+		deferralAllowed bool
+		cid             any
+		want            bool
+		wantReason      resource.DeferredReason
+		wantError       bool
+	}{
+		"unknown":             {deferralAllowed: true, cid: tftypes.UnknownValue, want: true, wantReason: resource.DeferredReasonResourceConfigUnknown},
+		"not found":           {deferralAllowed: true, cid: "new", want: true, wantReason: resource.DeferredReasonAbsentPrereq},
+		"auth failure":        {deferralAllowed: true, cid: "revoked", want: true, wantError: true},
+		"member":              {deferralAllowed: true, cid: "member"},
+		"provider CID":        {deferralAllowed: true},
+		"deferral disallowed": {cid: "new"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"cid": schema.StringAttribute{Optional: true},
+				},
+			}
+			typ := s.Type().TerraformType(ctx)
+			req := resource.ModifyPlanRequest{
+				ClientCapabilities: resource.ModifyPlanClientCapabilities{DeferralAllowed: tt.deferralAllowed},
+				Plan: tfsdk.Plan{
+					Schema: s,
+					Raw: tftypes.NewValue(typ, map[string]tftypes.Value{
+						"cid": tftypes.NewValue(tftypes.String, tt.cid),
+					}),
+				},
+			}
+
+			resp := &resource.ModifyPlanResponse{}
+			assert.Equal(t, tt.want, config.DeferPlanForCID(ctx, req, resp))
+			assert.Equal(t, tt.wantError, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			if tt.wantReason != resource.DeferredReasonUnknown {
+				require.NotNil(t, resp.Deferred)
+				assert.Equal(t, tt.wantReason, resp.Deferred.Reason)
+			} else {
+				assert.Nil(t, resp.Deferred)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+)
+
+// ErrMemberCIDNotFound is wrapped by the errors of clients of member CIDs that
+// are not, or not yet, members of the Flight Control parent CID of the provider
+// credentials.
+var ErrMemberCIDNotFound = errors.New("member CID not found")
+
+// MemberClients creates API clients acting on behalf of the member CIDs of a
+// Flight Control parent CID by exchanging the provider credentials for tokens of
+// the member CIDs. Clients are created on first use and shared by every
+// resource managing objects of the same member CID.
+type MemberClients struct {
+	mu        sync.Mutex
+	clients   map[string]*client.CrowdStrikeAPISpecification
+	newClient func(cid string) (*client.CrowdStrikeAPISpecification, error)
+}
+
+// NewMemberClients returns MemberClients creating the client of a member CID
+// with newClient.
+func NewMemberClients(newClient func(cid string) (*client.CrowdStrikeAPISpecification, error)) *MemberClients {
+	return &MemberClients{
+		clients:   map[string]*client.CrowdStrikeAPISpecification{},
+		newClient: newClient,
+	}
+}
+
+// Client returns the client of the member CID cid, creating it on first use.
+func (m *MemberClients) Client(cid string) (*client.CrowdStrikeAPISpecification, error) {
+	key := strings.ToLower(cid)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.clients[key]; ok {
+		return c, nil
+	}
+
+	c, err := m.newClient(cid)
+	if err != nil {
+		return nil, err
+	}
+	m.clients[key] = c
+
+	return c, nil
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientForCID(t *testing.T) {
+	providerClient := &client.CrowdStrikeAPISpecification{}
+	created := map[string]int{}
+	config := ProviderConfig{
+		Client: providerClient,
+		MemberClients: NewMemberClients(func(cid string) (*client.CrowdStrikeAPISpecification, error) {
+			if cid == "invalid" {
+				return nil, errors.New("access denied")
+			}
+			created[cid]++
+			return &client.CrowdStrikeAPISpecification{}, nil
+		}),
+	}
+
+	c, diags := config.ClientForCID(types.StringNull())
+	require.False(t, diags.HasError(), diags)
+	assert.Same(t, providerClient, c)
+
+	member, diags := config.ClientForCID(types.StringValue("member"))
+	require.False(t, diags.HasError(), diags)
+	assert.NotSame(t, providerClient, member)
+
+	again, diags := config.ClientForCID(types.StringValue("MEMBER"))
+	require.False(t, diags.HasError(), diags)
+	assert.Same(t, member, again)
+	assert.Equal(t, map[string]int{"member": 1}, created)
+
+	_, diags = config.ClientForCID(types.StringValue("invalid"))
+	assert.True(t, diags.HasError())
+
+	_, diags = ProviderConfig{Client: providerClient}.ClientForCID(types.StringValue("member"))
+	assert.True(t, diags.HasError())
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DefaultReadCacheTTL is how long the results of cached lookups are reused
+// when the provider does not configure read_cache_ttl.
+const DefaultReadCacheTTL = 5 * time.Minute
+
+// ReadCache caches the results of lookups repeated by many data sources of a
+// plan, such as the catalogs of sensor builds or cloud security rules, so they
+// are requested once per TTL rather than once per data source.
+type ReadCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*readCacheEntry
+}
+
+// readCacheEntry is a cached lookup. ready is closed once value and diags are
+// set, so concurrent lookups of the same key wait for a single request.
+type readCacheEntry struct {
+	ready   chan struct{}
+	value   any
+	diags   diag.Diagnostics
+	expires time.Time
+}
+
+// NewReadCache returns a ReadCache reusing results for ttl. Nothing is cached
+// when ttl is zero.
+func NewReadCache(ttl time.Duration) *ReadCache {
+	return &ReadCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]*readCacheEntry{},
+	}
+}
+
+// Cached returns the result of fetch for key, reusing the result of a previous
+// fetch of key that completed less than the TTL ago. Concurrent lookups of key
+// share the result of a single fetch. Results with errors are returned to the
+// lookups waiting for them but not cached. fetch is called directly when c is
+// nil or caching is disabled.
+func Cached[T any](c *ReadCache, key string, fetch func() (T, diag.Diagnostics)) (T, diag.Diagnostics) {
+	if c == nil || c.ttl <= 0 {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok {
+		select {
+		case <-entry.ready:
+			if c.now().After(entry.expires) {
+				ok = false
+			}
+		default:
+		}
+	}
+
+	if ok {
+		c.mu.Unlock()
+		<-entry.ready
+
+		value, _ := entry.value.(T)
+		return value, entry.diags
+	}
+
+	entry = &readCacheEntry{ready: make(chan struct{})}
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	value, diags := fetch()
+
+	c.mu.Lock()
+	entry.value, entry.diags = value, diags
+	entry.expires = c.now().Add(c.ttl)
+	if diags.HasError() {
+		delete(c.entries, key)
+	}
+	close(entry.ready)
+	c.mu.Unlock()
+
+	return value, diags
+}
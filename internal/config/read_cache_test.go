@@ -0,0 +1,95 @@
+package config
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCached(t *testing.T) {
+	now := time.Now()
+	c := NewReadCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	var calls int
+	fetch := func() (int, diag.Diagnostics) {
+		calls++
+		return calls, nil
+	}
+
+	v, diags := Cached(c, "key", fetch)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, v)
+
+	v, _ = Cached(c, "key", fetch)
+	assert.Equal(t, 1, v, "the result is reused within the TTL")
+
+	v, _ = Cached(c, "other", fetch)
+	assert.Equal(t, 2, v, "keys are cached separately")
+
+	now = now.Add(2 * time.Minute)
+	v, _ = Cached(c, "key", fetch)
+	assert.Equal(t, 3, v, "the result is fetched again once expired")
+}
+
+func TestCached_Errors(t *testing.T) {
+	c := NewReadCache(time.Minute)
+
+	var calls int
+	fetch := func() (int, diag.Diagnostics) {
+		calls++
+		var diags diag.Diagnostics
+		diags.AddError("failed", "")
+		return 0, diags
+	}
+
+	_, diags := Cached(c, "key", fetch)
+	assert.True(t, diags.HasError())
+	_, diags = Cached(c, "key", fetch)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, 2, calls, "errors are not cached")
+}
+
+func TestCached_Disabled(t *testing.T) {
+	var calls int
+	fetch := func() (int, diag.Diagnostics) {
+		calls++
+		return calls, nil
+	}
+
+	Cached(NewReadCache(0), "key", fetch)
+	Cached(NewReadCache(0), "key", fetch)
+	Cached[int](nil, "key", fetch)
+	assert.Equal(t, 3, calls)
+}
+
+func TestCached_Concurrent(t *testing.T) {
+	c := NewReadCache(time.Minute)
+
+	var calls atomic.Int32
+	fetch := func() (int32, diag.Diagnostics) {
+		time.Sleep(10 * time.Millisecond)
+		return calls.Add(1), nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int32, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = Cached(c, "key", fetch)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for _, r := range results {
+		assert.Equal(t, int32(1), r)
+	}
+}
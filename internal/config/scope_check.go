@@ -0,0 +1,89 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ScopeCheck verifies that the API client of the provider was granted the API
+// scopes required by the resources and data sources of a configuration when
+// they are configured, so missing scopes fail the plan with the list of scopes
+// to add rather than with a 403 error in the middle of an apply.
+type ScopeCheck struct {
+	granted []string
+
+	mu       sync.Mutex
+	reported map[string]bool
+}
+
+// NewScopeCheck returns a ScopeCheck for the scopes granted to the API client,
+// such as "host-group:read". granted is nil when the scopes of the API client
+// could not be determined, and nothing is verified.
+func NewScopeCheck(granted []string) *ScopeCheck {
+	return &ScopeCheck{
+		granted:  granted,
+		reported: map[string]bool{},
+	}
+}
+
+// Check returns an error listing the scopes of required that the API client
+// was not granted. Each missing scope is reported once, by the first resource
+// or data source requiring it. Nothing is verified when c is nil.
+func (c *ScopeCheck) Check(required []scopes.Scope) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c == nil {
+		return diags
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.granted == nil {
+		if !c.reported[""] {
+			c.reported[""] = true
+			diags.AddWarning(
+				"Unable to Verify API Scopes",
+				"verify_scopes is enabled, but the access token of the provider does not list the API scopes granted to its API client. "+
+					"Missing scopes are reported by the API when resources make requests instead.",
+			)
+		}
+		return diags
+	}
+
+	var sb strings.Builder
+	for _, scope := range scopes.Missing(required, c.granted) {
+		access := ""
+		switch {
+		case scope.Read && scope.Write:
+			access = "Read & Write"
+		case scope.Write:
+			access = "Write"
+		default:
+			access = "Read"
+		}
+
+		key := scope.Name + " | " + access
+		if c.reported[key] {
+			continue
+		}
+		c.reported[key] = true
+
+		fmt.Fprintf(&sb, "- %s\n", key)
+	}
+
+	if sb.Len() > 0 {
+		diags.AddError(
+			"Missing API Scopes",
+			"The API client of the provider is missing API scopes required by this configuration. "+
+				"Add the following scopes to the API client in the Falcon console, then run Terraform again:\n\n"+
+				sb.String(),
+		)
+	}
+
+	return diags
+}
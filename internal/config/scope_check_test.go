@@ -0,0 +1,47 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopeCheck(t *testing.T) {
+	hostGroups := []scopes.Scope{{Name: "Host groups", Read: true, Write: true}}
+	preventionPolicies := []scopes.Scope{
+		{Name: "Prevention policies", Read: true, Write: true},
+		{Name: "Host groups", Read: true},
+	}
+
+	check := NewScopeCheck([]string{"host-group:read", "prevention-policies:read", "prevention-policies:write"})
+
+	diags := check.Check(hostGroups)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "- Host groups | Write\n")
+	assert.NotContains(t, diags[0].Detail(), "Prevention policies")
+
+	// Missing scopes are reported once.
+	assert.False(t, check.Check(hostGroups).HasError())
+	assert.False(t, check.Check(preventionPolicies).HasError())
+
+	// Scopes whose identifier is not known are not verified.
+	assert.False(t, check.Check([]scopes.Scope{{Name: "Unknown scope", Read: true}}).HasError())
+}
+
+func TestScopeCheckUnknownGrantedScopes(t *testing.T) {
+	check := NewScopeCheck(nil)
+
+	diags := check.Check([]scopes.Scope{{Name: "Host groups", Read: true}})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, diags.WarningsCount())
+
+	assert.Empty(t, check.Check([]scopes.Scope{{Name: "Host groups", Read: true}}))
+}
+
+func TestScopeCheckDisabled(t *testing.T) {
+	var check *ScopeCheck
+	assert.Empty(t, check.Check([]scopes.Scope{{Name: "Host groups", Read: true}}))
+	assert.Empty(t, ProviderConfig{}.CheckScopes([]scopes.Scope{{Name: "Host groups", Read: true}}))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -34,7 +35,8 @@ type contentCategoryVersionsDataSourceModel struct {
 
 // contentCategoryVersionsDataSource is the data source implementation.
 type contentCategoryVersionsDataSource struct {
-	client *client.CrowdStrikeAPISpecification
+	client    *client.CrowdStrikeAPISpecification
+	readCache *config.ReadCache
 }
 
 // Metadata returns the data source type name.
@@ -102,25 +104,35 @@ func (d *contentCategoryVersionsDataSource) Read(
 
 	// Query each content category for available versions
 	for apiCategory := range categories {
-		categoryVersions, err := d.client.ContentUpdatePolicies.QueryPinnableContentVersions(
-			&content_update_policies.QueryPinnableContentVersionsParams{
-				Context:  ctx,
-				Category: apiCategory,
-			},
-		)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				fmt.Sprintf("Unable to read content versions for category %s", apiCategory),
-				err.Error(),
+		categoryVersions, diags := config.Cached(d.readCache, "content_versions/"+apiCategory, func() ([]string, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			categoryVersions, err := d.client.ContentUpdatePolicies.QueryPinnableContentVersions(
+				&content_update_policies.QueryPinnableContentVersionsParams{
+					Context:  ctx,
+					Category: apiCategory,
+				},
 			)
+			if err != nil {
+				diags.AddError(
+					fmt.Sprintf("Unable to read content versions for category %s", apiCategory),
+					err.Error(),
+				)
+				return nil, diags
+			}
+
+			if categoryVersions.Payload.Resources == nil {
+				return []string{}, diags
+			}
+
+			return categoryVersions.Payload.Resources, diags
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		if categoryVersions.Payload.Resources != nil {
-			versions[apiCategory] = categoryVersions.Payload.Resources
-		} else {
-			versions[apiCategory] = []string{}
-		}
+		versions[apiCategory] = categoryVersions
 	}
 
 	// Convert to types.String slices
@@ -150,8 +162,7 @@ func (d *contentCategoryVersionsDataSource) Read(
 	state.VulnerabilityManagement = vulnMgmtVersions
 	state.RapidResponse = rapidResponseVersions
 
-	diags := resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -180,4 +191,6 @@ func (d *contentCategoryVersionsDataSource) Configure(
 	}
 
 	d.client = config.Client
+	d.readCache = config.ReadCache
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesRead)...)
 }
@@ -3,6 +3,7 @@ package contentupdatepolicy
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/crowdstrike/gofalcon/falcon/client"
 	"github.com/crowdstrike/gofalcon/falcon/client/content_update_policies"
@@ -20,12 +21,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
 	dataSourceDocumentationSection = "Content Update Policy"
 	dataSourceMarkdownDescription  = "This data source provides information about content update policies in Falcon."
+	// contentUpdatePoliciesQueryLimit is the page size used when querying content update
+	// policies, and the largest page size accepted by the API.
+	contentUpdatePoliciesQueryLimit = 5000
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -58,6 +61,7 @@ func (d *contentUpdatePoliciesDataSource) Configure(
 	}
 
 	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesRead)...)
 }
 
 // contentUpdatePoliciesDataSource is the data source implementation.
@@ -103,6 +107,8 @@ type ContentUpdatePoliciesDataSourceModel struct {
 	Filter      types.String `tfsdk:"filter"`
 	IDs         types.List   `tfsdk:"ids"`
 	Sort        types.String `tfsdk:"sort"`
+	Limit       types.Int64  `tfsdk:"limit"`
+	MaxItems    types.Int64  `tfsdk:"max_items"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Enabled     types.Bool   `tfsdk:"enabled"`
@@ -245,6 +251,8 @@ func (d *contentUpdatePoliciesDataSource) Schema(
 					fwvalidators.StringNotWhitespace(),
 				},
 			},
+			"limit":     utils.PageLimitAttribute("content update policies", contentUpdatePoliciesQueryLimit, contentUpdatePoliciesQueryLimit),
+			"max_items": utils.MaxItemsAttribute("content update policies"),
 			"name": schema.StringAttribute{
 				Optional:    true,
 				Description: "Filter policies by name. All provided filter attributes must match for a policy to be returned (omitted attributes are ignored). Supports wildcard matching with '*' where '*' matches any sequence of characters until the end of the string or until the next literal character in the pattern is found. Multiple wildcards can be used in a single pattern. Matching is case insensitive. Cannot be used together with 'filter' or 'ids'.",
@@ -427,82 +435,48 @@ func (d *contentUpdatePoliciesDataSource) ValidateConfig(ctx context.Context, re
 	}
 }
 
-// getContentUpdatePolicies returns all content update policies matching filter.
+// getContentUpdatePolicies returns up to pagination.MaxItems content update
+// policies matching filter in the order of sort, and the total number of
+// matching policies.
 func (d *contentUpdatePoliciesDataSource) getContentUpdatePolicies(
 	ctx context.Context,
 	filter string,
 	sort string,
-) ([]*models.ContentUpdatePolicyV1, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	var allPolicies []*models.ContentUpdatePolicyV1
-
-	tflog.Debug(
-		ctx,
-		"[datasource] Getting all content update policies",
-	)
+	pagination utils.Pagination,
+) ([]*models.ContentUpdatePolicyV1, int64, diag.Diagnostics) {
+	return utils.QueryPages(pagination, func(offset, limit int64) ([]*models.ContentUpdatePolicyV1, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
 
-	limit := int64(5000)
-	offset := int64(0)
-
-	for {
 		params := &content_update_policies.QueryCombinedContentUpdatePoliciesParams{
 			Context: ctx,
 			Limit:   &limit,
 			Offset:  &offset,
 		}
-
 		if filter != "" {
 			params.Filter = &filter
 		}
-
 		if sort != "" {
 			params.Sort = &sort
 		}
 
 		res, err := d.client.ContentUpdatePolicies.QueryCombinedContentUpdatePolicies(params)
 		if err != nil {
-			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
-			return allPolicies, diags
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesRead))
+			return nil, 0, diags
 		}
 
-		if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 {
-			tflog.Debug(ctx, "[datasource] No more content update policies to retrieve",
-				map[string]interface{}{
-					"total_retrieved": len(allPolicies),
-				})
-			break
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
 		}
 
-		allPolicies = append(allPolicies, res.Payload.Resources...)
-		tflog.Debug(ctx, "[datasource] Retrieved page of content update policies",
-			map[string]interface{}{
-				"page_count":  len(res.Payload.Resources),
-				"total_count": len(allPolicies),
-				"offset":      offset,
-			})
-
-		if res.Payload.Meta == nil || res.Payload.Meta.Pagination == nil ||
-			res.Payload.Meta.Pagination.Offset == nil || res.Payload.Meta.Pagination.Total == nil {
-			tflog.Warn(ctx, "Missing pagination metadata in API response, using offset+limit for next page",
-				map[string]interface{}{
-					"meta": res.Payload.Meta,
-				})
-			offset += limit
-			continue
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
 		}
 
-		offset = int64(*res.Payload.Meta.Pagination.Offset)
-		if offset >= *res.Payload.Meta.Pagination.Total {
-			tflog.Info(ctx, "[datasource] Pagination complete",
-				map[string]interface{}{
-					"total_retrieved": len(allPolicies),
-					"total_available": *res.Payload.Meta.Pagination.Total,
-				})
-			break
-		}
-	}
-
-	return allPolicies, diags
+		return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+	})
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -517,7 +491,17 @@ func (d *contentUpdatePoliciesDataSource) Read(
 		return
 	}
 
-	policies, diags := d.getContentUpdatePolicies(ctx, data.Filter.ValueString(), data.Sort.ValueString())
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, contentUpdatePoliciesQueryLimit)
+
+	// ids and the individual filter attributes are matched against every
+	// policy, so max_items applies to the matching policies.
+	matchAll := utils.IsKnown(data.IDs) || data.hasIndividualFilters()
+	query := pagination
+	if matchAll {
+		query.MaxItems = math.MaxInt64
+	}
+
+	policies, total, diags := d.getContentUpdatePolicies(ctx, data.Filter.ValueString(), data.Sort.ValueString(), query)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -536,6 +520,12 @@ func (d *contentUpdatePoliciesDataSource) Read(
 		policies = filterPoliciesByAttributes(policies, &data)
 	}
 
+	if matchAll {
+		policies, total = utils.Truncate(policies, pagination.MaxItems)
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("content update policies", len(policies), total)...)
+
 	resp.Diagnostics.Append(data.wrap(ctx, policies)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -10,9 +10,11 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
 	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -30,11 +32,13 @@ var (
 	_ resource.Resource                = &contentUpdatePolicyAttachmentResource{}
 	_ resource.ResourceWithConfigure   = &contentUpdatePolicyAttachmentResource{}
 	_ resource.ResourceWithImportState = &contentUpdatePolicyAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &contentUpdatePolicyAttachmentResource{}
+	_ resource.ResourceWithMoveState   = &contentUpdatePolicyAttachmentResource{}
 )
 
 var (
 	attachmentDocumentationSection        string         = "Content Update Policy"
-	attachmentResourceMarkdownDescription string         = "This resource allows managing the host groups attached to a content update policy. By default (when `exclusive` is true), this resource takes exclusive ownership over the host groups assigned to a content update policy. When `exclusive` is false, this resource only manages the specific host groups defined in the configuration. If you want to fully create or manage a content update policy please use the `content_update_policy` resource."
+	attachmentResourceMarkdownDescription string         = "This resource allows managing the host groups attached to a content update policy. By default (when `exclusive` is true), this resource takes exclusive ownership over the host groups assigned to a content update policy. When `exclusive` is false, this resource only manages the specific host groups defined in the configuration. If you want to fully create or manage a content update policy please use the `content_update_policy` resource. A `crowdstrike_content_update_policy` resource can be moved to this resource with a `moved` block to take over its attachments without recreating the policy; the attachment starts with `exclusive` set to true."
 	attachmentRequiredScopes              []scopes.Scope = apiScopesReadWrite
 )
 
@@ -55,14 +59,16 @@ func NewContentUpdatePolicyAttachmentResource() resource.Resource {
 }
 
 type contentUpdatePolicyAttachmentResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client     *client.CrowdStrikeAPISpecification
+	references references.Validator
 }
 
 type contentUpdatePolicyAttachmentResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	HostGroups  types.Set    `tfsdk:"host_groups"`
-	Exclusive   types.Bool   `tfsdk:"exclusive"`
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	HostGroups  types.Set      `tfsdk:"host_groups"`
+	Exclusive   types.Bool     `tfsdk:"exclusive"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *contentUpdatePolicyAttachmentResourceModel) wrap(
@@ -143,6 +149,22 @@ func (r *contentUpdatePolicyAttachmentResource) Configure(
 	}
 
 	r.client = config.Client
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(attachmentRequiredScopes)...)
+}
+
+// ModifyPlan validates that the objects referenced by the resource exist.
+func (r *contentUpdatePolicyAttachmentResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	resp.Diagnostics.Append(r.references.Validate(
+		ctx,
+		req.Plan,
+		references.ContentUpdatePolicy(path.Root("id")),
+		references.HostGroups(path.Root("host_groups")),
+	)...)
 }
 
 func (r *contentUpdatePolicyAttachmentResource) Metadata(
@@ -154,7 +176,7 @@ func (r *contentUpdatePolicyAttachmentResource) Metadata(
 }
 
 func (r *contentUpdatePolicyAttachmentResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -194,6 +216,9 @@ func (r *contentUpdatePolicyAttachmentResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -208,6 +233,9 @@ func (r *contentUpdatePolicyAttachmentResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getContentUpdatePolicy(ctx, r.client, plan.ID.ValueString())
 	if diags.HasError() {
 		if tferrors.HasNotFoundError(diags) {
@@ -265,6 +293,9 @@ func (r *contentUpdatePolicyAttachmentResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getContentUpdatePolicy(ctx, r.client, state.ID.ValueString())
 	if diags.HasError() {
 		if tferrors.HasNotFoundError(diags) {
@@ -295,6 +326,9 @@ func (r *contentUpdatePolicyAttachmentResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	planHostGroups := plan.HostGroups
 
 	if !plan.Exclusive.ValueBool() {
@@ -369,6 +403,9 @@ func (r *contentUpdatePolicyAttachmentResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	resp.Diagnostics.Append(
 		syncHostGroups(
 			ctx,
@@ -379,6 +416,17 @@ func (r *contentUpdatePolicyAttachmentResource) Delete(
 		)...)
 }
 
+// MoveState allows moving a `crowdstrike_content_update_policy` resource to
+// this resource with a moved block, so the content update policy is not
+// destroyed and recreated when its attachments are managed separately.
+func (r *contentUpdatePolicyAttachmentResource) MoveState(
+	_ context.Context,
+) []resource.StateMover {
+	return []resource.StateMover{
+		utils.AttachmentStateMover("crowdstrike_content_update_policy", utils.MovedGroups{Source: "host_groups", Target: "host_groups"}),
+	}
+}
+
 func (r *contentUpdatePolicyAttachmentResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
@@ -0,0 +1,56 @@
+package contentupdatepolicy
+
+import (
+	"context"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/content_update_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/listresource"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewContentUpdatePolicyListResource is a helper function to simplify the provider implementation.
+func NewContentUpdatePolicyListResource() list.ListResource {
+	return listresource.New(listresource.Options[*models.ContentUpdatePolicyV1, contentPolicyResourceModel]{
+		TypeName: "_content_update_policy",
+		Objects:  "content update policies",
+		// Default policies are managed by their own resources and are not listed.
+		Filter: "name.raw:!'platform_default'",
+		Scopes: apiScopesRead,
+		Query:  queryContentUpdatePolicies,
+		ID:     func(policy *models.ContentUpdatePolicyV1) *string { return policy.ID },
+		Name:   func(policy *models.ContentUpdatePolicyV1) *string { return policy.Name },
+		Wrap: func(ctx context.Context, m *contentPolicyResourceModel, policy *models.ContentUpdatePolicyV1) diag.Diagnostics {
+			return m.wrap(ctx, *policy, false)
+		},
+	})
+}
+
+// queryContentUpdatePolicies fetches a page of the content update policies matching filter.
+func queryContentUpdatePolicies(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	filter *string,
+	offset int64,
+) (*listresource.Page[*models.ContentUpdatePolicyV1], error) {
+	res, err := client.ContentUpdatePolicies.QueryCombinedContentUpdatePolicies(
+		&content_update_policies.QueryCombinedContentUpdatePoliciesParams{
+			Context: ctx,
+			Filter:  filter,
+			Limit:   utils.Addr(utils.ListPageSize),
+			Offset:  &offset,
+		},
+	)
+	if err != nil || res == nil || res.Payload == nil {
+		return nil, err
+	}
+
+	return &listresource.Page[*models.ContentUpdatePolicyV1]{
+		Resources: res.Payload.Resources,
+		Meta:      res.Payload.Meta,
+		Errors:    res.Payload.Errors,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package contentupdatepolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccContentUpdatePolicyListResource(t *testing.T) {
+	rName := acctest.RandomResourceName()
+	listName := "crowdstrike_content_update_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentUpdatePolicyListResourceConfig(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccContentUpdatePolicyListResourceQuery(rName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(listName, 1),
+					querycheck.ExpectResourceDisplayName(
+						listName,
+						queryfilter.ByDisplayName(knownvalue.StringExact(rName)),
+						knownvalue.StringExact(rName),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccContentUpdatePolicyListResourceConfig(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_content_update_policy" "test" {
+  name        = %[1]q
+  description = "made with terraform"
+
+  sensor_operations = {
+    ring_assignment = "ga"
+  }
+
+  system_critical = {
+    ring_assignment = "ga"
+  }
+
+  vulnerability_management = {
+    ring_assignment = "ga"
+  }
+
+  rapid_response = {
+    ring_assignment = "ga"
+  }
+}
+`, rName)
+}
+
+func testAccContentUpdatePolicyListResourceQuery(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+list "crowdstrike_content_update_policy" "test" {
+  provider = crowdstrike
+
+  config {
+    filter = "name:'%s'"
+  }
+}
+`, rName)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -44,9 +45,10 @@ type contentUpdatePolicyPrecedenceResource struct {
 }
 
 type contentUpdatePolicyPrecedenceResourceModel struct {
-	IDs         types.List   `tfsdk:"ids"`
-	Enforcement types.String `tfsdk:"enforcement"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	IDs         types.List     `tfsdk:"ids"`
+	Enforcement types.String   `tfsdk:"enforcement"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (d *contentUpdatePolicyPrecedenceResourceModel) wrap(
@@ -90,6 +92,7 @@ func (r *contentUpdatePolicyPrecedenceResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
 }
 
 func (r *contentUpdatePolicyPrecedenceResource) Metadata(
@@ -101,7 +104,7 @@ func (r *contentUpdatePolicyPrecedenceResource) Metadata(
 }
 
 func (r *contentUpdatePolicyPrecedenceResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -129,6 +132,9 @@ func (r *contentUpdatePolicyPrecedenceResource) Schema(
 				Description: "Timestamp of the last Terraform update of the resource.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -143,6 +149,9 @@ func (r *contentUpdatePolicyPrecedenceResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var planPolicyIDs []string
 	resp.Diagnostics.Append(plan.IDs.ElementsAs(ctx, &planPolicyIDs, false)...)
 	if resp.Diagnostics.HasError() {
@@ -196,6 +205,9 @@ func (r *contentUpdatePolicyPrecedenceResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policies, diags := r.getContentUpdatePoliciesByPrecedence(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -223,6 +235,9 @@ func (r *contentUpdatePolicyPrecedenceResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var planPolicyIDs []string
 	resp.Diagnostics.Append(plan.IDs.ElementsAs(ctx, &planPolicyIDs, false)...)
 	if resp.Diagnostics.HasError() {
@@ -10,11 +10,13 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -34,6 +36,7 @@ var (
 	_ resource.Resource                   = &contentPolicyResource{}
 	_ resource.ResourceWithConfigure      = &contentPolicyResource{}
 	_ resource.ResourceWithImportState    = &contentPolicyResource{}
+	_ resource.ResourceWithIdentity       = &contentPolicyResource{}
 	_ resource.ResourceWithValidateConfig = &contentPolicyResource{}
 	_ resource.ResourceWithModifyPlan     = &contentPolicyResource{}
 )
@@ -45,7 +48,8 @@ func NewContentPolicyResource() resource.Resource {
 
 // contentPolicyResource is the resource implementation.
 type contentPolicyResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client     *client.CrowdStrikeAPISpecification
+	references references.Validator
 }
 
 // contentPolicyResourceModel is the resource model.
@@ -66,6 +70,7 @@ type contentPolicyResourceModel struct {
 	systemCritical          ringAssignmentModel `tfsdk:"-"`
 	vulnerabilityManagement ringAssignmentModel `tfsdk:"-"`
 	rapidResponse           ringAssignmentModel `tfsdk:"-"`
+	Timeouts                timeouts.Value      `tfsdk:"timeouts"`
 }
 
 // extract extracts the Go values from their terraform wrapped values.
@@ -278,6 +283,8 @@ func (r *contentPolicyResource) Configure(
 	}
 
 	r.client = config.Client
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
 }
 
 // Metadata returns the resource type name.
@@ -291,7 +298,7 @@ func (r *contentPolicyResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *contentPolicyResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -427,9 +434,21 @@ func (r *contentPolicyResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *contentPolicyResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *contentPolicyResource) Create(
 	ctx context.Context,
@@ -445,6 +464,9 @@ func (r *contentPolicyResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	// Build ring assignment settings using shared function with individual fields
 	ringAssignmentSettings := buildRingAssignmentSettings(
 		ctx,
@@ -544,6 +566,7 @@ func (r *contentPolicyResource) Create(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, *policy, true)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -560,6 +583,9 @@ func (r *contentPolicyResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Debug(ctx, "Retrieving content update policy", map[string]interface{}{
 		"policy_id": state.ID.ValueString(),
 	})
@@ -580,6 +606,7 @@ func (r *contentPolicyResource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -600,6 +627,9 @@ func (r *contentPolicyResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var state contentPolicyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(state.extract(ctx)...)
@@ -700,6 +730,7 @@ func (r *contentPolicyResource) Update(
 	plan.LastUpdated = utils.GenerateUpdateTimestamp()
 	resp.Diagnostics.Append(plan.wrap(ctx, *policy, true)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -716,6 +747,9 @@ func (r *contentPolicyResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Debug(ctx, "Disabling content update policy before deletion", map[string]interface{}{
 		"policy_id": state.ID.ValueString(),
 	})
@@ -755,7 +789,7 @@ func (r *contentPolicyResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // ValidateConfig runs during validate, plan, and apply to validate resource configuration.
@@ -836,6 +870,15 @@ func (r *contentPolicyResource) ModifyPlan(
 	req resource.ModifyPlanRequest,
 	resp *resource.ModifyPlanResponse,
 ) {
+	resp.Diagnostics.Append(r.references.Validate(
+		ctx,
+		req.Plan,
+		references.HostGroups(path.Root("host_groups")),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Check if this is a resource creation (state is null)
 	if req.State.Raw.IsNull() {
 		return
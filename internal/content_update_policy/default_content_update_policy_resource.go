@@ -12,6 +12,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -59,6 +60,7 @@ type defaultContentUpdatePolicyResourceModel struct {
 	systemCriticalSettings          *ringAssignmentModel `tfsdk:"-"`
 	vulnerabilityManagementSettings *ringAssignmentModel `tfsdk:"-"`
 	rapidResponseSettings           *ringAssignmentModel `tfsdk:"-"`
+	Timeouts                        timeouts.Value       `tfsdk:"timeouts"`
 }
 
 // extract extracts the Go values from their terraform wrapped values.
@@ -211,6 +213,7 @@ func (r *defaultContentUpdatePolicyResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
 }
 
 // Metadata returns the resource type name.
@@ -224,7 +227,7 @@ func (r *defaultContentUpdatePolicyResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *defaultContentUpdatePolicyResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -345,6 +348,9 @@ func (r *defaultContentUpdatePolicyResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -363,6 +369,9 @@ func (r *defaultContentUpdatePolicyResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Debug(ctx, "Retrieving default content update policy")
 	policy, diags := r.getDefaultPolicy(ctx)
 	resp.Diagnostics.Append(diags...)
@@ -476,6 +485,9 @@ func (r *defaultContentUpdatePolicyResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Debug(ctx, "Retrieving default content update policy", map[string]interface{}{
 		"policy_id": state.ID.ValueString(),
 	})
@@ -512,6 +524,9 @@ func (r *defaultContentUpdatePolicyResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var state defaultContentUpdatePolicyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(state.extract(ctx)...)
@@ -0,0 +1,738 @@
+package customioc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/ioc"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// iocCreateBatchSize is the maximum number of indicators accepted by a single create request.
+	iocCreateBatchSize = 200
+	// iocDeleteBatchSize is the number of indicator IDs sent in a single delete request.
+	iocDeleteBatchSize = 100
+	// iocQueryLimit is the page size used when reading the indicators of a feed.
+	iocQueryLimit = 2000
+	// iocComment is recorded in the IOC audit log for changes made by this resource.
+	iocComment = "Managed by Terraform"
+)
+
+var (
+	_ resource.Resource                = &iocFeedResource{}
+	_ resource.ResourceWithConfigure   = &iocFeedResource{}
+	_ resource.ResourceWithImportState = &iocFeedResource{}
+)
+
+func NewIOCFeedResource() resource.Resource {
+	return &iocFeedResource{}
+}
+
+type iocFeedResource struct {
+	client  *client.CrowdStrikeAPISpecification
+	comment string
+}
+
+type iocFeedResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Source          types.String   `tfsdk:"source"`
+	Action          types.String   `tfsdk:"action"`
+	Severity        types.String   `tfsdk:"severity"`
+	Description     types.String   `tfsdk:"description"`
+	Platforms       types.Set      `tfsdk:"platforms"`
+	Tags            types.Set      `tfsdk:"tags"`
+	HostGroups      types.Set      `tfsdk:"host_groups"`
+	Indicators      types.Set      `tfsdk:"indicators"`
+	LastUpdated     types.String   `tfsdk:"last_updated"`
+	RollbackOnError types.Bool     `tfsdk:"rollback_on_error"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+type iocFeedIndicatorModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (m iocFeedIndicatorModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":  types.StringType,
+		"value": types.StringType,
+	}
+}
+
+// key uniquely identifies an indicator within a feed.
+func (m iocFeedIndicatorModel) key() string {
+	return m.Type.ValueString() + ":" + m.Value.ValueString()
+}
+
+// wrap transforms the API response into the resource model.
+func (m *iocFeedResourceModel) wrap(
+	ctx context.Context,
+	indicators []*models.APIIndicatorV1,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	indicatorModels := make([]iocFeedIndicatorModel, 0, len(indicators))
+	for _, indicator := range indicators {
+		indicatorModels = append(indicatorModels, iocFeedIndicatorModel{
+			Type:  types.StringValue(indicator.Type),
+			Value: types.StringValue(indicator.Value),
+		})
+	}
+
+	indicatorSet, d := types.SetValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: iocFeedIndicatorModel{}.AttributeTypes()},
+		indicatorModels,
+	)
+	diags.Append(d...)
+	m.Indicators = indicatorSet
+
+	if len(indicators) == 0 {
+		return diags
+	}
+
+	// The shared attributes are applied to every indicator in the feed, so
+	// they are read from the first one.
+	first := indicators[0]
+	m.Action = flex.StringValueToFramework(strings.ToLower(first.Action))
+	m.Severity = flex.StringValueToFramework(strings.ToLower(first.Severity))
+	m.Description = flex.StringValueToFramework(first.Description)
+
+	m.Platforms, d = types.SetValueFrom(ctx, types.StringType, first.Platforms)
+	diags.Append(d...)
+
+	m.Tags, d = flex.FlattenStringValueSet(ctx, first.Tags)
+	diags.Append(d...)
+
+	m.HostGroups, d = flex.FlattenStringValueSet(ctx, first.HostGroups)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (r *iocFeedResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	r.comment = config.Comment(iocComment)
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
+}
+
+func (r *iocFeedResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_ioc_feed"
+}
+
+func (r *iocFeedResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"IOC Management",
+			"This resource publishes a feed of custom indicators of compromise (IOCs) to Falcon. "+
+				"Every indicator in the feed shares the same `source`, action, severity, platforms and host groups, and the feed is identified by its `source`.\n\n"+
+				"Indicators are created and deleted in batches, and only indicators added to or removed from `indicators` are sent to the API on update, "+
+				"so feeds with hundreds of thousands of indicators can be managed. Requests that are rate limited are retried once the API rate limit window resets.\n\n"+
+				"The `source` must not be shared with indicators managed outside of this resource, as they will be adopted or deleted by it.",
+			apiScopesReadWrite,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the feed. This is the same as `source`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The source recorded on every indicator in the feed. Changing this value forces a new resource to be created.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+					stringvalidator.LengthAtMost(200),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The action taken when an indicator is observed. One of: `no_action`, `allow`, `prevent_no_ui`, `detect`, `prevent`. `prevent` and `prevent_no_ui` are only supported for hash indicators.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("no_action", "allow", "prevent_no_ui", "detect", "prevent"),
+				},
+			},
+			"severity": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The severity of detections generated by the indicators. One of: `informational`, `low`, `medium`, `high`, `critical`. Required when `action` is `detect`, `prevent` or `prevent_no_ui`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("informational", "low", "medium", "high", "critical"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description applied to every indicator in the feed.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"platforms": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The platforms the indicators apply to. Valid values: `windows`, `mac`, `linux`.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("windows", "mac", "linux")),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags applied to every indicator in the feed.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(fwvalidators.StringNotWhitespace()),
+				},
+			},
+			"host_groups": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the host groups the indicators apply to. When not set, the indicators apply to all hosts.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(fwvalidators.StringNotWhitespace()),
+				},
+			},
+			"indicators": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The indicators published in the feed.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The type of the indicator. One of: `sha256`, `md5`, `domain`, `ipv4`, `ipv6`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("sha256", "md5", "domain", "ipv4", "ipv6"),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The value of the indicator. Values must be lowercase.",
+							Validators: []validator.String{
+								fwvalidators.StringNotWhitespace(),
+								stringvalidator.RegexMatches(
+									regexp.MustCompile(`^[^A-Z]*$`),
+									"must be lowercase",
+								),
+							},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC850 timestamp of the last update to this resource by Terraform.",
+			},
+			"rollback_on_error": utils.RollbackOnErrorAttribute("indicators"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *iocFeedResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan iocFeedResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	indicators := flex.ExpandSetAs[iocFeedIndicatorModel](ctx, plan.Indicators, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating IOC feed", map[string]any{
+		"source":     plan.Source.ValueString(),
+		"indicators": len(indicators),
+	})
+
+	plan.ID = plan.Source
+	createDiags := r.createIndicators(ctx, plan, indicators)
+
+	// Without any indicator left the feed does not exist, so no state is saved.
+	if r.rollback(ctx, plan, indicators, &createDiags) {
+		resp.Diagnostics.Append(createDiags...)
+		return
+	}
+
+	// Unless they were rolled back, indicators from successful batches exist
+	// even when a later batch fails, so the state is saved to let Terraform
+	// clean them up.
+	existing, diags := r.queryIndicators(ctx, plan.Source.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(createDiags...)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.wrap(ctx, existing)...)
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(createDiags...)
+}
+
+func (r *iocFeedResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state iocFeedResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	indicators, diags := r.queryIndicators(ctx, state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(indicators) == 0 {
+		resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Source = state.ID
+	resp.Diagnostics.Append(state.wrap(ctx, indicators)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *iocFeedResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state iocFeedResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	planIndicators := flex.ExpandSetAs[iocFeedIndicatorModel](ctx, plan.Indicators, &resp.Diagnostics)
+	stateIndicators := flex.ExpandSetAs[iocFeedIndicatorModel](ctx, state.Indicators, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, removed := diffIndicators(stateIndicators, planIndicators)
+
+	tflog.Info(ctx, "Updating IOC feed", map[string]any{
+		"source":  plan.Source.ValueString(),
+		"added":   len(added),
+		"removed": len(removed),
+	})
+
+	if len(removed) > 0 {
+		resp.Diagnostics.Append(r.deleteIndicators(ctx, plan.Source.ValueString(), removed)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Action.Equal(state.Action) ||
+		!plan.Severity.Equal(state.Severity) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.Platforms.Equal(state.Platforms) ||
+		!plan.Tags.Equal(state.Tags) ||
+		!plan.HostGroups.Equal(state.HostGroups) {
+		resp.Diagnostics.Append(r.bulkUpdateIndicators(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	createDiags := r.createIndicators(ctx, plan, added)
+	r.rollback(ctx, plan, added, &createDiags)
+
+	existing, diags := r.queryIndicators(ctx, plan.Source.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(createDiags...)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.wrap(ctx, existing)...)
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(createDiags...)
+}
+
+func (r *iocFeedResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state iocFeedResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Deleting IOC feed", map[string]any{"source": state.ID.ValueString()})
+
+	params := ioc.NewIndicatorDeleteV1Params()
+	params.Context = ctx
+	params.Filter = utils.Addr(iocSourceFilter(state.ID.ValueString()))
+	params.Comment = utils.Addr(r.comment)
+
+	res, err := retryOnRateLimit(ctx, func() (*ioc.IndicatorDeleteV1OK, error) {
+		return r.client.Ioc.IndicatorDeleteV1(params)
+	})
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Delete, err, apiScopesReadWrite))
+		return
+	}
+
+	if res != nil && res.Payload != nil {
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Delete, res.Payload.Errors); diag != nil {
+			resp.Diagnostics.Append(diag)
+		}
+	}
+}
+
+func (r *iocFeedResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// createIndicators creates the given indicators in batches, applying the shared attributes of plan.
+func (r *iocFeedResource) createIndicators(
+	ctx context.Context,
+	plan iocFeedResourceModel,
+	indicators []iocFeedIndicatorModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	platforms := flex.ExpandSetAs[string](ctx, plan.Platforms, &diags)
+	tags := flex.ExpandSetAs[string](ctx, plan.Tags, &diags)
+	hostGroups := flex.ExpandSetAs[string](ctx, plan.HostGroups, &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	for batch := range slices.Chunk(indicators, iocCreateBatchSize) {
+		reqs := make([]*models.APIIndicatorCreateReqV1, 0, len(batch))
+		for _, indicator := range batch {
+			reqs = append(reqs, &models.APIIndicatorCreateReqV1{
+				Type:            indicator.Type.ValueString(),
+				Value:           indicator.Value.ValueString(),
+				Source:          plan.Source.ValueString(),
+				Action:          plan.Action.ValueString(),
+				Severity:        plan.Severity.ValueString(),
+				Description:     plan.Description.ValueString(),
+				Platforms:       platforms,
+				Tags:            tags,
+				HostGroups:      hostGroups,
+				AppliedGlobally: utils.Addr(len(hostGroups) == 0),
+			})
+		}
+
+		params := ioc.NewIndicatorCreateV1Params()
+		params.Context = ctx
+		params.Body = &models.APIIndicatorCreateReqsV1{
+			Comment:    r.comment,
+			Indicators: reqs,
+		}
+
+		res, err := retryOnRateLimit(ctx, func() (*ioc.IndicatorCreateV1Created, error) {
+			return r.client.Ioc.IndicatorCreateV1(params)
+		})
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Create, err, apiScopesReadWrite))
+			return diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Create))
+			return diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Create, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// rollback deletes the indicators of a failed create request when rollback_on_error
+// is enabled, and reports whether they were all deleted.
+func (r *iocFeedResource) rollback(
+	ctx context.Context,
+	plan iocFeedResourceModel,
+	indicators []iocFeedIndicatorModel,
+	diags *diag.Diagnostics,
+) bool {
+	rollback := utils.NewRollback(plan.RollbackOnError)
+	rollback.Add(func(ctx context.Context) diag.Diagnostics {
+		return r.deleteIndicators(ctx, plan.Source.ValueString(), indicators)
+	})
+	return rollback.Run(ctx, diags)
+}
+
+// bulkUpdateIndicators applies the shared attributes of plan to every indicator in the feed.
+func (r *iocFeedResource) bulkUpdateIndicators(
+	ctx context.Context,
+	plan iocFeedResourceModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	platforms := flex.ExpandSetAs[string](ctx, plan.Platforms, &diags)
+	tags := flex.ExpandSetAs[string](ctx, plan.Tags, &diags)
+	hostGroups := flex.ExpandSetAs[string](ctx, plan.HostGroups, &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	params := ioc.NewIndicatorUpdateV1Params()
+	params.Context = ctx
+	params.Body = &models.APIIndicatorUpdateReqsV1{
+		Comment: r.comment,
+		BulkUpdate: &models.APIBulkUpdateReqV1{
+			Filter:          iocSourceFilter(plan.Source.ValueString()),
+			Action:          plan.Action.ValueString(),
+			Severity:        plan.Severity.ValueString(),
+			Description:     plan.Description.ValueString(),
+			Platforms:       platforms,
+			Tags:            tags,
+			HostGroups:      hostGroups,
+			AppliedGlobally: len(hostGroups) == 0,
+		},
+	}
+
+	res, err := retryOnRateLimit(ctx, func() (*ioc.IndicatorUpdateV1OK, error) {
+		return r.client.Ioc.IndicatorUpdateV1(params)
+	})
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, apiScopesReadWrite))
+		return diags
+	}
+
+	if res != nil && res.Payload != nil {
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Update, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+		}
+	}
+
+	return diags
+}
+
+// deleteIndicators deletes the given indicators of the feed in batches.
+func (r *iocFeedResource) deleteIndicators(
+	ctx context.Context,
+	source string,
+	indicators []iocFeedIndicatorModel,
+) diag.Diagnostics {
+	existing, diags := r.queryIndicators(ctx, source)
+	if diags.HasError() {
+		return diags
+	}
+
+	idsByKey := make(map[string]string, len(existing))
+	for _, indicator := range existing {
+		idsByKey[indicator.Type+":"+indicator.Value] = indicator.ID
+	}
+
+	ids := make([]string, 0, len(indicators))
+	for _, indicator := range indicators {
+		if id, ok := idsByKey[indicator.key()]; ok {
+			ids = append(ids, id)
+		}
+	}
+
+	for batch := range slices.Chunk(ids, iocDeleteBatchSize) {
+		params := ioc.NewIndicatorDeleteV1Params()
+		params.Context = ctx
+		params.Ids = batch
+		params.Comment = utils.Addr(r.comment)
+
+		res, err := retryOnRateLimit(ctx, func() (*ioc.IndicatorDeleteV1OK, error) {
+			return r.client.Ioc.IndicatorDeleteV1(params)
+		})
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, apiScopesReadWrite))
+			return diags
+		}
+
+		if res != nil && res.Payload != nil {
+			if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Update, res.Payload.Errors); diag != nil {
+				diags.Append(diag)
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+// queryIndicators returns every indicator in the feed.
+func (r *iocFeedResource) queryIndicators(
+	ctx context.Context,
+	source string,
+) ([]*models.APIIndicatorV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var indicators []*models.APIIndicatorV1
+	var after *string
+
+	for {
+		params := ioc.NewIndicatorCombinedV1Params()
+		params.Context = ctx
+		params.Filter = utils.Addr(iocSourceFilter(source))
+		params.Limit = utils.Addr(int64(iocQueryLimit))
+		params.After = after
+
+		res, err := retryOnRateLimit(ctx, func() (*ioc.IndicatorCombinedV1OK, error) {
+			return r.client.Ioc.IndicatorCombinedV1(params)
+		})
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesReadWrite))
+			return nil, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, diags
+		}
+
+		for _, indicator := range res.Payload.Resources {
+			if indicator != nil && !indicator.Deleted {
+				indicators = append(indicators, indicator)
+			}
+		}
+
+		if len(res.Payload.Resources) == 0 || res.Payload.Meta == nil || res.Payload.Meta.Pagination == nil ||
+			res.Payload.Meta.Pagination.After == "" {
+			break
+		}
+
+		after = utils.Addr(res.Payload.Meta.Pagination.After)
+	}
+
+	return indicators, diags
+}
+
+// diffIndicators returns the indicators in desired that are not in current,
+// and the indicators in current that are not in desired.
+func diffIndicators(current, desired []iocFeedIndicatorModel) (added, removed []iocFeedIndicatorModel) {
+	currentKeys := make(map[string]struct{}, len(current))
+	for _, indicator := range current {
+		currentKeys[indicator.key()] = struct{}{}
+	}
+
+	desiredKeys := make(map[string]struct{}, len(desired))
+	for _, indicator := range desired {
+		desiredKeys[indicator.key()] = struct{}{}
+		if _, ok := currentKeys[indicator.key()]; !ok {
+			added = append(added, indicator)
+		}
+	}
+
+	for _, indicator := range current {
+		if _, ok := desiredKeys[indicator.key()]; !ok {
+			removed = append(removed, indicator)
+		}
+	}
+
+	return added, removed
+}
+
+// iocSourceFilter returns the FQL filter matching every indicator in the feed.
+func iocSourceFilter(source string) string {
+	return "source:" + utils.FQLQuote(source)
+}
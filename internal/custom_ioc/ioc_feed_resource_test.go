@@ -0,0 +1,72 @@
+package customioc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func testAccIOCFeedConfig(source, severity string, domains []string) string {
+	indicators := ""
+	for _, d := range domains {
+		indicators += fmt.Sprintf("    { type = \"domain\", value = %q },\n", d)
+	}
+
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_ioc_feed" "test" {
+  source    = %q
+  action    = "detect"
+  severity  = %q
+  platforms = ["windows", "mac", "linux"]
+  tags      = ["terraform"]
+
+  indicators = [
+%s  ]
+}
+`, source, severity, indicators)
+}
+
+func TestAccIOCFeedResource_basic(t *testing.T) {
+	resourceName := "crowdstrike_ioc_feed.test"
+	source := acctest.RandomResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIOCFeedConfig(source, "medium", []string{
+					source + "-1.example.com",
+					source + "-2.example.com",
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("id"), knownvalue.StringExact(source)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("severity"), knownvalue.StringExact("medium")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("indicators"), knownvalue.SetSizeExact(2)),
+				},
+			},
+			{
+				Config: testAccIOCFeedConfig(source, "high", []string{
+					source + "-2.example.com",
+					source + "-3.example.com",
+					source + "-4.example.com",
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("severity"), knownvalue.StringExact("high")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("indicators"), knownvalue.SetSizeExact(3)),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
@@ -0,0 +1,73 @@
+package customioc
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDiffIndicators(t *testing.T) {
+	indicator := func(typ, value string) iocFeedIndicatorModel {
+		return iocFeedIndicatorModel{Type: types.StringValue(typ), Value: types.StringValue(value)}
+	}
+
+	keys := func(indicators []iocFeedIndicatorModel) []string {
+		var k []string
+		for _, i := range indicators {
+			k = append(k, i.key())
+		}
+		return k
+	}
+
+	tests := []struct {
+		name        string
+		current     []iocFeedIndicatorModel
+		desired     []iocFeedIndicatorModel
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		{
+			name:      "create",
+			desired:   []iocFeedIndicatorModel{indicator("domain", "example.com")},
+			wantAdded: []string{"domain:example.com"},
+		},
+		{
+			name:    "no changes",
+			current: []iocFeedIndicatorModel{indicator("domain", "example.com")},
+			desired: []iocFeedIndicatorModel{indicator("domain", "example.com")},
+		},
+		{
+			name: "add and remove",
+			current: []iocFeedIndicatorModel{
+				indicator("domain", "example.com"),
+				indicator("ipv4", "192.0.2.1"),
+			},
+			desired: []iocFeedIndicatorModel{
+				indicator("domain", "example.com"),
+				indicator("ipv4", "192.0.2.2"),
+			},
+			wantAdded:   []string{"ipv4:192.0.2.2"},
+			wantRemoved: []string{"ipv4:192.0.2.1"},
+		},
+		{
+			name:        "same value with a different type",
+			current:     []iocFeedIndicatorModel{indicator("md5", "d41d8cd98f00b204e9800998ecf8427e")},
+			desired:     []iocFeedIndicatorModel{indicator("sha256", "d41d8cd98f00b204e9800998ecf8427e")},
+			wantAdded:   []string{"sha256:d41d8cd98f00b204e9800998ecf8427e"},
+			wantRemoved: []string{"md5:d41d8cd98f00b204e9800998ecf8427e"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := diffIndicators(tt.current, tt.desired)
+			if got := keys(added); !slices.Equal(got, tt.wantAdded) {
+				t.Errorf("added = %v, want %v", got, tt.wantAdded)
+			}
+			if got := keys(removed); !slices.Equal(got, tt.wantRemoved) {
+				t.Errorf("removed = %v, want %v", got, tt.wantRemoved)
+			}
+		})
+	}
+}
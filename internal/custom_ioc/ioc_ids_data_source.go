@@ -0,0 +1,91 @@
+package customioc
+
+import (
+	"context"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/ioc"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/ids"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// NewIOCIDsDataSource is a helper function to simplify the provider implementation.
+func NewIOCIDsDataSource() datasource.DataSource {
+	return ids.NewDataSource(ids.Options{
+		TypeName: "_ioc_ids",
+		Section:  "IOC Management",
+		Objects:  "indicators",
+		Description: "This data source returns the IDs of all custom indicators of compromise (IOCs) matching an optional FQL filter. " +
+			"Indicators are adopted in feeds by importing a `crowdstrike_ioc_feed` resource with their `source`, so filter on `source` to review the indicators a feed import would take over.",
+		Example:      "source:'threat-intel'",
+		SortExample:  "modified_on.desc",
+		DefaultLimit: iocQueryLimit,
+		MaxLimit:     iocQueryLimit,
+		Scopes:       apiScopesRead,
+		Query:        queryIOCIDs,
+	})
+}
+
+// queryIOCIDs returns the function fetching the pages of IDs of the
+// indicators matching filter. Indicators are paginated with an after token, as
+// offsets cannot go past 10,000 indicators.
+func queryIOCIDs(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	filter *string,
+	sort *string,
+) utils.QueryPage[string] {
+	var after *string
+
+	return func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		params := ioc.NewIndicatorSearchV1Params()
+		params.Context = ctx
+		params.Filter = filter
+		params.Sort = sort
+		params.Limit = &limit
+		params.After = after
+
+		res, err := retryOnRateLimit(ctx, func() (*ioc.IndicatorSearchV1OK, error) {
+			return client.Ioc.IndicatorSearchV1(params)
+		})
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesRead))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
+		}
+
+		page := res.Payload.Resources
+
+		var paging *models.APIIndicatorsQueryPaging
+		if res.Payload.Meta != nil {
+			paging = res.Payload.Meta.Pagination
+		}
+		if paging == nil || paging.After == "" {
+			return page, offset + int64(len(page)), diags
+		}
+
+		after = &paging.After
+
+		total := int64(-1)
+		if paging.Total != nil {
+			total = *paging.Total
+		}
+
+		return page, total, diags
+	}
+}
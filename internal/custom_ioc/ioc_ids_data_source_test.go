@@ -0,0 +1,57 @@
+package customioc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccIOCIDsDataSource(t *testing.T) {
+	dataSourceName := "data.crowdstrike_ioc_ids.test"
+	source := acctest.RandomResourceName()
+
+	var domains []string
+	for i := range 5 {
+		domains = append(domains, fmt.Sprintf("%s-%d.example.com", source, i))
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// A page size of 2 pages through the indicators with the after token.
+				Config: testAccIOCFeedConfig(source, "medium", domains) + fmt.Sprintf(`
+data "crowdstrike_ioc_ids" "test" {
+  filter = "source:'%s'"
+  limit  = 2
+
+  depends_on = [crowdstrike_ioc_feed.test]
+}
+`, source),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("ids"), knownvalue.ListSizeExact(5)),
+				},
+			},
+			{
+				Config: testAccIOCFeedConfig(source, "medium", domains) + fmt.Sprintf(`
+data "crowdstrike_ioc_ids" "test" {
+  filter    = "source:'%s'"
+  limit     = 2
+  max_items = 3
+
+  depends_on = [crowdstrike_ioc_feed.test]
+}
+`, source),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("ids"), knownvalue.ListSizeExact(3)),
+				},
+			},
+		},
+	})
+}
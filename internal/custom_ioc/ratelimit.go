@@ -0,0 +1,77 @@
+package customioc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/crowdstrike/gofalcon/falcon/client/ioc"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/transport"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// maxRateLimitRetries is the number of times a rate limited request is retried before giving up.
+	maxRateLimitRetries = 10
+	// minRateLimitWait is the shortest time waited before retrying a rate limited request.
+	minRateLimitWait = time.Second
+	// maxRateLimitWait is the longest time waited before retrying a rate limited request.
+	maxRateLimitWait = time.Minute
+)
+
+// retryOnRateLimit calls fn until it succeeds or fails with an error other than
+// HTTP 429, waiting until the time advertised by the API between attempts.
+func retryOnRateLimit[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := fn()
+
+		wait, limited := rateLimitWait(err, time.Now())
+		if !limited || attempt >= maxRateLimitRetries {
+			return res, err
+		}
+
+		tflog.Warn(ctx, "IOC API rate limit reached, waiting before retrying", map[string]any{
+			"attempt": attempt,
+			"wait":    wait.String(),
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return res, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rateLimitWait reports whether err is a rate limit response from the IOC API
+// and, if so, how long to wait from now before retrying.
+func rateLimitWait(err error, now time.Time) (time.Duration, bool) {
+	if err == nil {
+		return 0, false
+	}
+
+	var retryAfter int64
+
+	var createErr *ioc.IndicatorCreateV1TooManyRequests
+	var updateErr *ioc.IndicatorUpdateV1TooManyRequests
+	var deleteErr *ioc.IndicatorDeleteV1TooManyRequests
+	var combinedErr *ioc.IndicatorCombinedV1TooManyRequests
+
+	switch {
+	case errors.As(err, &createErr):
+		retryAfter = createErr.XRateLimitRetryAfter
+	case errors.As(err, &updateErr):
+		retryAfter = updateErr.XRateLimitRetryAfter
+	case errors.As(err, &deleteErr):
+		retryAfter = deleteErr.XRateLimitRetryAfter
+	case errors.As(err, &combinedErr):
+		retryAfter = combinedErr.XRateLimitRetryAfter
+	default:
+		return 0, false
+	}
+
+	wait := transport.RateLimitResetTime(retryAfter).Sub(now)
+	return min(max(wait, minRateLimitWait), maxRateLimitWait), true
+}
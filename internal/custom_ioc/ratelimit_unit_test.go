@@ -0,0 +1,98 @@
+package customioc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/crowdstrike/gofalcon/falcon/client/ioc"
+)
+
+func TestRateLimitWait(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+
+	tests := []struct {
+		name        string
+		err         error
+		wantWait    time.Duration
+		wantLimited bool
+	}{
+		{
+			name: "no error",
+		},
+		{
+			name: "other error",
+			err:  errors.New("boom"),
+		},
+		{
+			name:        "create rate limited",
+			err:         &ioc.IndicatorCreateV1TooManyRequests{XRateLimitRetryAfter: now.Add(5 * time.Second).UnixMilli()},
+			wantWait:    5 * time.Second,
+			wantLimited: true,
+		},
+		{
+			name:        "rate limit reset in seconds",
+			err:         &ioc.IndicatorCreateV1TooManyRequests{XRateLimitRetryAfter: now.Add(5 * time.Second).Unix()},
+			wantWait:    5 * time.Second,
+			wantLimited: true,
+		},
+		{
+			name:        "wrapped delete rate limited",
+			err:         fmt.Errorf("deleting: %w", &ioc.IndicatorDeleteV1TooManyRequests{XRateLimitRetryAfter: now.Add(10 * time.Second).UnixMilli()}),
+			wantWait:    10 * time.Second,
+			wantLimited: true,
+		},
+		{
+			name:        "retry after in the past",
+			err:         &ioc.IndicatorUpdateV1TooManyRequests{XRateLimitRetryAfter: now.Add(-time.Second).UnixMilli()},
+			wantWait:    minRateLimitWait,
+			wantLimited: true,
+		},
+		{
+			name:        "retry after too far in the future",
+			err:         &ioc.IndicatorCombinedV1TooManyRequests{XRateLimitRetryAfter: now.Add(time.Hour).UnixMilli()},
+			wantWait:    maxRateLimitWait,
+			wantLimited: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, limited := rateLimitWait(tt.err, now)
+			if limited != tt.wantLimited {
+				t.Fatalf("limited = %v, want %v", limited, tt.wantLimited)
+			}
+			if wait != tt.wantWait {
+				t.Errorf("wait = %s, want %s", wait, tt.wantWait)
+			}
+		})
+	}
+}
+
+func TestRetryOnRateLimit(t *testing.T) {
+	calls := 0
+	res, err := retryOnRateLimit(context.Background(), func() (int, error) {
+		calls++
+		if calls == 1 {
+			return 0, &ioc.IndicatorCreateV1TooManyRequests{}
+		}
+		return 42, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res != 42 || calls != 2 {
+		t.Errorf("got result %d after %d calls, want 42 after 2 calls", res, calls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = retryOnRateLimit(ctx, func() (int, error) {
+		return 0, &ioc.IndicatorCreateV1TooManyRequests{}
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,18 @@
+package customioc
+
+import "github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+
+var apiScopesReadWrite = []scopes.Scope{
+	{
+		Name:  "IOC Management",
+		Read:  true,
+		Write: true,
+	},
+}
+
+var apiScopesRead = []scopes.Scope{
+	{
+		Name: "IOC Management",
+		Read: true,
+	},
+}
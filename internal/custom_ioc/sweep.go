@@ -0,0 +1,69 @@
+package customioc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/ioc"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/sweep"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+)
+
+func RegisterSweepers() {
+	sweep.Register("crowdstrike_ioc_feed", sweepIOCFeeds)
+}
+
+func sweepIOCFeeds(ctx context.Context, client *client.CrowdStrikeAPISpecification) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	params := ioc.NewIndicatorCombinedV1Params()
+	params.WithContext(ctx)
+	params.Filter = utils.Addr(fmt.Sprintf("source:'%s*'", sweep.ResourcePrefix))
+	params.Limit = utils.Addr(int64(iocQueryLimit))
+
+	res, err := client.Ioc.IndicatorCombinedV1(params)
+	if sweep.SkipSweepError(err) {
+		sweep.Warn("Skipping IOC feed sweep: %s", err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error listing IOC feeds: %w", err)
+	}
+
+	if res.Payload == nil {
+		return sweepables, nil
+	}
+
+	sources := make([]string, 0, len(res.Payload.Resources))
+	for _, indicator := range res.Payload.Resources {
+		if indicator != nil {
+			sources = append(sources, indicator.Source)
+		}
+	}
+
+	for _, source := range flex.Unique(sources) {
+		sweepables = append(sweepables, sweep.NewSweepResource(source, source, deleteIOCFeed))
+	}
+
+	return sweepables, nil
+}
+
+func deleteIOCFeed(ctx context.Context, client *client.CrowdStrikeAPISpecification, source string) error {
+	params := ioc.NewIndicatorDeleteV1Params()
+	params.WithContext(ctx)
+	params.Filter = utils.Addr(iocSourceFilter(source))
+	params.Comment = utils.Addr(iocComment)
+
+	_, err := client.Ioc.IndicatorDeleteV1(params)
+	if err != nil {
+		if sweep.ShouldIgnoreError(err) {
+			sweep.Debug("Ignoring error for IOC feed %s: %s", source, err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                   = &dataProtectionContentPatternResource{}
 	_ resource.ResourceWithConfigure      = &dataProtectionContentPatternResource{}
 	_ resource.ResourceWithImportState    = &dataProtectionContentPatternResource{}
+	_ resource.ResourceWithIdentity       = &dataProtectionContentPatternResource{}
 	_ resource.ResourceWithValidateConfig = &dataProtectionContentPatternResource{}
 )
 
@@ -44,12 +46,13 @@ type dataProtectionContentPatternResource struct {
 }
 
 type dataProtectionContentPatternResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	LastUpdated       types.String `tfsdk:"last_updated"`
-	Name              types.String `tfsdk:"name"`
-	Description       types.String `tfsdk:"description"`
-	Regex             types.String `tfsdk:"regex"`
-	MinMatchThreshold types.Int32  `tfsdk:"min_match_threshold"`
+	ID                types.String   `tfsdk:"id"`
+	LastUpdated       types.String   `tfsdk:"last_updated"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	Regex             types.String   `tfsdk:"regex"`
+	MinMatchThreshold types.Int32    `tfsdk:"min_match_threshold"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *dataProtectionContentPatternResource) Metadata(
@@ -79,10 +82,11 @@ func (r *dataProtectionContentPatternResource) Configure(
 	}
 
 	r.client = providerConfig.Client
+	resp.Diagnostics.Append(providerConfig.CheckScopes(contentPatternResourceRequiredScopes)...)
 }
 
 func (r *dataProtectionContentPatternResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -152,9 +156,21 @@ func (r *dataProtectionContentPatternResource) Schema(
 				Description: "Timestamp of the last Terraform update of the resource.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *dataProtectionContentPatternResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 func (r *dataProtectionContentPatternResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -166,6 +182,9 @@ func (r *dataProtectionContentPatternResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	createRequest := &models.APIContentPatternCreateRequestV1{
 		Name:              plan.Name.ValueString(),
 		Description:       flex.FrameworkToStringPointer(plan.Description),
@@ -202,6 +221,7 @@ func (r *dataProtectionContentPatternResource) Create(
 	plan.LastUpdated = utils.GenerateUpdateTimestamp()
 	plan.wrap(*res.Payload.Resources[0])
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *dataProtectionContentPatternResource) Read(
@@ -215,6 +235,9 @@ func (r *dataProtectionContentPatternResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	params := data_protection_configuration.NewEntitiesContentPatternGetParams().
 		WithContext(ctx).
 		WithIds([]string{state.ID.ValueString()})
@@ -248,6 +271,7 @@ func (r *dataProtectionContentPatternResource) Read(
 
 	state.wrap(*res.Payload.Resources[0])
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *dataProtectionContentPatternResource) Update(
@@ -261,6 +285,9 @@ func (r *dataProtectionContentPatternResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	updateRequest := &models.APIContentPatternUpdateRequestV1{
 		ID:                utils.Addr(plan.ID.ValueString()),
 		Name:              plan.Name.ValueStringPointer(),
@@ -297,6 +324,7 @@ func (r *dataProtectionContentPatternResource) Update(
 	plan.LastUpdated = utils.GenerateUpdateTimestamp()
 	plan.wrap(*res.Payload.Resources[0])
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *dataProtectionContentPatternResource) Delete(
@@ -310,6 +338,9 @@ func (r *dataProtectionContentPatternResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	params := data_protection_configuration.NewEntitiesContentPatternDeleteParams().
 		WithContext(ctx).
 		WithIds([]string{state.ID.ValueString()})
@@ -334,7 +365,7 @@ func (r *dataProtectionContentPatternResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *dataProtectionContentPatternResource) ValidateConfig(
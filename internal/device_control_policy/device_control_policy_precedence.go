@@ -0,0 +1,72 @@
+package devicecontrolpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/device_control_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/precedence"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewDeviceControlPolicyPrecedenceResource() resource.Resource {
+	return precedence.NewResource(precedence.Policies{
+		TypeName:             "_device_control_policy_precedence",
+		Kind:                 "device control",
+		DocumentationSection: "Host Setup and Management",
+		MarkdownDescription:  "This resource allows you set the precedence of Device Control Policies based on the order of IDs.",
+		Scopes:               apiScopesReadWrite,
+		List:                 getDeviceControlPoliciesByPrecedence,
+		Set:                  setDeviceControlPoliciesPrecedence,
+	})
+}
+
+// getDeviceControlPoliciesByPrecedence returns device control policy ids ordered by precedence.
+func getDeviceControlPoliciesByPrecedence(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	platformName string,
+) ([]string, error) {
+	filter := fmt.Sprintf("platform_name:'%s'", platformName)
+	sort := "precedence.asc"
+	res, err := client.DeviceControlPolicies.QueryCombinedDeviceControlPolicies(
+		&device_control_policies.QueryCombinedDeviceControlPoliciesParams{
+			Context: ctx,
+			Filter:  &filter,
+			Sort:    &sort,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []string
+	if res != nil && res.Payload != nil {
+		for _, policy := range res.Payload.Resources {
+			policies = append(policies, *policy.ID)
+		}
+	}
+
+	return policies, nil
+}
+
+// setDeviceControlPoliciesPrecedence sets the precedence of the device control policies.
+func setDeviceControlPoliciesPrecedence(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	platformName string,
+	policyIDs []string,
+) error {
+	_, err := client.DeviceControlPolicies.SetDeviceControlPoliciesPrecedence(
+		&device_control_policies.SetDeviceControlPoliciesPrecedenceParams{
+			Context: ctx,
+			Body: &models.BaseSetPolicyPrecedenceReqV1{
+				Ids:          policyIDs,
+				PlatformName: &platformName,
+			},
+		},
+	)
+	return err
+}
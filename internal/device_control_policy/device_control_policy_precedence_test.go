@@ -0,0 +1,170 @@
+package devicecontrolpolicy_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/crowdstrike/gofalcon/falcon/client/device_control_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/testconfig"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const precedenceResourceName = "crowdstrike_device_control_policy_precedence.test"
+
+func TestAccDeviceControlPolicyPrecedenceResource_dynamic(t *testing.T) {
+	policyIDs := createDeviceControlPolicies(t, 2)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceControlPolicyPrecedenceConfig("dynamic", policyIDs),
+				Check:  testAccDeviceControlPolicyPrecedenceChecks("dynamic", policyIDs),
+			},
+			{
+				Config: testAccDeviceControlPolicyPrecedenceConfig("dynamic", []string{policyIDs[1], policyIDs[0]}),
+				Check:  testAccDeviceControlPolicyPrecedenceChecks("dynamic", []string{policyIDs[1], policyIDs[0]}),
+			},
+		},
+	})
+}
+
+func TestAccDeviceControlPolicyPrecedenceResource_strict(t *testing.T) {
+	policyIDs := createDeviceControlPolicies(t, 2)
+	existingPolicyIDs := getOtherDeviceControlPolicyIDs(t, policyIDs)
+
+	initial := append(slices.Clone(policyIDs), existingPolicyIDs...)
+	reordered := append([]string{policyIDs[1], policyIDs[0]}, existingPolicyIDs...)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceControlPolicyPrecedenceConfig("strict", initial),
+				Check:  testAccDeviceControlPolicyPrecedenceChecks("strict", initial),
+			},
+			{
+				Config: testAccDeviceControlPolicyPrecedenceConfig("strict", reordered),
+				Check:  testAccDeviceControlPolicyPrecedenceChecks("strict", reordered),
+			},
+		},
+	})
+}
+
+func testAccDeviceControlPolicyPrecedenceConfig(enforcement string, policyIDs []string) string {
+	ids := make([]string, len(policyIDs))
+	for i, id := range policyIDs {
+		ids[i] = fmt.Sprintf("%q", id)
+	}
+
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_device_control_policy_precedence" "test" {
+  platform_name = "Windows"
+  enforcement   = %q
+  ids           = [%s]
+}
+`, enforcement, strings.Join(ids, ", "))
+}
+
+func testAccDeviceControlPolicyPrecedenceChecks(enforcement string, policyIDs []string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrSet(precedenceResourceName, "last_updated"),
+		resource.TestCheckResourceAttr(precedenceResourceName, "platform_name", "Windows"),
+		resource.TestCheckResourceAttr(precedenceResourceName, "enforcement", enforcement),
+		resource.TestCheckResourceAttr(precedenceResourceName, "ids.#", fmt.Sprintf("%d", len(policyIDs))),
+	}
+	for i, id := range policyIDs {
+		checks = append(checks, resource.TestCheckResourceAttr(precedenceResourceName, fmt.Sprintf("ids.%d", i), id))
+	}
+
+	return resource.ComposeAggregateTestCheckFunc(checks...)
+}
+
+// createDeviceControlPolicies creates count Windows device control policies,
+// which are deleted when the test finishes, and returns their IDs. There is no
+// device control policy resource to create them with.
+func createDeviceControlPolicies(t *testing.T, count int) []string {
+	t.Helper()
+
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skip("Skipping acceptance test: TF_ACC not set")
+	}
+	acctest.PreCheck(t)
+	client := testconfig.GetTestClient()
+
+	platformName := "Windows"
+	var policies []*models.DeviceControlCreatePolicyReqV1
+	for range count {
+		name := acctest.RandomResourceName()
+		policies = append(policies, &models.DeviceControlCreatePolicyReqV1{
+			Name:         &name,
+			PlatformName: &platformName,
+		})
+	}
+
+	res, err := client.DeviceControlPolicies.CreateDeviceControlPolicies(
+		&device_control_policies.CreateDeviceControlPoliciesParams{
+			Context: t.Context(),
+			Body:    &models.DeviceControlCreatePoliciesV1{Resources: policies},
+		},
+	)
+	if err != nil {
+		t.Fatalf("failed to create device control policies: %v", err)
+	}
+
+	var policyIDs []string
+	for _, policy := range res.Payload.Resources {
+		policyIDs = append(policyIDs, *policy.ID)
+	}
+
+	t.Cleanup(func() {
+		_, err := client.DeviceControlPolicies.DeleteDeviceControlPolicies(
+			&device_control_policies.DeleteDeviceControlPoliciesParams{
+				Context: context.Background(),
+				Ids:     policyIDs,
+			},
+		)
+		if err != nil {
+			t.Logf("failed to delete device control policies %v: %v", policyIDs, err)
+		}
+	})
+
+	return policyIDs
+}
+
+// getOtherDeviceControlPolicyIDs returns the IDs of the non-default Windows
+// device control policies not in policyIDs, ordered by precedence.
+func getOtherDeviceControlPolicyIDs(t *testing.T, policyIDs []string) []string {
+	t.Helper()
+
+	filter := "platform_name:'Windows'"
+	sort := "precedence.asc"
+	res, err := testconfig.GetTestClient().DeviceControlPolicies.QueryCombinedDeviceControlPolicies(
+		&device_control_policies.QueryCombinedDeviceControlPoliciesParams{
+			Context: t.Context(),
+			Filter:  &filter,
+			Sort:    &sort,
+		},
+	)
+	if err != nil {
+		t.Fatalf("failed to query device control policies: %v", err)
+	}
+
+	var otherPolicyIDs []string
+	policies := res.Payload.Resources
+	for _, policy := range policies[:len(policies)-1] {
+		if !slices.Contains(policyIDs, *policy.ID) {
+			otherPolicyIDs = append(otherPolicyIDs, *policy.ID)
+		}
+	}
+
+	return otherPolicyIDs
+}
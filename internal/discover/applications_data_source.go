@@ -0,0 +1,426 @@
+package discover
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/discover"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// applicationsQueryLimit is the largest page size used when querying applications.
+const applicationsQueryLimit = 1000
+
+var (
+	_ datasource.DataSource              = &applicationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &applicationsDataSource{}
+)
+
+func NewApplicationsDataSource() datasource.DataSource {
+	return &applicationsDataSource{}
+}
+
+type applicationsDataSource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type applicationsDataSourceModel struct {
+	Vendor          types.String `tfsdk:"vendor"`
+	Name            types.String `tfsdk:"name"`
+	Version         types.String `tfsdk:"version"`
+	Filter          types.String `tfsdk:"filter"`
+	IncludeHostInfo types.Bool   `tfsdk:"include_host_info"`
+	Sort            types.String `tfsdk:"sort"`
+	Limit           types.Int64  `tfsdk:"limit"`
+	MaxItems        types.Int64  `tfsdk:"max_items"`
+	Applications    types.List   `tfsdk:"applications"`
+}
+
+type applicationModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Vendor                types.String `tfsdk:"vendor"`
+	Name                  types.String `tfsdk:"name"`
+	Version               types.String `tfsdk:"version"`
+	Category              types.String `tfsdk:"category"`
+	SoftwareType          types.String `tfsdk:"software_type"`
+	IsSuspicious          types.Bool   `tfsdk:"is_suspicious"`
+	InstallationPaths     types.List   `tfsdk:"installation_paths"`
+	FirstSeenTimestamp    types.String `tfsdk:"first_seen_timestamp"`
+	InstallationTimestamp types.String `tfsdk:"installation_timestamp"`
+	LastUsedTimestamp     types.String `tfsdk:"last_used_timestamp"`
+	LastUsedUserName      types.String `tfsdk:"last_used_user_name"`
+	HostID                types.String `tfsdk:"host_id"`
+	Hostname              types.String `tfsdk:"hostname"`
+	PlatformName          types.String `tfsdk:"platform_name"`
+}
+
+func (m applicationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                     types.StringType,
+		"vendor":                 types.StringType,
+		"name":                   types.StringType,
+		"version":                types.StringType,
+		"category":               types.StringType,
+		"software_type":          types.StringType,
+		"is_suspicious":          types.BoolType,
+		"installation_paths":     types.ListType{ElemType: types.StringType},
+		"first_seen_timestamp":   types.StringType,
+		"installation_timestamp": types.StringType,
+		"last_used_timestamp":    types.StringType,
+		"last_used_user_name":    types.StringType,
+		"host_id":                types.StringType,
+		"hostname":               types.StringType,
+		"platform_name":          types.StringType,
+	}
+}
+
+func (d *applicationsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesRead)...)
+}
+
+func (d *applicationsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_discover_applications"
+}
+
+func (d *applicationsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Falcon Discover",
+			"This data source retrieves the applications discovered on hosts by Falcon Discover. Each element of `applications` is one installation of an application on a host. "+
+				"All filters are combined using logical AND and accept wildcards `*`. For advanced queries use `filter` with a "+
+				"[Falcon Query Language (FQL)](https://falcon.crowdstrike.com/documentation/page/d3c84a1b/falcon-query-language-fql) expression.",
+			apiScopesRead,
+		),
+		Attributes: map[string]schema.Attribute{
+			"vendor": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return applications from this vendor. Examples: `Microsoft Corporation`, `Google*`",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return applications with this name. Examples: `Google Chrome`, `Python 3*`",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return applications with this version. Examples: `124.0.6367.91`, `3.11.*`",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An additional FQL filter, for example `host.platform_name:'Windows'+is_suspicious:true`.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"include_host_info": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Populate `host_id`, `hostname` and `platform_name` for each application. Defaults to `false`.",
+			},
+			"sort": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The sort order of the applications, as `<field>|asc` or `<field>|desc`, for example `name|asc`.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"limit":     utils.PageLimitAttribute("applications", applicationsQueryLimit, applicationsQueryLimit),
+			"max_items": utils.MaxItemsAttribute("applications"),
+			"applications": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The applications matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the application installation.",
+						},
+						"vendor": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The vendor of the application.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the application.",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version of the application.",
+						},
+						"category": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The category of the application.",
+						},
+						"software_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of software, such as an application or browser extension.",
+						},
+						"is_suspicious": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether Falcon Discover flagged the application as suspicious.",
+						},
+						"installation_paths": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The paths the application is installed in.",
+						},
+						"first_seen_timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the application was first seen.",
+						},
+						"installation_timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the application was installed.",
+						},
+						"last_used_timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the application was last used.",
+						},
+						"last_used_user_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user that last used the application.",
+						},
+						"host_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the host the application is installed on. Only set when `include_host_info` is `true`.",
+						},
+						"hostname": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The hostname of the host the application is installed on. Only set when `include_host_info` is `true`.",
+						},
+						"platform_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The platform of the host the application is installed on. Only set when `include_host_info` is `true`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *applicationsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data applicationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, applicationsQueryLimit)
+
+	filter := buildApplicationsFilter(
+		data.Vendor.ValueString(),
+		data.Name.ValueString(),
+		data.Version.ValueString(),
+		data.Filter.ValueString(),
+	)
+
+	applications, total, diags := d.queryApplications(
+		ctx,
+		filter,
+		data.Sort.ValueString(),
+		data.IncludeHostInfo.ValueBool(),
+		pagination,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("applications", len(applications), total)...)
+
+	resp.Diagnostics.Append(data.wrap(ctx, applications)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildApplicationsFilter combines the data source filters into a single FQL expression.
+// FQL requires a filter, so an empty result matches every application.
+func buildApplicationsFilter(vendor, name, version, filter string) string {
+	var parts []string
+
+	if vendor != "" {
+		parts = append(parts, "vendor:"+utils.FQLQuote(vendor))
+	}
+	if name != "" {
+		parts = append(parts, "name:"+utils.FQLQuote(name))
+	}
+	if version != "" {
+		parts = append(parts, "version:"+utils.FQLQuote(version))
+	}
+	if filter != "" {
+		parts = append(parts, "("+filter+")")
+	}
+
+	if len(parts) == 0 {
+		return "name:'*'"
+	}
+
+	return strings.Join(parts, "+")
+}
+
+// queryApplications returns up to pagination.MaxItems applications matching
+// filter in the order of sort, and the total number of matching applications.
+func (d *applicationsDataSource) queryApplications(
+	ctx context.Context,
+	filter string,
+	sort string,
+	includeHostInfo bool,
+	pagination utils.Pagination,
+) ([]*models.DomainDiscoverAPIApplication, int64, diag.Diagnostics) {
+	var after *string
+
+	applications, total, diags := utils.QueryPages(pagination, func(offset, limit int64) ([]*models.DomainDiscoverAPIApplication, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		params := discover.NewCombinedApplicationsParams()
+		params.Context = ctx
+		params.Filter = filter
+		params.Limit = utils.Addr(limit)
+		params.After = after
+		if sort != "" {
+			params.Sort = &sort
+		}
+		if includeHostInfo {
+			params.Facet = []string{"host_info"}
+		}
+
+		res, err := d.client.Discover.CombinedApplications(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesRead))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
+		}
+
+		page := res.Payload.Resources
+
+		var pagination *models.DomainDiscoverAPIPaging
+		if res.Payload.Meta != nil {
+			pagination = res.Payload.Meta.Pagination
+		}
+		if pagination == nil || pagination.After == nil || *pagination.After == "" {
+			return page, offset + int64(len(page)), diags
+		}
+
+		after = pagination.After
+
+		total := int64(-1)
+		if pagination.Total != nil {
+			total = *pagination.Total
+		}
+
+		return page, total, diags
+	})
+
+	return slices.DeleteFunc(applications, func(app *models.DomainDiscoverAPIApplication) bool { return app == nil }), total, diags
+}
+
+// wrap transforms the API response into the data source model.
+func (m *applicationsDataSourceModel) wrap(
+	ctx context.Context,
+	applications []*models.DomainDiscoverAPIApplication,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	appModels := make([]applicationModel, 0, len(applications))
+	for _, app := range applications {
+		am := applicationModel{
+			ID:                    flex.StringPointerToFramework(app.ID),
+			Vendor:                flex.StringValueToFramework(app.Vendor),
+			Name:                  flex.StringValueToFramework(app.Name),
+			Version:               flex.StringValueToFramework(app.Version),
+			Category:              flex.StringValueToFramework(app.Category),
+			SoftwareType:          flex.StringValueToFramework(app.SoftwareType),
+			IsSuspicious:          types.BoolValue(app.IsSuspicious),
+			FirstSeenTimestamp:    flex.StringValueToFramework(app.FirstSeenTimestamp),
+			InstallationTimestamp: flex.StringValueToFramework(app.InstallationTimestamp),
+			LastUsedTimestamp:     flex.StringValueToFramework(app.LastUsedTimestamp),
+			LastUsedUserName:      flex.StringValueToFramework(app.LastUsedUserName),
+			HostID:                types.StringNull(),
+			Hostname:              types.StringNull(),
+			PlatformName:          types.StringNull(),
+		}
+
+		am.InstallationPaths = utils.SliceToListTypeString(ctx, app.InstallationPaths, &diags)
+
+		if app.Host != nil {
+			am.HostID = flex.StringPointerToFramework(app.Host.ID)
+			am.Hostname = flex.StringValueToFramework(app.Host.Hostname)
+			am.PlatformName = flex.StringValueToFramework(app.Host.PlatformName)
+		}
+
+		appModels = append(appModels, am)
+	}
+
+	m.Applications = utils.SliceToListTypeObject(ctx, appModels, applicationModel{}.AttributeTypes(), &diags)
+
+	return diags
+}
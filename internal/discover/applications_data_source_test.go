@@ -0,0 +1,57 @@
+package discover_test
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDiscoverApplicationsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.crowdstrike_discover_applications.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_discover_applications" "test" {
+  name              = "*"
+  include_host_info = true
+  max_items         = 5
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("applications"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_discover_applications" "test" {
+  name      = "*"
+  sort      = "name|asc"
+  limit     = 2
+  max_items = 5
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("applications"), knownvalue.ListSizeExact(5)),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_discover_applications" "test" {
+  vendor = "tf-acc-test-does-not-exist"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("applications"), knownvalue.ListSizeExact(0)),
+				},
+			},
+		},
+	})
+}
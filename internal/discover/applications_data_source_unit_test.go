@@ -0,0 +1,41 @@
+package discover
+
+import "testing"
+
+func TestBuildApplicationsFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		vendor  string
+		appName string
+		version string
+		filter  string
+		want    string
+	}{
+		{
+			name: "no filters matches everything",
+			want: "name:'*'",
+		},
+		{
+			name:   "vendor only",
+			vendor: "Google*",
+			want:   "vendor:'Google*'",
+		},
+		{
+			name:    "all filters",
+			vendor:  "Python Software Foundation",
+			appName: "Python 3*",
+			version: "3.11.*",
+			filter:  "host.platform_name:'Windows'",
+			want:    "vendor:'Python Software Foundation'+name:'Python 3*'+version:'3.11.*'+(host.platform_name:'Windows')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildApplicationsFilter(tt.vendor, tt.appName, tt.version, tt.filter)
+			if got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package discover
+
+import "github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+
+var apiScopesRead = []scopes.Scope{
+	{
+		Name:  "Assets",
+		Read:  true,
+		Write: false,
+	},
+}
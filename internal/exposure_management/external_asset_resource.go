@@ -0,0 +1,470 @@
+package exposuremanagement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/exposure_management"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// externalAssetDeleteDescription is recorded as the reason when an external asset is deleted.
+const externalAssetDeleteDescription = "Removed by Terraform"
+
+var (
+	_ resource.Resource                = &externalAssetResource{}
+	_ resource.ResourceWithConfigure   = &externalAssetResource{}
+	_ resource.ResourceWithImportState = &externalAssetResource{}
+	_ resource.ResourceWithIdentity    = &externalAssetResource{}
+)
+
+func NewExternalAssetResource() resource.Resource {
+	return &externalAssetResource{}
+}
+
+type externalAssetResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type externalAssetResourceModel struct {
+	ID                     types.String   `tfsdk:"id"`
+	Value                  types.String   `tfsdk:"value"`
+	SubsidiaryID           types.String   `tfsdk:"subsidiary_id"`
+	Criticality            types.String   `tfsdk:"criticality"`
+	CriticalityDescription types.String   `tfsdk:"criticality_description"`
+	AssetType              types.String   `tfsdk:"asset_type"`
+	LastUpdated            types.String   `tfsdk:"last_updated"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+// wrap transforms the API response into the resource model.
+func (m *externalAssetResourceModel) wrap(asset *models.DomainFemExternalAsset) {
+	m.ID = flex.StringPointerToFramework(asset.ID)
+	m.AssetType = flex.StringPointerToFramework(asset.AssetType)
+	m.Criticality = flex.StringValueToFramework(asset.Criticality)
+	m.CriticalityDescription = flex.StringValueToFramework(asset.CriticalityDescription)
+}
+
+func (r *externalAssetResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
+}
+
+func (r *externalAssetResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_external_asset"
+}
+
+func (r *externalAssetResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Falcon Exposure Management",
+			"This resource adds a domain or IP address to the Falcon Exposure Management external asset inventory so it is included in external attack surface scans. "+
+				"Scan cadence is managed by CrowdStrike and cannot be configured through the API.",
+			apiScopesReadWrite,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the external asset.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The domain or IP address to scan. Changing this value replaces the asset.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"subsidiary_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the subsidiary the asset belongs to. Changing this value replaces the asset.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"criticality": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The criticality assigned to the asset. When not set, the criticality assigned in the Falcon console is kept.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"criticality_description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The reason for the assigned criticality.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"asset_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of the asset, for example `ip` or `dns_domain`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp of the last Terraform update of the resource.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *externalAssetResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
+func (r *externalAssetResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan externalAssetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Adding external asset", map[string]any{"value": plan.Value.ValueString()})
+
+	params := exposure_management.NewPostExternalAssetsInventoryV1Params()
+	params.Context = ctx
+	params.Body = &models.InventoryapiUserExternalAssetCreateRequestV1{
+		Data: []*models.InventoryapiUserExternalAssetCreate{
+			{
+				SubsidiaryID: plan.SubsidiaryID.ValueStringPointer(),
+				Assets: []*models.InventoryapiUserExternalAsset{
+					{
+						ID:    plan.Value.ValueStringPointer(),
+						Value: plan.Value.ValueStringPointer(),
+					},
+				},
+			},
+		},
+	}
+
+	res, err := r.client.ExposureManagement.PostExternalAssetsInventoryV1(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Create, err, apiScopesReadWrite))
+		return
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Create))
+		return
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Create, res.Payload.Errors); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	result := res.Payload.Resources[0]
+	if result.Error != nil && result.Error.Message != nil {
+		resp.Diagnostics.AddError(
+			"Failed to add external asset",
+			fmt.Sprintf("The asset %s was rejected: %s %s", plan.Value.ValueString(), *result.Error.Message, result.Error.Details),
+		)
+		return
+	}
+
+	plan.ID = flex.StringPointerToFramework(result.ID)
+	if plan.ID.IsNull() {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Create))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if utils.IsKnown(plan.Criticality) || utils.IsKnown(plan.CriticalityDescription) {
+		resp.Diagnostics.Append(r.patchCriticality(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	asset, diag := r.getExternalAsset(ctx, plan.ID.ValueString())
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	plan.wrap(asset)
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *externalAssetResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state externalAssetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	asset, diag := r.getExternalAsset(ctx, state.ID.ValueString())
+	if diag != nil {
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	state.wrap(asset)
+
+	// value and subsidiary_id are not returned in a form that can be compared
+	// with the configuration, so they are only set on import.
+	if state.Value.IsNull() {
+		state.Value = externalAssetValue(asset)
+	}
+	if state.SubsidiaryID.IsNull() && len(asset.Subsidiaries) > 0 && asset.Subsidiaries[0] != nil {
+		state.SubsidiaryID = flex.StringPointerToFramework(asset.Subsidiaries[0].ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func (r *externalAssetResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan externalAssetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Updating external asset", map[string]any{"id": plan.ID.ValueString()})
+
+	resp.Diagnostics.Append(r.patchCriticality(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asset, diag := r.getExternalAsset(ctx, plan.ID.ValueString())
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	plan.wrap(asset)
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *externalAssetResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state externalAssetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Deleting external asset", map[string]any{"id": state.ID.ValueString()})
+
+	resp.Diagnostics.Append(deleteExternalAssets(ctx, r.client, []string{state.ID.ValueString()})...)
+}
+
+func (r *externalAssetResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// patchCriticality updates the criticality of the asset.
+func (r *externalAssetResource) patchCriticality(
+	ctx context.Context,
+	plan externalAssetResourceModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	params := exposure_management.NewPatchExternalAssetsParams()
+	params.Context = ctx
+	params.Body = &models.DomainExternalAssetAPIPatchRequestV1{
+		Assets: []*models.DomainExternalAssetsAPIPatch{
+			{
+				ID:                     plan.ID.ValueStringPointer(),
+				Criticality:            plan.Criticality.ValueString(),
+				CriticalityDescription: plan.CriticalityDescription.ValueString(),
+			},
+		},
+	}
+
+	res, err := r.client.ExposureManagement.PatchExternalAssets(params)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, apiScopesReadWrite))
+		return diags
+	}
+
+	if res != nil && res.Payload != nil {
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Update, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+		}
+	}
+
+	return diags
+}
+
+// getExternalAsset returns the external asset with the given ID.
+func (r *externalAssetResource) getExternalAsset(
+	ctx context.Context,
+	id string,
+) (*models.DomainFemExternalAsset, diag.Diagnostic) {
+	params := exposure_management.NewGetExternalAssetsParams()
+	params.Context = ctx
+	params.Ids = []string{id}
+
+	res, err := r.client.ExposureManagement.GetExternalAssets(params)
+	if err != nil {
+		return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesReadWrite)
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		return nil, tferrors.NewNotFoundError(fmt.Sprintf("External asset %s not found.", id))
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		return nil, diag
+	}
+
+	return res.Payload.Resources[0], nil
+}
+
+// deleteExternalAssets deletes the external assets with the given IDs.
+func deleteExternalAssets(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	ids []string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	params := exposure_management.NewDeleteExternalAssetsParams()
+	params.Context = ctx
+	params.Ids = ids
+	params.Body = &models.DomainExternalAssetAPIDeleteRequestV1{
+		Description: externalAssetDeleteDescription,
+	}
+
+	_, err := client.ExposureManagement.DeleteExternalAssets(params)
+	if err != nil {
+		diag := tferrors.NewDiagnosticFromAPIError(tferrors.Delete, err, apiScopesReadWrite)
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			return diags
+		}
+		diags.Append(diag)
+	}
+
+	return diags
+}
+
+// externalAssetValue returns the domain or IP address of an asset.
+func externalAssetValue(asset *models.DomainFemExternalAsset) types.String {
+	if asset.DNSDomain != nil && asset.DNSDomain.Fqdn != nil {
+		return flex.StringPointerToFramework(asset.DNSDomain.Fqdn)
+	}
+	if asset.IP != nil {
+		return flex.StringValueToFramework(asset.IP.IPAddress)
+	}
+	return types.StringNull()
+}
@@ -0,0 +1,57 @@
+package exposuremanagement_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func testAccExternalAssetConfig(value, subsidiaryID, criticality string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_external_asset" "test" {
+  value         = %q
+  subsidiary_id = %q
+  criticality   = %q
+}
+`, value, subsidiaryID, criticality)
+}
+
+func TestAccExternalAssetResource_basic(t *testing.T) {
+	resourceName := "crowdstrike_external_asset.test"
+	value := acctest.RandomResourceName() + ".example.com"
+	subsidiaryID := os.Getenv(string(acctest.RequireSubsidiaryID))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t, acctest.RequireSubsidiaryID) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExternalAssetConfig(value, subsidiaryID, "High"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("value"), knownvalue.StringExact(value)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("criticality"), knownvalue.StringExact("High")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("asset_type"), knownvalue.StringExact("dns_domain")),
+				},
+			},
+			{
+				Config: testAccExternalAssetConfig(value, subsidiaryID, "Critical"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("criticality"), knownvalue.StringExact("Critical")),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
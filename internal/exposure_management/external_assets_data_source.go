@@ -0,0 +1,548 @@
+package exposuremanagement
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/exposure_management"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// externalAssetsQueryLimit is the largest page size used when querying external asset IDs.
+	externalAssetsQueryLimit = 100
+	// maxExternalAssetIDsPerRequest is the maximum number of IDs accepted by the external assets entity endpoint.
+	maxExternalAssetIDsPerRequest = 100
+)
+
+var (
+	_ datasource.DataSource              = &externalAssetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &externalAssetsDataSource{}
+)
+
+func NewExternalAssetsDataSource() datasource.DataSource {
+	return &externalAssetsDataSource{}
+}
+
+type externalAssetsDataSource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type externalAssetsDataSourceModel struct {
+	Domain      types.String `tfsdk:"domain"`
+	AssetType   types.String `tfsdk:"asset_type"`
+	Criticality types.Set    `tfsdk:"criticality"`
+	Filter      types.String `tfsdk:"filter"`
+	Sort        types.String `tfsdk:"sort"`
+	Limit       types.Int64  `tfsdk:"limit"`
+	MaxItems    types.Int64  `tfsdk:"max_items"`
+	Assets      types.List   `tfsdk:"assets"`
+}
+
+type externalAssetModel struct {
+	ID               types.String `tfsdk:"id"`
+	AssetType        types.String `tfsdk:"asset_type"`
+	FQDN             types.String `tfsdk:"fqdn"`
+	ParentDomain     types.String `tfsdk:"parent_domain"`
+	IPAddress        types.String `tfsdk:"ip_address"`
+	ResolvedIPs      types.List   `tfsdk:"resolved_ips"`
+	Criticality      types.String `tfsdk:"criticality"`
+	InternetExposure types.String `tfsdk:"internet_exposure"`
+	Perimeter        types.String `tfsdk:"perimeter"`
+	Status           types.String `tfsdk:"status"`
+	TriageStatus     types.String `tfsdk:"triage_status"`
+	CloudProvider    types.String `tfsdk:"cloud_provider"`
+	FirstSeen        types.String `tfsdk:"first_seen"`
+	LastSeen         types.String `tfsdk:"last_seen"`
+	Services         types.List   `tfsdk:"services"`
+}
+
+func (m externalAssetModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.StringType,
+		"asset_type":        types.StringType,
+		"fqdn":              types.StringType,
+		"parent_domain":     types.StringType,
+		"ip_address":        types.StringType,
+		"resolved_ips":      types.ListType{ElemType: types.StringType},
+		"criticality":       types.StringType,
+		"internet_exposure": types.StringType,
+		"perimeter":         types.StringType,
+		"status":            types.StringType,
+		"triage_status":     types.StringType,
+		"cloud_provider":    types.StringType,
+		"first_seen":        types.StringType,
+		"last_seen":         types.StringType,
+		"services": types.ListType{
+			ElemType: types.ObjectType{AttrTypes: externalAssetServiceModel{}.AttributeTypes()},
+		},
+	}
+}
+
+type externalAssetServiceModel struct {
+	Port         types.Int32  `tfsdk:"port"`
+	Protocol     types.String `tfsdk:"protocol"`
+	Transport    types.String `tfsdk:"transport"`
+	Status       types.String `tfsdk:"status"`
+	PlatformName types.String `tfsdk:"platform_name"`
+}
+
+func (m externalAssetServiceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"port":          types.Int32Type,
+		"protocol":      types.StringType,
+		"transport":     types.StringType,
+		"status":        types.StringType,
+		"platform_name": types.StringType,
+	}
+}
+
+func (d *externalAssetsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesRead)...)
+}
+
+func (d *externalAssetsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_external_assets"
+}
+
+func (d *externalAssetsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Falcon Exposure Management",
+			"This data source retrieves the external assets discovered by Falcon Exposure Management (formerly Falcon Surface). "+
+				"All filters are combined using logical AND. For filters not covered by the attributes below, use `filter` with a "+
+				"[Falcon Query Language (FQL)](https://falcon.crowdstrike.com/documentation/page/d3c84a1b/falcon-query-language-fql) expression.",
+			apiScopesRead,
+		),
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return assets whose fully qualified domain name or parent domain matches this value. Wildcards `*` are supported. Examples: `example.com`, `*.example.com`",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"asset_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return assets of this type. One of: `ip`, `dns_domain`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("ip", "dns_domain"),
+				},
+			},
+			"criticality": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return assets with one of these criticalities. Examples: `Critical`, `High`, `Medium`, `Low`, `Unassigned`",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(fwvalidators.StringNotWhitespace()),
+				},
+			},
+			"filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An additional FQL filter, for example `internet_exposure:'Yes'+ip.services.port:443`.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The sort order of the external assets, as `<field>|asc` or `<field>|desc`, for example `last_seen|desc`.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"limit":     utils.PageLimitAttribute("external assets", externalAssetsQueryLimit, externalAssetsQueryLimit),
+			"max_items": utils.MaxItemsAttribute("external assets"),
+			"assets": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The external assets matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the asset.",
+						},
+						"asset_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the asset.",
+						},
+						"fqdn": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The fully qualified domain name of a `dns_domain` asset.",
+						},
+						"parent_domain": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The parent domain of a `dns_domain` asset.",
+						},
+						"ip_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The IP address of an `ip` asset.",
+						},
+						"resolved_ips": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The IP addresses a `dns_domain` asset resolves to.",
+						},
+						"criticality": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The criticality of the asset.",
+						},
+						"internet_exposure": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the asset is exposed to the internet.",
+						},
+						"perimeter": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The perimeter the asset belongs to.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the asset.",
+						},
+						"triage_status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The triage status of the asset.",
+						},
+						"cloud_provider": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The cloud provider hosting an `ip` asset.",
+						},
+						"first_seen": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the asset was first discovered.",
+						},
+						"last_seen": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the asset was last seen.",
+						},
+						"services": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The services exposed by the asset.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"port": schema.Int32Attribute{
+										Computed:            true,
+										MarkdownDescription: "The port of the service.",
+									},
+									"protocol": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The application protocol of the service.",
+									},
+									"transport": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The transport protocol of the service.",
+									},
+									"status": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The status of the service.",
+									},
+									"platform_name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The platform detected for the service.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *externalAssetsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data externalAssetsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var criticality []string
+	if utils.IsKnown(data.Criticality) {
+		resp.Diagnostics.Append(data.Criticality.ElementsAs(ctx, &criticality, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		slices.Sort(criticality)
+	}
+
+	filter := buildExternalAssetsFilter(
+		data.Domain.ValueString(),
+		data.AssetType.ValueString(),
+		criticality,
+		data.Filter.ValueString(),
+	)
+
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, externalAssetsQueryLimit)
+	ids, total, diags := d.queryExternalAssetIDs(ctx, filter, data.Sort.ValueString(), pagination)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("external assets", len(ids), total)...)
+
+	assets, diags := d.getExternalAssets(ctx, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.wrap(ctx, assets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildExternalAssetsFilter combines the data source filters into a single FQL expression.
+func buildExternalAssetsFilter(domain, assetType string, criticality []string, filter string) string {
+	var parts []string
+
+	if domain != "" {
+		parts = append(parts, fmt.Sprintf(
+			"(dns_domain.fqdn:%[1]s,dns_domain.parent_domain:%[1]s,ip.fqdns:%[1]s)",
+			utils.FQLQuote(domain),
+		))
+	}
+
+	if assetType != "" {
+		parts = append(parts, "asset_type:"+utils.FQLQuote(assetType))
+	}
+
+	if len(criticality) > 0 {
+		values := make([]string, 0, len(criticality))
+		for _, c := range criticality {
+			values = append(values, utils.FQLQuote(c))
+		}
+		parts = append(parts, fmt.Sprintf("criticality:[%s]", strings.Join(values, ",")))
+	}
+
+	if filter != "" {
+		parts = append(parts, "("+filter+")")
+	}
+
+	return strings.Join(parts, "+")
+}
+
+// queryExternalAssetIDs returns the IDs of up to pagination.MaxItems external
+// assets matching filter in the order of sort, and the total number of
+// matching external assets.
+func (d *externalAssetsDataSource) queryExternalAssetIDs(
+	ctx context.Context,
+	filter string,
+	sort string,
+	pagination utils.Pagination,
+) ([]string, int64, diag.Diagnostics) {
+	var after *string
+
+	ids, total, diags := utils.QueryPages(pagination, func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		params := exposure_management.NewQueryExternalAssetsV2Params()
+		params.Context = ctx
+		params.Limit = utils.Addr(limit)
+		params.After = after
+		if filter != "" {
+			params.Filter = &filter
+		}
+		if sort != "" {
+			params.Sort = &sort
+		}
+
+		res, err := d.client.ExposureManagement.QueryExternalAssetsV2(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesRead))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
+		}
+
+		page := res.Payload.Resources
+		if res.Payload.Meta == nil || res.Payload.Meta.Pagination == nil ||
+			res.Payload.Meta.Pagination.After == nil || *res.Payload.Meta.Pagination.After == "" {
+			return page, offset + int64(len(page)), diags
+		}
+
+		after = res.Payload.Meta.Pagination.After
+
+		total := int64(-1)
+		if res.Payload.Meta.Pagination.Total != nil {
+			total = *res.Payload.Meta.Pagination.Total
+		}
+
+		return page, total, diags
+	})
+
+	return flex.Unique(ids), total, diags
+}
+
+// getExternalAssets returns the external assets with the given IDs, in the same order.
+func (d *externalAssetsDataSource) getExternalAssets(
+	ctx context.Context,
+	ids []string,
+) ([]*models.DomainFemExternalAsset, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	assets := make([]*models.DomainFemExternalAsset, 0, len(ids))
+
+	for batch := range slices.Chunk(ids, maxExternalAssetIDsPerRequest) {
+		params := exposure_management.NewGetExternalAssetsParams()
+		params.Context = ctx
+		params.Ids = batch
+
+		res, err := d.client.ExposureManagement.GetExternalAssets(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesRead))
+			return nil, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, diags
+		}
+
+		for _, asset := range res.Payload.Resources {
+			if asset != nil {
+				assets = append(assets, asset)
+			}
+		}
+	}
+
+	return assets, diags
+}
+
+// wrap transforms the API response into the data source model.
+func (m *externalAssetsDataSourceModel) wrap(
+	ctx context.Context,
+	assets []*models.DomainFemExternalAsset,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	assetModels := make([]externalAssetModel, 0, len(assets))
+	for _, asset := range assets {
+		am := externalAssetModel{
+			ID:               flex.StringPointerToFramework(asset.ID),
+			AssetType:        flex.StringPointerToFramework(asset.AssetType),
+			FQDN:             types.StringNull(),
+			ParentDomain:     types.StringNull(),
+			IPAddress:        types.StringNull(),
+			Criticality:      flex.StringValueToFramework(asset.Criticality),
+			InternetExposure: flex.StringValueToFramework(asset.InternetExposure),
+			Perimeter:        flex.StringValueToFramework(asset.Perimeter),
+			Status:           flex.StringPointerToFramework(asset.Status),
+			TriageStatus:     types.StringNull(),
+			CloudProvider:    types.StringNull(),
+			FirstSeen:        flex.StringValueToFramework(asset.FirstSeen),
+			LastSeen:         flex.StringValueToFramework(asset.LastSeen),
+		}
+
+		if asset.Triage != nil {
+			am.TriageStatus = flex.StringValueToFramework(asset.Triage.Status)
+		}
+
+		var resolvedIPs []string
+		var services []*models.DomainExternalAssetService
+
+		if asset.DNSDomain != nil {
+			am.FQDN = flex.StringPointerToFramework(asset.DNSDomain.Fqdn)
+			am.ParentDomain = flex.StringValueToFramework(asset.DNSDomain.ParentDomain)
+			resolvedIPs = asset.DNSDomain.ResolvedIps
+			services = append(services, asset.DNSDomain.Services...)
+		}
+
+		if asset.IP != nil {
+			am.IPAddress = flex.StringValueToFramework(asset.IP.IPAddress)
+			am.CloudProvider = flex.StringValueToFramework(asset.IP.CloudProvider)
+			services = append(services, asset.IP.Services...)
+		}
+
+		am.ResolvedIPs = utils.SliceToListTypeString(ctx, resolvedIPs, &diags)
+
+		serviceModels := make([]externalAssetServiceModel, 0, len(services))
+		for _, s := range services {
+			if s == nil {
+				continue
+			}
+			serviceModels = append(serviceModels, externalAssetServiceModel{
+				Port:         flex.Int32PointerToFramework(s.Port),
+				Protocol:     flex.StringPointerToFramework(s.Protocol),
+				Transport:    flex.StringPointerToFramework(s.Transport),
+				Status:       flex.StringPointerToFramework(s.Status),
+				PlatformName: flex.StringValueToFramework(s.PlatformName),
+			})
+		}
+		am.Services = utils.SliceToListTypeObject(ctx, serviceModels, externalAssetServiceModel{}.AttributeTypes(), &diags)
+
+		assetModels = append(assetModels, am)
+	}
+
+	m.Assets = utils.SliceToListTypeObject(ctx, assetModels, externalAssetModel{}.AttributeTypes(), &diags)
+
+	return diags
+}
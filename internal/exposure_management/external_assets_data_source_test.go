@@ -0,0 +1,43 @@
+package exposuremanagement_test
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccExternalAssetsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.crowdstrike_external_assets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_external_assets" "test" {
+  asset_type  = "ip"
+  criticality = ["Critical", "High", "Medium", "Low", "Unassigned"]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("assets"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_external_assets" "test" {
+  domain = "tf-acc-test-does-not-exist.invalid"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("assets"), knownvalue.ListSizeExact(0)),
+				},
+			},
+		},
+	})
+}
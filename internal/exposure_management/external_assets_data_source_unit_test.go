@@ -0,0 +1,47 @@
+package exposuremanagement
+
+import "testing"
+
+func TestBuildExternalAssetsFilter(t *testing.T) {
+	tests := []struct {
+		name        string
+		domain      string
+		assetType   string
+		criticality []string
+		filter      string
+		want        string
+	}{
+		{
+			name: "no filters",
+			want: "",
+		},
+		{
+			name:   "domain",
+			domain: "*.example.com",
+			want:   "(dns_domain.fqdn:'*.example.com',dns_domain.parent_domain:'*.example.com',ip.fqdns:'*.example.com')",
+		},
+		{
+			name:        "all filters",
+			domain:      "example.com",
+			assetType:   "dns_domain",
+			criticality: []string{"Critical", "High"},
+			filter:      "internet_exposure:'Yes'",
+			want: "(dns_domain.fqdn:'example.com',dns_domain.parent_domain:'example.com',ip.fqdns:'example.com')" +
+				"+asset_type:'dns_domain'+criticality:['Critical','High']+(internet_exposure:'Yes')",
+		},
+		{
+			name:   "quotes are escaped",
+			domain: `o'neil.example.com`,
+			want:   `(dns_domain.fqdn:'o\'neil.example.com',dns_domain.parent_domain:'o\'neil.example.com',ip.fqdns:'o\'neil.example.com')`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildExternalAssetsFilter(tt.domain, tt.assetType, tt.criticality, tt.filter)
+			if got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package exposuremanagement
+
+import "github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+
+var apiScopesRead = []scopes.Scope{
+	{
+		Name:  "Exposure Management",
+		Read:  true,
+		Write: false,
+	},
+}
+
+var apiScopesReadWrite = []scopes.Scope{
+	{
+		Name:  "Exposure Management",
+		Read:  true,
+		Write: true,
+	},
+}
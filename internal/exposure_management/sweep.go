@@ -0,0 +1,66 @@
+package exposuremanagement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/exposure_management"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/sweep"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+)
+
+func RegisterSweepers() {
+	sweep.Register("crowdstrike_external_asset", sweepExternalAssets)
+}
+
+func sweepExternalAssets(ctx context.Context, client *client.CrowdStrikeAPISpecification) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	filter := fmt.Sprintf("manual:true+dns_domain.fqdn:'%s*'", sweep.ResourcePrefix)
+
+	params := exposure_management.NewQueryExternalAssetsV2Params()
+	params.WithContext(ctx)
+	params.Filter = &filter
+	params.Limit = utils.Addr(int64(externalAssetsQueryLimit))
+
+	res, err := client.ExposureManagement.QueryExternalAssetsV2(params)
+	if sweep.SkipSweepError(err) {
+		sweep.Warn("Skipping external asset sweep: %s", err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error listing external assets: %w", err)
+	}
+
+	if res.Payload == nil {
+		return sweepables, nil
+	}
+
+	for _, id := range res.Payload.Resources {
+		sweepables = append(sweepables, sweep.NewSweepResource(id, id, deleteExternalAsset))
+	}
+
+	return sweepables, nil
+}
+
+func deleteExternalAsset(ctx context.Context, client *client.CrowdStrikeAPISpecification, id string) error {
+	params := exposure_management.NewDeleteExternalAssetsParams()
+	params.WithContext(ctx)
+	params.Ids = []string{id}
+	params.Body = &models.DomainExternalAssetAPIDeleteRequestV1{
+		Description: externalAssetDeleteDescription,
+	}
+
+	_, err := client.ExposureManagement.DeleteExternalAssets(params)
+	if err != nil {
+		if sweep.ShouldIgnoreError(err) {
+			sweep.Debug("Ignoring error for external asset %s: %s", id, err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
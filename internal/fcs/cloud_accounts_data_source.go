@@ -0,0 +1,456 @@
+package fcs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/cloud_google_cloud_registration"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cloudAccountsDataSource{}
+	_ datasource.DataSourceWithConfigure = &cloudAccountsDataSource{}
+)
+
+// cloudAccountsDataSource is the data source implementation.
+type cloudAccountsDataSource struct {
+	client      *client.CrowdStrikeAPISpecification
+	checkScopes func([]scopes.Scope) diag.Diagnostics
+}
+
+type cloudAccountsDataSourceModel struct {
+	AWSAccountIDs         types.Set               `tfsdk:"aws_account_ids"`
+	AWSOrganizationIDs    types.Set               `tfsdk:"aws_organization_ids"`
+	AzureTenantIDs        types.Set               `tfsdk:"azure_tenant_ids"`
+	GoogleRegistrationIDs types.Set               `tfsdk:"google_registration_ids"`
+	Accounts              []cloudAccountDataModel `tfsdk:"accounts"`
+}
+
+type cloudAccountDataModel struct {
+	CloudProvider      types.String                  `tfsdk:"cloud_provider"`
+	AccountID          types.String                  `tfsdk:"account_id"`
+	OrganizationID     types.String                  `tfsdk:"organization_id"`
+	RegistrationStatus types.String                  `tfsdk:"registration_status"`
+	Features           []cloudAccountFeatureModel    `tfsdk:"features"`
+	Permissions        []cloudAccountPermissionModel `tfsdk:"permissions"`
+	Conditions         []cloudAccountConditionModel  `tfsdk:"conditions"`
+}
+
+type cloudAccountFeatureModel struct {
+	Product types.String `tfsdk:"product"`
+	Feature types.String `tfsdk:"feature"`
+}
+
+type cloudAccountPermissionModel struct {
+	Product    types.String `tfsdk:"product"`
+	Feature    types.String `tfsdk:"feature"`
+	Permission types.String `tfsdk:"permission"`
+	Status     types.String `tfsdk:"status"`
+}
+
+type cloudAccountConditionModel struct {
+	Product types.String `tfsdk:"product"`
+	Feature types.String `tfsdk:"feature"`
+	Type    types.String `tfsdk:"type"`
+	Status  types.String `tfsdk:"status"`
+	Reason  types.String `tfsdk:"reason"`
+	Message types.String `tfsdk:"message"`
+}
+
+// NewCloudAccountsDataSource is a helper function to simplify the provider implementation.
+func NewCloudAccountsDataSource() datasource.DataSource {
+	return &cloudAccountsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *cloudAccountsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_accounts"
+}
+
+// Schema defines the schema for the data source.
+func (d *cloudAccountsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(
+			"Falcon Cloud Security --- This data source provides the registration status and health of cloud accounts registered in Falcon Cloud Security. "+
+				"AWS accounts are returned when `aws_account_ids` or `aws_organization_ids` is set, or when no filter is set. "+
+				"Azure tenants and Google Cloud registrations can not be listed, and are only returned when their IDs are set. "+
+				"Only the scopes of the cloud providers queried are required.\n\n%s",
+			scopes.GenerateScopeDescription(slices.Concat(
+				readScopes(cloudSecurityScopes),
+				readScopes(azureRegistrationScopes),
+				googleRegistrationScopes,
+			)),
+		),
+		Attributes: map[string]schema.Attribute{
+			"aws_account_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The IDs of the AWS accounts to return.",
+			},
+			"aws_organization_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The IDs of the AWS Organizations to return the accounts of.",
+			},
+			"azure_tenant_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The IDs of the Azure tenants to return.",
+			},
+			"google_registration_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The IDs of the Google Cloud registrations to return.",
+			},
+			"accounts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The registered cloud accounts.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cloud_provider": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The cloud provider of the account. One of: `aws`, `azure`, `gcp`.",
+						},
+						"account_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The AWS account ID, Azure tenant ID, or Google Cloud registration ID.",
+						},
+						"organization_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The AWS Organization ID of an AWS account.",
+						},
+						"registration_status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the registration.",
+						},
+						"features": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The features enabled for the account, such as `iom` for configuration assessment and `ioa` for behavior assessment.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"product": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The product of the feature.",
+									},
+									"feature": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The name of the feature.",
+									},
+								},
+							},
+						},
+						"permissions": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the IAM permissions required by the features of the account. Only reported for AWS accounts.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"product": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The product requiring the permission.",
+									},
+									"feature": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The feature requiring the permission.",
+									},
+									"permission": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The name of the permission.",
+									},
+									"status": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Whether the permission was granted, as reported by the last health check.",
+									},
+								},
+							},
+						},
+						"conditions": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The conditions reported for the account. Only reported for AWS accounts.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"product": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The product of the condition.",
+									},
+									"feature": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The feature of the condition.",
+									},
+									"type": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The type of the condition.",
+									},
+									"status": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The status of the condition.",
+									},
+									"reason": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The reason for the status of the condition.",
+									},
+									"message": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "A description of the condition.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cloudAccountsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data cloudAccountsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	awsAccountIDs := flex.ExpandSetAs[string](ctx, data.AWSAccountIDs, &resp.Diagnostics)
+	awsOrganizationIDs := flex.ExpandSetAs[string](ctx, data.AWSOrganizationIDs, &resp.Diagnostics)
+	azureTenantIDs := flex.ExpandSetAs[string](ctx, data.AzureTenantIDs, &resp.Diagnostics)
+	googleRegistrationIDs := flex.ExpandSetAs[string](ctx, data.GoogleRegistrationIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Accounts = make([]cloudAccountDataModel, 0)
+
+	listAWS := len(awsAccountIDs) > 0 || len(awsOrganizationIDs) > 0 ||
+		(len(azureTenantIDs) == 0 && len(googleRegistrationIDs) == 0)
+	if listAWS {
+		resp.Diagnostics.Append(d.checkScopes(readScopes(cloudSecurityScopes))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		awsAccounts := &cloudAwsAccountsDataSource{client: d.client}
+		accounts, diags := awsAccounts.getCloudAccounts(ctx, awsAccountIDs, awsOrganizationIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, account := range accounts {
+			if account != nil {
+				data.Accounts = append(data.Accounts, wrapAWSAccountHealth(account))
+			}
+		}
+	}
+
+	if len(azureTenantIDs) > 0 {
+		resp.Diagnostics.Append(d.checkScopes(readScopes(azureRegistrationScopes))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	for _, tenantID := range azureTenantIDs {
+		registration, diags := getAzureRegistration(ctx, d.client, tenantID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Accounts = append(data.Accounts, cloudAccountDataModel{
+			CloudProvider:      types.StringValue("azure"),
+			AccountID:          types.StringValue(tenantID),
+			OrganizationID:     types.StringNull(),
+			RegistrationStatus: flex.StringValueToFramework(registration.Status),
+			Features:           wrapProductFeatures(registration.Products),
+			Permissions:        []cloudAccountPermissionModel{},
+			Conditions:         []cloudAccountConditionModel{},
+		})
+	}
+
+	if len(googleRegistrationIDs) > 0 {
+		resp.Diagnostics.Append(d.checkScopes(googleRegistrationScopes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	for _, registrationID := range googleRegistrationIDs {
+		registration, diags := d.getGoogleRegistration(ctx, registrationID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Accounts = append(data.Accounts, cloudAccountDataModel{
+			CloudProvider:      types.StringValue("gcp"),
+			AccountID:          types.StringValue(registrationID),
+			OrganizationID:     types.StringNull(),
+			RegistrationStatus: flex.StringValueToFramework(registration.Status),
+			Features:           wrapProductFeatures(registration.Products),
+			Permissions:        []cloudAccountPermissionModel{},
+			Conditions:         []cloudAccountConditionModel{},
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the data source. The
+// scopes of each cloud provider are checked when it is queried.
+func (d *cloudAccountsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	d.client = cfg.Client
+	d.checkScopes = cfg.CheckScopes
+}
+
+// getGoogleRegistration returns a Google Cloud registration.
+func (d *cloudAccountsDataSource) getGoogleRegistration(
+	ctx context.Context,
+	registrationID string,
+) (*models.DtoGCPRegistration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := d.client.CloudGoogleCloudRegistration.CloudRegistrationGcpGetRegistration(
+		&cloud_google_cloud_registration.CloudRegistrationGcpGetRegistrationParams{
+			Ids:     registrationID,
+			Context: ctx,
+		},
+	)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, googleRegistrationScopes))
+		return nil, diags
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		diags.Append(tferrors.NewNotFoundError(
+			fmt.Sprintf("Google Cloud registration %s not found.", registrationID),
+		))
+		return nil, diags
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		diags.Append(diag)
+		return nil, diags
+	}
+
+	return res.Payload.Resources[0], diags
+}
+
+// wrapAWSAccountHealth returns the registration status and health of an AWS
+// account.
+func wrapAWSAccountHealth(account *models.DomainCloudAWSAccountV1) cloudAccountDataModel {
+	m := cloudAccountDataModel{
+		CloudProvider:      types.StringValue("aws"),
+		AccountID:          types.StringValue(account.AccountID),
+		OrganizationID:     flex.StringValueToFramework(account.OrganizationID),
+		RegistrationStatus: flex.StringValueToFramework(account.RegistrationStatus),
+		Features:           wrapProductFeatures(account.Products),
+		Permissions:        []cloudAccountPermissionModel{},
+		Conditions:         []cloudAccountConditionModel{},
+	}
+
+	for _, product := range account.IamServicePermissionsStatus {
+		if product == nil {
+			continue
+		}
+		for _, feature := range product.Features {
+			if feature == nil {
+				continue
+			}
+			for _, permission := range feature.Permissions {
+				if permission == nil {
+					continue
+				}
+				m.Permissions = append(m.Permissions, cloudAccountPermissionModel{
+					Product:    types.StringPointerValue(product.Product),
+					Feature:    types.StringPointerValue(feature.Feature),
+					Permission: types.StringPointerValue(permission.Name),
+					Status:     types.StringPointerValue(permission.Status),
+				})
+			}
+		}
+	}
+
+	for _, condition := range account.Conditions {
+		if condition == nil {
+			continue
+		}
+		m.Conditions = append(m.Conditions, cloudAccountConditionModel{
+			Product: flex.StringValueToFramework(condition.Product),
+			Feature: types.StringPointerValue(condition.Feature),
+			Type:    types.StringPointerValue(condition.Type),
+			Status:  types.StringPointerValue(condition.Status),
+			Reason:  flex.StringValueToFramework(condition.Reason),
+			Message: flex.StringValueToFramework(condition.Message),
+		})
+	}
+
+	return m
+}
+
+// wrapProductFeatures returns the enabled features of products.
+func wrapProductFeatures(products []*models.DomainProductFeatures) []cloudAccountFeatureModel {
+	features := []cloudAccountFeatureModel{}
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		for _, feature := range product.Features {
+			features = append(features, cloudAccountFeatureModel{
+				Product: types.StringPointerValue(product.Product),
+				Feature: types.StringValue(feature),
+			})
+		}
+	}
+	return features
+}
+
+// readScopes returns the read access of scopes.
+func readScopes(required []scopes.Scope) []scopes.Scope {
+	read := make([]scopes.Scope, 0, len(required))
+	for _, scope := range required {
+		read = append(read, scopes.Scope{Name: scope.Name, Read: scope.Read})
+	}
+	return read
+}
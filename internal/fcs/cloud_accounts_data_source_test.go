@@ -0,0 +1,50 @@
+package fcs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+)
+
+func TestAccCloudAccountsDataSource_AWS(t *testing.T) {
+	accountID := sdkacctest.RandStringFromCharSet(12, acctest.CharSetNum)
+
+	resourceName := "crowdstrike_cloud_aws_account.test"
+	dataSourceName := "data.crowdstrike_cloud_accounts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudAccountsDataSource_aws(accountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.0.cloud_provider", "aws"),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceName, "accounts.0.account_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.0.registration_status"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "accounts.0.features.*", map[string]string{
+						"product": "cspm",
+						"feature": "iom",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudAccountsDataSource_aws(accountID string) string {
+	return fmt.Sprintf(`
+resource "crowdstrike_cloud_aws_account" "test" {
+  account_id = %[1]q
+}
+
+data "crowdstrike_cloud_accounts" "test" {
+  aws_account_ids = [crowdstrike_cloud_aws_account.test.account_id]
+}
+`, accountID)
+}
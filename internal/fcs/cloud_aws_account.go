@@ -15,6 +15,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -99,18 +100,19 @@ type cloudAWSAccountModel struct {
 	ResourceNamePrefix     types.String                  `tfsdk:"resource_name_prefix"`
 	ResourceNameSuffix     types.String                  `tfsdk:"resource_name_suffix"`
 	// Computed
-	ExternalID                    types.String `tfsdk:"external_id"`
-	IntermediateRoleArn           types.String `tfsdk:"intermediate_role_arn"`
-	IamRoleArn                    types.String `tfsdk:"iam_role_arn"`
-	IamRoleName                   types.String `tfsdk:"iam_role_name"`
-	EventbusName                  types.String `tfsdk:"eventbus_name"`
-	EventbusArn                   types.String `tfsdk:"eventbus_arn"`
-	CloudTrailBucketName          types.String `tfsdk:"cloudtrail_bucket_name"`
-	DspmRoleArn                   types.String `tfsdk:"dspm_role_arn"`
-	DspmRoleName                  types.String `tfsdk:"dspm_role_name"`
-	VulnerabilityScanningRoleArn  types.String `tfsdk:"vulnerability_scanning_role_arn"`
-	VulnerabilityScanningRoleName types.String `tfsdk:"vulnerability_scanning_role_name"`
-	AgentlessScanningRoleName     types.String `tfsdk:"agentless_scanning_role_name"`
+	ExternalID                    types.String   `tfsdk:"external_id"`
+	IntermediateRoleArn           types.String   `tfsdk:"intermediate_role_arn"`
+	IamRoleArn                    types.String   `tfsdk:"iam_role_arn"`
+	IamRoleName                   types.String   `tfsdk:"iam_role_name"`
+	EventbusName                  types.String   `tfsdk:"eventbus_name"`
+	EventbusArn                   types.String   `tfsdk:"eventbus_arn"`
+	CloudTrailBucketName          types.String   `tfsdk:"cloudtrail_bucket_name"`
+	DspmRoleArn                   types.String   `tfsdk:"dspm_role_arn"`
+	DspmRoleName                  types.String   `tfsdk:"dspm_role_name"`
+	VulnerabilityScanningRoleArn  types.String   `tfsdk:"vulnerability_scanning_role_arn"`
+	VulnerabilityScanningRoleName types.String   `tfsdk:"vulnerability_scanning_role_name"`
+	AgentlessScanningRoleName     types.String   `tfsdk:"agentless_scanning_role_name"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -138,7 +140,7 @@ func (r *cloudAWSAccountResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *cloudAWSAccountResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -620,6 +622,9 @@ func (r *cloudAWSAccountResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -709,6 +714,9 @@ func (r *cloudAWSAccountResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	cloudAccount, diags := r.createCloudAccount(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -932,6 +940,9 @@ func (r *cloudAWSAccountResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	if state.AccountID.ValueString() == "" {
 		return
 	}
@@ -1150,6 +1161,9 @@ func (r *cloudAWSAccountResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	// Update Cloud Registration account (replacing both CSPM and Cloud calls)
 	cloudAccount, diags := r.updateCloudAccount(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -1338,6 +1352,9 @@ func (r *cloudAWSAccountResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	diags = append(diags, r.deleteCloudAccount(ctx, state)...)
 
 	resp.Diagnostics.Append(diags...)
@@ -1408,6 +1425,7 @@ func (r *cloudAWSAccountResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(cloudSecurityScopes)...)
 }
 
 // ImportState implements the logic to support resource imports.
@@ -367,4 +367,5 @@ func (d *cloudAwsAccountsDataSource) Configure(
 	}
 
 	d.client = cfg.Client
+	resp.Diagnostics.Append(cfg.CheckScopes(cloudSecurityScopes)...)
 }
@@ -216,4 +216,5 @@ func (d *cloudAwsAccountValidationDataSource) Configure(
 	}
 
 	d.client = cfg.Client
+	resp.Diagnostics.Append(cfg.CheckScopes(cloudSecurityScopes)...)
 }
@@ -0,0 +1,381 @@
+package fcs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/cloud_azure_registration"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &cloudAzureSubscriptionResource{}
+	_ resource.ResourceWithConfigure   = &cloudAzureSubscriptionResource{}
+	_ resource.ResourceWithImportState = &cloudAzureSubscriptionResource{}
+	_ resource.ResourceWithModifyPlan  = &cloudAzureSubscriptionResource{}
+)
+
+// Mutex for changes to the subscriptions of a tenant registration, which are
+// read, modified, and written back as a whole.
+var azureSubscriptionsMutex sync.Mutex
+
+func NewCloudAzureSubscriptionResource() resource.Resource {
+	return &cloudAzureSubscriptionResource{}
+}
+
+type cloudAzureSubscriptionResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type cloudAzureSubscriptionModel struct {
+	ID             types.String   `tfsdk:"id"`
+	TenantId       types.String   `tfsdk:"tenant_id"`
+	SubscriptionId types.String   `tfsdk:"subscription_id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cloudAzureSubscriptionResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(azureRegistrationScopes)...)
+}
+
+func (r *cloudAzureSubscriptionResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_azure_subscription"
+}
+
+func (r *cloudAzureSubscriptionResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(
+			"Falcon Cloud Security --- This resource enrolls an Azure subscription in the registration of its Azure Tenant in Falcon Cloud Security. "+
+				"The tenant must be registered with `crowdstrike_cloud_azure_tenant`, which should not also set `subscription_ids`; "+
+				"use `lifecycle { ignore_changes = [subscription_ids] }` on the tenant when subscriptions are enrolled with this resource. "+
+				"When the last enrolled subscription is removed, the registration targets every subscription in the tenant unless `management_group_ids` is set.\n\n%s",
+			scopes.GenerateScopeDescription(azureRegistrationScopes),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the enrollment, in the format `<tenant_id>/<subscription_id>`.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"tenant_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the registered Azure Tenant the subscription belongs to.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"subscription_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the Azure subscription to enroll.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *cloudAzureSubscriptionResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data cloudAzureSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.updateSubscriptions(
+		ctx,
+		tferrors.Create,
+		data.TenantId.ValueString(),
+		func(subscriptionIDs []string) []string {
+			if slices.Contains(subscriptionIDs, data.SubscriptionId.ValueString()) {
+				return subscriptionIDs
+			}
+			return append(subscriptionIDs, data.SubscriptionId.ValueString())
+		},
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(
+		azureSubscriptionID(data.TenantId.ValueString(), data.SubscriptionId.ValueString()),
+	)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudAzureSubscriptionResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data cloudAzureSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	registration, diags := getAzureRegistration(ctx, r.client, data.TenantId.ValueString())
+	if diags.HasError() {
+		if tferrors.HasNotFoundError(diags) {
+			resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if !slices.Contains(registration.SubscriptionIds, data.SubscriptionId.ValueString()) {
+		resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(
+		azureSubscriptionID(data.TenantId.ValueString(), data.SubscriptionId.ValueString()),
+	)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudAzureSubscriptionResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// tenant_id and subscription_id require replacement, so only timeouts can be updated.
+	var data cloudAzureSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudAzureSubscriptionResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data cloudAzureSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	diags := r.updateSubscriptions(
+		ctx,
+		tferrors.Delete,
+		data.TenantId.ValueString(),
+		func(subscriptionIDs []string) []string {
+			return slices.DeleteFunc(subscriptionIDs, func(id string) bool {
+				return id == data.SubscriptionId.ValueString()
+			})
+		},
+	)
+	if tferrors.HasNotFoundError(diags) {
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cloudAzureSubscriptionResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tenantID, subscriptionID, ok := strings.Cut(req.ID, "/")
+	if !ok || tenantID == "" || subscriptionID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format <tenant_id>/<subscription_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), tenantID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subscription_id"), subscriptionID)...)
+}
+
+// ModifyPlan defers the enrollment of the subscription until the tenant is
+// registered, so it can be planned with a crowdstrike_cloud_azure_tenant
+// registering the tenant in the same apply.
+func (r *cloudAzureSubscriptionResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if !req.ClientCapabilities.DeferralAllowed || !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if utils.DeferPlanIfUnknown(ctx, req, resp, path.Root("tenant_id")) {
+		return
+	}
+
+	var tenantID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tenant_id"), &tenantID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := getAzureRegistration(ctx, r.client, tenantID.ValueString())
+	if tferrors.HasNotFoundError(diags) {
+		utils.DeferPlanIfAbsent(ctx, req, resp, diags)
+	}
+}
+
+// updateSubscriptions replaces the subscriptions of the registration of a
+// tenant with the result of modify.
+func (r *cloudAzureSubscriptionResource) updateSubscriptions(
+	ctx context.Context,
+	operation tferrors.Operation,
+	tenantID string,
+	modify func(subscriptionIDs []string) []string,
+) diag.Diagnostics {
+	azureSubscriptionsMutex.Lock()
+	tflog.Debug(ctx, "[DEBUG] locked changes to Azure subscriptions")
+	defer func() {
+		azureSubscriptionsMutex.Unlock()
+		tflog.Debug(ctx, "[DEBUG] unlocked changes to Azure subscriptions")
+	}()
+
+	registration, diags := getAzureRegistration(ctx, r.client, tenantID)
+	if diags.HasError() {
+		return diags
+	}
+
+	subscriptionIDs := modify(slices.Clone(registration.SubscriptionIds))
+	if slices.Equal(subscriptionIDs, registration.SubscriptionIds) {
+		return diags
+	}
+	if subscriptionIDs == nil {
+		subscriptionIDs = []string{}
+	}
+
+	res, err := r.client.CloudAzureRegistration.CloudRegistrationAzureUpdateRegistration(
+		&cloud_azure_registration.CloudRegistrationAzureUpdateRegistrationParams{
+			Body: &models.AzureAzureRegistrationUpdateRequestExtV1{
+				Resource: &models.AzureAzureRegistrationUpdateInput{
+					TenantID:        &tenantID,
+					SubscriptionIds: subscriptionIDs,
+				},
+			},
+			Context: ctx,
+		},
+	)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(operation, err, azureRegistrationScopes))
+		return diags
+	}
+
+	if res == nil || res.Payload == nil {
+		diags.Append(tferrors.NewEmptyResponseError(operation))
+		return diags
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(operation, res.Payload.Errors); diag != nil {
+		diags.Append(diag)
+	}
+
+	return diags
+}
+
+// azureSubscriptionID returns the ID of the enrollment of a subscription.
+func azureSubscriptionID(tenantID, subscriptionID string) string {
+	return tenantID + "/" + subscriptionID
+}
+
+// getAzureRegistration returns the registration of a tenant.
+func getAzureRegistration(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	tenantID string,
+) (*models.AzureTenantRegistration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := client.CloudAzureRegistration.CloudRegistrationAzureGetRegistration(
+		&cloud_azure_registration.CloudRegistrationAzureGetRegistrationParams{
+			TenantID: tenantID,
+			Context:  ctx,
+		},
+	)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, azureRegistrationScopes))
+		return nil, diags
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+		return nil, diags
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		diags.Append(diag)
+		return nil, diags
+	}
+
+	return res.Payload.Resources[0], diags
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -30,6 +31,7 @@ var (
 	_ resource.ResourceWithConfigure      = &cloudAzureTenantEventhubSettingsResource{}
 	_ resource.ResourceWithImportState    = &cloudAzureTenantEventhubSettingsResource{}
 	_ resource.ResourceWithValidateConfig = &cloudAzureTenantEventhubSettingsResource{}
+	_ resource.ResourceWithModifyPlan     = &cloudAzureTenantEventhubSettingsResource{}
 )
 
 func NewCloudAzureTenantEventhubSettingsResource() resource.Resource {
@@ -65,6 +67,7 @@ func (r *cloudAzureTenantEventhubSettingsResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(azureRegistrationScopes)...)
 }
 
 func (r *cloudAzureTenantEventhubSettingsResource) Metadata(
@@ -76,8 +79,9 @@ func (r *cloudAzureTenantEventhubSettingsResource) Metadata(
 }
 
 type cloudAzureTenantEventhubSettingsModel struct {
-	TenantId types.String `tfsdk:"tenant_id"`
-	Settings types.List   `tfsdk:"settings"`
+	TenantId types.String   `tfsdk:"tenant_id"`
+	Settings types.List     `tfsdk:"settings"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type eventhubSettings struct {
@@ -136,6 +140,9 @@ func (r *cloudAzureTenantEventhubSettingsResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -184,6 +191,9 @@ func (r *cloudAzureTenantEventhubSettingsResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	registration, diags := r.updateRegistration(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
@@ -209,6 +219,9 @@ func (r *cloudAzureTenantEventhubSettingsResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	registration, diags := r.getRegistration(ctx, data.TenantId.ValueString())
 	if diags.HasError() {
 		if tferrors.HasNotFoundError(diags) {
@@ -235,6 +248,9 @@ func (r *cloudAzureTenantEventhubSettingsResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	registration, err := r.updateRegistration(ctx, &data)
 	resp.Diagnostics.Append(err...)
 	if resp.Diagnostics.HasError() {
@@ -259,6 +275,9 @@ func (r *cloudAzureTenantEventhubSettingsResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	data.Settings = types.ListNull(types.ObjectType{AttrTypes: eventhubSettings{}.attrTypes()})
 	registration, err := r.updateRegistration(ctx, &data)
 	resp.Diagnostics.Append(err...)
@@ -291,6 +310,34 @@ func (r *cloudAzureTenantEventhubSettingsResource) ImportState(
 	resource.ImportStatePassthroughID(ctx, path.Root("tenant_id"), req, resp)
 }
 
+// ModifyPlan defers the creation of the settings until the tenant is
+// registered, so they can be planned with a crowdstrike_cloud_azure_tenant
+// registering the tenant in the same apply.
+func (r *cloudAzureTenantEventhubSettingsResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if !req.ClientCapabilities.DeferralAllowed || !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if utils.DeferPlanIfUnknown(ctx, req, resp, path.Root("tenant_id")) {
+		return
+	}
+
+	var tenantID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tenant_id"), &tenantID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := r.getRegistration(ctx, tenantID.ValueString())
+	if tferrors.HasNotFoundError(diags) {
+		utils.DeferPlanIfAbsent(ctx, req, resp, diags)
+	}
+}
+
 // ValidateConfig runs during validate, plan, and apply
 // validate resource is configured as expected.
 func (r *cloudAzureTenantEventhubSettingsResource) ValidateConfig(
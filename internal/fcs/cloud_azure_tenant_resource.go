@@ -11,6 +11,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -69,6 +70,7 @@ func (r *cloudAzureTenantResource) Configure(
 
 	r.client = config.Client
 	r.clientId = config.ClientId
+	resp.Diagnostics.Append(config.CheckScopes(azureRegistrationScopes)...)
 }
 
 func (r *cloudAzureTenantResource) Metadata(
@@ -81,9 +83,11 @@ func (r *cloudAzureTenantResource) Metadata(
 
 type cloudAzureTenantModel struct {
 	AccountType                 types.String        `tfsdk:"account_type"`
+	AdminConsentURL             types.String        `tfsdk:"admin_consent_url"`
 	AppRegistrationId           types.String        `tfsdk:"cs_azure_client_id"`
 	CsInfraRegion               types.String        `tfsdk:"cs_infra_location"`
 	CsInfraSubscriptionId       types.String        `tfsdk:"cs_infra_subscription_id"`
+	EnterpriseAppURL            types.String        `tfsdk:"enterprise_app_url"`
 	Environment                 types.String        `tfsdk:"environment"`
 	ManagementGroupIds          types.List          `tfsdk:"management_group_ids"`
 	MicrosoftGraphPermissionIds types.List          `tfsdk:"microsoft_graph_permission_ids"`
@@ -93,12 +97,42 @@ type cloudAzureTenantModel struct {
 	Tags                        types.Map           `tfsdk:"tags"`
 	TenantId                    types.String        `tfsdk:"tenant_id"`
 	RealtimeVisibility          *realtimeVisibility `tfsdk:"realtime_visibility"`
+	DSPM                        *azureDSPMOptions   `tfsdk:"dspm"`
+	Timeouts                    timeouts.Value      `tfsdk:"timeouts"`
 }
 
 type realtimeVisibility struct {
 	Enabled types.Bool `tfsdk:"enabled"`
 }
 
+type azureDSPMOptions struct {
+	Enabled                  types.Bool   `tfsdk:"enabled"`
+	Regions                  types.List   `tfsdk:"regions"`
+	HostSubscriptionID       types.String `tfsdk:"host_subscription_id"`
+	NetworkConfigurationType types.String `tfsdk:"network_configuration_type"`
+	CustomNetworks           types.Map    `tfsdk:"custom_networks"`
+}
+
+type azureDSPMCustomNetwork struct {
+	ScannersSubnetID types.String `tfsdk:"scanners_subnet_id"`
+	ClonesSubnetID   types.String `tfsdk:"clones_subnet_id"`
+}
+
+func (n azureDSPMCustomNetwork) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"scanners_subnet_id": types.StringType,
+		"clones_subnet_id":   types.StringType,
+	}
+}
+
+// azureDSPMSettings holds the DSPM settings of a registration request.
+type azureDSPMSettings struct {
+	Regions                  []string
+	HostSubscriptionID       string
+	NetworkConfigurationType string
+	CustomVnetConfiguration  models.AzureDSPMSettingsDspmCustomVnetConfiguration
+}
+
 func (r *cloudAzureTenantResource) Schema(
 	ctx context.Context,
 	req resource.SchemaRequest,
@@ -121,7 +155,17 @@ func (r *cloudAzureTenantResource) Schema(
 			},
 			"cs_azure_client_id": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Client ID of CrowdStrike's multi-tenant application in Azure. This is used to establish the connection between Azure and Falcon Cloud Security.",
+				MarkdownDescription: "Client ID of CrowdStrike's multi-tenant application in Azure. This is used to establish the connection between Azure and Falcon Cloud Security, and is the application ID of the service principal to create in the tenant.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"admin_consent_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URL an administrator of the tenant visits to grant consent to CrowdStrike's multi-tenant application, creating its service principal in the tenant.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"enterprise_app_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URL of the enterprise application of CrowdStrike's multi-tenant application in the Azure portal.",
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			// reguired if realtime_visibility is enabled todo validate
@@ -160,7 +204,7 @@ func (r *cloudAzureTenantResource) Schema(
 				Attributes: map[string]schema.Attribute{
 					"enabled": schema.BoolAttribute{
 						Required:    true,
-						Description: "Enable real-time visibility and detection",
+						Description: "Enable real-time visibility and detection (behavior assessment). Indicators of misconfiguration are always assessed for registered subscriptions.",
 					},
 				},
 				Default: objectdefault.StaticValue(
@@ -177,6 +221,75 @@ func (r *cloudAzureTenantResource) Schema(
 					objectplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"dspm": schema.SingleNestedAttribute{
+				Optional: true,
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Required:    true,
+						Description: "Enable Data Security Posture Management (DSPM) for the registered subscriptions.",
+					},
+					"regions": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						MarkdownDescription: "A list of Azure regions, such as `eastus`, where data stores are scanned by DSPM.",
+					},
+					"host_subscription_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Azure subscription ID where the DSPM scanning infrastructure is deployed.",
+					},
+					"network_configuration_type": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("managed"),
+						MarkdownDescription: "How the network of the DSPM scanners is configured. One of `managed`, `managed_no_nat` or `custom`. With `custom`, the subnets of each region are set in `custom_networks`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("managed", "managed_no_nat", "custom"),
+						},
+					},
+					"custom_networks": schema.MapNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "The subnets used by DSPM in each region, keyed by region. Required when `network_configuration_type` is `custom`.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"scanners_subnet_id": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "Resource ID of the subnet of the DSPM scanners.",
+								},
+								"clones_subnet_id": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "Resource ID of the subnet of the disk clones scanned by DSPM.",
+								},
+							},
+						},
+					},
+				},
+				Default: objectdefault.StaticValue(
+					types.ObjectValueMust(
+						map[string]attr.Type{
+							"enabled":                    types.BoolType,
+							"regions":                    types.ListType{ElemType: types.StringType},
+							"host_subscription_id":       types.StringType,
+							"network_configuration_type": types.StringType,
+							"custom_networks": types.MapType{
+								ElemType: types.ObjectType{AttrTypes: azureDSPMCustomNetwork{}.AttributeTypes()},
+							},
+						},
+						map[string]attr.Value{
+							"enabled":                    types.BoolValue(false),
+							"regions":                    types.ListNull(types.StringType),
+							"host_subscription_id":       types.StringNull(),
+							"network_configuration_type": types.StringValue("managed"),
+							"custom_networks": types.MapNull(
+								types.ObjectType{AttrTypes: azureDSPMCustomNetwork{}.AttributeTypes()},
+							),
+						},
+					),
+				),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"resource_name_prefix": schema.StringAttribute{
 				MarkdownDescription: "The prefix added to resources created during onboarding. It will be used if you generate new .tfvars from the UI.",
 				Optional:            true,
@@ -202,6 +315,9 @@ func (r *cloudAzureTenantResource) Schema(
 				MarkdownDescription: "Tags applied to managed resources. This does not effect the registration of the tenant. It will be used if you generate new .tfvars from the UI.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -238,6 +354,8 @@ func (m *cloudAzureTenantModel) wrap(
 
 	m.TenantId = types.StringValue(*registration.TenantID)
 	m.AppRegistrationId = types.StringValue(registration.AppRegistrationID)
+	m.AdminConsentURL = types.StringValue(registration.AdminConsentURL)
+	m.EnterpriseAppURL = types.StringValue(registration.EnterpriseAppURL)
 	m.AccountType = types.StringValue(registration.AccountType)
 	m.CsInfraRegion = types.StringPointerValue(registration.CsInfraRegion)
 	m.CsInfraSubscriptionId = types.StringPointerValue(registration.CsInfraSubscriptionID)
@@ -252,20 +370,100 @@ func (m *cloudAzureTenantModel) wrap(
 	if m.RealtimeVisibility == nil {
 		m.RealtimeVisibility = &realtimeVisibility{}
 	}
+	if m.DSPM == nil {
+		m.DSPM = &azureDSPMOptions{
+			Regions:                  types.ListNull(types.StringType),
+			NetworkConfigurationType: types.StringValue("managed"),
+			CustomNetworks:           types.MapNull(types.ObjectType{AttrTypes: azureDSPMCustomNetwork{}.AttributeTypes()}),
+		}
+	}
 	m.RealtimeVisibility.Enabled = types.BoolValue(false)
+	m.DSPM.Enabled = types.BoolValue(false)
 	for _, product := range registration.Products {
 		if *product.Product == "cspm" {
 			for _, feature := range product.Features {
-				if feature == "ioa" {
+				switch feature {
+				case "ioa":
 					m.RealtimeVisibility.Enabled = types.BoolValue(true)
+				case "dspm":
+					m.DSPM.Enabled = types.BoolValue(true)
 				}
 			}
 		}
 	}
+	diags.Append(m.DSPM.wrap(ctx, registration)...)
 
 	return diags
 }
 
+func (d *azureDSPMOptions) wrap(
+	ctx context.Context,
+	registration models.AzureTenantRegistration,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	regions := utils.SliceToListTypeString(ctx, registration.DspmRegions, &diags)
+	if d.Regions.IsNull() && len(regions.Elements()) == 0 {
+		regions = types.ListNull(types.StringType)
+	}
+	d.Regions = regions
+
+	d.HostSubscriptionID = utils.SetStringFromAPIIfNotEmpty(
+		d.HostSubscriptionID,
+		registration.DspmHostSubscriptionID,
+	)
+
+	if registration.DspmNetworkConfigurationType != "" {
+		d.NetworkConfigurationType = types.StringValue(registration.DspmNetworkConfigurationType)
+	}
+
+	networkType := types.ObjectType{AttrTypes: azureDSPMCustomNetwork{}.AttributeTypes()}
+	if d.CustomNetworks.IsNull() && len(registration.DspmCustomVnetConfiguration) == 0 {
+		d.CustomNetworks = types.MapNull(networkType)
+		return diags
+	}
+
+	networks := make(map[string]azureDSPMCustomNetwork, len(registration.DspmCustomVnetConfiguration))
+	for region, network := range registration.DspmCustomVnetConfiguration {
+		networks[region] = azureDSPMCustomNetwork{
+			ScannersSubnetID: types.StringValue(network.ScannersSubnetID),
+			ClonesSubnetID:   types.StringValue(network.ClonesSubnetID),
+		}
+	}
+	customNetworks, mapDiags := types.MapValueFrom(ctx, networkType, networks)
+	diags.Append(mapDiags...)
+	d.CustomNetworks = customNetworks
+
+	return diags
+}
+
+// expand returns the DSPM settings to send with a registration request.
+func (d *azureDSPMOptions) expand(ctx context.Context, diags *diag.Diagnostics) azureDSPMSettings {
+	settings := azureDSPMSettings{Regions: []string{}}
+	if d == nil {
+		return settings
+	}
+
+	if regions := utils.ListTypeAs[string](ctx, d.Regions, diags); regions != nil {
+		settings.Regions = regions
+	}
+	settings.HostSubscriptionID = d.HostSubscriptionID.ValueString()
+	settings.NetworkConfigurationType = d.NetworkConfigurationType.ValueString()
+
+	networks := utils.MapTypeAs[azureDSPMCustomNetwork](ctx, d.CustomNetworks, diags)
+	if len(networks) > 0 {
+		settings.CustomVnetConfiguration = make(models.AzureDSPMSettingsDspmCustomVnetConfiguration, len(networks))
+		for region, network := range networks {
+			settings.CustomVnetConfiguration[region] = models.AzureDSPMRegionCustomNetworkConfiguration{
+				ScannersSubnetID: network.ScannersSubnetID.ValueString(),
+				ClonesSubnetID:   network.ClonesSubnetID.ValueString(),
+			}
+		}
+	}
+
+	return settings
+}
+
 func (r *cloudAzureTenantResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -277,6 +475,9 @@ func (r *cloudAzureTenantResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	registration, err := r.createRegistration(ctx, &data)
 	resp.Diagnostics.Append(err...)
 	if resp.Diagnostics.HasError() {
@@ -308,6 +509,9 @@ func (r *cloudAzureTenantResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	registration, diags := r.getRegistration(ctx, data.TenantId.ValueString())
 	if diags.HasError() {
 		if tferrors.HasNotFoundError(diags) {
@@ -334,6 +538,9 @@ func (r *cloudAzureTenantResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	registration, err := r.updateRegistration(ctx, &data)
 	resp.Diagnostics.Append(err...)
 	if resp.Diagnostics.HasError() {
@@ -355,6 +562,9 @@ func (r *cloudAzureTenantResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	resp.Diagnostics.Append(r.deleteRegistration(ctx, data.TenantId.ValueString())...)
 }
 
@@ -394,6 +604,24 @@ func (r *cloudAzureTenantResource) ValidateConfig(
 			"microsoft_graph_permission_ids",
 		)...)
 
+	if config.DSPM != nil && utils.IsKnown(config.DSPM.NetworkConfigurationType) {
+		customNetwork := config.DSPM.NetworkConfigurationType.ValueString() == "custom"
+		if customNetwork && config.DSPM.CustomNetworks.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("dspm").AtName("custom_networks"),
+				"Missing custom networks",
+				"custom_networks must be set when network_configuration_type is custom.",
+			)
+		}
+		if !customNetwork && !config.DSPM.CustomNetworks.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("dspm").AtName("custom_networks"),
+				"Invalid custom networks",
+				"custom_networks can only be set when network_configuration_type is custom.",
+			)
+		}
+	}
+
 	affixCount := 0
 
 	if utils.IsKnown(config.ResourceNamePrefix) {
@@ -435,6 +663,8 @@ func (r cloudAzureTenantResource) ModifyPlan(
 
 	if !state.MicrosoftGraphPermissionIds.Equal(plan.MicrosoftGraphPermissionIds) {
 		plan.AppRegistrationId = types.StringUnknown()
+		plan.AdminConsentURL = types.StringUnknown()
+		plan.EnterpriseAppURL = types.StringUnknown()
 	}
 
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
@@ -520,6 +750,12 @@ func (r *cloudAzureTenantResource) createRegistration(
 		cspmProductFeatures.Features = append(cspmProductFeatures.Features, "ioa")
 	}
 
+	if data.DSPM != nil && data.DSPM.Enabled.ValueBool() {
+		cspmProductFeatures.Features = append(cspmProductFeatures.Features, "dspm")
+	}
+
+	dspm := data.DSPM.expand(ctx, &diags)
+
 	params := cloud_azure_registration.CloudRegistrationAzureCreateRegistrationParams{
 		Body: &models.AzureAzureRegistrationCreateRequestExtV1{
 			Resource: &models.AzureAzureRegistrationCreateInput{
@@ -543,7 +779,11 @@ func (r *cloudAzureTenantResource) createRegistration(
 				Products: []*models.DomainProductFeatures{
 					&cspmProductFeatures,
 				},
-				Tags: utils.MapTypeAs[string](ctx, data.Tags, &diags),
+				DspmRegions:                  dspm.Regions,
+				DspmHostSubscriptionID:       dspm.HostSubscriptionID,
+				DspmNetworkConfigurationType: dspm.NetworkConfigurationType,
+				DspmCustomVnetConfiguration:  dspm.CustomVnetConfiguration,
+				Tags:                         utils.MapTypeAs[string](ctx, data.Tags, &diags),
 			},
 		},
 		Context: ctx,
@@ -587,6 +827,12 @@ func (r *cloudAzureTenantResource) updateRegistration(
 		cspmProductFeatures.Features = append(cspmProductFeatures.Features, "ioa")
 	}
 
+	if data.DSPM != nil && data.DSPM.Enabled.ValueBool() {
+		cspmProductFeatures.Features = append(cspmProductFeatures.Features, "dspm")
+	}
+
+	dspm := data.DSPM.expand(ctx, &diags)
+
 	params := cloud_azure_registration.CloudRegistrationAzureUpdateRegistrationParams{
 		Body: &models.AzureAzureRegistrationUpdateRequestExtV1{
 			Resource: &models.AzureAzureRegistrationUpdateInput{
@@ -614,7 +860,11 @@ func (r *cloudAzureTenantResource) updateRegistration(
 				Products: []*models.DomainProductFeatures{
 					&cspmProductFeatures,
 				},
-				Tags: utils.MapTypeAs[string](ctx, data.Tags, &diags),
+				DspmRegions:                  dspm.Regions,
+				DspmHostSubscriptionID:       dspm.HostSubscriptionID,
+				DspmNetworkConfigurationType: dspm.NetworkConfigurationType,
+				DspmCustomVnetConfiguration:  dspm.CustomVnetConfiguration,
+				Tags:                         utils.MapTypeAs[string](ctx, data.Tags, &diags),
 			},
 		},
 		Context: ctx,
@@ -0,0 +1,358 @@
+package fcs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/cspm_registration"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &cloudScanScheduleResource{}
+	_ resource.ResourceWithConfigure      = &cloudScanScheduleResource{}
+	_ resource.ResourceWithImportState    = &cloudScanScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &cloudScanScheduleResource{}
+)
+
+// scanScheduleCloudPlatforms are the cloud platforms with a scan schedule.
+var scanScheduleCloudPlatforms = []string{"aws", "azure", "gcp"}
+
+func NewCloudScanScheduleResource() resource.Resource {
+	return &cloudScanScheduleResource{}
+}
+
+type cloudScanScheduleResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type cloudScanScheduleModel struct {
+	ID                types.String   `tfsdk:"id"`
+	CloudPlatform     types.String   `tfsdk:"cloud_platform"`
+	ScanSchedule      types.String   `tfsdk:"scan_schedule"`
+	ScanInterval      types.String   `tfsdk:"scan_interval"`
+	NextScanTimestamp types.String   `tfsdk:"next_scan_timestamp"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cloudScanScheduleResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(cspmRegistrationScopes)...)
+}
+
+func (r *cloudScanScheduleResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_scan_schedule"
+}
+
+func (r *cloudScanScheduleResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(
+			"Falcon Cloud Security --- This resource manages the schedule of the configuration assessment scans of a cloud provider in Falcon Cloud Security. "+
+				"Destruction of this resource will not change the scan schedule.\n\n%s",
+			scopes.GenerateScopeDescription(cspmRegistrationScopes),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The cloud platform of the scan schedule.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"cloud_platform": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The cloud platform to schedule scans for. One of: `aws`, `azure`, `gcp`.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.OneOf(scanScheduleCloudPlatforms...)},
+			},
+			"scan_schedule": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The schedule of the scans, such as `24h`. Left unchanged when not set.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"scan_interval": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The interval between scans. Left unchanged when not set.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"next_scan_timestamp": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time of the next scheduled scan.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// wrap transforms Go values to their terraform wrapped values.
+func (m *cloudScanScheduleModel) wrap(schedule *models.DomainScanScheduleDataV1) {
+	m.ID = m.CloudPlatform
+	m.ScanSchedule = flex.StringValueToFramework(schedule.ScanSchedule)
+	m.ScanInterval = flex.StringValueToFramework(schedule.ScanInterval)
+	m.NextScanTimestamp = types.StringNull()
+	if !time.Time(schedule.NextScanTimestamp).IsZero() {
+		m.NextScanTimestamp = types.StringValue(schedule.NextScanTimestamp.String())
+	}
+}
+
+func (r *cloudScanScheduleResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan cloudScanScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, tferrors.Create, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *cloudScanScheduleResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state cloudScanScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	schedule, diags := r.getScanSchedule(ctx, state.CloudPlatform.ValueString())
+	if tferrors.HasNotFoundError(diags) {
+		resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.wrap(schedule)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *cloudScanScheduleResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan cloudScanScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, tferrors.Update, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *cloudScanScheduleResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// The scan schedule of a cloud platform can not be deleted, so we will just remove it from state.
+}
+
+func (r *cloudScanScheduleResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cloud_platform"), req, resp)
+}
+
+// ValidateConfig runs during validate, plan, and apply
+// validate resource is configured as expected.
+func (r *cloudScanScheduleResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config cloudScanScheduleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ScanSchedule.IsNull() && config.ScanInterval.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scan_schedule"),
+			"Missing scan schedule",
+			"At least one of scan_schedule or scan_interval must be set.",
+		)
+	}
+}
+
+// apply updates the scan schedule to the planned values, keeping the current
+// values of those not set, and refreshes the model.
+func (r *cloudScanScheduleResource) apply(
+	ctx context.Context,
+	operation tferrors.Operation,
+	plan *cloudScanScheduleModel,
+) diag.Diagnostics {
+	schedule, diags := r.getScanSchedule(ctx, plan.CloudPlatform.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+
+	if utils.IsKnown(plan.ScanSchedule) {
+		schedule.ScanSchedule = plan.ScanSchedule.ValueString()
+	}
+	if utils.IsKnown(plan.ScanInterval) {
+		schedule.ScanInterval = plan.ScanInterval.ValueString()
+	}
+
+	res, err := r.client.CspmRegistration.UpdateCSPMScanSchedule(
+		&cspm_registration.UpdateCSPMScanScheduleParams{
+			Body: &models.RegistrationScanScheduleUpdateRequestV1{
+				Resources: []*models.DomainScanScheduleDataV1{
+					{
+						CloudPlatform: plan.CloudPlatform.ValueStringPointer(),
+						ScanSchedule:  schedule.ScanSchedule,
+						ScanInterval:  schedule.ScanInterval,
+					},
+				},
+			},
+			Context: ctx,
+		},
+	)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(operation, err, cspmRegistrationScopes))
+		return diags
+	}
+
+	if res == nil || res.Payload == nil {
+		diags.Append(tferrors.NewEmptyResponseError(operation))
+		return diags
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(operation, res.Payload.Errors); diag != nil {
+		diags.Append(diag)
+		return diags
+	}
+
+	for _, updated := range res.Payload.Resources {
+		if updated != nil && updated.CloudPlatform != nil && *updated.CloudPlatform == plan.CloudPlatform.ValueString() {
+			schedule = updated
+			break
+		}
+	}
+
+	plan.wrap(schedule)
+	return diags
+}
+
+// getScanSchedule returns the scan schedule of a cloud platform.
+func (r *cloudScanScheduleResource) getScanSchedule(
+	ctx context.Context,
+	cloudPlatform string,
+) (*models.DomainScanScheduleDataV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := r.client.CspmRegistration.GetCSPMScanSchedule(
+		&cspm_registration.GetCSPMScanScheduleParams{
+			CloudPlatform: []string{cloudPlatform},
+			Context:       ctx,
+		},
+	)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, cspmRegistrationScopes))
+		return nil, diags
+	}
+
+	if res == nil || res.Payload == nil {
+		diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+		return nil, diags
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		diags.Append(diag)
+		return nil, diags
+	}
+
+	for _, schedule := range res.Payload.Resources {
+		if schedule != nil && schedule.CloudPlatform != nil && *schedule.CloudPlatform == cloudPlatform {
+			return schedule, diags
+		}
+	}
+
+	diags.Append(tferrors.NewNotFoundError(
+		fmt.Sprintf("No scan schedule found for cloud platform %s.", cloudPlatform),
+	))
+	return nil, diags
+}
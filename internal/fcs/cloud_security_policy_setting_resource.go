@@ -0,0 +1,497 @@
+package fcs
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/cspm_registration"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &cloudSecurityPolicySettingResource{}
+	_ resource.ResourceWithConfigure   = &cloudSecurityPolicySettingResource{}
+	_ resource.ResourceWithImportState = &cloudSecurityPolicySettingResource{}
+)
+
+// policySeverities are the severities a policy can be set to.
+var policySeverities = []string{"critical", "high", "medium", "informational"}
+
+func NewCloudSecurityPolicySettingResource() resource.Resource {
+	return &cloudSecurityPolicySettingResource{}
+}
+
+type cloudSecurityPolicySettingResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type cloudSecurityPolicySettingModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	PolicyID           types.Int64    `tfsdk:"policy_id"`
+	AccountIDs         types.Set      `tfsdk:"account_ids"`
+	Regions            types.Set      `tfsdk:"regions"`
+	Enabled            types.Bool     `tfsdk:"enabled"`
+	Severity           types.String   `tfsdk:"severity"`
+	Name               types.String   `tfsdk:"name"`
+	PolicyType         types.String   `tfsdk:"policy_type"`
+	AttackTypes        types.Set      `tfsdk:"attack_types"`
+	CloudProvider      types.String   `tfsdk:"cloud_provider"`
+	CloudService       types.String   `tfsdk:"cloud_service"`
+	DefaultSeverity    types.String   `tfsdk:"default_severity"`
+	IsRemediable       types.Bool     `tfsdk:"is_remediable"`
+	RemediationSummary types.String   `tfsdk:"remediation_summary"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cloudSecurityPolicySettingResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(cspmRegistrationScopes)...)
+}
+
+func (r *cloudSecurityPolicySettingResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_security_policy_setting"
+}
+
+func (r *cloudSecurityPolicySettingResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(
+			"Falcon Cloud Security --- This resource manages the settings of a policy in Falcon Cloud Security, either an indicator of misconfiguration (IOM) policy "+
+				"or a behavioral indicator of attack (IOA) policy: whether it is enabled, and the severity of its findings, for all accounts or for selected accounts and regions. "+
+				"Destroying the resource enables the policy again at its default severity for the same accounts and regions.\n\n%s",
+			scopes.GenerateScopeDescription(cspmRegistrationScopes),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the policy.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"policy_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the IOM or IOA policy to configure.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				Validators:          []validator.Int64{int64validator.Between(1, math.MaxInt32)},
+			},
+			"account_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The AWS accounts, Azure subscriptions, or Google Cloud projects the settings apply to. When not set, the settings apply to all accounts.",
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"regions": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The regions the settings apply to. When not set, the settings apply to all regions.",
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether the policy is evaluated.",
+			},
+			"severity": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The severity of the findings of the policy. One of: `critical`, `high`, `medium`, `informational`. Defaults to the default severity of the policy.",
+				Validators:          []validator.String{stringvalidator.OneOf(policySeverities...)},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the policy.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"policy_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of the policy, which tells IOM and IOA policies apart.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"attack_types": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The attack types detected by an IOA policy.",
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"cloud_provider": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The cloud provider the policy evaluates.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"cloud_service": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The cloud service the policy evaluates.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"default_severity": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The default severity of the findings of the policy.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"is_remediable": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether findings of the policy can be remediated by Falcon Cloud Security.",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"remediation_summary": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A summary of how to remediate findings of the policy.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// wrap transforms Go values to their terraform wrapped values. The enabled
+// and severity values are taken from the settings of the configured accounts
+// that differ from the model, so changes outside of Terraform are detected.
+func (m *cloudSecurityPolicySettingModel) wrap(
+	ctx context.Context,
+	policy *models.DomainCIDPolicyAssignments,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(strconv.FormatInt(int64(policy.PolicyID), 10))
+	m.PolicyID = types.Int64Value(int64(policy.PolicyID))
+	m.Name = flex.StringValueToFramework(policy.Name)
+	m.PolicyType = flex.StringValueToFramework(policy.PolicyType)
+	attackTypes, attackTypesDiags := flex.FlattenStringValueSet(ctx, policy.AttackTypes)
+	diags.Append(attackTypesDiags...)
+	m.AttackTypes = attackTypes
+	m.CloudProvider = flex.StringValueToFramework(policy.CloudProvider)
+	m.CloudService = flex.StringValueToFramework(policy.CloudService)
+	m.DefaultSeverity = flex.StringValueToFramework(strings.ToLower(policy.DefaultSeverity))
+	m.IsRemediable = types.BoolPointerValue(policy.IsRemediable)
+	m.RemediationSummary = flex.StringValueToFramework(policy.RemediationSummary)
+
+	if m.Enabled.IsNull() {
+		m.Enabled = types.BoolValue(true)
+	}
+	if !utils.IsKnown(m.Severity) {
+		m.Severity = m.DefaultSeverity
+	}
+
+	accountIDs := flex.ExpandSetAs[string](ctx, m.AccountIDs, &diags)
+	for _, setting := range policy.PolicySettings {
+		if setting == nil {
+			continue
+		}
+		if len(accountIDs) > 0 &&
+			!slices.Contains(accountIDs, setting.AccountID) &&
+			!slices.Contains(accountIDs, setting.TenantID) {
+			continue
+		}
+
+		severity := strings.ToLower(setting.Severity)
+		if (setting.Enabled != nil && *setting.Enabled != m.Enabled.ValueBool()) ||
+			(severity != "" && severity != m.Severity.ValueString()) {
+			if setting.Enabled != nil {
+				m.Enabled = types.BoolValue(*setting.Enabled)
+			}
+			if severity != "" {
+				m.Severity = types.StringValue(severity)
+			}
+			break
+		}
+	}
+
+	return diags
+}
+
+func (r *cloudSecurityPolicySettingResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan cloudSecurityPolicySettingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	policy, diags := r.getPolicy(ctx, plan.PolicyID.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !utils.IsKnown(plan.Severity) {
+		plan.Severity = flex.StringValueToFramework(strings.ToLower(policy.DefaultSeverity))
+	}
+
+	resp.Diagnostics.Append(r.updatePolicySettings(ctx, tferrors.Create, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *cloudSecurityPolicySettingResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state cloudSecurityPolicySettingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	diags := r.read(ctx, &state)
+	if tferrors.HasNotFoundError(diags) {
+		resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *cloudSecurityPolicySettingResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state cloudSecurityPolicySettingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	if !utils.IsKnown(plan.Severity) {
+		plan.Severity = state.DefaultSeverity
+	}
+
+	resp.Diagnostics.Append(r.updatePolicySettings(ctx, tferrors.Update, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *cloudSecurityPolicySettingResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state cloudSecurityPolicySettingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	state.Enabled = types.BoolValue(true)
+	state.Severity = state.DefaultSeverity
+
+	diags := r.updatePolicySettings(ctx, tferrors.Delete, &state)
+	if tferrors.HasNotFoundError(diags) {
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cloudSecurityPolicySettingResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	policyID, err := strconv.ParseInt(req.ID, 10, 32)
+	if err != nil || policyID < 1 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected the numeric ID of a policy, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_id"), policyID)...)
+}
+
+// read refreshes the model from the policy.
+func (r *cloudSecurityPolicySettingResource) read(
+	ctx context.Context,
+	model *cloudSecurityPolicySettingModel,
+) diag.Diagnostics {
+	policy, diags := r.getPolicy(ctx, model.PolicyID.ValueInt64())
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(model.wrap(ctx, policy)...)
+	return diags
+}
+
+// getPolicy returns a policy and its settings.
+func (r *cloudSecurityPolicySettingResource) getPolicy(
+	ctx context.Context,
+	policyID int64,
+) (*models.DomainCIDPolicyAssignments, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, status, err := r.client.CspmRegistration.GetCSPMPolicySettings(
+		&cspm_registration.GetCSPMPolicySettingsParams{
+			PolicyID: utils.Addr(strconv.FormatInt(policyID, 10)),
+			Context:  ctx,
+		},
+	)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, cspmRegistrationScopes))
+		return nil, diags
+	}
+	if status != nil {
+		diags.Append(tferrors.NewNotFoundError(
+			fmt.Sprintf("Policy %d not found: %s", policyID, status.Error()),
+		))
+		return nil, diags
+	}
+
+	if res == nil || res.Payload == nil {
+		diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+		return nil, diags
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		diags.Append(diag)
+		return nil, diags
+	}
+
+	for _, policy := range res.Payload.Resources {
+		if policy != nil && int64(policy.PolicyID) == policyID {
+			return policy, diags
+		}
+	}
+
+	diags.Append(tferrors.NewNotFoundError(fmt.Sprintf("Policy %d not found.", policyID)))
+	return nil, diags
+}
+
+// updatePolicySettings applies the enabled and severity values of the model
+// to its accounts and regions.
+func (r *cloudSecurityPolicySettingResource) updatePolicySettings(
+	ctx context.Context,
+	operation tferrors.Operation,
+	model *cloudSecurityPolicySettingModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	setting := &models.RegistrationPolicyExtV1{
+		AccountID:   utils.Addr(""),
+		AccountIds:  flex.ExpandSetAs[string](ctx, model.AccountIDs, &diags),
+		Enabled:     model.Enabled.ValueBoolPointer(),
+		PolicyID:    utils.Addr(int32(model.PolicyID.ValueInt64())),
+		Regions:     flex.ExpandSetAs[string](ctx, model.Regions, &diags),
+		Severity:    model.Severity.ValueStringPointer(),
+		TagExcluded: utils.Addr(false),
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	res, status, err := r.client.CspmRegistration.UpdateCSPMPolicySettings(
+		&cspm_registration.UpdateCSPMPolicySettingsParams{
+			Body: &models.RegistrationPolicyRequestExtV1{
+				Resources: []*models.RegistrationPolicyExtV1{setting},
+			},
+			Context: ctx,
+		},
+	)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(operation, err, cspmRegistrationScopes))
+		return diags
+	}
+	if status != nil {
+		diags.Append(tferrors.NewOperationError(operation, status))
+		return diags
+	}
+
+	if res == nil || res.Payload == nil {
+		diags.Append(tferrors.NewEmptyResponseError(operation))
+		return diags
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(operation, res.Payload.Errors); diag != nil {
+		diags.Append(diag)
+	}
+
+	return diags
+}
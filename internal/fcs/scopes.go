@@ -17,3 +17,18 @@ var azureRegistrationScopes = []scopes.Scope{
 		Write: true,
 	},
 }
+
+var cspmRegistrationScopes = []scopes.Scope{
+	{
+		Name:  "CSPM registration",
+		Read:  true,
+		Write: true,
+	},
+}
+
+var googleRegistrationScopes = []scopes.Scope{
+	{
+		Name: "Cloud Security Google Cloud Registration",
+		Read: true,
+	},
+}
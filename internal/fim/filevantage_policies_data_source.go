@@ -60,6 +60,7 @@ func (d *filevantagePoliciesDataSource) Configure(
 	}
 
 	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesRead)...)
 }
 
 // filevantagePoliciesDataSource is the data source implementation.
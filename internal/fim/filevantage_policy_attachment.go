@@ -10,8 +10,10 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -31,11 +33,13 @@ var (
 	_ resource.ResourceWithConfigure      = &filevantagePolicyAttachmentResource{}
 	_ resource.ResourceWithImportState    = &filevantagePolicyAttachmentResource{}
 	_ resource.ResourceWithValidateConfig = &filevantagePolicyAttachmentResource{}
+	_ resource.ResourceWithModifyPlan     = &filevantagePolicyAttachmentResource{}
+	_ resource.ResourceWithMoveState      = &filevantagePolicyAttachmentResource{}
 )
 
 var (
 	attachmentDocumentationSection        string         = "FileVantage"
-	attachmentResourceMarkdownDescription string         = "This resource allows managing the host groups and rule groups attached to a FileVantage policy. By default (when `exclusive` is true), this resource takes exclusive ownership over the host groups and rule groups assigned to a FileVantage policy. When `exclusive` is false, this resource only manages the specific host groups and rule groups defined in the configuration. If you want to fully create or manage a FileVantage policy please use the `filevantage_policy` resource."
+	attachmentResourceMarkdownDescription string         = "This resource allows managing the host groups and rule groups attached to a FileVantage policy. By default (when `exclusive` is true), this resource takes exclusive ownership over the host groups and rule groups assigned to a FileVantage policy. When `exclusive` is false, this resource only manages the specific host groups and rule groups defined in the configuration. If you want to fully create or manage a FileVantage policy please use the `filevantage_policy` resource. A `crowdstrike_filevantage_policy` resource can be moved to this resource with a `moved` block to take over its attachments without recreating the policy; the attachment starts with `exclusive` set to true."
 	attachmentRequiredScopes              []scopes.Scope = apiScopesReadWrite
 )
 
@@ -44,15 +48,17 @@ func NewFilevantagePolicyAttachmentResource() resource.Resource {
 }
 
 type filevantagePolicyAttachmentResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client     *client.CrowdStrikeAPISpecification
+	references references.Validator
 }
 
 type filevantagePolicyAttachmentResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	HostGroups  types.Set    `tfsdk:"host_groups"`
-	RuleGroups  types.Set    `tfsdk:"rule_groups"`
-	Exclusive   types.Bool   `tfsdk:"exclusive"`
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	HostGroups  types.Set      `tfsdk:"host_groups"`
+	RuleGroups  types.Set      `tfsdk:"rule_groups"`
+	Exclusive   types.Bool     `tfsdk:"exclusive"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *filevantagePolicyAttachmentResourceModel) wrap(
@@ -172,6 +178,23 @@ func (r *filevantagePolicyAttachmentResource) Configure(
 	}
 
 	r.client = config.Client
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(attachmentRequiredScopes)...)
+}
+
+// ModifyPlan validates that the objects referenced by the resource exist.
+func (r *filevantagePolicyAttachmentResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	resp.Diagnostics.Append(r.references.Validate(
+		ctx,
+		req.Plan,
+		references.FileVantagePolicy(path.Root("id")),
+		references.HostGroups(path.Root("host_groups")),
+		references.FileVantageRuleGroups(path.Root("rule_groups")),
+	)...)
 }
 
 func (r *filevantagePolicyAttachmentResource) Metadata(
@@ -183,7 +206,7 @@ func (r *filevantagePolicyAttachmentResource) Metadata(
 }
 
 func (r *filevantagePolicyAttachmentResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -234,6 +257,9 @@ func (r *filevantagePolicyAttachmentResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -248,6 +274,9 @@ func (r *filevantagePolicyAttachmentResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getFilevantagePolicy(ctx, r.client, plan.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -315,6 +344,9 @@ func (r *filevantagePolicyAttachmentResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getFilevantagePolicy(ctx, r.client, state.ID.ValueString())
 	for _, err := range diags.Errors() {
 		if err.Summary() == "Failed to get FileVantage policy" {
@@ -352,6 +384,9 @@ func (r *filevantagePolicyAttachmentResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	planHostGroups := plan.HostGroups
 	planRuleGroups := plan.RuleGroups
 
@@ -453,6 +488,9 @@ func (r *filevantagePolicyAttachmentResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	emptySet := basetypes.SetValue{}
 
 	resp.Diagnostics.Append(
@@ -461,6 +499,21 @@ func (r *filevantagePolicyAttachmentResource) Delete(
 		syncAttachmentRuleGroups(ctx, r.client, emptySet, state.RuleGroups, state.ID.ValueString())...)
 }
 
+// MoveState allows moving a `crowdstrike_filevantage_policy` resource to this
+// resource with a moved block, so the FileVantage policy is not destroyed and
+// recreated when its attachments are managed separately.
+func (r *filevantagePolicyAttachmentResource) MoveState(
+	_ context.Context,
+) []resource.StateMover {
+	return []resource.StateMover{
+		utils.AttachmentStateMover(
+			"crowdstrike_filevantage_policy",
+			utils.MovedGroups{Source: "host_groups", Target: "host_groups"},
+			utils.MovedGroups{Source: "rule_groups", List: true, Target: "rule_groups"},
+		),
+	}
+}
+
 func (r *filevantagePolicyAttachmentResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
@@ -10,6 +10,7 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/client/filevantage"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -45,10 +46,11 @@ type filevantagePolicyPrecedenceResource struct {
 }
 
 type filevantagePolicyPrecedenceResourceModel struct {
-	IDs          types.List   `tfsdk:"ids"`
-	Enforcement  types.String `tfsdk:"enforcement"`
-	PlatformName types.String `tfsdk:"platform_name"`
-	LastUpdated  types.String `tfsdk:"last_updated"`
+	IDs          types.List     `tfsdk:"ids"`
+	Enforcement  types.String   `tfsdk:"enforcement"`
+	PlatformName types.String   `tfsdk:"platform_name"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (d *filevantagePolicyPrecedenceResourceModel) wrap(
@@ -92,6 +94,7 @@ func (r *filevantagePolicyPrecedenceResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
 }
 
 func (r *filevantagePolicyPrecedenceResource) Metadata(
@@ -103,7 +106,7 @@ func (r *filevantagePolicyPrecedenceResource) Metadata(
 }
 
 func (r *filevantagePolicyPrecedenceResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -138,6 +141,9 @@ func (r *filevantagePolicyPrecedenceResource) Schema(
 				Description: "Timestamp of the last Terraform update of the resource.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -152,6 +158,9 @@ func (r *filevantagePolicyPrecedenceResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var planPolicyIDs []string
 	resp.Diagnostics.Append(plan.IDs.ElementsAs(ctx, &planPolicyIDs, false)...)
 	if resp.Diagnostics.HasError() {
@@ -206,6 +215,9 @@ func (r *filevantagePolicyPrecedenceResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policies, diags := r.getFilevantagePoilciesByPrecedence(ctx, state.PlatformName.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -233,6 +245,9 @@ func (r *filevantagePolicyPrecedenceResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var planPolicyIDs []string
 	resp.Diagnostics.Append(plan.IDs.ElementsAs(ctx, &planPolicyIDs, false)...)
 	if resp.Diagnostics.HasError() {
@@ -11,7 +11,9 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/client/filevantage"
 	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -59,7 +61,9 @@ var (
 	_ resource.Resource                   = &fimPolicyResource{}
 	_ resource.ResourceWithConfigure      = &fimPolicyResource{}
 	_ resource.ResourceWithImportState    = &fimPolicyResource{}
+	_ resource.ResourceWithIdentity       = &fimPolicyResource{}
 	_ resource.ResourceWithValidateConfig = &fimPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &fimPolicyResource{}
 )
 
 // NewFIMPolicyResource is a helper function to simplify the provider implementation.
@@ -69,7 +73,8 @@ func NewFIMPolicyResource() resource.Resource {
 
 // fimPolicyResource is the resource implementation.
 type fimPolicyResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client     *client.CrowdStrikeAPISpecification
+	references references.Validator
 }
 
 // fimPolicyResourceModel is the resource implementation.
@@ -83,6 +88,7 @@ type fimPolicyResourceModel struct {
 	RuleGroups          types.List            `tfsdk:"rule_groups"`
 	LastUpdated         types.String          `tfsdk:"last_updated"`
 	ScheduledExclusions []*scheduledExclusion `tfsdk:"scheduled_exclusions"`
+	Timeouts            timeouts.Value        `tfsdk:"timeouts"`
 }
 
 type scheduledExclusion struct {
@@ -134,6 +140,22 @@ func (r *fimPolicyResource) Configure(
 	}
 
 	r.client = config.Client
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
+}
+
+// ModifyPlan validates that the objects referenced by the resource exist.
+func (r *fimPolicyResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	resp.Diagnostics.Append(r.references.Validate(
+		ctx,
+		req.Plan,
+		references.HostGroups(path.Root("host_groups")),
+		references.FileVantageRuleGroups(path.Root("rule_groups")),
+	)...)
 }
 
 // Metadata returns the resource type name.
@@ -147,7 +169,7 @@ func (r *fimPolicyResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *fimPolicyResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -330,9 +352,21 @@ func (r *fimPolicyResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *fimPolicyResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *fimPolicyResource) Create(
 	ctx context.Context,
@@ -347,6 +381,9 @@ func (r *fimPolicyResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := r.createFIMPolicy(ctx, plan)
 
 	resp.Diagnostics.Append(diags...)
@@ -425,6 +462,7 @@ func (r *fimPolicyResource) Create(
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -444,6 +482,9 @@ func (r *fimPolicyResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, oldState.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	if oldState.ID.ValueString() == "" {
 		return
 	}
@@ -493,6 +534,7 @@ func (r *fimPolicyResource) Read(
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -512,6 +554,9 @@ func (r *fimPolicyResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from state
 	var state fimPolicyResourceModel
 	diags = req.State.Get(ctx, &state)
@@ -577,6 +622,7 @@ func (r *fimPolicyResource) Update(
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -595,6 +641,9 @@ func (r *fimPolicyResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	resp.Diagnostics.Append(r.deleteFIMPolicy(ctx, state)...)
 }
 
@@ -605,7 +654,7 @@ func (r *fimPolicyResource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // ValidateConfig runs during validate, plan, and apply
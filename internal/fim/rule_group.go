@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -27,11 +29,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// maxRuleIDsPerRequest is the most rule IDs the API accepts in a single request.
+const maxRuleIDsPerRequest = 500
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                   = &filevantageRuleGroupResource{}
 	_ resource.ResourceWithConfigure      = &filevantageRuleGroupResource{}
 	_ resource.ResourceWithImportState    = &filevantageRuleGroupResource{}
+	_ resource.ResourceWithIdentity       = &filevantageRuleGroupResource{}
 	_ resource.ResourceWithValidateConfig = &filevantageRuleGroupResource{}
 )
 
@@ -54,12 +60,13 @@ type filevantageRuleGroupResource struct {
 
 // filevantageRuleGroupResourceModel is the resource implementation.
 type filevantageRuleGroupResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Type        types.String `tfsdk:"type"`
-	Description types.String `tfsdk:"description"`
-	Rules       types.List   `tfsdk:"rules"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Type        types.String   `tfsdk:"type"`
+	Description types.String   `tfsdk:"description"`
+	Rules       types.List     `tfsdk:"rules"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // fimRule is the resource implementation.
@@ -170,6 +177,7 @@ func (r *filevantageRuleGroupResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
 }
 
 // Metadata returns the resource type name.
@@ -183,7 +191,7 @@ func (r *filevantageRuleGroupResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *filevantageRuleGroupResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -492,9 +500,21 @@ func (r *filevantageRuleGroupResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *filevantageRuleGroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *filevantageRuleGroupResource) Create(
 	ctx context.Context,
@@ -507,6 +527,9 @@ func (r *filevantageRuleGroupResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	rgType := plan.Type.ValueString()
 
 	params := filevantage.CreateRuleGroupsParams{
@@ -572,6 +595,7 @@ func (r *filevantageRuleGroupResource) Create(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -589,6 +613,9 @@ func (r *filevantageRuleGroupResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	res, diags := r.getRuleGroup(ctx, state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -632,6 +659,7 @@ func (r *filevantageRuleGroupResource) Read(
 
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -651,6 +679,9 @@ func (r *filevantageRuleGroupResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from state
 	var state filevantageRuleGroupResourceModel
 	diags = req.State.Get(ctx, &state)
@@ -726,6 +757,7 @@ func (r *filevantageRuleGroupResource) Update(
 
 	resp.Diagnostics.Append(
 		resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -743,6 +775,9 @@ func (r *filevantageRuleGroupResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	id := state.ID.ValueString()
 
 	if id == "" {
@@ -770,7 +805,7 @@ func (r *filevantageRuleGroupResource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // ValidateConfig runs during validate, plan, and apply
@@ -1180,32 +1215,50 @@ func (r *filevantageRuleGroupResource) getRules(
 			assignedRuleIDs = append(assignedRuleIDs, *r.ID)
 		}
 
-		res, err := r.client.Filevantage.GetRules(&filevantage.GetRulesParams{
-			Ids:         assignedRuleIDs,
-			RuleGroupID: ruleGroupID,
-			Context:     ctx,
-		})
-		if err != nil {
-			diags.AddError(
-				"Failed to get rules assigned to rule group",
-				fmt.Sprintf(
-					"Failed to get rules for ids (%s): %s",
-					strings.Join(assignedRuleIDs, ", "),
-					err.Error(),
-				),
-			)
-			return rules, diags
-		}
+		// Rules are requested in batches of the most IDs the API accepts,
+		// read concurrently for rule groups with many rules.
+		batches := slices.Collect(slices.Chunk(assignedRuleIDs, maxRuleIDsPerRequest))
+		pages, pageDiags := utils.ConcurrentMap(
+			ctx,
+			batches,
+			utils.DefaultReadConcurrency,
+			func(ctx context.Context, ids []string) ([]*models.RulegroupsRule, diag.Diagnostics) {
+				var diags diag.Diagnostics
+
+				res, err := r.client.Filevantage.GetRules(&filevantage.GetRulesParams{
+					Ids:         ids,
+					RuleGroupID: ruleGroupID,
+					Context:     ctx,
+				})
+				if err != nil {
+					diags.AddError(
+						"Failed to get rules assigned to rule group",
+						fmt.Sprintf(
+							"Failed to get rules for ids (%s): %s",
+							strings.Join(ids, ", "),
+							err.Error(),
+						),
+					)
+					return nil, diags
+				}
 
-		if res == nil || res.Payload == nil {
-			diags.AddError(
-				"Failed to get rules assigned to rule group",
-				"Failed to get rules: response payload is nil",
-			)
+				if res == nil || res.Payload == nil {
+					diags.AddError(
+						"Failed to get rules assigned to rule group",
+						"Failed to get rules: response payload is nil",
+					)
+					return nil, diags
+				}
+
+				return res.Payload.Resources, diags
+			},
+		)
+		diags.Append(pageDiags...)
+		if diags.HasError() {
 			return rules, diags
 		}
 
-		for _, rule := range res.Payload.Resources {
+		for _, rule := range slices.Concat(pages...) {
 			r := rule
 			fimRule := fimRule{}
 
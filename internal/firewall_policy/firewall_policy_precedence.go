@@ -0,0 +1,72 @@
+package firewallpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/firewall_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/precedence"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewFirewallPolicyPrecedenceResource() resource.Resource {
+	return precedence.NewResource(precedence.Policies{
+		TypeName:             "_firewall_policy_precedence",
+		Kind:                 "firewall",
+		DocumentationSection: "Host Setup and Management",
+		MarkdownDescription:  "This resource allows you set the precedence of Firewall Policies based on the order of IDs.",
+		Scopes:               apiScopesReadWrite,
+		List:                 getFirewallPoliciesByPrecedence,
+		Set:                  setFirewallPoliciesPrecedence,
+	})
+}
+
+// getFirewallPoliciesByPrecedence returns firewall policy ids ordered by precedence.
+func getFirewallPoliciesByPrecedence(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	platformName string,
+) ([]string, error) {
+	filter := fmt.Sprintf("platform_name:'%s'", platformName)
+	sort := "precedence.asc"
+	res, err := client.FirewallPolicies.QueryCombinedFirewallPolicies(
+		&firewall_policies.QueryCombinedFirewallPoliciesParams{
+			Context: ctx,
+			Filter:  &filter,
+			Sort:    &sort,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []string
+	if res != nil && res.Payload != nil {
+		for _, policy := range res.Payload.Resources {
+			policies = append(policies, *policy.ID)
+		}
+	}
+
+	return policies, nil
+}
+
+// setFirewallPoliciesPrecedence sets the precedence of the firewall policies.
+func setFirewallPoliciesPrecedence(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	platformName string,
+	policyIDs []string,
+) error {
+	_, err := client.FirewallPolicies.SetFirewallPoliciesPrecedence(
+		&firewall_policies.SetFirewallPoliciesPrecedenceParams{
+			Context: ctx,
+			Body: &models.BaseSetPolicyPrecedenceReqV1{
+				Ids:          policyIDs,
+				PlatformName: &platformName,
+			},
+		},
+	)
+	return err
+}
@@ -0,0 +1,170 @@
+package firewallpolicy_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/crowdstrike/gofalcon/falcon/client/firewall_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/testconfig"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const precedenceResourceName = "crowdstrike_firewall_policy_precedence.test"
+
+func TestAccFirewallPolicyPrecedenceResource_dynamic(t *testing.T) {
+	policyIDs := createFirewallPolicies(t, 2)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyPrecedenceConfig("dynamic", policyIDs),
+				Check:  testAccFirewallPolicyPrecedenceChecks("dynamic", policyIDs),
+			},
+			{
+				Config: testAccFirewallPolicyPrecedenceConfig("dynamic", []string{policyIDs[1], policyIDs[0]}),
+				Check:  testAccFirewallPolicyPrecedenceChecks("dynamic", []string{policyIDs[1], policyIDs[0]}),
+			},
+		},
+	})
+}
+
+func TestAccFirewallPolicyPrecedenceResource_strict(t *testing.T) {
+	policyIDs := createFirewallPolicies(t, 2)
+	existingPolicyIDs := getOtherFirewallPolicyIDs(t, policyIDs)
+
+	initial := append(slices.Clone(policyIDs), existingPolicyIDs...)
+	reordered := append([]string{policyIDs[1], policyIDs[0]}, existingPolicyIDs...)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyPrecedenceConfig("strict", initial),
+				Check:  testAccFirewallPolicyPrecedenceChecks("strict", initial),
+			},
+			{
+				Config: testAccFirewallPolicyPrecedenceConfig("strict", reordered),
+				Check:  testAccFirewallPolicyPrecedenceChecks("strict", reordered),
+			},
+		},
+	})
+}
+
+func testAccFirewallPolicyPrecedenceConfig(enforcement string, policyIDs []string) string {
+	ids := make([]string, len(policyIDs))
+	for i, id := range policyIDs {
+		ids[i] = fmt.Sprintf("%q", id)
+	}
+
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_firewall_policy_precedence" "test" {
+  platform_name = "Windows"
+  enforcement   = %q
+  ids           = [%s]
+}
+`, enforcement, strings.Join(ids, ", "))
+}
+
+func testAccFirewallPolicyPrecedenceChecks(enforcement string, policyIDs []string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrSet(precedenceResourceName, "last_updated"),
+		resource.TestCheckResourceAttr(precedenceResourceName, "platform_name", "Windows"),
+		resource.TestCheckResourceAttr(precedenceResourceName, "enforcement", enforcement),
+		resource.TestCheckResourceAttr(precedenceResourceName, "ids.#", fmt.Sprintf("%d", len(policyIDs))),
+	}
+	for i, id := range policyIDs {
+		checks = append(checks, resource.TestCheckResourceAttr(precedenceResourceName, fmt.Sprintf("ids.%d", i), id))
+	}
+
+	return resource.ComposeAggregateTestCheckFunc(checks...)
+}
+
+// createFirewallPolicies creates count Windows firewall policies, which are
+// deleted when the test finishes, and returns their IDs. There is no firewall
+// policy resource to create them with.
+func createFirewallPolicies(t *testing.T, count int) []string {
+	t.Helper()
+
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skip("Skipping acceptance test: TF_ACC not set")
+	}
+	acctest.PreCheck(t)
+	client := testconfig.GetTestClient()
+
+	platformName := "Windows"
+	var policies []*models.FirewallCreateFirewallPolicyReqV1
+	for range count {
+		name := acctest.RandomResourceName()
+		policies = append(policies, &models.FirewallCreateFirewallPolicyReqV1{
+			Name:         &name,
+			PlatformName: &platformName,
+		})
+	}
+
+	res, err := client.FirewallPolicies.CreateFirewallPolicies(
+		&firewall_policies.CreateFirewallPoliciesParams{
+			Context: t.Context(),
+			Body:    &models.FirewallCreateFirewallPoliciesReqV1{Resources: policies},
+		},
+	)
+	if err != nil {
+		t.Fatalf("failed to create firewall policies: %v", err)
+	}
+
+	var policyIDs []string
+	for _, policy := range res.Payload.Resources {
+		policyIDs = append(policyIDs, *policy.ID)
+	}
+
+	t.Cleanup(func() {
+		_, err := client.FirewallPolicies.DeleteFirewallPolicies(
+			&firewall_policies.DeleteFirewallPoliciesParams{
+				Context: context.Background(),
+				Ids:     policyIDs,
+			},
+		)
+		if err != nil {
+			t.Logf("failed to delete firewall policies %v: %v", policyIDs, err)
+		}
+	})
+
+	return policyIDs
+}
+
+// getOtherFirewallPolicyIDs returns the IDs of the non-default Windows firewall
+// policies not in policyIDs, ordered by precedence.
+func getOtherFirewallPolicyIDs(t *testing.T, policyIDs []string) []string {
+	t.Helper()
+
+	filter := "platform_name:'Windows'"
+	sort := "precedence.asc"
+	res, err := testconfig.GetTestClient().FirewallPolicies.QueryCombinedFirewallPolicies(
+		&firewall_policies.QueryCombinedFirewallPoliciesParams{
+			Context: t.Context(),
+			Filter:  &filter,
+			Sort:    &sort,
+		},
+	)
+	if err != nil {
+		t.Fatalf("failed to query firewall policies: %v", err)
+	}
+
+	var otherPolicyIDs []string
+	policies := res.Payload.Resources
+	for _, policy := range policies[:len(policies)-1] {
+		if !slices.Contains(policyIDs, *policy.ID) {
+			otherPolicyIDs = append(otherPolicyIDs, *policy.ID)
+		}
+	}
+
+	return otherPolicyIDs
+}
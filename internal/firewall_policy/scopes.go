@@ -0,0 +1,11 @@
+package firewallpolicy
+
+import "github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+
+var apiScopesReadWrite = []scopes.Scope{
+	{
+		Name:  "Firewall management",
+		Read:  true,
+		Write: true,
+	},
+}
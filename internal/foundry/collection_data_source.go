@@ -0,0 +1,210 @@
+package foundry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/custom_storage"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &collectionDataSource{}
+	_ datasource.DataSourceWithConfigure = &collectionDataSource{}
+)
+
+func NewCollectionDataSource() datasource.DataSource {
+	return &collectionDataSource{}
+}
+
+type collectionDataSource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type collectionDataSourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Namespace        types.String `tfsdk:"namespace"`
+	Version          types.String `tfsdk:"version"`
+	SchemaVersion    types.String `tfsdk:"schema_version"`
+	PublishedVersion types.String `tfsdk:"published_version"`
+	Status           types.String `tfsdk:"status"`
+	IsGlobal         types.Bool   `tfsdk:"is_global"`
+	Permissions      types.List   `tfsdk:"permissions"`
+	Schema           types.String `tfsdk:"schema"`
+}
+
+func (d *collectionDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+func (d *collectionDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_foundry_collection"
+}
+
+func (d *collectionDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Falcon Foundry",
+			"This data source retrieves a Falcon Foundry custom object collection and its current JSON schema. "+
+				"Collections and their schemas are defined by Foundry app manifests; use this data source to reference them when provisioning collection objects with `crowdstrike_foundry_collection_object`.",
+			apiScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the collection.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the collection.",
+			},
+			"namespace": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The namespace of the collection.",
+			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the collection.",
+			},
+			"schema_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the collection schema.",
+			},
+			"published_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The published version of the collection.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the collection.",
+			},
+			"is_global": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the collection is shared across all apps.",
+			},
+			"permissions": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The permissions required to access the collection.",
+			},
+			"schema": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The JSON schema of the collection at `schema_version`.",
+			},
+		},
+	}
+}
+
+func (d *collectionDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data collectionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := custom_storage.NewDescribeCollectionParams()
+	params.Context = ctx
+	params.CollectionName = data.Name.ValueString()
+
+	res, err := d.client.CustomStorage.DescribeCollection(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes))
+		return
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		resp.Diagnostics.Append(tferrors.NewNotFoundError(
+			fmt.Sprintf("Foundry collection %s not found.", data.Name.ValueString()),
+		))
+		return
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	collection := res.Payload.Resources[0]
+	data.Description = flex.StringPointerToFramework(collection.Description)
+	data.Namespace = flex.StringPointerToFramework(collection.Namespace)
+	data.Version = flex.StringValueToFramework(collection.Version)
+	data.SchemaVersion = flex.StringPointerToFramework(collection.SchemaVersion)
+	data.PublishedVersion = flex.StringValueToFramework(collection.PublishedVersion)
+	data.Status = flex.StringValueToFramework(collection.Status)
+	data.IsGlobal = types.BoolPointerValue(collection.IsGlobal)
+
+	permissions, diags := types.ListValueFrom(ctx, types.StringType, collection.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Permissions = permissions
+
+	schemaVersion := "latest"
+	if collection.SchemaVersion != nil && *collection.SchemaVersion != "" {
+		schemaVersion = *collection.SchemaVersion
+	}
+
+	schemaParams := custom_storage.NewGetSchemaParams()
+	schemaParams.Context = ctx
+	schemaParams.CollectionName = data.Name.ValueString()
+	schemaParams.SchemaVersion = schemaVersion
+
+	var buf bytes.Buffer
+	if _, err := d.client.CustomStorage.GetSchema(schemaParams, &buf); err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes))
+		return
+	}
+	data.Schema = flex.StringValueToFramework(buf.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
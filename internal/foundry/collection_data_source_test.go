@@ -0,0 +1,37 @@
+package foundry_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFoundryCollectionDataSource_basic(t *testing.T) {
+	collectionName := os.Getenv(string(acctest.RequireFoundryCollection))
+	dataSourceName := "data.crowdstrike_foundry_collection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t, acctest.RequireFoundryCollection) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + fmt.Sprintf(`
+data "crowdstrike_foundry_collection" "test" {
+  name = %q
+}
+`, collectionName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("name"), knownvalue.StringExact(collectionName)),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("schema_version"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("schema"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
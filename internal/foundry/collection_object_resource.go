@@ -0,0 +1,374 @@
+package foundry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/custom_storage"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &collectionObjectResource{}
+	_ resource.ResourceWithConfigure   = &collectionObjectResource{}
+	_ resource.ResourceWithImportState = &collectionObjectResource{}
+)
+
+func NewCollectionObjectResource() resource.Resource {
+	return &collectionObjectResource{}
+}
+
+type collectionObjectResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type collectionObjectResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	CollectionName types.String   `tfsdk:"collection_name"`
+	ObjectKey      types.String   `tfsdk:"object_key"`
+	Value          types.String   `tfsdk:"value"`
+	SchemaVersion  types.String   `tfsdk:"schema_version"`
+	LastUpdated    types.String   `tfsdk:"last_updated"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *collectionObjectResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+func (r *collectionObjectResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_foundry_collection_object"
+}
+
+func (r *collectionObjectResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Falcon Foundry",
+			"This resource manages an object stored in a Falcon Foundry custom object collection. "+
+				"Objects are validated against the collection schema by Falcon, so apps and workflows that read from the collection can be provisioned together with their data.\n\n"+
+				"The collection itself must already exist. Collections and their schemas are defined in Foundry app manifests and cannot be created through the API.",
+			apiScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the object in the format `<collection_name>/<object_key>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the collection that stores the object. Changing this value forces a new resource to be created.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The key of the object within the collection. Changing this value forces a new resource to be created.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The JSON encoded object. The object must conform to the collection schema. Use `jsonencode()` to build this value.",
+				Validators: []validator.String{
+					fwvalidators.StringIsJSONObject(),
+				},
+			},
+			"schema_version": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The version of the collection schema used to validate the object. Defaults to the latest schema version.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC850 timestamp of the last update to this resource by Terraform.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *collectionObjectResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan collectionObjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Creating Foundry collection object", map[string]any{
+		"collection_name": plan.CollectionName.ValueString(),
+		"object_key":      plan.ObjectKey.ValueString(),
+	})
+
+	resp.Diagnostics.Append(r.putObject(ctx, &plan, tferrors.Create)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.CollectionName.ValueString() + "/" + plan.ObjectKey.ValueString())
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *collectionObjectResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state collectionObjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	metadata, diag := r.getObjectMetadata(ctx, state.CollectionName.ValueString(), state.ObjectKey.ValueString())
+	if diag != nil {
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	params := custom_storage.NewGetObjectParams()
+	params.Context = ctx
+	params.CollectionName = state.CollectionName.ValueString()
+	params.ObjectKey = state.ObjectKey.ValueString()
+
+	var buf bytes.Buffer
+	if _, err := r.client.CustomStorage.GetObject(params, &buf); err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes))
+		return
+	}
+
+	state.ID = types.StringValue(state.CollectionName.ValueString() + "/" + state.ObjectKey.ValueString())
+	state.Value = flex.JSONStringToFramework(state.Value, buf.String())
+	state.SchemaVersion = flex.StringPointerToFramework(metadata.SchemaVersion)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *collectionObjectResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan collectionObjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Updating Foundry collection object", map[string]any{"id": plan.ID.ValueString()})
+
+	resp.Diagnostics.Append(r.putObject(ctx, &plan, tferrors.Update)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *collectionObjectResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state collectionObjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Deleting Foundry collection object", map[string]any{"id": state.ID.ValueString()})
+
+	params := custom_storage.NewDeleteObjectParams()
+	params.Context = ctx
+	params.CollectionName = state.CollectionName.ValueString()
+	params.ObjectKey = state.ObjectKey.ValueString()
+
+	_, err := r.client.CustomStorage.DeleteObject(params)
+	if err != nil {
+		diag := tferrors.NewDiagnosticFromAPIError(tferrors.Delete, err, apiScopes)
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			return
+		}
+		resp.Diagnostics.Append(diag)
+	}
+}
+
+func (r *collectionObjectResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	collectionName, objectKey, err := parseCollectionObjectID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection_name"), collectionName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_key"), objectKey)...)
+}
+
+// putObject writes the planned object and refreshes the schema version it was stored with.
+func (r *collectionObjectResource) putObject(
+	ctx context.Context,
+	plan *collectionObjectResourceModel,
+	operation tferrors.Operation,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	params := custom_storage.NewPutObjectParams()
+	params.Context = ctx
+	params.CollectionName = plan.CollectionName.ValueString()
+	params.ObjectKey = plan.ObjectKey.ValueString()
+	params.Body = io.NopCloser(strings.NewReader(plan.Value.ValueString()))
+	if utils.IsKnown(plan.SchemaVersion) {
+		params.SchemaVersion = plan.SchemaVersion.ValueStringPointer()
+	}
+
+	res, err := r.client.CustomStorage.PutObject(params)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(operation, err, apiScopes))
+		return diags
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		diags.Append(tferrors.NewEmptyResponseError(operation))
+		return diags
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(operation, res.Payload.Errors); diag != nil {
+		diags.Append(diag)
+		return diags
+	}
+
+	plan.SchemaVersion = flex.StringPointerToFramework(res.Payload.Resources[0].SchemaVersion)
+	return diags
+}
+
+func (r *collectionObjectResource) getObjectMetadata(
+	ctx context.Context,
+	collectionName string,
+	objectKey string,
+) (*models.APIObjectMetadata, diag.Diagnostic) {
+	params := custom_storage.NewGetObjectMetadataParams()
+	params.Context = ctx
+	params.CollectionName = collectionName
+	params.ObjectKey = objectKey
+
+	res, err := r.client.CustomStorage.GetObjectMetadata(params)
+	if err != nil {
+		return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes)
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		return nil, tferrors.NewNotFoundError(
+			fmt.Sprintf("Object %s not found in Foundry collection %s.", objectKey, collectionName),
+		)
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		return nil, diag
+	}
+
+	return res.Payload.Resources[0], nil
+}
+
+// parseCollectionObjectID splits an import ID in the format <collection_name>/<object_key>.
+func parseCollectionObjectID(id string) (string, string, error) {
+	collectionName, objectKey, ok := strings.Cut(id, "/")
+	if !ok || collectionName == "" || objectKey == "" {
+		return "", "", fmt.Errorf(
+			"expected import ID in the format <collection_name>/<object_key>, got: %q",
+			id,
+		)
+	}
+	return collectionName, objectKey, nil
+}
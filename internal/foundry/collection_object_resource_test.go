@@ -0,0 +1,55 @@
+package foundry_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFoundryCollectionObjectResource_basic(t *testing.T) {
+	collectionName := os.Getenv(string(acctest.RequireFoundryCollection))
+	objectKey := acctest.RandomResourceName()
+	resourceName := "crowdstrike_foundry_collection_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t, acctest.RequireFoundryCollection) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFoundryCollectionObjectConfig(collectionName, objectKey, "initial"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("id"), knownvalue.StringExact(collectionName+"/"+objectKey)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("schema_version"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccFoundryCollectionObjectConfig(collectionName, objectKey, "updated"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("value"), knownvalue.StringExact(`{"name":"updated"}`)),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func testAccFoundryCollectionObjectConfig(collectionName, objectKey, value string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_foundry_collection_object" "test" {
+  collection_name = %[1]q
+  object_key      = %[2]q
+  value           = jsonencode({ name = %[3]q })
+}
+`, collectionName, objectKey, value)
+}
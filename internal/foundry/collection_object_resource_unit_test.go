@@ -0,0 +1,36 @@
+package foundry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCollectionObjectID(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		wantCollection string
+		wantKey        string
+		wantErr        bool
+	}{
+		{name: "valid", id: "domains/example", wantCollection: "domains", wantKey: "example"},
+		{name: "key with slash", id: "domains/a/b", wantCollection: "domains", wantKey: "a/b"},
+		{name: "missing separator", id: "domains", wantErr: true},
+		{name: "empty collection", id: "/example", wantErr: true},
+		{name: "empty key", id: "domains/", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collection, key, err := parseCollectionObjectID(tt.id)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCollection, collection)
+			assert.Equal(t, tt.wantKey, key)
+		})
+	}
+}
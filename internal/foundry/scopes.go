@@ -0,0 +1,11 @@
+package foundry
+
+import "github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+
+var apiScopes = []scopes.Scope{
+	{
+		Name:  "Custom storage",
+		Read:  true,
+		Write: true,
+	},
+}
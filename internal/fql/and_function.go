@@ -0,0 +1,97 @@
+package fql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &andFunction{}
+
+// fieldNamePattern matches the FQL field names accepted by fql_and.
+var fieldNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.\-]+$`)
+
+func NewAndFunction() function.Function {
+	return &andFunction{}
+}
+
+type andFunction struct{}
+
+func (f *andFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "fql_and"
+}
+
+func (f *andFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Build a Falcon Query Language filter matching all of the given field values.",
+		MarkdownDescription: "Builds a Falcon Query Language (FQL) filter that matches when every field in the map equals its value, for example " +
+			"`{ platform_name = \"Windows\", hostname = \"web-01\" }` becomes `hostname:'web-01'+platform_name:'Windows'`. " +
+			"Values are quoted and escaped, and fields are sorted by name so the result is stable. " +
+			"Use it for host group `assignment_rule` values or the `filter` of data sources.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "fields",
+				ElementType:         types.StringType,
+				MarkdownDescription: "A map of FQL field names to the values they must equal. Fields with a `null` value are ignored.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *andFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var fields map[string]*string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &fields))
+	if resp.Error != nil {
+		return
+	}
+
+	filter, err := buildAndFilter(fields)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, filter))
+}
+
+// buildAndFilter joins an equality expression for each non-null field with the FQL and operator.
+func buildAndFilter(fields map[string]*string) (string, error) {
+	names := make([]string, 0, len(fields))
+	for name, value := range fields {
+		if value == nil {
+			continue
+		}
+		if !fieldNamePattern.MatchString(name) {
+			return "", fmt.Errorf("invalid FQL field name %q: field names may only contain letters, digits, '_', '.' and '-'", name)
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	expressions := make([]string, 0, len(names))
+	for _, name := range names {
+		expressions = append(expressions, name+":"+utils.FQLQuote(*fields[name]))
+	}
+
+	return strings.Join(expressions, "+"), nil
+}
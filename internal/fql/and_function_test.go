@@ -0,0 +1,77 @@
+package fql
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+)
+
+func TestBuildAndFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  map[string]*string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "empty",
+			fields: map[string]*string{},
+			want:   "",
+		},
+		{
+			name: "sorted fields",
+			fields: map[string]*string{
+				"platform_name": utils.Addr("Windows"),
+				"hostname":      utils.Addr("web-01"),
+			},
+			want: "hostname:'web-01'+platform_name:'Windows'",
+		},
+		{
+			name: "escaped values",
+			fields: map[string]*string{
+				"tags": utils.Addr(`SensorGroupingTags/it's\here`),
+			},
+			want: `tags:'SensorGroupingTags/it\'s\\here'`,
+		},
+		{
+			name: "null values are ignored",
+			fields: map[string]*string{
+				"hostname":      utils.Addr("web-01"),
+				"platform_name": nil,
+			},
+			want: "hostname:'web-01'",
+		},
+		{
+			name: "nested field",
+			fields: map[string]*string{
+				"device.os_version": utils.Addr("Windows 11"),
+			},
+			want: "device.os_version:'Windows 11'",
+		},
+		{
+			name: "invalid field name",
+			fields: map[string]*string{
+				"hostname:'x'+name": utils.Addr("y"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildAndFilter(tt.fields)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package fql
+
+import (
+	"context"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &escapeFunction{}
+
+func NewEscapeFunction() function.Function {
+	return &escapeFunction{}
+}
+
+type escapeFunction struct{}
+
+func (f *escapeFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "fql_escape"
+}
+
+func (f *escapeFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Escape a value for use in a Falcon Query Language string.",
+		MarkdownDescription: "Escapes backslashes and single quotes in a value so it can be placed between single quotes in a Falcon Query Language (FQL) filter, " +
+			"such as a host group `assignment_rule` or the `filter` of a data source. The result does not include the surrounding quotes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The value to escape.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *escapeFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, utils.FQLEscape(value)))
+}
@@ -0,0 +1,35 @@
+package flex
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// JSONStringToFramework converts a JSON document returned by the API to a types.String.
+// When the prior value is semantically equal to v (ignoring whitespace and key order),
+// the prior value is returned so that formatting differences do not cause drift.
+func JSONStringToFramework(prior types.String, v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() && JSONEqual(prior.ValueString(), v) {
+		return prior
+	}
+
+	return types.StringValue(v)
+}
+
+// JSONEqual reports whether a and b are valid JSON documents with the same content.
+func JSONEqual(a, b string) bool {
+	var av, bv any
+	if err := json.Unmarshal([]byte(a), &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bv); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
@@ -0,0 +1,78 @@
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONStringToFramework(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		prior    types.String
+		input    string
+		expected types.String
+	}{
+		{
+			name:     "empty string returns null",
+			prior:    types.StringValue(`{"a":1}`),
+			input:    "",
+			expected: types.StringNull(),
+		},
+		{
+			name:     "null prior returns value",
+			prior:    types.StringNull(),
+			input:    `{"a":1}`,
+			expected: types.StringValue(`{"a":1}`),
+		},
+		{
+			name:     "semantically equal keeps prior",
+			prior:    types.StringValue("{\n  \"b\": [1, 2],\n  \"a\": \"x\"\n}"),
+			input:    `{"a":"x","b":[1,2]}`,
+			expected: types.StringValue("{\n  \"b\": [1, 2],\n  \"a\": \"x\"\n}"),
+		},
+		{
+			name:     "different content returns value",
+			prior:    types.StringValue(`{"a":1}`),
+			input:    `{"a":2}`,
+			expected: types.StringValue(`{"a":2}`),
+		},
+		{
+			name:     "invalid prior returns value",
+			prior:    types.StringValue(`not json`),
+			input:    `{"a":1}`,
+			expected: types.StringValue(`{"a":1}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := JSONStringToFramework(tt.prior, tt.input)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestJSONEqual(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{name: "identical", a: `{"a":1}`, b: `{"a":1}`, expected: true},
+		{name: "key order and whitespace", a: `{"a":1,"b":2}`, b: "{ \"b\": 2, \"a\": 1 }", expected: true},
+		{name: "array order matters", a: `[1,2]`, b: `[2,1]`, expected: false},
+		{name: "different values", a: `{"a":1}`, b: `{"a":"1"}`, expected: false},
+		{name: "invalid json", a: `{`, b: `{}`, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, JSONEqual(tt.a, tt.b))
+		})
+	}
+}
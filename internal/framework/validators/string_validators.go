@@ -2,9 +2,11 @@ package validators
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -102,3 +104,90 @@ func SortField(validFields []string) validator.String {
 		validFields: validFields,
 	}
 }
+
+// jsonObjectValidator validates that a string is a JSON encoded object.
+type jsonObjectValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	return "must be a valid JSON encoded object"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &obj); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object",
+			fmt.Sprintf("Value must be a valid JSON encoded object: %s", err),
+		)
+	}
+}
+
+// StringIsJSONObject returns a validator that ensures a string attribute is a
+// JSON encoded object, such as the output of jsonencode().
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+//
+// Valid values: "{}", "{\"key\": \"value\"}"
+// Invalid values: "", "[]", "not json", "{\"key\": }".
+func StringIsJSONObject() validator.String {
+	return jsonObjectValidator{}
+}
+
+// durationValidator validates that a string is a Go duration.
+type durationValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v durationValidator) Description(_ context.Context) string {
+	return "must be a valid duration such as \"500ms\", \"30s\" or \"5m\""
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Value must be a valid duration such as \"500ms\", \"30s\" or \"5m\": %s", err),
+		)
+		return
+	}
+
+	if d < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			"Value must not be a negative duration.",
+		)
+	}
+}
+
+// StringIsDuration returns a validator that ensures a string attribute is a
+// non-negative duration in the format accepted by Go's time.ParseDuration.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+//
+// Valid values: "0s", "500ms", "30s", "1m30s"
+// Invalid values: "", "30", "-1s", "thirty seconds".
+func StringIsDuration() validator.String {
+	return durationValidator{}
+}
@@ -323,3 +323,130 @@ func TestSortFieldValidator_MultipleErrors(t *testing.T) {
 	assert.Contains(t, errorMessages, "Invalid Sort Field Format", "Should contain format error")
 	assert.Contains(t, errorMessages, "Invalid Sort Field", "Should contain invalid field error")
 }
+
+func TestStringIsJSONObjectValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       types.String
+		expectError bool
+	}{
+		{
+			name:        "empty object",
+			value:       types.StringValue("{}"),
+			expectError: false,
+		},
+		{
+			name:        "nested object",
+			value:       types.StringValue(`{"key": "value", "nested": {"count": 1}}`),
+			expectError: false,
+		},
+		{
+			name:        "array",
+			value:       types.StringValue("[]"),
+			expectError: true,
+		},
+		{
+			name:        "empty string",
+			value:       types.StringValue(""),
+			expectError: true,
+		},
+		{
+			name:        "malformed",
+			value:       types.StringValue(`{"key": }`),
+			expectError: true,
+		},
+		{
+			name:        "null value",
+			value:       types.StringNull(),
+			expectError: false,
+		},
+		{
+			name:        "unknown value",
+			value:       types.StringUnknown(),
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			StringIsJSONObject().ValidateString(context.Background(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), "unexpected result for value: %q", tt.value.ValueString())
+		})
+	}
+}
+
+func TestStringIsDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       types.String
+		expectError bool
+	}{
+		{
+			name:        "seconds",
+			value:       types.StringValue("30s"),
+			expectError: false,
+		},
+		{
+			name:        "compound",
+			value:       types.StringValue("1m30s"),
+			expectError: false,
+		},
+		{
+			name:        "zero",
+			value:       types.StringValue("0s"),
+			expectError: false,
+		},
+		{
+			name:        "missing unit",
+			value:       types.StringValue("30"),
+			expectError: true,
+		},
+		{
+			name:        "negative",
+			value:       types.StringValue("-1s"),
+			expectError: true,
+		},
+		{
+			name:        "empty string",
+			value:       types.StringValue(""),
+			expectError: true,
+		},
+		{
+			name:        "null value",
+			value:       types.StringNull(),
+			expectError: false,
+		},
+		{
+			name:        "unknown value",
+			value:       types.StringUnknown(),
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			StringIsDuration().ValidateString(context.Background(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), "unexpected result for value: %q", tt.value.ValueString())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package hostgroups
+
+import (
+	"context"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/host_group"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/ids"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var hostGroupIDsScopes = []scopes.Scope{
+	{
+		Name: "Host groups",
+		Read: true,
+	},
+}
+
+// NewHostGroupIDsDataSource is a helper function to simplify the provider implementation.
+func NewHostGroupIDsDataSource() datasource.DataSource {
+	return ids.NewDataSource(ids.Options{
+		TypeName:     "_host_group_ids",
+		Section:      "Host Group",
+		Objects:      "host groups",
+		Description:  "This data source returns the IDs of all host groups matching an optional FQL filter. Use it with a `for_each` import block to adopt existing host groups as `crowdstrike_host_group` resources.",
+		Example:      "group_type:'dynamic'",
+		Scopes:       hostGroupIDsScopes,
+		SortExample:  "name.asc",
+		DefaultLimit: 5000,
+		MaxLimit:     5000,
+		Query: func(
+			ctx context.Context,
+			client *client.CrowdStrikeAPISpecification,
+			filter *string,
+			sort *string,
+		) utils.QueryPage[string] {
+			return func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+				var diags diag.Diagnostics
+
+				res, err := client.HostGroup.QueryHostGroups(&host_group.QueryHostGroupsParams{
+					Context: ctx,
+					Filter:  filter,
+					Sort:    sort,
+					Limit:   &limit,
+					Offset:  &offset,
+				})
+				if err != nil {
+					diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, hostGroupIDsScopes))
+					return nil, 0, diags
+				}
+
+				if res == nil || res.Payload == nil {
+					diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+					return nil, 0, diags
+				}
+
+				if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+					diags.Append(diag)
+					return nil, 0, diags
+				}
+
+				return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+			}
+		},
+	})
+}
@@ -0,0 +1,77 @@
+package hostgroups_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHostGroupIDsDataSource(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.crowdstrike_host_group_ids.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_host_group" "test" {
+  name            = "%s"
+  description     = "made with terraform"
+  type            = "dynamic"
+  assignment_rule = ""
+}
+
+data "crowdstrike_host_group_ids" "test" {
+  filter = "name:'${crowdstrike_host_group.test.name}'"
+}
+`, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "crowdstrike_host_group.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHostGroupIDsDataSource_maxItems(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.crowdstrike_host_group_ids.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_host_group" "test" {
+  count           = 3
+  name            = "%s-${count.index}"
+  description     = "made with terraform"
+  type            = "dynamic"
+  assignment_rule = ""
+}
+
+data "crowdstrike_host_group_ids" "test" {
+  filter    = "name:['%s-0','%s-1','%s-2']"
+  sort      = "name.desc"
+  limit     = 1
+  max_items = 2
+
+  depends_on = [crowdstrike_host_group.test]
+}
+`, rName, rName, rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "crowdstrike_host_group.test.2", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.1", "crowdstrike_host_group.test.1", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package hostgroups
+
+import (
+	"context"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/host_group"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/listresource"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewHostGroupListResource is a helper function to simplify the provider implementation.
+func NewHostGroupListResource() list.ListResource {
+	return listresource.New(listresource.Options[*models.HostGroupsHostGroupV1, HostGroupResourceModel]{
+		TypeName: "_host_group",
+		Objects:  "host groups",
+		Scopes:   apiScopes,
+		Query:    queryHostGroups,
+		ID:       func(group *models.HostGroupsHostGroupV1) *string { return group.ID },
+		Name:     func(group *models.HostGroupsHostGroupV1) *string { return group.Name },
+		Wrap: func(ctx context.Context, m *HostGroupResourceModel, group *models.HostGroupsHostGroupV1) diag.Diagnostics {
+			return m.wrap(ctx, group)
+		},
+	})
+}
+
+// queryHostGroups fetches a page of the host groups matching filter.
+func queryHostGroups(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	filter *string,
+	offset int64,
+) (*listresource.Page[*models.HostGroupsHostGroupV1], error) {
+	res, err := client.HostGroup.QueryCombinedHostGroups(
+		&host_group.QueryCombinedHostGroupsParams{
+			Context: ctx,
+			Filter:  filter,
+			Limit:   utils.Addr(utils.ListPageSize),
+			Offset:  &offset,
+		},
+	)
+	if err != nil || res == nil || res.Payload == nil {
+		return nil, err
+	}
+
+	return &listresource.Page[*models.HostGroupsHostGroupV1]{
+		Resources: res.Payload.Resources,
+		Meta:      res.Payload.Meta,
+		Errors:    res.Payload.Errors,
+	}, nil
+}
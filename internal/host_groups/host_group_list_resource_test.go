@@ -0,0 +1,66 @@
+package hostgroups_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccHostGroupListResource(t *testing.T) {
+	rName := acctest.RandomResourceName()
+	listName := "crowdstrike_host_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostGroupListResourceConfig(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccHostGroupListResourceQuery(rName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(listName, 1),
+					querycheck.ExpectResourceDisplayName(
+						listName,
+						queryfilter.ByDisplayName(knownvalue.StringExact(rName)),
+						knownvalue.StringExact(rName),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccHostGroupListResourceConfig(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_host_group" "test" {
+  name            = %[1]q
+  description     = "made with terraform"
+  type            = "dynamic"
+  assignment_rule = ""
+}
+`, rName)
+}
+
+func testAccHostGroupListResourceQuery(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+list "crowdstrike_host_group" "test" {
+  provider = crowdstrike
+
+  config {
+    filter = "name:'%s'"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,185 @@
+package hostgroups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/host_group"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// hostGroupMembersQueryLimit is the page size used when querying host group
+// members, and the largest page size accepted by the API.
+const hostGroupMembersQueryLimit = 5000
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &hostGroupMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &hostGroupMembersDataSource{}
+)
+
+// NewHostGroupMembersDataSource is a helper function to simplify the provider implementation.
+func NewHostGroupMembersDataSource() datasource.DataSource {
+	return &hostGroupMembersDataSource{}
+}
+
+// hostGroupMembersDataSource is the data source implementation.
+type hostGroupMembersDataSource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+// hostGroupMembersDataSourceModel is the data source model.
+type hostGroupMembersDataSourceModel struct {
+	HostGroupID types.String `tfsdk:"host_group_id"`
+	Filter      types.String `tfsdk:"filter"`
+	Sort        types.String `tfsdk:"sort"`
+	Limit       types.Int64  `tfsdk:"limit"`
+	MaxItems    types.Int64  `tfsdk:"max_items"`
+	IDs         types.List   `tfsdk:"ids"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *hostGroupMembersDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(hostGroupIDsScopes)...)
+}
+
+// Metadata returns the data source type name.
+func (d *hostGroupMembersDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_host_group_members"
+}
+
+// Schema defines the schema for the data source.
+func (d *hostGroupMembersDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Host Group",
+			"This data source returns the IDs of the hosts that are members of a host group. For dynamic host groups, these are the hosts currently matching the assignment rule, so it can be used to audit the effective membership of any host group.",
+			hostGroupIDsScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"host_group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the host group.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "FQL filter limiting the members returned. All members, up to `max_items`, are returned when omitted. Example: `platform_name:'Windows'`",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The sort order of the members, as `<field>.asc` or `<field>.desc`. Example: `hostname.asc`",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"limit":     utils.PageLimitAttribute("members", hostGroupMembersQueryLimit, hostGroupMembersQueryLimit),
+			"max_items": utils.MaxItemsAttribute("members"),
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the hosts that are members of the host group.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *hostGroupMembersDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data hostGroupMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, hostGroupMembersQueryLimit)
+
+	members, total, diags := utils.QueryPages(pagination, func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		res, err := d.client.HostGroup.QueryGroupMembers(&host_group.QueryGroupMembersParams{
+			Context: ctx,
+			ID:      data.HostGroupID.ValueStringPointer(),
+			Filter:  data.Filter.ValueStringPointer(),
+			Sort:    data.Sort.ValueStringPointer(),
+			Limit:   &limit,
+			Offset:  &offset,
+		})
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, hostGroupIDsScopes))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
+		}
+
+		return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("members", len(members), total)...)
+
+	data.IDs = utils.SliceToListTypeString(ctx, members, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
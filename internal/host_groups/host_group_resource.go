@@ -11,6 +11,7 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/client"
 	"github.com/crowdstrike/gofalcon/falcon/client/firewall_policies"
 	"github.com/crowdstrike/gofalcon/falcon/client/host_group"
+	"github.com/crowdstrike/gofalcon/falcon/client/hosts"
 	"github.com/crowdstrike/gofalcon/falcon/client/prevention_policies"
 	"github.com/crowdstrike/gofalcon/falcon/client/response_policies"
 	"github.com/crowdstrike/gofalcon/falcon/client/sensor_update_policies"
@@ -20,6 +21,9 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/retry"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -38,7 +42,9 @@ var (
 	_ resource.Resource                   = &hostGroupResource{}
 	_ resource.ResourceWithConfigure      = &hostGroupResource{}
 	_ resource.ResourceWithImportState    = &hostGroupResource{}
+	_ resource.ResourceWithIdentity       = &hostGroupResource{}
 	_ resource.ResourceWithValidateConfig = &hostGroupResource{}
+	_ resource.ResourceWithModifyPlan     = &hostGroupResource{}
 )
 
 var (
@@ -82,19 +88,35 @@ func NewHostGroupResource() resource.Resource {
 
 // hostGroupResource is the resource implementation.
 type hostGroupResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client         *client.CrowdStrikeAPISpecification
+	providerConfig config.ProviderConfig
 }
 
 // HostGroupResourceModel maps the resource schema data.
 type HostGroupResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	AssignmentRule types.String `tfsdk:"assignment_rule"`
-	Hostnames      types.Set    `tfsdk:"hostnames"`
-	HostIDs        types.Set    `tfsdk:"host_ids"`
-	Description    types.String `tfsdk:"description"`
-	GroupType      types.String `tfsdk:"type"`
-	LastUpdated    types.String `tfsdk:"last_updated"`
+	ID             types.String   `tfsdk:"id"`
+	CID            types.String   `tfsdk:"cid"`
+	Name           types.String   `tfsdk:"name"`
+	AssignmentRule types.String   `tfsdk:"assignment_rule"`
+	Hostnames      types.Set      `tfsdk:"hostnames"`
+	HostIDs        types.Set      `tfsdk:"host_ids"`
+	Description    types.String   `tfsdk:"description"`
+	GroupType      types.String   `tfsdk:"type"`
+	LastUpdated    types.String   `tfsdk:"last_updated"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// wrap transforms the API response into the resource model.
+func (m *HostGroupResourceModel) wrap(
+	ctx context.Context,
+	group *models.HostGroupsHostGroupV1,
+) diag.Diagnostics {
+	m.ID = types.StringValue(*group.ID)
+	m.Name = types.StringValue(*group.Name)
+	m.Description = types.StringValue(*group.Description)
+	m.GroupType = types.StringValue(group.GroupType)
+
+	return AssignAssignmentRule(ctx, group.AssignmentRule, m)
 }
 
 // Configure adds the provider configured client to the resource.
@@ -122,6 +144,96 @@ func (r *hostGroupResource) Configure(
 	}
 
 	r.client = config.Client
+	r.providerConfig = config
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+// withCID returns a copy of the resource managing the host groups of the
+// member CID cid.
+func (r *hostGroupResource) withCID(cid types.String, diags *diag.Diagnostics) *hostGroupResource {
+	c, d := r.providerConfig.ClientForCID(cid)
+	diags.Append(d...)
+
+	withCID := *r
+	withCID.client = c
+	return &withCID
+}
+
+// ModifyPlan defers changes to the host groups of a member CID that is not
+// available yet, and validates the assignment rule of dynamic host groups.
+func (r *hostGroupResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if r.providerConfig.DeferPlanForCID(ctx, req, resp) || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state HostGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.GroupType.ValueString() != HgDynamic ||
+		!utils.IsKnown(plan.AssignmentRule) ||
+		plan.AssignmentRule.ValueString() == "" ||
+		plan.AssignmentRule.Equal(state.AssignmentRule) ||
+		plan.CID.IsUnknown() ||
+		r.client == nil {
+		return
+	}
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateAssignmentRule(ctx, plan.AssignmentRule.ValueString())...)
+}
+
+// validateAssignmentRule returns an error when the hosts API rejects the FQL
+// filter of a dynamic host group, so an invalid assignment rule fails the plan
+// instead of the apply. The rule is not validated when the API client lacks
+// the Hosts read scope or the request fails for another reason.
+func (r *hostGroupResource) validateAssignmentRule(
+	ctx context.Context,
+	assignmentRule string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, err := r.client.Hosts.QueryDevicesByFilter(&hosts.QueryDevicesByFilterParams{
+		Context: ctx,
+		Filter:  &assignmentRule,
+		Limit:   utils.Addr(int64(1)),
+	})
+	if err == nil {
+		return diags
+	}
+
+	var statusErr runtime.ClientResponseStatus
+	if errors.As(err, &statusErr) && statusErr.IsCode(400) {
+		diags.AddAttributeError(
+			path.Root("assignment_rule"),
+			"Invalid assignment rule",
+			fmt.Sprintf(
+				"The assignment_rule %q is not a valid FQL filter for hosts: %s",
+				assignmentRule,
+				err.Error(),
+			),
+		)
+		return diags
+	}
+
+	tflog.Debug(ctx, "Unable to validate the assignment rule of the host group", map[string]any{
+		"error": err.Error(),
+	})
+
+	return diags
 }
 
 // Metadata returns the resource type name.
@@ -135,7 +247,7 @@ func (r *hostGroupResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *hostGroupResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -152,6 +264,7 @@ func (r *hostGroupResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"cid": utils.CIDAttribute("host group"),
 			"last_updated": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The RFC850 timestamp of the last update to this resource by Terraform.",
@@ -165,7 +278,7 @@ func (r *hostGroupResource) Schema(
 			},
 			"assignment_rule": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The assignment rule used for dynamic host groups. Required if `type` is `dynamic`.",
+				MarkdownDescription: "The assignment rule used for dynamic host groups. Required if `type` is `dynamic`. The rule is an FQL filter on host properties, which is validated during the plan when the API client has the `Hosts` read scope.",
 			},
 			"hostnames": schema.SetAttribute{
 				Optional:            true,
@@ -205,9 +318,21 @@ func (r *hostGroupResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *hostGroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *hostGroupResource) Create(
 	ctx context.Context,
@@ -220,6 +345,14 @@ func (r *hostGroupResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	assignmentRule, diags := GenerateAssignmentRule(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -306,6 +439,7 @@ func (r *hostGroupResource) Create(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -320,6 +454,14 @@ func (r *hostGroupResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	hostGroup, err := r.client.HostGroup.GetHostGroups(
 		&host_group.GetHostGroupsParams{
 			Context: ctx,
@@ -344,15 +486,10 @@ func (r *hostGroupResource) Read(
 		return
 	}
 
-	hostGroupResource := hostGroup.Payload.Resources[0]
-
-	state.ID = types.StringValue(*hostGroupResource.ID)
-	state.Name = types.StringValue(*hostGroupResource.Name)
-	state.Description = types.StringValue(*hostGroupResource.Description)
-	state.GroupType = types.StringValue(hostGroupResource.GroupType)
-	resp.Diagnostics.Append(AssignAssignmentRule(ctx, hostGroupResource.AssignmentRule, &state)...)
+	resp.Diagnostics.Append(state.wrap(ctx, hostGroup.Payload.Resources[0])...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -367,6 +504,14 @@ func (r *hostGroupResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	assignmentRule, diags := GenerateAssignmentRule(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -423,6 +568,7 @@ func (r *hostGroupResource) Update(
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *hostGroupResource) updateHostGroup(
@@ -461,6 +607,14 @@ func (r *hostGroupResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// some cxs may not have all modules so they will get a 403
 	// storing errors in tempDiags and only throw them after a failed 409 delete
 	// https://github.com/CrowdStrike/terraform-provider-crowdstrike/issues/24
@@ -516,8 +670,7 @@ func (r *hostGroupResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithCID(ctx, req, resp)
 }
 
 // purgeSensorUpdatePolicies removes all sensor update policies from a host group.
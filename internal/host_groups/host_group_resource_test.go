@@ -7,9 +7,14 @@ import (
 	"testing"
 
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
 )
@@ -384,6 +389,43 @@ resource "crowdstrike_host_group" "test" {
 	})
 }
 
+func TestAccHostGroupResourceIdentity(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.12.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_host_group" "test" {
+  name            = "%s"
+  description     = "made with terraform"
+  type            = "dynamic"
+  assignment_rule = ""
+}
+`, rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("crowdstrike_host_group.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState(
+						"crowdstrike_host_group.test",
+						tfjsonpath.New("id"),
+					),
+				},
+			},
+			{
+				ResourceName:    "crowdstrike_host_group.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func TestAccHostGroupResourceValidation(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -433,6 +475,11 @@ resource "crowdstrike_host_group" "test" {
 			config:      hostGroup(rName, "staticByID", `host_ids = ["   "]`),
 			expectError: regexp.MustCompile(`must not be empty or contain only\s+whitespace`),
 		},
+		{
+			name:        "assignment_rule_invalid_fql",
+			config:      hostGroup(rName, "dynamic", `assignment_rule = "tags:'test'+("`),
+			expectError: regexp.MustCompile(`Invalid assignment rule`),
+		},
 	}
 
 	for _, tt := range tests {
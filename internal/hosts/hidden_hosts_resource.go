@@ -0,0 +1,485 @@
+package hosts
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/hosts"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	hideHostAction   = "hide_host"
+	unhideHostAction = "unhide_host"
+
+	// hostActionBatchSize is the largest number of hosts of a host action request.
+	hostActionBatchSize = 100
+	// hostQueryLimit is the largest page size used when querying host IDs.
+	hostQueryLimit = 5000
+)
+
+var (
+	_ resource.Resource                     = &hiddenHostsResource{}
+	_ resource.ResourceWithConfigure        = &hiddenHostsResource{}
+	_ resource.ResourceWithConfigValidators = &hiddenHostsResource{}
+	_ resource.ResourceWithModifyPlan       = &hiddenHostsResource{}
+)
+
+func NewHiddenHostsResource() resource.Resource {
+	return &hiddenHostsResource{}
+}
+
+type hiddenHostsResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type hiddenHostsResourceModel struct {
+	HostIDs         types.Set      `tfsdk:"host_ids"`
+	Filter          types.String   `tfsdk:"filter"`
+	UnhideOnDestroy types.Bool     `tfsdk:"unhide_on_destroy"`
+	HiddenHostIDs   types.Set      `tfsdk:"hidden_host_ids"`
+	LastUpdated     types.String   `tfsdk:"last_updated"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *hiddenHostsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
+}
+
+func (r *hiddenHostsResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_hidden_hosts"
+}
+
+func (r *hiddenHostsResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Host",
+			"This resource hides (retires) hosts in the Falcon console, so decommissioned hosts are cleaned up by the same pipeline that removes them. "+
+				"Hidden hosts no longer report detections and are not returned by host queries. "+
+				"Hosts are selected by ID, by an FQL filter, or both. The filter is evaluated when it is set or changed, not during every plan, "+
+				"because hosts matching it are no longer returned once hidden. A host listed in `host_ids` that is restored outside of Terraform is hidden again by the next apply.",
+			apiScopesReadWrite,
+		),
+		Attributes: map[string]schema.Attribute{
+			"host_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the hosts to hide.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(fwvalidators.StringNotWhitespace()),
+				},
+			},
+			"filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An FQL filter selecting the hosts to hide, for example `tags:'SensorGroupingTags/decommissioned'` or `last_seen:<='now-90d'`.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"unhide_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Restore the hidden hosts when the resource is destroyed. Defaults to `false`, so retired hosts stay hidden when the resource is removed.",
+			},
+			"hidden_host_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the hosts hidden by this resource.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp of the last Terraform update of the resource.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *hiddenHostsResource) ConfigValidators(
+	_ context.Context,
+) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("host_ids"),
+			path.MatchRoot("filter"),
+		),
+	}
+}
+
+// ModifyPlan marks hidden_host_ids unknown when the hosts to hide change.
+func (r *hiddenHostsResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state hiddenHostsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.HostIDs.Equal(state.HostIDs) || !plan.Filter.Equal(state.Filter) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hidden_host_ids"), types.SetUnknown(types.StringType))...)
+	}
+}
+
+func (r *hiddenHostsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan hiddenHostsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	hostIDs := flex.ExpandSetAs[string](ctx, plan.HostIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Filter.ValueString() != "" {
+		filterIDs, diags := r.queryHostIDs(ctx, plan.Filter.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		hostIDs = union(hostIDs, filterIDs)
+	}
+
+	hidden, diags := r.performAction(ctx, hideHostAction, hostIDs)
+	resp.Diagnostics.Append(diags...)
+
+	plan.HiddenHostIDs, diags = flex.FlattenStringValueSet(ctx, hidden)
+	resp.Diagnostics.Append(diags...)
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *hiddenHostsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state hiddenHostsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	hidden := flex.ExpandSetAs[string](ctx, state.HiddenHostIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restored, diags := r.visibleHostIDs(ctx, hidden)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(restored) == 0 {
+		return
+	}
+
+	// Hosts restored outside of Terraform are no longer hidden by the
+	// resource. Removing them from host_ids hides them again on the next apply.
+	hidden = slices.DeleteFunc(hidden, func(id string) bool { return slices.Contains(restored, id) })
+	state.HiddenHostIDs, diags = flex.FlattenStringValueSet(ctx, hidden)
+	resp.Diagnostics.Append(diags...)
+
+	if !state.HostIDs.IsNull() {
+		hostIDs := flex.ExpandSetAs[string](ctx, state.HostIDs, &resp.Diagnostics)
+		hostIDs = slices.DeleteFunc(hostIDs, func(id string) bool { return slices.Contains(restored, id) })
+		state.HostIDs, diags = flex.FlattenStringValueSet(ctx, hostIDs)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *hiddenHostsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state hiddenHostsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	hidden := flex.ExpandSetAs[string](ctx, state.HiddenHostIDs, &resp.Diagnostics)
+	stateHostIDs := flex.ExpandSetAs[string](ctx, state.HostIDs, &resp.Diagnostics)
+	hostIDs := flex.ExpandSetAs[string](ctx, plan.HostIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The hosts hidden by an unchanged filter stay hidden, since they are no
+	// longer returned by the filter.
+	var filterIDs []string
+	filterChanged := !plan.Filter.Equal(state.Filter)
+	if !filterChanged && plan.Filter.ValueString() != "" {
+		filterIDs = slices.DeleteFunc(slices.Clone(hidden), func(id string) bool { return slices.Contains(stateHostIDs, id) })
+	}
+
+	unhide := slices.DeleteFunc(slices.Clone(hidden), func(id string) bool {
+		return slices.Contains(hostIDs, id) || slices.Contains(filterIDs, id)
+	})
+	restored, diags := r.performAction(ctx, unhideHostAction, unhide)
+	resp.Diagnostics.Append(diags...)
+	hidden = slices.DeleteFunc(hidden, func(id string) bool { return slices.Contains(restored, id) })
+	if resp.Diagnostics.HasError() {
+		r.setHiddenHostIDs(ctx, resp, hidden)
+		return
+	}
+
+	if filterChanged && plan.Filter.ValueString() != "" {
+		filterIDs, diags = r.queryHostIDs(ctx, plan.Filter.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			r.setHiddenHostIDs(ctx, resp, hidden)
+			return
+		}
+	}
+
+	hide := slices.DeleteFunc(union(hostIDs, filterIDs), func(id string) bool { return slices.Contains(hidden, id) })
+	newlyHidden, diags := r.performAction(ctx, hideHostAction, hide)
+	resp.Diagnostics.Append(diags...)
+	hidden = union(hidden, newlyHidden)
+
+	plan.HiddenHostIDs, diags = flex.FlattenStringValueSet(ctx, hidden)
+	resp.Diagnostics.Append(diags...)
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// setHiddenHostIDs records the hosts hidden before an update failed, so the
+// hosts that were restored are not tracked by the resource anymore.
+func (r *hiddenHostsResource) setHiddenHostIDs(
+	ctx context.Context,
+	resp *resource.UpdateResponse,
+	hidden []string,
+) {
+	hiddenSet, diags := flex.FlattenStringValueSet(ctx, hidden)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hidden_host_ids"), hiddenSet)...)
+}
+
+func (r *hiddenHostsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state hiddenHostsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.UnhideOnDestroy.ValueBool() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	hidden := flex.ExpandSetAs[string](ctx, state.HiddenHostIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := r.performAction(ctx, unhideHostAction, hidden)
+	resp.Diagnostics.Append(diags...)
+}
+
+// performAction performs the host action on hostIDs in batches, and returns
+// the IDs of the hosts it was performed on until a batch failed.
+func (r *hiddenHostsResource) performAction(
+	ctx context.Context,
+	action string,
+	hostIDs []string,
+) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	done := []string{}
+
+	op := tferrors.Create
+	if action == unhideHostAction {
+		op = tferrors.Delete
+	}
+
+	for batch := range slices.Chunk(hostIDs, hostActionBatchSize) {
+		res, err := r.client.Hosts.PerformActionV2(&hosts.PerformActionV2Params{
+			Context:    ctx,
+			ActionName: action,
+			Body: &models.MsaEntityActionRequestV2{
+				Ids: batch,
+			},
+		})
+		if diag := tferrors.NewDiagnosticFromAPIError(op, err, apiScopesReadWrite); diag != nil {
+			diags.Append(diag)
+			return done, diags
+		}
+
+		if res != nil && res.Payload != nil {
+			if diag := tferrors.NewDiagnosticFromPayloadErrors(op, res.Payload.Errors); diag != nil {
+				diags.Append(diag)
+				return done, diags
+			}
+		}
+
+		done = append(done, batch...)
+	}
+
+	return done, diags
+}
+
+// queryHostIDs returns the IDs of the visible hosts matching filter.
+func (r *hiddenHostsResource) queryHostIDs(
+	ctx context.Context,
+	filter string,
+) ([]string, diag.Diagnostics) {
+	all := utils.Pagination{Limit: hostQueryLimit, MaxItems: math.MaxInt64}
+	hostIDs, _, diags := utils.QueryPages(all, func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		res, err := r.client.Hosts.QueryDevicesByFilter(&hosts.QueryDevicesByFilterParams{
+			Context: ctx,
+			Filter:  &filter,
+			Limit:   &limit,
+			Offset:  &offset,
+		})
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesReadWrite))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
+		}
+
+		return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+	})
+
+	return hostIDs, diags
+}
+
+// visibleHostIDs returns the hosts of hostIDs that are not hidden.
+func (r *hiddenHostsResource) visibleHostIDs(
+	ctx context.Context,
+	hostIDs []string,
+) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	visible := []string{}
+
+	for batch := range slices.Chunk(hostIDs, hostActionBatchSize) {
+		quoted := make([]string, 0, len(batch))
+		for _, id := range batch {
+			quoted = append(quoted, utils.FQLQuote(id))
+		}
+
+		found, queryDiags := r.queryHostIDs(ctx, fmt.Sprintf("device_id:[%s]", strings.Join(quoted, ",")))
+		diags.Append(queryDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		visible = append(visible, found...)
+	}
+
+	return visible, diags
+}
+
+// union returns the IDs of a followed by the IDs of b missing from a.
+func union(a, b []string) []string {
+	result := slices.Clone(a)
+	for _, id := range b {
+		if !slices.Contains(result, id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+package hosts_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHiddenHostsResourceValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		expectError *regexp.Regexp
+	}{
+		{
+			name:        "no_hosts",
+			config:      `unhide_on_destroy = true`,
+			expectError: regexp.MustCompile(`Missing Attribute Configuration`),
+		},
+		{
+			name:        "empty_host_ids",
+			config:      `host_ids = []`,
+			expectError: regexp.MustCompile(`set must contain at least 1 elements`),
+		},
+		{
+			name:        "host_id_whitespace_only",
+			config:      `host_ids = ["  "]`,
+			expectError: regexp.MustCompile(`must not be empty or contain only\s+whitespace`),
+		},
+		{
+			name:        "filter_whitespace_only",
+			config:      `filter = "  "`,
+			expectError: regexp.MustCompile(`must not be empty or contain only\s+whitespace`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				PreCheck:                 func() { acctest.PreCheck(t) },
+				Steps: []resource.TestStep{
+					{
+						Config: acctest.ProviderConfig + `
+resource "crowdstrike_hidden_hosts" "test" {
+  ` + tt.config + `
+}
+`,
+						ExpectError: tt.expectError,
+						PlanOnly:    true,
+					},
+				},
+			})
+		})
+	}
+}
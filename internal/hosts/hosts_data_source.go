@@ -0,0 +1,351 @@
+package hosts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/hosts"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// hostsDefaultLimit is the page size used when querying hosts.
+	hostsDefaultLimit = 1000
+	// hostsMaxLimit is the largest page size accepted by the hosts API.
+	hostsMaxLimit = 10000
+)
+
+var (
+	_ datasource.DataSource              = &hostsDataSource{}
+	_ datasource.DataSourceWithConfigure = &hostsDataSource{}
+)
+
+func NewHostsDataSource() datasource.DataSource {
+	return &hostsDataSource{}
+}
+
+type hostsDataSource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type hostsDataSourceModel struct {
+	Filter   types.String `tfsdk:"filter"`
+	Sort     types.String `tfsdk:"sort"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	MaxItems types.Int64  `tfsdk:"max_items"`
+	IDs      types.List   `tfsdk:"ids"`
+	Hosts    types.List   `tfsdk:"hosts"`
+}
+
+type hostModel struct {
+	ID              types.String `tfsdk:"id"`
+	Hostname        types.String `tfsdk:"hostname"`
+	PlatformName    types.String `tfsdk:"platform_name"`
+	OSVersion       types.String `tfsdk:"os_version"`
+	ProductTypeDesc types.String `tfsdk:"product_type_desc"`
+	Status          types.String `tfsdk:"status"`
+	AgentVersion    types.String `tfsdk:"agent_version"`
+	MachineDomain   types.String `tfsdk:"machine_domain"`
+	SiteName        types.String `tfsdk:"site_name"`
+	OU              types.List   `tfsdk:"ou"`
+	Tags            types.List   `tfsdk:"tags"`
+	Groups          types.List   `tfsdk:"groups"`
+	LocalIP         types.String `tfsdk:"local_ip"`
+	ExternalIP      types.String `tfsdk:"external_ip"`
+	MacAddress      types.String `tfsdk:"mac_address"`
+	SerialNumber    types.String `tfsdk:"serial_number"`
+	FirstSeen       types.String `tfsdk:"first_seen"`
+	LastSeen        types.String `tfsdk:"last_seen"`
+}
+
+func (m hostModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.StringType,
+		"hostname":          types.StringType,
+		"platform_name":     types.StringType,
+		"os_version":        types.StringType,
+		"product_type_desc": types.StringType,
+		"status":            types.StringType,
+		"agent_version":     types.StringType,
+		"machine_domain":    types.StringType,
+		"site_name":         types.StringType,
+		"ou":                types.ListType{ElemType: types.StringType},
+		"tags":              types.ListType{ElemType: types.StringType},
+		"groups":            types.ListType{ElemType: types.StringType},
+		"local_ip":          types.StringType,
+		"external_ip":       types.StringType,
+		"mac_address":       types.StringType,
+		"serial_number":     types.StringType,
+		"first_seen":        types.StringType,
+		"last_seen":         types.StringType,
+	}
+}
+
+func (d *hostsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesRead)...)
+}
+
+func (d *hostsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_hosts"
+}
+
+func (d *hostsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	listAttribute := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: description,
+		}
+	}
+	stringAttribute := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Host",
+			"This data source retrieves the hosts matching a "+
+				"[Falcon Query Language (FQL)](https://falcon.crowdstrike.com/documentation/page/d3c84a1b/falcon-query-language-fql) filter, "+
+				"such as their platform, organizational unit, sensor grouping tags or when they were last seen. "+
+				"Use `ids` for the `host_ids` of a `staticByID` `crowdstrike_host_group`, or the hostnames of `hosts` for a `static` one.",
+			apiScopesRead,
+		),
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The FQL filter selecting the hosts, for example `platform_name:'Linux'+tags:'SensorGroupingTags/web'+last_seen:>'now-7d'`. All hosts are returned when it is not set.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The sort order of the hosts, as `<field>.asc` or `<field>.desc`, for example `hostname.asc`. Defaults to `device_id.asc`.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"limit":     utils.PageLimitAttribute("hosts", hostsDefaultLimit, hostsMaxLimit),
+			"max_items": utils.MaxItemsAttribute("hosts"),
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the hosts matching the filter, in the order of `hosts`.",
+			},
+			"hosts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hosts matching the filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                stringAttribute("The ID of the host (device ID)."),
+						"hostname":          stringAttribute("The hostname of the host."),
+						"platform_name":     stringAttribute("The platform of the host, such as `Windows`, `Mac` or `Linux`."),
+						"os_version":        stringAttribute("The operating system version of the host."),
+						"product_type_desc": stringAttribute("The product type of the host, such as `Workstation`, `Server` or `Domain Controller`."),
+						"status":            stringAttribute("The containment status of the host, such as `normal` or `contained`."),
+						"agent_version":     stringAttribute("The version of the Falcon sensor installed on the host."),
+						"machine_domain":    stringAttribute("The Active Directory domain of the host."),
+						"site_name":         stringAttribute("The Active Directory site of the host."),
+						"ou":                listAttribute("The Active Directory organizational units of the host."),
+						"tags":              listAttribute("The sensor grouping and Falcon grouping tags of the host."),
+						"groups":            listAttribute("The IDs of the host groups the host belongs to."),
+						"local_ip":          stringAttribute("The local IP address of the host."),
+						"external_ip":       stringAttribute("The external IP address of the host."),
+						"mac_address":       stringAttribute("The MAC address of the host."),
+						"serial_number":     stringAttribute("The serial number of the host."),
+						"first_seen":        stringAttribute("When the host was first seen."),
+						"last_seen":         stringAttribute("When the host was last seen."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *hostsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data hostsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, hostsDefaultLimit)
+
+	devices, total, diags := d.queryHosts(ctx, data.Filter.ValueString(), data.Sort.ValueString(), pagination)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("hosts", len(devices), total)...)
+
+	resp.Diagnostics.Append(data.wrap(ctx, devices)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// queryHosts returns up to pagination.MaxItems hosts matching filter in the
+// order of sort, and the total number of matching hosts.
+func (d *hostsDataSource) queryHosts(
+	ctx context.Context,
+	filter string,
+	sort string,
+	pagination utils.Pagination,
+) ([]*models.DeviceapiDeviceSwagger, int64, diag.Diagnostics) {
+	var next *string
+
+	return utils.QueryPages(pagination, func(offset, limit int64) ([]*models.DeviceapiDeviceSwagger, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		params := hosts.NewCombinedDevicesByFilterParams()
+		params.Context = ctx
+		params.Limit = utils.Addr(limit)
+		params.Offset = next
+		if filter != "" {
+			params.Filter = &filter
+		}
+		if sort != "" {
+			params.Sort = &sort
+		}
+
+		res, err := d.client.Hosts.CombinedDevicesByFilter(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesRead))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
+		}
+
+		page := res.Payload.Resources
+
+		var paging *models.DeviceapiDevicePagingV2
+		if res.Payload.Meta != nil {
+			paging = res.Payload.Meta.Pagination
+		}
+		if paging == nil || paging.Next == "" {
+			return page, offset + int64(len(page)), diags
+		}
+
+		next = &paging.Next
+
+		total := int64(-1)
+		if paging.Total != nil {
+			total = *paging.Total
+		}
+
+		return page, total, diags
+	})
+}
+
+// wrap sets the hosts and their IDs from the devices returned by the API.
+func (m *hostsDataSourceModel) wrap(ctx context.Context, devices []*models.DeviceapiDeviceSwagger) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := make([]string, 0, len(devices))
+	hostModels := make([]hostModel, 0, len(devices))
+	for _, device := range devices {
+		if device == nil || device.DeviceID == nil {
+			continue
+		}
+
+		ou := utils.SliceToListTypeString(ctx, device.Ou, &diags)
+		tags := utils.SliceToListTypeString(ctx, device.Tags, &diags)
+		groups := utils.SliceToListTypeString(ctx, device.Groups, &diags)
+		if diags.HasError() {
+			return diags
+		}
+
+		ids = append(ids, *device.DeviceID)
+		hostModels = append(hostModels, hostModel{
+			ID:              types.StringValue(*device.DeviceID),
+			Hostname:        types.StringValue(device.Hostname),
+			PlatformName:    types.StringValue(device.PlatformName),
+			OSVersion:       types.StringValue(device.OsVersion),
+			ProductTypeDesc: types.StringValue(device.ProductTypeDesc),
+			Status:          types.StringValue(device.Status),
+			AgentVersion:    types.StringValue(device.AgentVersion),
+			MachineDomain:   types.StringValue(device.MachineDomain),
+			SiteName:        types.StringValue(device.SiteName),
+			OU:              ou,
+			Tags:            tags,
+			Groups:          groups,
+			LocalIP:         types.StringValue(device.LocalIP),
+			ExternalIP:      types.StringValue(device.ExternalIP),
+			MacAddress:      types.StringValue(device.MacAddress),
+			SerialNumber:    types.StringValue(device.SerialNumber),
+			FirstSeen:       types.StringValue(device.FirstSeen),
+			LastSeen:        types.StringValue(device.LastSeen),
+		})
+	}
+
+	hostList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: hostModel{}.AttributeTypes()}, hostModels)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.IDs = utils.SliceToListTypeString(ctx, ids, &diags)
+	m.Hosts = hostList
+
+	return diags
+}
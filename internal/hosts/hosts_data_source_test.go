@@ -0,0 +1,44 @@
+package hosts_test
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHostsDataSource(t *testing.T) {
+	dataSourceName := "data.crowdstrike_hosts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_hosts" "test" {
+  filter    = "last_seen:>'now-30d'"
+  sort      = "hostname.asc"
+  limit     = 2
+  max_items = 5
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "ids.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hosts.#"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_hosts" "test" {
+  filter = "hostname:'tf-acc-test-host-that-does-not-exist'"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "hosts.#", "0"),
+				),
+			},
+		},
+	})
+}
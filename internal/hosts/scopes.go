@@ -0,0 +1,19 @@
+package hosts
+
+import "github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+
+var apiScopesRead = []scopes.Scope{
+	{
+		Name:  "Hosts",
+		Read:  true,
+		Write: false,
+	},
+}
+
+var apiScopesReadWrite = []scopes.Scope{
+	{
+		Name:  "Hosts",
+		Read:  true,
+		Write: true,
+	},
+}
@@ -0,0 +1,193 @@
+// Package ids implements data sources returning the IDs of the objects of a
+// type matching an FQL filter. They drive the generation of import blocks when
+// adopting the objects of an existing CID into Terraform.
+package ids
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &idsDataSource{}
+	_ datasource.DataSourceWithConfigure = &idsDataSource{}
+)
+
+// Query returns the function fetching the pages of IDs of the objects matching
+// filter in the order of sort, which are nil when they are not configured. It
+// is called once per read, so queries paginated by cursor can keep the cursor
+// between pages.
+type Query func(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	filter *string,
+	sort *string,
+) utils.QueryPage[string]
+
+// Options describe the objects returned by an IDs data source.
+type Options struct {
+	// TypeName is the data source type name without the provider prefix, such as `_host_group_ids`.
+	TypeName string
+	// Section is the documentation section of the data source.
+	Section string
+	// Objects is the plural name of the objects, such as `host groups`.
+	Objects string
+	// Description is the description of the data source.
+	Description string
+	// Example is an FQL filter shown in the documentation of the filter attribute.
+	Example string
+	// SortExample is a sort expression shown in the documentation of the sort attribute.
+	SortExample string
+	// DefaultLimit is the number of IDs requested per page when limit is not set.
+	DefaultLimit int64
+	// MaxLimit is the largest page size accepted by the API.
+	MaxLimit int64
+	// Scopes are the API scopes required to query the objects.
+	Scopes []scopes.Scope
+	// Query returns the IDs of the objects.
+	Query Query
+}
+
+// NewDataSource returns an IDs data source for the objects described by opts.
+func NewDataSource(opts Options) datasource.DataSource {
+	return &idsDataSource{opts: opts}
+}
+
+// idsDataSource is the data source implementation.
+type idsDataSource struct {
+	client *client.CrowdStrikeAPISpecification
+	opts   Options
+}
+
+// idsDataSourceModel is the data source model.
+type idsDataSourceModel struct {
+	Filter   types.String `tfsdk:"filter"`
+	Sort     types.String `tfsdk:"sort"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	MaxItems types.Int64  `tfsdk:"max_items"`
+	IDs      types.List   `tfsdk:"ids"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *idsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(d.opts.Scopes)...)
+}
+
+// Metadata returns the data source type name.
+func (d *idsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + d.opts.TypeName
+}
+
+// Schema defines the schema for the data source.
+func (d *idsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			d.opts.Section,
+			d.opts.Description,
+			d.opts.Scopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf(
+					"FQL filter limiting the %s returned. All %s, up to `max_items`, are returned when omitted. Example: `%s`",
+					d.opts.Objects,
+					d.opts.Objects,
+					d.opts.Example,
+				),
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf(
+					"The sort order of the %s, as `<field>.asc` or `<field>.desc`. Example: `%s`",
+					d.opts.Objects,
+					d.opts.SortExample,
+				),
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"limit":     utils.PageLimitAttribute(d.opts.Objects, d.opts.DefaultLimit, d.opts.MaxLimit),
+			"max_items": utils.MaxItemsAttribute(d.opts.Objects),
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: fmt.Sprintf("The IDs of the %s matching the filter.", d.opts.Objects),
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *idsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data idsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, d.opts.DefaultLimit)
+	fetch := d.opts.Query(ctx, d.client, data.Filter.ValueStringPointer(), data.Sort.ValueStringPointer())
+
+	ids, total, diags := utils.QueryPages(pagination, fetch)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning(d.opts.Objects, len(ids), total)...)
+
+	data.IDs = utils.SliceToListTypeString(ctx, ids, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,448 @@
+package intel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/intel"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// actorsQueryLimit is the largest page size used when querying actors.
+const actorsQueryLimit = 1000
+
+// defaultActorsSort is the sort order of the actors when sort is not set.
+const defaultActorsSort = "name|asc"
+
+// actorFields are the actor document fields requested from the API.
+var actorFields = []string{
+	"id",
+	"name",
+	"slug",
+	"known_as",
+	"short_description",
+	"actor_type",
+	"status",
+	"url",
+	"first_activity_date",
+	"last_activity_date",
+	"origins",
+	"motivations",
+	"target_industries",
+	"target_countries",
+	"target_regions",
+}
+
+var (
+	_ datasource.DataSource              = &actorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &actorsDataSource{}
+)
+
+func NewActorsDataSource() datasource.DataSource {
+	return &actorsDataSource{}
+}
+
+type actorsDataSource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type actorsDataSourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	TargetIndustries types.Set    `tfsdk:"target_industries"`
+	Filter           types.String `tfsdk:"filter"`
+	Sort             types.String `tfsdk:"sort"`
+	Limit            types.Int64  `tfsdk:"limit"`
+	MaxItems         types.Int64  `tfsdk:"max_items"`
+	Actors           types.List   `tfsdk:"actors"`
+}
+
+type actorModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Slug              types.String `tfsdk:"slug"`
+	KnownAs           types.List   `tfsdk:"known_as"`
+	ShortDescription  types.String `tfsdk:"short_description"`
+	ActorType         types.String `tfsdk:"actor_type"`
+	Status            types.String `tfsdk:"status"`
+	URL               types.String `tfsdk:"url"`
+	FirstActivityDate types.String `tfsdk:"first_activity_date"`
+	LastActivityDate  types.String `tfsdk:"last_activity_date"`
+	Origins           types.List   `tfsdk:"origins"`
+	Motivations       types.List   `tfsdk:"motivations"`
+	TargetIndustries  types.List   `tfsdk:"target_industries"`
+	TargetCountries   types.List   `tfsdk:"target_countries"`
+	TargetRegions     types.List   `tfsdk:"target_regions"`
+}
+
+func (m actorModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                  types.Int64Type,
+		"name":                types.StringType,
+		"slug":                types.StringType,
+		"known_as":            types.ListType{ElemType: types.StringType},
+		"short_description":   types.StringType,
+		"actor_type":          types.StringType,
+		"status":              types.StringType,
+		"url":                 types.StringType,
+		"first_activity_date": types.StringType,
+		"last_activity_date":  types.StringType,
+		"origins":             types.ListType{ElemType: types.StringType},
+		"motivations":         types.ListType{ElemType: types.StringType},
+		"target_industries":   types.ListType{ElemType: types.StringType},
+		"target_countries":    types.ListType{ElemType: types.StringType},
+		"target_regions":      types.ListType{ElemType: types.StringType},
+	}
+}
+
+func (d *actorsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesActorsRead)...)
+}
+
+func (d *actorsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_intel_actors"
+}
+
+func (d *actorsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Falcon Intelligence",
+			"This data source retrieves threat actor profiles from Falcon Intelligence. "+
+				"All filters are combined using logical AND. For filters not covered by the attributes below, use `filter` with a "+
+				"[Falcon Query Language (FQL)](https://falcon.crowdstrike.com/documentation/page/d3c84a1b/falcon-query-language-fql) expression.",
+			apiScopesActorsRead,
+		),
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return actors whose name matches this value. Wildcards `*` are supported. Examples: `FANCY BEAR`, `*SPIDER`",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"target_industries": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return actors known to target at least one of these industries. Examples: `Financial Services`, `Healthcare`",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(fwvalidators.StringNotWhitespace()),
+				},
+			},
+			"filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An additional FQL filter, for example `origins.value:'Russian Federation'+status:'Active'`.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The sort order of the actors, as `<field>|asc` or `<field>|desc`, for example `last_activity_date|desc`. Defaults to `%s`.", defaultActorsSort),
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"limit":     utils.PageLimitAttribute("actors", actorsQueryLimit, actorsQueryLimit),
+			"max_items": utils.MaxItemsAttribute("actors"),
+			"actors": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The threat actors matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the actor.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the actor.",
+						},
+						"slug": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL-friendly identifier of the actor.",
+						},
+						"known_as": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Other names the actor is known by.",
+						},
+						"short_description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A short description of the actor.",
+						},
+						"actor_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the actor.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The activity status of the actor.",
+						},
+						"url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL of the actor profile in the Falcon console.",
+						},
+						"first_activity_date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The RFC3339 timestamp of the first observed activity of the actor.",
+						},
+						"last_activity_date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The RFC3339 timestamp of the last observed activity of the actor.",
+						},
+						"origins": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The countries or regions the actor originates from.",
+						},
+						"motivations": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The motivations of the actor.",
+						},
+						"target_industries": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The industries targeted by the actor.",
+						},
+						"target_countries": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The countries targeted by the actor.",
+						},
+						"target_regions": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The regions targeted by the actor.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *actorsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data actorsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var targetIndustries []string
+	if utils.IsKnown(data.TargetIndustries) {
+		resp.Diagnostics.Append(data.TargetIndustries.ElementsAs(ctx, &targetIndustries, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		slices.Sort(targetIndustries)
+	}
+
+	filter := buildActorsFilter(data.Name.ValueString(), targetIndustries, data.Filter.ValueString())
+
+	sort := defaultActorsSort
+	if utils.IsKnown(data.Sort) {
+		sort = data.Sort.ValueString()
+	}
+
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, actorsQueryLimit)
+	actors, total, diags := d.queryActors(ctx, filter, sort, pagination)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("actors", len(actors), total)...)
+
+	resp.Diagnostics.Append(data.wrap(ctx, actors)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildActorsFilter combines the data source filters into a single FQL expression.
+func buildActorsFilter(name string, targetIndustries []string, filter string) string {
+	var parts []string
+
+	if name != "" {
+		parts = append(parts, "name:"+utils.FQLQuote(name))
+	}
+
+	if len(targetIndustries) > 0 {
+		values := make([]string, 0, len(targetIndustries))
+		for _, industry := range targetIndustries {
+			values = append(values, utils.FQLQuote(industry))
+		}
+		parts = append(parts, fmt.Sprintf("target_industries.value:[%s]", strings.Join(values, ",")))
+	}
+
+	if filter != "" {
+		parts = append(parts, "("+filter+")")
+	}
+
+	return strings.Join(parts, "+")
+}
+
+// queryActors returns up to pagination.MaxItems actors matching filter in the
+// order of sort, and the total number of matching actors.
+func (d *actorsDataSource) queryActors(
+	ctx context.Context,
+	filter string,
+	sort string,
+	pagination utils.Pagination,
+) ([]*models.ActorActorDocument, int64, diag.Diagnostics) {
+	actors, total, diags := utils.QueryPages(pagination, func(offset, limit int64) ([]*models.ActorActorDocument, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		params := intel.NewQueryIntelActorEntitiesParams()
+		params.Context = ctx
+		params.Fields = actorFields
+		params.Limit = utils.Addr(limit)
+		params.Offset = utils.Addr(offset)
+		params.Sort = utils.Addr(sort)
+		if filter != "" {
+			params.Filter = &filter
+		}
+
+		res, err := d.client.Intel.QueryIntelActorEntities(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesActorsRead))
+			return nil, 0, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
+		}
+
+		return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+	})
+
+	return slices.DeleteFunc(actors, func(actor *models.ActorActorDocument) bool { return actor == nil }), total, diags
+}
+
+// wrap transforms the API response into the data source model.
+func (m *actorsDataSourceModel) wrap(
+	ctx context.Context,
+	actors []*models.ActorActorDocument,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	actorModels := make([]actorModel, 0, len(actors))
+	for _, actor := range actors {
+		var knownAs []string
+		if actor.KnownAs != nil {
+			knownAs = splitKnownAs(*actor.KnownAs)
+		}
+
+		actorModels = append(actorModels, actorModel{
+			ID:                types.Int64PointerValue(actor.ID),
+			Name:              flex.StringValueToFramework(actor.Name),
+			Slug:              flex.StringValueToFramework(actor.Slug),
+			KnownAs:           utils.SliceToListTypeString(ctx, knownAs, &diags),
+			ShortDescription:  flex.StringPointerToFramework(actor.ShortDescription),
+			ActorType:         flex.StringValueToFramework(actor.ActorType),
+			Status:            flex.StringPointerToFramework(actor.Status),
+			URL:               flex.StringValueToFramework(actor.URL),
+			FirstActivityDate: unixToFramework(actor.FirstActivityDate),
+			LastActivityDate:  unixToFramework(actor.LastActivityDate),
+			Origins:           utils.SliceToListTypeString(ctx, entityValues(actor.Origins), &diags),
+			Motivations:       utils.SliceToListTypeString(ctx, entityValues(actor.Motivations), &diags),
+			TargetIndustries:  utils.SliceToListTypeString(ctx, entityValues(actor.TargetIndustries), &diags),
+			TargetCountries:   utils.SliceToListTypeString(ctx, entityValues(actor.TargetCountries), &diags),
+			TargetRegions:     utils.SliceToListTypeString(ctx, entityValues(actor.TargetRegions), &diags),
+		})
+	}
+
+	m.Actors = utils.SliceToListTypeObject(ctx, actorModels, actorModel{}.AttributeTypes(), &diags)
+
+	return diags
+}
+
+// splitKnownAs splits the comma separated known_as field into individual names.
+func splitKnownAs(v string) []string {
+	var names []string
+	for name := range strings.SplitSeq(v, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// entityValues returns the display values of the given entities.
+func entityValues(entities []*models.DomainEntity) []string {
+	values := make([]string, 0, len(entities))
+	for _, e := range entities {
+		if e != nil && e.Value != "" {
+			values = append(values, e.Value)
+		}
+	}
+	return values
+}
+
+// unixToFramework converts a unix timestamp in seconds to an RFC3339 string.
+func unixToFramework(v *int64) types.String {
+	if v == nil || *v == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Unix(*v, 0).UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,57 @@
+package intel_test
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccIntelActorsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.crowdstrike_intel_actors.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_intel_actors" "test" {
+  name = "FANCY BEAR"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("actors"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(
+						dataSourceName,
+						tfjsonpath.New("actors").AtSliceIndex(0).AtMapKey("slug"),
+						knownvalue.StringExact("fancy-bear"),
+					),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_intel_actors" "test" {
+  target_industries = ["Financial Services"]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("actors"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_intel_actors" "test" {
+  name = "TF-ACC-TEST-DOES-NOT-EXIST"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("actors"), knownvalue.ListSizeExact(0)),
+				},
+			},
+		},
+	})
+}
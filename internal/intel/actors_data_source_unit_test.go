@@ -0,0 +1,62 @@
+package intel
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBuildActorsFilter(t *testing.T) {
+	tests := []struct {
+		name             string
+		actorName        string
+		targetIndustries []string
+		filter           string
+		want             string
+	}{
+		{
+			name: "no filters",
+			want: "",
+		},
+		{
+			name:      "name",
+			actorName: "*BEAR",
+			want:      "name:'*BEAR'",
+		},
+		{
+			name:             "all filters",
+			actorName:        "FANCY BEAR",
+			targetIndustries: []string{"Financial Services", "Healthcare"},
+			filter:           "status:'Active'",
+			want:             "name:'FANCY BEAR'+target_industries.value:['Financial Services','Healthcare']+(status:'Active')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildActorsFilter(tt.actorName, tt.targetIndustries, tt.filter)
+			if got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitKnownAs(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{name: "empty", in: "", want: nil},
+		{name: "single", in: "APT28", want: []string{"APT28"}},
+		{name: "multiple", in: "APT28, Sofacy ,, Sednit", want: []string{"APT28", "Sofacy", "Sednit"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitKnownAs(tt.in); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package intel
+
+import "github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+
+var apiScopesActorsRead = []scopes.Scope{
+	{
+		Name:  "Actors (Falcon Intelligence)",
+		Read:  true,
+		Write: false,
+	},
+}
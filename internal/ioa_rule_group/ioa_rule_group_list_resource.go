@@ -0,0 +1,60 @@
+package ioarulegroup
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/custom_ioa"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/listresource"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewIOARuleGroupListResource is a helper function to simplify the provider implementation.
+func NewIOARuleGroupListResource() list.ListResource {
+	return listresource.New(listresource.Options[*models.APIRuleGroupV1, ioaRuleGroupResourceModel]{
+		TypeName: "_ioa_rule_group",
+		Objects:  "IOA rule groups",
+		Scopes:   apiScopesReadWrite,
+		Query:    queryRuleGroups,
+		ID:       func(group *models.APIRuleGroupV1) *string { return group.ID },
+		Name:     func(group *models.APIRuleGroupV1) *string { return group.Name },
+		Wrap: func(ctx context.Context, m *ioaRuleGroupResourceModel, group *models.APIRuleGroupV1) diag.Diagnostics {
+			platform := ""
+			if group.Platform != nil {
+				platform = normalizePlatform(*group.Platform)
+			}
+
+			return m.wrap(ctx, group, platform, nil)
+		},
+	})
+}
+
+// queryRuleGroups fetches a page of the IOA rule groups matching filter.
+func queryRuleGroups(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	filter *string,
+	offset int64,
+) (*listresource.Page[*models.APIRuleGroupV1], error) {
+	res, err := client.CustomIoa.QueryRuleGroupsFull(
+		&custom_ioa.QueryRuleGroupsFullParams{
+			Context: ctx,
+			Filter:  filter,
+			Limit:   utils.Addr(utils.ListPageSize),
+			Offset:  utils.Addr(strconv.FormatInt(offset, 10)),
+		},
+	)
+	if err != nil || res == nil || res.Payload == nil {
+		return nil, err
+	}
+
+	return &listresource.Page[*models.APIRuleGroupV1]{
+		Resources: res.Payload.Resources,
+		Meta:      res.Payload.Meta,
+		Errors:    res.Payload.Errors,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package ioarulegroup_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccIOARuleGroupListResource(t *testing.T) {
+	rName := acctest.RandomResourceName()
+	listName := "crowdstrike_ioa_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIOARuleGroupListResourceConfig(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccIOARuleGroupListResourceQuery(rName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(listName, 1),
+					querycheck.ExpectResourceDisplayName(
+						listName,
+						queryfilter.ByDisplayName(knownvalue.StringExact(rName)),
+						knownvalue.StringExact(rName),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccIOARuleGroupListResourceConfig(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_ioa_rule_group" "test" {
+  name     = %[1]q
+  platform = "Windows"
+}
+`, rName)
+}
+
+func testAccIOARuleGroupListResourceQuery(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+list "crowdstrike_ioa_rule_group" "test" {
+  provider = crowdstrike
+
+  config {
+    filter = "name:'%s'"
+  }
+}
+`, rName)
+}
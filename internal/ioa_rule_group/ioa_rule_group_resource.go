@@ -14,6 +14,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -36,6 +37,7 @@ var (
 	_ resource.Resource                   = &ioaRuleGroupResource{}
 	_ resource.ResourceWithConfigure      = &ioaRuleGroupResource{}
 	_ resource.ResourceWithImportState    = &ioaRuleGroupResource{}
+	_ resource.ResourceWithIdentity       = &ioaRuleGroupResource{}
 	_ resource.ResourceWithValidateConfig = &ioaRuleGroupResource{}
 )
 
@@ -194,20 +196,21 @@ type ioaRuleGroupResource struct {
 }
 
 type ioaRuleGroupResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Platform    types.String `tfsdk:"platform"`
-	Description types.String `tfsdk:"description"`
-	Comment     types.String `tfsdk:"comment"`
-	Enabled     types.Bool   `tfsdk:"enabled"`
-	CreatedBy   types.String `tfsdk:"created_by"`
-	CreatedOn   types.String `tfsdk:"created_on"`
-	ModifiedBy  types.String `tfsdk:"modified_by"`
-	ModifiedOn  types.String `tfsdk:"modified_on"`
-	CommittedOn types.String `tfsdk:"committed_on"`
-	CID         types.String `tfsdk:"cid"`
-	Deleted     types.Bool   `tfsdk:"deleted"`
-	Rules       types.List   `tfsdk:"rules"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Platform    types.String   `tfsdk:"platform"`
+	Description types.String   `tfsdk:"description"`
+	Comment     types.String   `tfsdk:"comment"`
+	Enabled     types.Bool     `tfsdk:"enabled"`
+	CreatedBy   types.String   `tfsdk:"created_by"`
+	CreatedOn   types.String   `tfsdk:"created_on"`
+	ModifiedBy  types.String   `tfsdk:"modified_by"`
+	ModifiedOn  types.String   `tfsdk:"modified_on"`
+	CommittedOn types.String   `tfsdk:"committed_on"`
+	CID         types.String   `tfsdk:"cid"`
+	Deleted     types.Bool     `tfsdk:"deleted"`
+	Rules       types.List     `tfsdk:"rules"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ioaRuleModel struct {
@@ -326,6 +329,7 @@ func (r *ioaRuleGroupResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
 }
 
 func (r *ioaRuleGroupResource) Metadata(
@@ -548,9 +552,21 @@ func (r *ioaRuleGroupResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *ioaRuleGroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 func (m *ioaRuleGroupResourceModel) wrap(
 	ctx context.Context,
 	group *models.APIRuleGroupV1,
@@ -933,6 +949,9 @@ func (r *ioaRuleGroupResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	comment := plan.Comment.ValueString()
 	if comment == "" {
 		comment = "Created by Terraform"
@@ -1031,6 +1050,7 @@ func (r *ioaRuleGroupResource) Create(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, group, plan.Platform.ValueString(), orderedInstanceIDs)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *ioaRuleGroupResource) Read(
@@ -1044,6 +1064,9 @@ func (r *ioaRuleGroupResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var ruleOrder []string
 	stateRules := utils.ListTypeAs[ioaRuleModel](ctx, state.Rules, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -1073,6 +1096,7 @@ func (r *ioaRuleGroupResource) Read(
 
 	resp.Diagnostics.Append(state.wrap(ctx, group, platform, ruleOrder)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *ioaRuleGroupResource) Update(
@@ -1086,6 +1110,9 @@ func (r *ioaRuleGroupResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	groupID := plan.ID.ValueString()
 	platform := plan.Platform.ValueString()
 
@@ -1230,6 +1257,7 @@ func (r *ioaRuleGroupResource) Update(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, group, platform, orderedInstanceIDs)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *ioaRuleGroupResource) Delete(
@@ -1243,6 +1271,9 @@ func (r *ioaRuleGroupResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	groupID := state.ID.ValueString()
 
 	deleteParams := custom_ioa.NewDeleteRuleGroupsMixin0ParamsWithContext(ctx)
@@ -1268,7 +1299,7 @@ func (r *ioaRuleGroupResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *ioaRuleGroupResource) ValidateConfig(
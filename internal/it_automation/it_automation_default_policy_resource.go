@@ -10,6 +10,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -49,25 +50,26 @@ type itAutomationDefaultPolicyResource struct {
 
 // itAutomationDefaultPolicyResourceModel is the resource model.
 type itAutomationDefaultPolicyResourceModel struct {
-	ID                              types.String `tfsdk:"id"`
-	Name                            types.String `tfsdk:"name"`
-	Description                     types.String `tfsdk:"description"`
-	ConcurrentHostFileTransferLimit types.Int32  `tfsdk:"concurrent_host_file_transfer_limit"`
-	ConcurrentHostLimit             types.Int32  `tfsdk:"concurrent_host_limit"`
-	ConcurrentTaskLimit             types.Int32  `tfsdk:"concurrent_task_limit"`
-	CPUSchedulingPriority           types.String `tfsdk:"cpu_scheduling_priority"`
-	CPUThrottle                     types.Int32  `tfsdk:"cpu_throttle"`
-	EnableOsQuery                   types.Bool   `tfsdk:"enable_os_query"`
-	EnablePythonExecution           types.Bool   `tfsdk:"enable_python_execution"`
-	EnableScriptExecution           types.Bool   `tfsdk:"enable_script_execution"`
-	ExecutionTimeout                types.Int32  `tfsdk:"execution_timeout"`
-	ExecutionTimeoutUnit            types.String `tfsdk:"execution_timeout_unit"`
-	Enabled                         types.Bool   `tfsdk:"enabled"`
-	LastUpdated                     types.String `tfsdk:"last_updated"`
-	MemoryAllocation                types.Int32  `tfsdk:"memory_allocation"`
-	MemoryAllocationUnit            types.String `tfsdk:"memory_allocation_unit"`
-	MemoryPressureLevel             types.String `tfsdk:"memory_pressure_level"`
-	PlatformName                    types.String `tfsdk:"platform_name"`
+	ID                              types.String   `tfsdk:"id"`
+	Name                            types.String   `tfsdk:"name"`
+	Description                     types.String   `tfsdk:"description"`
+	ConcurrentHostFileTransferLimit types.Int32    `tfsdk:"concurrent_host_file_transfer_limit"`
+	ConcurrentHostLimit             types.Int32    `tfsdk:"concurrent_host_limit"`
+	ConcurrentTaskLimit             types.Int32    `tfsdk:"concurrent_task_limit"`
+	CPUSchedulingPriority           types.String   `tfsdk:"cpu_scheduling_priority"`
+	CPUThrottle                     types.Int32    `tfsdk:"cpu_throttle"`
+	EnableOsQuery                   types.Bool     `tfsdk:"enable_os_query"`
+	EnablePythonExecution           types.Bool     `tfsdk:"enable_python_execution"`
+	EnableScriptExecution           types.Bool     `tfsdk:"enable_script_execution"`
+	ExecutionTimeout                types.Int32    `tfsdk:"execution_timeout"`
+	ExecutionTimeoutUnit            types.String   `tfsdk:"execution_timeout_unit"`
+	Enabled                         types.Bool     `tfsdk:"enabled"`
+	LastUpdated                     types.String   `tfsdk:"last_updated"`
+	MemoryAllocation                types.Int32    `tfsdk:"memory_allocation"`
+	MemoryAllocationUnit            types.String   `tfsdk:"memory_allocation_unit"`
+	MemoryPressureLevel             types.String   `tfsdk:"memory_pressure_level"`
+	PlatformName                    types.String   `tfsdk:"platform_name"`
+	Timeouts                        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (t *itAutomationDefaultPolicyResourceModel) wrap(
@@ -191,6 +193,7 @@ func (r *itAutomationDefaultPolicyResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(defaultPoliciesRequiredScopes)...)
 }
 
 // Metadata returns the resource type name.
@@ -204,7 +207,7 @@ func (r *itAutomationDefaultPolicyResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *itAutomationDefaultPolicyResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -328,6 +331,9 @@ func (r *itAutomationDefaultPolicyResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -343,6 +349,9 @@ func (r *itAutomationDefaultPolicyResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := r.getDefaultPolicyByPlatform(ctx, plan.PlatformName.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -376,6 +385,9 @@ func (r *itAutomationDefaultPolicyResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policyID := state.ID.ValueString()
 	policy, diags := getItAutomationPolicy(ctx, r.client, policyID)
 
@@ -410,6 +422,9 @@ func (r *itAutomationDefaultPolicyResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	updatedPolicy, err := r.updateDefaultPolicyConfig(ctx, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -11,6 +11,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -50,10 +51,11 @@ type itAutomationPolicyPrecedenceResource struct {
 
 // itAutomationPolicyPrecedenceResourceModel is the resource model.
 type itAutomationPolicyPrecedenceResourceModel struct {
-	IDs          types.List   `tfsdk:"ids"`
-	Enforcement  types.String `tfsdk:"enforcement"`
-	LastUpdated  types.String `tfsdk:"last_updated"`
-	PlatformName types.String `tfsdk:"platform_name"`
+	IDs          types.List     `tfsdk:"ids"`
+	Enforcement  types.String   `tfsdk:"enforcement"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	PlatformName types.String   `tfsdk:"platform_name"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (d *itAutomationPolicyPrecedenceResourceModel) wrap(
@@ -97,6 +99,7 @@ func (r *itAutomationPolicyPrecedenceResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(precedenceRequiredScopes)...)
 }
 
 // Metadata returns the resource type name.
@@ -110,7 +113,7 @@ func (r *itAutomationPolicyPrecedenceResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *itAutomationPolicyPrecedenceResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -145,6 +148,9 @@ func (r *itAutomationPolicyPrecedenceResource) Schema(
 				Description: "Timestamp of the last Terraform update of the resource.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -160,6 +166,9 @@ func (r *itAutomationPolicyPrecedenceResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var planPolicyIDs []string
 	resp.Diagnostics.Append(plan.IDs.ElementsAs(ctx, &planPolicyIDs, false)...)
 	if resp.Diagnostics.HasError() {
@@ -234,6 +243,9 @@ func (r *itAutomationPolicyPrecedenceResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	_, policies, diags := getItAutomationPolicies(
 		ctx,
 		r.client,
@@ -266,6 +278,9 @@ func (r *itAutomationPolicyPrecedenceResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var planPolicyIDs []string
 	resp.Diagnostics.Append(plan.IDs.ElementsAs(ctx, &planPolicyIDs, false)...)
 	if resp.Diagnostics.HasError() {
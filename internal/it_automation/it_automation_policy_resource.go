@@ -10,8 +10,10 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/client/it_automation"
 	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -31,7 +33,9 @@ var (
 	_ resource.Resource                   = &itAutomationPolicyResource{}
 	_ resource.ResourceWithConfigure      = &itAutomationPolicyResource{}
 	_ resource.ResourceWithImportState    = &itAutomationPolicyResource{}
+	_ resource.ResourceWithIdentity       = &itAutomationPolicyResource{}
 	_ resource.ResourceWithValidateConfig = &itAutomationPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &itAutomationPolicyResource{}
 )
 
 var (
@@ -47,31 +51,33 @@ func NewItAutomationPolicyResource() resource.Resource {
 
 // itAutomationPolicyResource is the resource implementation.
 type itAutomationPolicyResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client     *client.CrowdStrikeAPISpecification
+	references references.Validator
 }
 
 // itAutomationPolicyResourceModel is the resource model.
 type itAutomationPolicyResourceModel struct {
-	ID                              types.String `tfsdk:"id"`
-	Name                            types.String `tfsdk:"name"`
-	Description                     types.String `tfsdk:"description"`
-	ConcurrentHostFileTransferLimit types.Int32  `tfsdk:"concurrent_host_file_transfer_limit"`
-	ConcurrentHostLimit             types.Int32  `tfsdk:"concurrent_host_limit"`
-	ConcurrentTaskLimit             types.Int32  `tfsdk:"concurrent_task_limit"`
-	CPUSchedulingPriority           types.String `tfsdk:"cpu_scheduling_priority"`
-	CPUThrottle                     types.Int32  `tfsdk:"cpu_throttle"`
-	EnableOsQuery                   types.Bool   `tfsdk:"enable_os_query"`
-	EnablePythonExecution           types.Bool   `tfsdk:"enable_python_execution"`
-	EnableScriptExecution           types.Bool   `tfsdk:"enable_script_execution"`
-	ExecutionTimeout                types.Int32  `tfsdk:"execution_timeout"`
-	ExecutionTimeoutUnit            types.String `tfsdk:"execution_timeout_unit"`
-	HostGroups                      types.Set    `tfsdk:"host_groups"`
-	Enabled                         types.Bool   `tfsdk:"enabled"`
-	LastUpdated                     types.String `tfsdk:"last_updated"`
-	MemoryAllocation                types.Int32  `tfsdk:"memory_allocation"`
-	MemoryAllocationUnit            types.String `tfsdk:"memory_allocation_unit"`
-	MemoryPressureLevel             types.String `tfsdk:"memory_pressure_level"`
-	PlatformName                    types.String `tfsdk:"platform_name"`
+	ID                              types.String   `tfsdk:"id"`
+	Name                            types.String   `tfsdk:"name"`
+	Description                     types.String   `tfsdk:"description"`
+	ConcurrentHostFileTransferLimit types.Int32    `tfsdk:"concurrent_host_file_transfer_limit"`
+	ConcurrentHostLimit             types.Int32    `tfsdk:"concurrent_host_limit"`
+	ConcurrentTaskLimit             types.Int32    `tfsdk:"concurrent_task_limit"`
+	CPUSchedulingPriority           types.String   `tfsdk:"cpu_scheduling_priority"`
+	CPUThrottle                     types.Int32    `tfsdk:"cpu_throttle"`
+	EnableOsQuery                   types.Bool     `tfsdk:"enable_os_query"`
+	EnablePythonExecution           types.Bool     `tfsdk:"enable_python_execution"`
+	EnableScriptExecution           types.Bool     `tfsdk:"enable_script_execution"`
+	ExecutionTimeout                types.Int32    `tfsdk:"execution_timeout"`
+	ExecutionTimeoutUnit            types.String   `tfsdk:"execution_timeout_unit"`
+	HostGroups                      types.Set      `tfsdk:"host_groups"`
+	Enabled                         types.Bool     `tfsdk:"enabled"`
+	LastUpdated                     types.String   `tfsdk:"last_updated"`
+	MemoryAllocation                types.Int32    `tfsdk:"memory_allocation"`
+	MemoryAllocationUnit            types.String   `tfsdk:"memory_allocation_unit"`
+	MemoryPressureLevel             types.String   `tfsdk:"memory_pressure_level"`
+	PlatformName                    types.String   `tfsdk:"platform_name"`
+	Timeouts                        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (t *itAutomationPolicyResourceModel) wrap(
@@ -180,6 +186,21 @@ func (r *itAutomationPolicyResource) Configure(
 	}
 
 	r.client = config.Client
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(policiesRequiredScopes)...)
+}
+
+// ModifyPlan validates that the objects referenced by the resource exist.
+func (r *itAutomationPolicyResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	resp.Diagnostics.Append(r.references.Validate(
+		ctx,
+		req.Plan,
+		references.HostGroups(path.Root("host_groups")),
+	)...)
 }
 
 // Metadata returns the resource type name.
@@ -193,7 +214,7 @@ func (r *itAutomationPolicyResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *itAutomationPolicyResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -335,9 +356,21 @@ func (r *itAutomationPolicyResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *itAutomationPolicyResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // createPolicyConfigFromModel creates a policy configuration from the resource model.
 func createPolicyConfigFromModel(
 	plan *itAutomationPolicyResourceModel,
@@ -383,6 +416,9 @@ func (r *itAutomationPolicyResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	body := &models.ItautomationCreatePolicyRequest{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueStringPointer(),
@@ -466,6 +502,7 @@ func (r *itAutomationPolicyResource) Create(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, updatedPolicy)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -480,6 +517,9 @@ func (r *itAutomationPolicyResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policyID := state.ID.ValueString()
 	policy, diags := getItAutomationPolicy(ctx, r.client, policyID)
 	if diags.HasError() {
@@ -502,6 +542,7 @@ func (r *itAutomationPolicyResource) Read(
 
 	resp.Diagnostics.Append(state.wrap(ctx, policy)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -516,6 +557,9 @@ func (r *itAutomationPolicyResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var state itAutomationPolicyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -642,6 +686,7 @@ func (r *itAutomationPolicyResource) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // updatePolicyEnabledState enables or disables a policy.
@@ -703,6 +748,9 @@ func (r *itAutomationPolicyResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policyID := state.ID.ValueString()
 
 	currentPolicy, diags := getItAutomationPolicy(ctx, r.client, policyID)
@@ -787,7 +835,7 @@ func (r *itAutomationPolicyResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // ValidateConfig validates the resource configuration.
@@ -11,6 +11,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -29,6 +30,7 @@ var (
 	_ resource.Resource                   = &itAutomationTaskGroupResource{}
 	_ resource.ResourceWithConfigure      = &itAutomationTaskGroupResource{}
 	_ resource.ResourceWithImportState    = &itAutomationTaskGroupResource{}
+	_ resource.ResourceWithIdentity       = &itAutomationTaskGroupResource{}
 	_ resource.ResourceWithValidateConfig = &itAutomationTaskGroupResource{}
 )
 
@@ -50,13 +52,14 @@ type itAutomationTaskGroupResource struct {
 
 // itAutomationTaskGroupResourceModel is the resource model.
 type itAutomationTaskGroupResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Description     types.String `tfsdk:"description"`
-	AccessType      types.String `tfsdk:"access_type"`
-	AssignedUserIds types.Set    `tfsdk:"assigned_user_ids"`
-	LastUpdated     types.String `tfsdk:"last_updated"`
-	TaskIds         types.Set    `tfsdk:"task_ids"`
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	Description     types.String   `tfsdk:"description"`
+	AccessType      types.String   `tfsdk:"access_type"`
+	AssignedUserIds types.Set      `tfsdk:"assigned_user_ids"`
+	LastUpdated     types.String   `tfsdk:"last_updated"`
+	TaskIds         types.Set      `tfsdk:"task_ids"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (t *itAutomationTaskGroupResourceModel) wrap(
@@ -112,6 +115,7 @@ func (r *itAutomationTaskGroupResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(taskGroupsRequiredScopes)...)
 }
 
 // Metadata returns the resource type name.
@@ -125,7 +129,7 @@ func (r *itAutomationTaskGroupResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *itAutomationTaskGroupResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -199,9 +203,21 @@ func (r *itAutomationTaskGroupResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *itAutomationTaskGroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *itAutomationTaskGroupResource) Create(
 	ctx context.Context,
@@ -214,6 +230,9 @@ func (r *itAutomationTaskGroupResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	body := &models.ItautomationCreateTaskGroupRequest{
 		Name:       plan.Name.ValueStringPointer(),
 		AccessType: plan.AccessType.ValueStringPointer(),
@@ -268,6 +287,7 @@ func (r *itAutomationTaskGroupResource) Create(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, *apiResponse.Payload.Resources[0])...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -282,6 +302,9 @@ func (r *itAutomationTaskGroupResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	groupID := state.ID.ValueString()
 	taskGroup, diags := getItAutomationTaskGroup(ctx, r.client, groupID)
 	if diags.HasError() {
@@ -304,6 +327,7 @@ func (r *itAutomationTaskGroupResource) Read(
 
 	resp.Diagnostics.Append(state.wrap(ctx, taskGroup)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -318,6 +342,9 @@ func (r *itAutomationTaskGroupResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var state itAutomationTaskGroupResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -386,6 +413,7 @@ func (r *itAutomationTaskGroupResource) Update(
 	resp.Diagnostics.Append(plan.wrap(ctx, updatedTask)...)
 	plan.LastUpdated = utils.GenerateUpdateTimestamp()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -400,6 +428,9 @@ func (r *itAutomationTaskGroupResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	params := it_automation.ITAutomationDeleteTaskGroupsParams{
 		Context: ctx,
 		Ids:     []string{state.ID.ValueString()},
@@ -440,7 +471,7 @@ func (r *itAutomationTaskGroupResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // ValidateConfig validates the resource configuration.
@@ -11,6 +11,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -32,6 +33,7 @@ var (
 	_ resource.Resource                     = &itAutomationTaskResource{}
 	_ resource.ResourceWithConfigure        = &itAutomationTaskResource{}
 	_ resource.ResourceWithImportState      = &itAutomationTaskResource{}
+	_ resource.ResourceWithIdentity         = &itAutomationTaskResource{}
 	_ resource.ResourceWithValidateConfig   = &itAutomationTaskResource{}
 	_ resource.ResourceWithConfigValidators = &itAutomationTaskResource{}
 	_ resource.ResourceWithModifyPlan       = &itAutomationTaskResource{}
@@ -78,30 +80,31 @@ type verificationConditionModel struct {
 
 // itAutomationTaskResourceModel is the resource model.
 type itAutomationTaskResourceModel struct {
-	ID                       types.String `tfsdk:"id"`
-	Name                     types.String `tfsdk:"name"`
-	Description              types.String `tfsdk:"description"`
-	AccessType               types.String `tfsdk:"access_type"`
-	AssignedUserIds          types.Set    `tfsdk:"assigned_user_ids"`
-	EffectiveAccessType      types.String `tfsdk:"effective_access_type"`
-	EffectiveAssignedUserIds types.Set    `tfsdk:"effective_assigned_user_ids"`
-	AdditionalFileIds        types.Set    `tfsdk:"additional_file_ids"`
-	LastUpdated              types.String `tfsdk:"last_updated"`
-	LinuxScriptContent       types.String `tfsdk:"linux_script_content"`
-	LinuxScriptFileId        types.String `tfsdk:"linux_script_file_id"`
-	LinuxScriptLanguage      types.String `tfsdk:"linux_script_language"`
-	MacScriptContent         types.String `tfsdk:"mac_script_content"`
-	MacScriptFileId          types.String `tfsdk:"mac_script_file_id"`
-	MacScriptLanguage        types.String `tfsdk:"mac_script_language"`
-	OsQuery                  types.String `tfsdk:"os_query"`
-	ScriptColumns            types.Object `tfsdk:"script_columns"`
-	Target                   types.String `tfsdk:"target"`
-	TaskGroupID              types.String `tfsdk:"task_group_id"`
-	Type                     types.String `tfsdk:"type"`
-	VerificationCondition    types.List   `tfsdk:"verification_condition"`
-	WindowsScriptContent     types.String `tfsdk:"windows_script_content"`
-	WindowsScriptFileId      types.String `tfsdk:"windows_script_file_id"`
-	WindowsScriptLanguage    types.String `tfsdk:"windows_script_language"`
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Description              types.String   `tfsdk:"description"`
+	AccessType               types.String   `tfsdk:"access_type"`
+	AssignedUserIds          types.Set      `tfsdk:"assigned_user_ids"`
+	EffectiveAccessType      types.String   `tfsdk:"effective_access_type"`
+	EffectiveAssignedUserIds types.Set      `tfsdk:"effective_assigned_user_ids"`
+	AdditionalFileIds        types.Set      `tfsdk:"additional_file_ids"`
+	LastUpdated              types.String   `tfsdk:"last_updated"`
+	LinuxScriptContent       types.String   `tfsdk:"linux_script_content"`
+	LinuxScriptFileId        types.String   `tfsdk:"linux_script_file_id"`
+	LinuxScriptLanguage      types.String   `tfsdk:"linux_script_language"`
+	MacScriptContent         types.String   `tfsdk:"mac_script_content"`
+	MacScriptFileId          types.String   `tfsdk:"mac_script_file_id"`
+	MacScriptLanguage        types.String   `tfsdk:"mac_script_language"`
+	OsQuery                  types.String   `tfsdk:"os_query"`
+	ScriptColumns            types.Object   `tfsdk:"script_columns"`
+	Target                   types.String   `tfsdk:"target"`
+	TaskGroupID              types.String   `tfsdk:"task_group_id"`
+	Type                     types.String   `tfsdk:"type"`
+	VerificationCondition    types.List     `tfsdk:"verification_condition"`
+	WindowsScriptContent     types.String   `tfsdk:"windows_script_content"`
+	WindowsScriptFileId      types.String   `tfsdk:"windows_script_file_id"`
+	WindowsScriptLanguage    types.String   `tfsdk:"windows_script_language"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
 }
 
 // convertType converts the type value to the Terraform or API expected values.
@@ -480,6 +483,7 @@ func (r *itAutomationTaskResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(tasksRequiredScopes)...)
 }
 
 // Metadata returns the resource type name.
@@ -493,7 +497,7 @@ func (r *itAutomationTaskResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *itAutomationTaskResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -742,9 +746,21 @@ func (r *itAutomationTaskResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *itAutomationTaskResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // ModifyPlan validates configuration when task is in a task group.
 func (r *itAutomationTaskResource) ModifyPlan(
 	ctx context.Context,
@@ -811,6 +827,9 @@ func (r *itAutomationTaskResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	// convert type value for api compatibility.
 	apiType := convertType(plan.Type.ValueString(), "api")
 
@@ -949,6 +968,7 @@ func (r *itAutomationTaskResource) Create(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, *apiResponse.Payload.Resources[0])...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -963,6 +983,9 @@ func (r *itAutomationTaskResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	taskID := state.ID.ValueString()
 	task, diags := getItAutomationTask(ctx, r.client, taskID)
 	if diags.HasError() {
@@ -985,6 +1008,7 @@ func (r *itAutomationTaskResource) Read(
 
 	resp.Diagnostics.Append(state.wrap(ctx, task)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -999,6 +1023,9 @@ func (r *itAutomationTaskResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var state itAutomationTaskResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -1043,6 +1070,7 @@ func (r *itAutomationTaskResource) Update(
 	plan.LastUpdated = utils.GenerateUpdateTimestamp()
 	resp.Diagnostics.Append(plan.wrap(ctx, updatedTask)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -1057,6 +1085,9 @@ func (r *itAutomationTaskResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	params := it_automation.ITAutomationDeleteTaskParams{
 		Context: ctx,
 		Ids:     []string{state.ID.ValueString()},
@@ -1097,7 +1128,7 @@ func (r *itAutomationTaskResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // ConfigValidators provides declarative validation for the resource configuration.
@@ -0,0 +1,170 @@
+// Package listresource implements list resources enumerating the objects of a
+// resource matching an optional FQL filter, so that they can be imported with
+// `terraform query`.
+package listresource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Page is the page of objects returned by a query of the API.
+type Page[T any] struct {
+	Resources []T
+	Meta      *models.MsaMetaInfo
+	Errors    []*models.MsaAPIError
+}
+
+// Query fetches the page of objects matching filter starting at offset, with
+// utils.ListPageSize objects per page. It returns a nil page when the API
+// returns an empty response.
+type Query[T any] func(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	filter *string,
+	offset int64,
+) (*Page[T], error)
+
+// Options describe the objects listed by a list resource.
+type Options[T any, M any] struct {
+	// TypeName is the type name of the resource without the provider prefix, such as `_host_group`.
+	TypeName string
+	// Objects is the plural name of the objects, such as `host groups`.
+	Objects string
+	// Filter is an FQL filter selecting the objects managed by the resource,
+	// such as excluding default policies, combined with the configured filter.
+	Filter string
+	// Scopes are the API scopes required to query the objects.
+	Scopes []scopes.Scope
+	// Query fetches the pages of objects.
+	Query Query[T]
+	// ID returns the ID of an object.
+	ID func(T) *string
+	// Name returns the display name of an object. The ID is displayed when it is nil.
+	Name func(T) *string
+	// Wrap fills in the resource model of an object.
+	Wrap func(context.Context, *M, T) diag.Diagnostics
+}
+
+// New returns a list resource for the objects described by opts.
+func New[T any, M any](opts Options[T, M]) list.ListResource {
+	return &listResource[T, M]{opts: opts}
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &listResource[any, any]{}
+	_ list.ListResourceWithConfigure = &listResource[any, any]{}
+)
+
+// listResource is the list resource implementation.
+type listResource[T any, M any] struct {
+	client *client.CrowdStrikeAPISpecification
+	opts   Options[T, M]
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *listResource[T, M]) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(r.opts.Scopes)...)
+}
+
+// Metadata returns the list resource type name.
+func (r *listResource[T, M]) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + r.opts.TypeName
+}
+
+// ListResourceConfigSchema defines the schema for the list resource.
+func (r *listResource[T, M]) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = utils.ListFilterSchema(r.opts.Objects)
+}
+
+// List streams the objects matching the configured filter.
+func (r *listResource[T, M]) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config utils.ListFilterModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := utils.ListFilter(r.opts.Filter, config)
+
+	fetch := func(ctx context.Context, offset int64) ([]T, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		page, err := r.opts.Query(ctx, r.client, filter, offset)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, r.opts.Scopes))
+			return nil, 0, diags
+		}
+
+		if page == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, page.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
+		}
+
+		return page.Resources, utils.ListTotal(page.Meta), diags
+	}
+
+	stream.Results = utils.ListResults(ctx, req, fetch, func(object T) list.ListResult {
+		id := types.StringPointerValue(r.opts.ID(object))
+
+		displayName := id.ValueString()
+		if name := r.opts.Name(object); name != nil {
+			displayName = *name
+		}
+
+		return utils.NewListResult(ctx, req, id, displayName, func(m *M) diag.Diagnostics {
+			return r.opts.Wrap(ctx, m, object)
+		})
+	})
+}
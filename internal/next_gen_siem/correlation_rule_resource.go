@@ -0,0 +1,807 @@
+package nextgensiem
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/correlation_rules"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &correlationRuleResource{}
+	_ resource.ResourceWithConfigure   = &correlationRuleResource{}
+	_ resource.ResourceWithImportState = &correlationRuleResource{}
+	_ resource.ResourceWithIdentity    = &correlationRuleResource{}
+)
+
+const (
+	correlationRuleStatusActive   = "active"
+	correlationRuleStatusInactive = "inactive"
+)
+
+func NewCorrelationRuleResource() resource.Resource {
+	return &correlationRuleResource{}
+}
+
+type correlationRuleResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type correlationRuleResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	Severity      types.Int64    `tfsdk:"severity"`
+	Status        types.String   `tfsdk:"status"`
+	Search        types.Object   `tfsdk:"search"`
+	Schedule      types.Object   `tfsdk:"schedule"`
+	Notifications types.List     `tfsdk:"notifications"`
+	CustomerID    types.String   `tfsdk:"customer_id"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type correlationRuleSearchModel struct {
+	Query         types.String `tfsdk:"query"`
+	Lookback      types.String `tfsdk:"lookback"`
+	Outcome       types.String `tfsdk:"outcome"`
+	TriggerMode   types.String `tfsdk:"trigger_mode"`
+	UseIngestTime types.Bool   `tfsdk:"use_ingest_time"`
+}
+
+func (m correlationRuleSearchModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"query":           types.StringType,
+		"lookback":        types.StringType,
+		"outcome":         types.StringType,
+		"trigger_mode":    types.StringType,
+		"use_ingest_time": types.BoolType,
+	}
+}
+
+type correlationRuleScheduleModel struct {
+	Definition types.String      `tfsdk:"definition"`
+	StartOn    timetypes.RFC3339 `tfsdk:"start_on"`
+	StopOn     timetypes.RFC3339 `tfsdk:"stop_on"`
+}
+
+func (m correlationRuleScheduleModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"definition": types.StringType,
+		"start_on":   timetypes.RFC3339Type{},
+		"stop_on":    timetypes.RFC3339Type{},
+	}
+}
+
+type correlationRuleNotificationModel struct {
+	Type       types.String `tfsdk:"type"`
+	ConfigID   types.String `tfsdk:"config_id"`
+	PluginID   types.String `tfsdk:"plugin_id"`
+	Recipients types.List   `tfsdk:"recipients"`
+	Severity   types.String `tfsdk:"severity"`
+	Options    types.Map    `tfsdk:"options"`
+}
+
+func (m correlationRuleNotificationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":       types.StringType,
+		"config_id":  types.StringType,
+		"plugin_id":  types.StringType,
+		"recipients": types.ListType{ElemType: types.StringType},
+		"severity":   types.StringType,
+		"options":    types.MapType{ElemType: types.StringType},
+	}
+}
+
+func (r *correlationRuleResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(correlationRuleScopes)...)
+}
+
+func (r *correlationRuleResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_correlation_rule"
+}
+
+func (r *correlationRuleResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Next-Gen SIEM",
+			"This resource manages correlation rules (scheduled searches) in CrowdStrike Falcon Next-Gen SIEM. A correlation rule runs a query on a schedule, raises a detection or incident when the query returns results, and triggers the configured notification actions such as email, webhook, or workflow.",
+			correlationRuleScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the correlation rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the correlation rule.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description for the correlation rule.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"severity": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The severity of results generated by the rule. Valid values are `10` (informational), `30` (low), `50` (medium), `70` (high), and `90` (critical).",
+				Validators: []validator.Int64{
+					int64validator.OneOf(10, 30, 50, 70, 90),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The status of the correlation rule. Valid values are `active` and `inactive`.",
+				Default:             stringdefault.StaticString(correlationRuleStatusActive),
+				Validators: []validator.String{
+					stringvalidator.OneOf(correlationRuleStatusActive, correlationRuleStatusInactive),
+				},
+			},
+			"search": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The search executed by the correlation rule and the conditions that trigger it.",
+				Attributes: map[string]schema.Attribute{
+					"query": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The CrowdStrike Query Language (CQL) query executed by the rule. The rule triggers when the query returns results.",
+						Validators: []validator.String{
+							fwvalidators.StringNotWhitespace(),
+						},
+					},
+					"lookback": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The time window searched on each execution, for example `1h0m` or `15m`.",
+						Validators: []validator.String{
+							fwvalidators.StringNotWhitespace(),
+						},
+					},
+					"outcome": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The result generated when the rule triggers. Valid values are `detection` and `incident`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("detection", "incident"),
+						},
+					},
+					"trigger_mode": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Whether the rule generates a single result for all matching events (`summary`) or one result per matching event (`verbose`).",
+						Default:             stringdefault.StaticString("summary"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("summary", "verbose"),
+						},
+					},
+					"use_ingest_time": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Whether the lookback window is evaluated against the ingest timestamp instead of the event timestamp.",
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"schedule": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "When the correlation rule runs.",
+				Attributes: map[string]schema.Attribute{
+					"definition": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "How often the rule runs, for example `@every 1h0m`.",
+						Validators: []validator.String{
+							fwvalidators.StringNotWhitespace(),
+						},
+					},
+					"start_on": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						CustomType:          timetypes.RFC3339Type{},
+						MarkdownDescription: "The RFC3339 timestamp of the first execution. Defaults to the time the rule is created.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"stop_on": schema.StringAttribute{
+						Optional:            true,
+						CustomType:          timetypes.RFC3339Type{},
+						MarkdownDescription: "The RFC3339 timestamp after which the rule stops running.",
+					},
+				},
+			},
+			"notifications": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Actions executed when the rule triggers.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The notification type, for example `email`, `webhook`, or `workflow`.",
+							Validators: []validator.String{
+								fwvalidators.StringNotWhitespace(),
+							},
+						},
+						"config_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The ID of the notification configuration, such as a webhook integration or workflow.",
+						},
+						"plugin_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The ID of the plugin that delivers the notification.",
+						},
+						"recipients": schema.ListAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Recipients of the notification, such as email addresses.",
+						},
+						"severity": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The severity passed to the notification.",
+						},
+						"options": schema.MapAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Additional options for the notification.",
+						},
+					},
+				},
+			},
+			"customer_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The customer ID the correlation rule belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC850 timestamp of the last update to this resource by Terraform.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *correlationRuleResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
+func (r *correlationRuleResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan correlationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Creating correlation rule", map[string]any{"name": plan.Name.ValueString()})
+
+	cid, diag := getCustomerID(ctx, r.client, correlationRuleScopes)
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	var search correlationRuleSearchModel
+	var schedule correlationRuleScheduleModel
+	resp.Diagnostics.Append(plan.Search.As(ctx, &search, basetypes.ObjectAsOptions{})...)
+	resp.Diagnostics.Append(plan.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{})...)
+	notifications, diags := expandCorrelationRuleNotifications(ctx, plan.Notifications, cid)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := &models.CorrelationrulesapiCreateRuleOperationV1{
+		Schedule: &models.CorrelationrulesapiRuleScheduleV1{
+			Definition: schedule.Definition.ValueStringPointer(),
+		},
+	}
+
+	startOn := time.Now().UTC()
+	if utils.IsKnown(schedule.StartOn) {
+		t, diags := schedule.StartOn.ValueRFC3339Time()
+		resp.Diagnostics.Append(diags...)
+		startOn = t
+	}
+	operation.StartOn = (*strfmt.DateTime)(&startOn)
+
+	if utils.IsKnown(schedule.StopOn) {
+		t, diags := schedule.StopOn.ValueRFC3339Time()
+		resp.Diagnostics.Append(diags...)
+		operation.StopOn = (*strfmt.DateTime)(&t)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	severity := int32(plan.Severity.ValueInt64())
+	executionMode := "scheduled"
+
+	params := correlation_rules.NewEntitiesRulesPostV1Params()
+	params.Context = ctx
+	params.Body = &models.CorrelationrulesapiRuleCreateRequestV1{
+		CustomerID:    &cid,
+		Name:          plan.Name.ValueStringPointer(),
+		Description:   plan.Description.ValueString(),
+		Severity:      &severity,
+		Status:        plan.Status.ValueStringPointer(),
+		Notifications: notifications,
+		Operation:     operation,
+		Search: &models.CorrelationrulesapiRuleSearchV1{
+			ExecutionMode: &executionMode,
+			Filter:        search.Query.ValueStringPointer(),
+			Lookback:      search.Lookback.ValueStringPointer(),
+			Outcome:       search.Outcome.ValueStringPointer(),
+			TriggerMode:   search.TriggerMode.ValueStringPointer(),
+			UseIngestTime: search.UseIngestTime.ValueBoolPointer(),
+		},
+	}
+
+	res, err := r.client.CorrelationRules.EntitiesRulesPostV1(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Create, err, correlationRuleScopes))
+		return
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Create))
+		return
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Create, res.Payload.Errors); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.wrap(ctx, res.Payload.Resources[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *correlationRuleResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state correlationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	rule, diag := r.getCorrelationRule(ctx, state.ID.ValueString())
+	if diag != nil {
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	resp.Diagnostics.Append(state.wrap(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func (r *correlationRuleResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan correlationRuleResourceModel
+	var state correlationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Updating correlation rule", map[string]any{"id": plan.ID.ValueString()})
+
+	var search correlationRuleSearchModel
+	var schedule correlationRuleScheduleModel
+	resp.Diagnostics.Append(plan.Search.As(ctx, &search, basetypes.ObjectAsOptions{})...)
+	resp.Diagnostics.Append(plan.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{})...)
+	createNotifications, diags := expandCorrelationRuleNotifications(ctx, plan.Notifications, state.CustomerID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notifications := make([]*models.CorrelationrulesapiPatchRuleNotificationsV1, 0, len(createNotifications))
+	for _, n := range createNotifications {
+		notifications = append(notifications, &models.CorrelationrulesapiPatchRuleNotificationsV1{
+			Type:    *n.Type,
+			Options: n.Options,
+			Config: &models.CorrelationrulesapiPatchRuleNotificationConfigV1{
+				Cid:        *n.Config.Cid,
+				ConfigID:   *n.Config.ConfigID,
+				PluginID:   *n.Config.PluginID,
+				Recipients: n.Config.Recipients,
+				Severity:   *n.Config.Severity,
+			},
+		})
+	}
+
+	operation := &models.CorrelationrulesapiPatchRuleOperationV1{
+		Schedule: &models.CorrelationrulesapiRuleScheduleV1Patch{
+			Definition: schedule.Definition.ValueStringPointer(),
+		},
+	}
+
+	if utils.IsKnown(schedule.StartOn) {
+		t, diags := schedule.StartOn.ValueRFC3339Time()
+		resp.Diagnostics.Append(diags...)
+		operation.StartOn = (*strfmt.DateTime)(&t)
+	}
+
+	// An empty stop_on clears a previously configured end date.
+	stopOn := ""
+	if utils.IsKnown(schedule.StopOn) {
+		stopOn = schedule.StopOn.ValueString()
+	}
+	operation.StopOn = &stopOn
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := correlation_rules.NewEntitiesRulesPatchV1Params()
+	params.Context = ctx
+	params.Body = []*models.CorrelationrulesapiRulePatchRequestV1{
+		{
+			ID:            plan.ID.ValueStringPointer(),
+			Name:          plan.Name.ValueString(),
+			Description:   plan.Description.ValueString(),
+			Severity:      int32(plan.Severity.ValueInt64()),
+			Status:        plan.Status.ValueString(),
+			Notifications: notifications,
+			Operation:     operation,
+			Search: &models.CorrelationrulesapiPatchRuleSearchV1{
+				Filter:        search.Query.ValueString(),
+				Lookback:      search.Lookback.ValueString(),
+				Outcome:       search.Outcome.ValueString(),
+				TriggerMode:   search.TriggerMode.ValueStringPointer(),
+				UseIngestTime: search.UseIngestTime.ValueBool(),
+			},
+		},
+	}
+
+	res, err := r.client.CorrelationRules.EntitiesRulesPatchV1(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, correlationRuleScopes))
+		return
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Update))
+		return
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Update, res.Payload.Errors); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.wrap(ctx, res.Payload.Resources[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *correlationRuleResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state correlationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Deleting correlation rule", map[string]any{"id": state.ID.ValueString()})
+
+	params := correlation_rules.NewEntitiesRulesDeleteV1Params()
+	params.Context = ctx
+	params.Ids = []string{state.ID.ValueString()}
+
+	_, err := r.client.CorrelationRules.EntitiesRulesDeleteV1(params)
+	if err != nil {
+		diag := tferrors.NewDiagnosticFromAPIError(tferrors.Delete, err, correlationRuleScopes)
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			return
+		}
+		resp.Diagnostics.Append(diag)
+	}
+}
+
+func (r *correlationRuleResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *correlationRuleResource) getCorrelationRule(
+	ctx context.Context,
+	id string,
+) (*models.CorrelationrulesapiRuleV1, diag.Diagnostic) {
+	params := correlation_rules.NewEntitiesRulesGetV1Params()
+	params.Context = ctx
+	params.Ids = []string{id}
+
+	res, err := r.client.CorrelationRules.EntitiesRulesGetV1(params)
+	if err != nil {
+		return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, correlationRuleScopes)
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		return nil, tferrors.NewNotFoundError(fmt.Sprintf("Correlation rule %s not found.", id))
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		return nil, diag
+	}
+
+	return res.Payload.Resources[0], nil
+}
+
+// expandCorrelationRuleNotifications converts the configured notifications to the API model.
+func expandCorrelationRuleNotifications(
+	ctx context.Context,
+	list types.List,
+	cid string,
+) ([]*models.CorrelationrulesapiCreateRuleNotifications, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	notifications := []*models.CorrelationrulesapiCreateRuleNotifications{}
+
+	if !utils.IsKnown(list) {
+		return notifications, diags
+	}
+
+	var elements []correlationRuleNotificationModel
+	diags.Append(list.ElementsAs(ctx, &elements, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, n := range elements {
+		var recipients []string
+		if utils.IsKnown(n.Recipients) {
+			diags.Append(n.Recipients.ElementsAs(ctx, &recipients, false)...)
+		}
+
+		var options map[string]string
+		if utils.IsKnown(n.Options) {
+			diags.Append(n.Options.ElementsAs(ctx, &options, false)...)
+		}
+
+		notifications = append(notifications, &models.CorrelationrulesapiCreateRuleNotifications{
+			Type:    n.Type.ValueStringPointer(),
+			Options: options,
+			Config: &models.CorrelationrulesapiCreateRuleNotificationConfig{
+				Cid:        &cid,
+				ConfigID:   flex.FrameworkToStringPointer(n.ConfigID),
+				PluginID:   flex.FrameworkToStringPointer(n.PluginID),
+				Recipients: recipients,
+				Severity:   flex.FrameworkToStringPointer(n.Severity),
+			},
+		})
+	}
+
+	return notifications, diags
+}
+
+func (m *correlationRuleResourceModel) wrap(
+	ctx context.Context,
+	rule *models.CorrelationrulesapiRuleV1,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringPointerValue(rule.ID)
+	m.Name = types.StringPointerValue(rule.Name)
+	m.Description = flex.StringValueToFramework(rule.Description)
+	m.CustomerID = types.StringPointerValue(rule.CustomerID)
+	m.Status = types.StringPointerValue(rule.Status)
+	if rule.Severity != nil {
+		m.Severity = types.Int64Value(int64(*rule.Severity))
+	}
+
+	if rule.Search != nil {
+		search := correlationRuleSearchModel{
+			Query:         types.StringPointerValue(rule.Search.Filter),
+			Lookback:      types.StringPointerValue(rule.Search.Lookback),
+			Outcome:       types.StringPointerValue(rule.Search.Outcome),
+			TriggerMode:   types.StringPointerValue(rule.Search.TriggerMode),
+			UseIngestTime: types.BoolValue(rule.Search.UseIngestTime != nil && *rule.Search.UseIngestTime),
+		}
+		searchValue, d := types.ObjectValueFrom(ctx, search.AttributeTypes(), search)
+		diags.Append(d...)
+		m.Search = searchValue
+	}
+
+	if rule.Operation != nil {
+		var prior correlationRuleScheduleModel
+		if utils.IsKnown(m.Schedule) {
+			diags.Append(m.Schedule.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+		}
+
+		schedule := correlationRuleScheduleModel{
+			Definition: types.StringNull(),
+			StartOn:    rfc3339FromAPI(prior.StartOn, rule.Operation.StartOn),
+			StopOn:     rfc3339FromAPI(prior.StopOn, rule.Operation.StopOn),
+		}
+		if rule.Operation.Schedule != nil {
+			schedule.Definition = types.StringPointerValue(rule.Operation.Schedule.Definition)
+		}
+
+		scheduleValue, d := types.ObjectValueFrom(ctx, schedule.AttributeTypes(), schedule)
+		diags.Append(d...)
+		m.Schedule = scheduleValue
+	}
+
+	notificationValues := make([]correlationRuleNotificationModel, 0, len(rule.Notifications))
+	for _, n := range rule.Notifications {
+		if n == nil {
+			continue
+		}
+
+		notification := correlationRuleNotificationModel{
+			Type:       types.StringPointerValue(n.Type),
+			ConfigID:   types.StringNull(),
+			PluginID:   types.StringNull(),
+			Recipients: types.ListNull(types.StringType),
+			Severity:   types.StringNull(),
+			Options:    types.MapNull(types.StringType),
+		}
+
+		if n.Config != nil {
+			notification.ConfigID = flex.StringPointerToFramework(n.Config.ConfigID)
+			notification.PluginID = flex.StringPointerToFramework(n.Config.PluginID)
+			notification.Severity = flex.StringPointerToFramework(n.Config.Severity)
+			if len(n.Config.Recipients) > 0 {
+				recipients, d := types.ListValueFrom(ctx, types.StringType, n.Config.Recipients)
+				diags.Append(d...)
+				notification.Recipients = recipients
+			}
+		}
+
+		if len(n.Options) > 0 {
+			options, d := types.MapValueFrom(ctx, types.StringType, n.Options)
+			diags.Append(d...)
+			notification.Options = options
+		}
+
+		notificationValues = append(notificationValues, notification)
+	}
+
+	notificationType := types.ObjectType{AttrTypes: correlationRuleNotificationModel{}.AttributeTypes()}
+	if len(notificationValues) == 0 {
+		m.Notifications = types.ListNull(notificationType)
+	} else {
+		notifications, d := types.ListValueFrom(ctx, notificationType, notificationValues)
+		diags.Append(d...)
+		m.Notifications = notifications
+	}
+
+	return diags
+}
+
+// rfc3339FromAPI returns the API timestamp, preserving the prior value when both
+// represent the same instant so that differences in formatting do not cause drift.
+func rfc3339FromAPI(prior timetypes.RFC3339, apiValue strfmt.DateTime) timetypes.RFC3339 {
+	t := time.Time(apiValue)
+	if t.IsZero() {
+		return timetypes.NewRFC3339Null()
+	}
+
+	if utils.IsKnown(prior) {
+		if priorTime, diags := prior.ValueRFC3339Time(); !diags.HasError() && priorTime.Equal(t) {
+			return prior
+		}
+	}
+
+	return timetypes.NewRFC3339TimeValue(t)
+}
@@ -0,0 +1,105 @@
+package nextgensiem_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccCorrelationRuleResource_basic(t *testing.T) {
+	rName := acctest.RandomResourceName()
+	rNameUpdated := rName + "-updated"
+	resourceName := "crowdstrike_correlation_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCorrelationRuleConfig_basic(rName, "inactive"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("severity"), knownvalue.Int64Exact(50)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("status"), knownvalue.StringExact("inactive")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("search").AtMapKey("lookback"), knownvalue.StringExact("1h0m")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("search").AtMapKey("trigger_mode"), knownvalue.StringExact("summary")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("schedule").AtMapKey("definition"), knownvalue.StringExact("@every 1h0m")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("schedule").AtMapKey("start_on"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("notifications"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("customer_id"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccCorrelationRuleConfig_notifications(rNameUpdated),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(rNameUpdated)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("description"), knownvalue.StringExact("Correlation rule created by Terraform")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("search").AtMapKey("trigger_mode"), knownvalue.StringExact("verbose")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("notifications"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("notifications").AtSliceIndex(0).AtMapKey("type"), knownvalue.StringExact("email")),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func testAccCorrelationRuleConfig_basic(name, status string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_correlation_rule" "test" {
+  name     = %[1]q
+  severity = 50
+  status   = %[2]q
+
+  search = {
+    query    = "#event_simpleName=UserLogonFailed2"
+    lookback = "1h0m"
+    outcome  = "detection"
+  }
+
+  schedule = {
+    definition = "@every 1h0m"
+  }
+}
+`, name, status)
+}
+
+func testAccCorrelationRuleConfig_notifications(name string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_correlation_rule" "test" {
+  name        = %[1]q
+  description = "Correlation rule created by Terraform"
+  severity    = 50
+  status      = "inactive"
+
+  search = {
+    query        = "#event_simpleName=UserLogonFailed2 | groupBy([UserName])"
+    lookback     = "1h0m"
+    outcome      = "detection"
+    trigger_mode = "verbose"
+  }
+
+  schedule = {
+    definition = "@every 1h0m"
+  }
+
+  notifications = [
+    {
+      type       = "email"
+      recipients = ["soc@example.com"]
+    }
+  ]
+}
+`, name)
+}
@@ -0,0 +1,433 @@
+package nextgensiem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/ngsiem"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &dashboardResource{}
+	_ resource.ResourceWithConfigure      = &dashboardResource{}
+	_ resource.ResourceWithImportState    = &dashboardResource{}
+	_ resource.ResourceWithValidateConfig = &dashboardResource{}
+)
+
+func NewDashboardResource() resource.Resource {
+	return &dashboardResource{}
+}
+
+type dashboardResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type dashboardResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	SearchDomain types.String   `tfsdk:"search_domain"`
+	Definition   types.String   `tfsdk:"definition"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *dashboardResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+func (r *dashboardResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+func (r *dashboardResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Next-Gen SIEM",
+			"This resource manages dashboards in CrowdStrike Falcon Next-Gen SIEM from an exported dashboard definition.\n\n"+
+				"Export a dashboard from the Falcon console and pass the JSON definition to `definition`. "+
+				"Formatting, key order, and defaults added by Falcon are ignored when detecting drift, so only changes to the values you define are reported.",
+			apiScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the dashboard.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the dashboard, taken from the `name` field of `definition`.",
+			},
+			"search_domain": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The search domain (repository or view) the dashboard belongs to, for example `all`, `falcon`, or `third-party`. Changing this value forces a new resource to be created.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"definition": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The JSON encoded dashboard definition, as exported from Falcon. The definition must include a `name`. Use `file()` or `jsonencode()` to build this value.",
+				Validators: []validator.String{
+					fwvalidators.StringIsJSONObject(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC850 timestamp of the last update to this resource by Terraform.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *dashboardResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var cfg dashboardResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() || !utils.IsKnown(cfg.Definition) {
+		return
+	}
+
+	if _, err := dashboardName(cfg.Definition.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition"), "Invalid dashboard definition", err.Error())
+	}
+}
+
+func (r *dashboardResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan dashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	name, err := dashboardName(plan.Definition.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition"), "Invalid dashboard definition", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Creating dashboard", map[string]any{"name": name})
+
+	params := ngsiem.NewCreateDashboardFromTemplateParams()
+	params.Context = ctx
+	params.Name = &name
+	params.SearchDomain = plan.SearchDomain.ValueStringPointer()
+	params.YamlTemplate = newTemplateFile(plan.Definition.ValueString(), "dashboard.yaml")
+
+	res, err := r.client.Ngsiem.CreateDashboardFromTemplate(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Create, err, apiScopes))
+		return
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == "" {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Create))
+		return
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Create, res.Payload.Errors); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	plan.ID = types.StringValue(res.Payload.Resources[0])
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, diag := r.getDashboard(ctx, plan.ID.ValueString(), plan.SearchDomain.ValueString())
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	// The applied definition is stored as planned; drift is detected on refresh.
+	definition := plan.Definition
+	resp.Diagnostics.Append(plan.wrap(dashboard)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Definition = definition
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *dashboardResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state dashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	dashboard, diag := r.getDashboard(ctx, state.ID.ValueString(), state.SearchDomain.ValueString())
+	if diag != nil {
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	resp.Diagnostics.Append(state.wrap(dashboard)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dashboardResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan dashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Updating dashboard", map[string]any{"id": plan.ID.ValueString()})
+
+	params := ngsiem.NewUpdateDashboardFromTemplateParams()
+	params.Context = ctx
+	params.Ids = plan.ID.ValueStringPointer()
+	params.SearchDomain = plan.SearchDomain.ValueStringPointer()
+	params.YamlTemplate = newTemplateFile(plan.Definition.ValueString(), "dashboard.yaml")
+
+	res, err := r.client.Ngsiem.UpdateDashboardFromTemplate(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, apiScopes))
+		return
+	}
+
+	if res != nil && res.Payload != nil {
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Update, res.Payload.Errors); diag != nil {
+			resp.Diagnostics.Append(diag)
+			return
+		}
+	}
+
+	dashboard, diag := r.getDashboard(ctx, plan.ID.ValueString(), plan.SearchDomain.ValueString())
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	// The applied definition is stored as planned; drift is detected on refresh.
+	definition := plan.Definition
+	resp.Diagnostics.Append(plan.wrap(dashboard)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Definition = definition
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *dashboardResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state dashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Deleting dashboard", map[string]any{"id": state.ID.ValueString()})
+
+	params := ngsiem.NewDeleteDashboardParams()
+	params.Context = ctx
+	params.Ids = state.ID.ValueStringPointer()
+	params.SearchDomain = state.SearchDomain.ValueStringPointer()
+
+	_, err := r.client.Ngsiem.DeleteDashboard(params)
+	if err != nil {
+		diag := tferrors.NewDiagnosticFromAPIError(tferrors.Delete, err, apiScopes)
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			return
+		}
+		resp.Diagnostics.Append(diag)
+	}
+}
+
+func (r *dashboardResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	searchDomain, id, err := parseSearchDomainID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("search_domain"), searchDomain)...)
+}
+
+func (r *dashboardResource) getDashboard(
+	ctx context.Context,
+	id string,
+	searchDomain string,
+) (*models.APIDashboardTemplate, diag.Diagnostic) {
+	params := ngsiem.NewGetDashboardTemplateParams()
+	params.Context = ctx
+	params.Ids = &id
+	params.SearchDomain = &searchDomain
+
+	res, err := r.client.Ngsiem.GetDashboardTemplate(params)
+	if err != nil {
+		return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes)
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		return nil, tferrors.NewNotFoundError(fmt.Sprintf("Dashboard %s not found in search domain %s.", id, searchDomain))
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		return nil, diag
+	}
+
+	return res.Payload.Resources[0], nil
+}
+
+// dashboardName returns the name field of a dashboard definition.
+func dashboardName(definition string) (string, error) {
+	decoded, err := decodeDashboardDefinition(definition)
+	if err != nil {
+		return "", err
+	}
+
+	name, ok := decoded["name"].(string)
+	if !ok || name == "" {
+		return "", fmt.Errorf("the dashboard definition must contain a non-empty \"name\" field")
+	}
+
+	return name, nil
+}
+
+func (m *dashboardResourceModel) wrap(dashboard *models.APIDashboardTemplate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringPointerValue(dashboard.ID)
+	m.Name = types.StringPointerValue(dashboard.Name)
+
+	if dashboard.YamlTemplate == nil {
+		return diags
+	}
+
+	remote, err := decodeDashboardDefinition(*dashboard.YamlTemplate)
+	if err != nil {
+		diags.AddError("Failed to read dashboard template", err.Error())
+		return diags
+	}
+
+	if name, ok := remote["name"].(string); ok && name != "" {
+		m.Name = types.StringValue(name)
+	}
+
+	// Keep the configured definition unless a configured value differs from the
+	// dashboard in Falcon, so formatting and server defaults do not show as drift.
+	if utils.IsKnown(m.Definition) {
+		configured, err := decodeDashboardDefinition(m.Definition.ValueString())
+		if err == nil && dashboardDefinitionMatches(configured, remote) {
+			return diags
+		}
+	}
+
+	definition, err := json.MarshalIndent(remote, "", "  ")
+	if err != nil {
+		diags.AddError("Failed to read dashboard template", err.Error())
+		return diags
+	}
+	m.Definition = types.StringValue(string(definition))
+
+	return diags
+}
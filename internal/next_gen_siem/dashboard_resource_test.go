@@ -0,0 +1,115 @@
+package nextgensiem_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDashboardResource_basic(t *testing.T) {
+	rName := acctest.RandomResourceName()
+	rNameUpdated := rName + "-updated"
+	resourceName := "crowdstrike_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_encoded(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("search_domain"), knownvalue.StringExact("all")),
+				},
+			},
+			{
+				// The same definition with different formatting and key order must not produce a diff.
+				Config: testAccDashboardConfig_raw(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccDashboardConfig_encoded(rNameUpdated),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(rNameUpdated)),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccSavedSearchImportStateIDFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"last_updated", "definition"},
+			},
+		},
+	})
+}
+
+func testAccDashboardConfig_encoded(name string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_dashboard" "test" {
+  search_domain = "all"
+  definition = jsonencode({
+    name            = %[1]q
+    updateFrequency = "never"
+    timeSelector    = {}
+    sharedTimeInterval = {
+      enabled = true
+      isLive  = false
+      start   = "1d"
+    }
+    widgets = {
+      logons = {
+        x             = 0
+        y             = 0
+        height        = 4
+        width         = 6
+        title         = "Failed logons"
+        type          = "query"
+        queryString   = "#event_simpleName=UserLogonFailed2 | count()"
+        visualization = "single-value"
+        start         = "1d"
+        end           = "now"
+        isLive        = false
+      }
+    }
+  })
+}
+`, name)
+}
+
+func testAccDashboardConfig_raw(name string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_dashboard" "test" {
+  search_domain = "all"
+  definition    = <<-EOT
+    {
+      "widgets": {
+        "logons": {
+          "title": "Failed logons",
+          "type": "query",
+          "queryString": "#event_simpleName=UserLogonFailed2 | count()",
+          "visualization": "single-value",
+          "x": 0, "y": 0, "height": 4, "width": 6,
+          "start": "1d", "end": "now", "isLive": false
+        }
+      },
+      "sharedTimeInterval": { "isLive": false, "enabled": true, "start": "1d" },
+      "timeSelector": {},
+      "updateFrequency": "never",
+      "name": %[1]q
+    }
+  EOT
+}
+`, name)
+}
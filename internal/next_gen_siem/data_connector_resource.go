@@ -0,0 +1,619 @@
+package nextgensiem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/ngsiem"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dataConnectorResource{}
+	_ resource.ResourceWithConfigure   = &dataConnectorResource{}
+	_ resource.ResourceWithImportState = &dataConnectorResource{}
+	_ resource.ResourceWithIdentity    = &dataConnectorResource{}
+)
+
+func NewDataConnectorResource() resource.Resource {
+	return &dataConnectorResource{}
+}
+
+type dataConnectorResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type dataConnectorResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	Name                 types.String   `tfsdk:"name"`
+	Description          types.String   `tfsdk:"description"`
+	ConnectorID          types.String   `tfsdk:"connector_id"`
+	ConnectorType        types.String   `tfsdk:"connector_type"`
+	Parser               types.String   `tfsdk:"parser"`
+	LogSources           types.List     `tfsdk:"log_sources"`
+	EnableHostEnrichment types.Bool     `tfsdk:"enable_host_enrichment"`
+	EnableUserEnrichment types.Bool     `tfsdk:"enable_user_enrichment"`
+	Config               types.Object   `tfsdk:"config"`
+	CredentialsWO        types.String   `tfsdk:"credentials_wo"`
+	CredentialsWOVersion types.Int64    `tfsdk:"credentials_wo_version"`
+	VendorName           types.String   `tfsdk:"vendor_name"`
+	VendorProductName    types.String   `tfsdk:"vendor_product_name"`
+	Status               types.String   `tfsdk:"status"`
+	IngestURL            types.String   `tfsdk:"ingest_url"`
+	LastUpdated          types.String   `tfsdk:"last_updated"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+type dataConnectorConfigModel struct {
+	Name   types.String `tfsdk:"name"`
+	Params types.String `tfsdk:"params"`
+}
+
+func (m dataConnectorConfigModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":   types.StringType,
+		"params": types.StringType,
+	}
+}
+
+func (r *dataConnectorResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+func (r *dataConnectorResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_data_connector"
+}
+
+func (r *dataConnectorResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Next-Gen SIEM",
+			"This resource manages third-party data connections in CrowdStrike Falcon Next-Gen SIEM, such as Okta, AWS CloudTrail, or Amazon GuardDuty ingest connectors.\n\n"+
+				"Connector credentials are supplied through the write-only `credentials_wo` attribute and are never stored in the Terraform state. "+
+				"Increment `credentials_wo_version` to push rotated credentials to an existing connection. Write-only attributes require Terraform 1.11 or later.\n\n"+
+				"The API does not return the description, connector, log sources, enrichment settings or configuration of a connection, "+
+				"so they keep the values last applied by Terraform and are not set after import.",
+			apiScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the data connection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the data connection.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description for the data connection. Not read back from the API.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"connector_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the data connector from the Next-Gen SIEM connector catalog. Not read back from the API. Changing this value forces a new resource to be created.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfStateKnownString,
+						"Requires replacement when changed, unless the value was not known after import.",
+						"Requires replacement when changed, unless the value was not known after import.",
+					),
+				},
+			},
+			"connector_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The type of the data connector. Not read back from the API. Changing this value forces a new resource to be created.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfStateKnownString,
+						"Requires replacement when changed, unless the value was not known after import.",
+						"Requires replacement when changed, unless the value was not known after import.",
+					),
+				},
+			},
+			"parser": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the parser applied to events ingested through the connection.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"log_sources": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The log sources collected by the connection. Not read back from the API. Changing this value forces a new resource to be created.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfStateKnownList,
+						"Requires replacement when changed, unless the value was not known after import.",
+						"Requires replacement when changed, unless the value was not known after import.",
+					),
+				},
+			},
+			"enable_host_enrichment": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether ingested events are enriched with Falcon host data. Not read back from the API.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"enable_user_enrichment": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether ingested events are enriched with Falcon identity data. Not read back from the API.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"config": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The connector configuration, including polling settings for pull-based connectors. Not read back from the API.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The name of the connector configuration.",
+						Validators: []validator.String{
+							fwvalidators.StringNotWhitespace(),
+						},
+					},
+					"params": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "A JSON encoded object of connector parameters such as the polling interval, region, or API domain. Use `jsonencode()` to build this value.",
+						Validators: []validator.String{
+							fwvalidators.StringIsJSONObject(),
+						},
+					},
+				},
+			},
+			"credentials_wo": schema.StringAttribute{
+				Optional:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				MarkdownDescription: "A JSON encoded object of connector credentials, such as API tokens or client secrets. This value is write-only and is never stored in the Terraform state. Requires `config` to be set.",
+				Validators: []validator.String{
+					fwvalidators.StringIsJSONObject(),
+				},
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "A version number for `credentials_wo`. Change this value to send updated credentials to the connection.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"vendor_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The vendor of the data source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vendor_product_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The vendor product of the data source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The current status of the data connection.",
+			},
+			"ingest_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL that push-based sources send events to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC850 timestamp of the last update to this resource by Terraform.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *dataConnectorResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
+func (r *dataConnectorResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan dataConnectorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Creating data connection", map[string]any{"name": plan.Name.ValueString()})
+
+	var credentials types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credentials_wo"), &credentials)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectorConfig, diags := expandDataConnectorConfig(ctx, plan.Config, credentials)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var logSources []string
+	if utils.IsKnown(plan.LogSources) {
+		resp.Diagnostics.Append(plan.LogSources.ElementsAs(ctx, &logSources, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	params := ngsiem.NewExternalCreateDataConnectionParams()
+	params.Context = ctx
+	params.Body = &models.DataconnectionmanagementCreateDataConnectionRequest{
+		Name:                 plan.Name.ValueStringPointer(),
+		Description:          plan.Description.ValueString(),
+		ConnectorID:          plan.ConnectorID.ValueStringPointer(),
+		ConnectorType:        plan.ConnectorType.ValueString(),
+		Parser:               plan.Parser.ValueStringPointer(),
+		LogSources:           logSources,
+		EnableHostEnrichment: plan.EnableHostEnrichment.ValueBool(),
+		EnableUserEnrichment: plan.EnableUserEnrichment.ValueBool(),
+		Config:               connectorConfig,
+	}
+
+	res, err := r.client.Ngsiem.ExternalCreateDataConnection(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Create, err, apiScopes))
+		return
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Create))
+		return
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Create, res.Payload.Errors); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	plan.ID = types.StringPointerValue(res.Payload.Resources[0].ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, diag := r.getDataConnection(ctx, plan.ID.ValueString())
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	plan.wrap(connection)
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *dataConnectorResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state dataConnectorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	connection, diag := r.getDataConnection(ctx, state.ID.ValueString())
+	if diag != nil {
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	state.wrap(connection)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func (r *dataConnectorResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan dataConnectorResourceModel
+	var state dataConnectorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Updating data connection", map[string]any{"id": plan.ID.ValueString()})
+
+	body := &models.DataconnectionmanagementUpdateDataConnectionRequest{
+		Name:                 plan.Name.ValueString(),
+		Description:          plan.Description.ValueString(),
+		Parser:               plan.Parser.ValueString(),
+		EnableHostEnrichment: plan.EnableHostEnrichment.ValueBool(),
+		EnableUserEnrichment: plan.EnableUserEnrichment.ValueBool(),
+	}
+
+	// The connector configuration includes credentials, so it is only sent when
+	// the configuration changes or the credentials version is bumped.
+	if !plan.Config.Equal(state.Config) || !plan.CredentialsWOVersion.Equal(state.CredentialsWOVersion) {
+		var credentials types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credentials_wo"), &credentials)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		connectorConfig, diags := expandDataConnectorConfig(ctx, plan.Config, credentials)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		body.Config = connectorConfig
+	}
+
+	params := ngsiem.NewExternalUpdateDataConnectionParams()
+	params.Context = ctx
+	params.Ids = plan.ID.ValueString()
+	params.Body = body
+
+	res, err := r.client.Ngsiem.ExternalUpdateDataConnection(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, apiScopes))
+		return
+	}
+
+	if res != nil && res.Payload != nil {
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Update, res.Payload.Errors); diag != nil {
+			resp.Diagnostics.Append(diag)
+			return
+		}
+	}
+
+	connection, diag := r.getDataConnection(ctx, plan.ID.ValueString())
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	plan.wrap(connection)
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *dataConnectorResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state dataConnectorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Deleting data connection", map[string]any{"id": state.ID.ValueString()})
+
+	params := ngsiem.NewExternalDeleteDataConnectionParams()
+	params.Context = ctx
+	params.Ids = state.ID.ValueString()
+
+	_, err := r.client.Ngsiem.ExternalDeleteDataConnection(params)
+	if err != nil {
+		diag := tferrors.NewDiagnosticFromAPIError(tferrors.Delete, err, apiScopes)
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			return
+		}
+		resp.Diagnostics.Append(diag)
+	}
+}
+
+func (r *dataConnectorResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *dataConnectorResource) getDataConnection(
+	ctx context.Context,
+	id string,
+) (*models.DataconnectionmanagementDataConnection, diag.Diagnostic) {
+	params := ngsiem.NewExternalGetDataConnectionByIDParams()
+	params.Context = ctx
+	params.Ids = []string{id}
+
+	res, err := r.client.Ngsiem.ExternalGetDataConnectionByID(params)
+	if err != nil {
+		return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes)
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		return nil, tferrors.NewNotFoundError(fmt.Sprintf("Data connection %s not found.", id))
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		return nil, diag
+	}
+
+	return res.Payload.Resources[0], nil
+}
+
+// requiresReplaceIfStateKnownString skips replacement when the prior value is
+// null, which happens after import because the API does not return it.
+func requiresReplaceIfStateKnownString(
+	_ context.Context,
+	req planmodifier.StringRequest,
+	resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// requiresReplaceIfStateKnownList is the list counterpart of requiresReplaceIfStateKnownString.
+func requiresReplaceIfStateKnownList(
+	_ context.Context,
+	req planmodifier.ListRequest,
+	resp *listplanmodifier.RequiresReplaceIfFuncResponse,
+) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// expandDataConnectorConfig builds the connector configuration from the planned
+// config object and the write-only credentials.
+func expandDataConnectorConfig(
+	ctx context.Context,
+	configObject types.Object,
+	credentials types.String,
+) (*models.DataconnectionmanagementConnectorConfigRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(configObject) {
+		if utils.IsKnown(credentials) {
+			diags.AddAttributeError(
+				path.Root("credentials_wo"),
+				"Missing connector configuration",
+				"The config attribute must be set when credentials_wo is provided.",
+			)
+		}
+		return nil, diags
+	}
+
+	var cfg dataConnectorConfigModel
+	diags.Append(configObject.As(ctx, &cfg, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	connectorConfig := &models.DataconnectionmanagementConnectorConfigRequest{
+		Name:   cfg.Name.ValueStringPointer(),
+		Params: map[string]any{},
+		Auth:   map[string]any{},
+	}
+
+	if utils.IsKnown(cfg.Params) {
+		if err := json.Unmarshal([]byte(cfg.Params.ValueString()), &connectorConfig.Params); err != nil {
+			diags.AddAttributeError(path.Root("config").AtName("params"), "Invalid connector parameters", err.Error())
+		}
+	}
+
+	if utils.IsKnown(credentials) {
+		if err := json.Unmarshal([]byte(credentials.ValueString()), &connectorConfig.Auth); err != nil {
+			diags.AddAttributeError(path.Root("credentials_wo"), "Invalid connector credentials", err.Error())
+		}
+	}
+
+	return connectorConfig, diags
+}
+
+// wrap sets the attributes returned by the API. The description, connector,
+// log sources, enrichment settings and configuration are not returned, so they
+// keep their planned or prior state values.
+func (m *dataConnectorResourceModel) wrap(connection *models.DataconnectionmanagementDataConnection) {
+	m.ID = types.StringPointerValue(connection.ID)
+	m.Name = types.StringPointerValue(connection.Name)
+	m.Parser = types.StringPointerValue(connection.ParserName)
+	m.VendorName = flex.StringPointerToFramework(connection.VendorName)
+	m.VendorProductName = flex.StringPointerToFramework(connection.VendorProductName)
+	m.Status = flex.StringPointerToFramework(connection.Status)
+	m.IngestURL = flex.StringValueToFramework(connection.IngestURL)
+}
@@ -0,0 +1,83 @@
+package nextgensiem_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDataConnectorResource_basic(t *testing.T) {
+	connectorID := os.Getenv("NGSIEM_DATA_CONNECTOR_ID")
+	if connectorID == "" {
+		t.Skip("NGSIEM_DATA_CONNECTOR_ID must be set for data connector acceptance tests")
+	}
+
+	rName := acctest.RandomResourceName()
+	rNameUpdated := rName + "-updated"
+	resourceName := "crowdstrike_data_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataConnectorConfig(rName, connectorID, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("connector_id"), knownvalue.StringExact(connectorID)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("enable_host_enrichment"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("status"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccDataConnectorConfig(rNameUpdated, connectorID, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(rNameUpdated)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("enable_host_enrichment"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				// Read keeps the values the API does not return.
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Data connector created by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "connector_id", connectorID),
+					resource.TestCheckResourceAttr(resourceName, "enable_host_enrichment", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enable_user_enrichment", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API does not return these values, so they are not set after import.
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+					"description",
+					"connector_id",
+					"enable_host_enrichment",
+					"enable_user_enrichment",
+				},
+			},
+		},
+	})
+}
+
+func testAccDataConnectorConfig(name, connectorID string, hostEnrichment bool) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_data_connector" "test" {
+  name                   = %[1]q
+  description            = "Data connector created by Terraform"
+  connector_id           = %[2]q
+  parser                 = %[2]q
+  enable_host_enrichment = %[3]t
+}
+`, name, connectorID, hostEnrichment)
+}
@@ -0,0 +1,718 @@
+package nextgensiem
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/ngsiem"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &parserResource{}
+	_ resource.ResourceWithConfigure   = &parserResource{}
+	_ resource.ResourceWithImportState = &parserResource{}
+	_ resource.ResourceWithModifyPlan  = &parserResource{}
+)
+
+func NewParserResource() resource.Resource {
+	return &parserResource{}
+}
+
+type parserResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type parserResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Repository        types.String   `tfsdk:"repository"`
+	Script            types.String   `tfsdk:"script"`
+	FieldsToTag       types.List     `tfsdk:"fields_to_tag"`
+	FieldsToRemove    types.List     `tfsdk:"fields_to_remove"`
+	TestCases         types.List     `tfsdk:"test_cases"`
+	ValidateTestCases types.Bool     `tfsdk:"validate_test_cases"`
+	LastUpdated       types.String   `tfsdk:"last_updated"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+type parserTestCaseModel struct {
+	Event      types.String `tfsdk:"event"`
+	Assertions types.List   `tfsdk:"assertions"`
+}
+
+func (m parserTestCaseModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"event":      types.StringType,
+		"assertions": types.ListType{ElemType: types.ObjectType{AttrTypes: parserTestAssertionModel{}.AttributeTypes()}},
+	}
+}
+
+type parserTestAssertionModel struct {
+	OutputEventIndex types.Int64 `tfsdk:"output_event_index"`
+	ExpectedFields   types.Map   `tfsdk:"expected_fields"`
+	AbsentFields     types.List  `tfsdk:"absent_fields"`
+}
+
+func (m parserTestAssertionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"output_event_index": types.Int64Type,
+		"expected_fields":    types.MapType{ElemType: types.StringType},
+		"absent_fields":      types.ListType{ElemType: types.StringType},
+	}
+}
+
+func (r *parserResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+func (r *parserResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_parser"
+}
+
+func (r *parserResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Next-Gen SIEM",
+			"This resource manages custom parsers in CrowdStrike Falcon Next-Gen SIEM.\n\n"+
+				"Parsers can include test cases made of sample events and the fields expected after parsing. "+
+				"When `validate_test_cases` is enabled, the provider runs every test case against the planned `script` during `terraform plan` and fails the plan if any expectation is not met, so extraction regressions are caught before they are applied. "+
+				"Test cases are also stored with the parser and are visible in the Falcon console.",
+			apiScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the parser.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the parser. Changing this value forces a new resource to be created.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The repository the parser belongs to, for example `parsers-repository`. Changing this value forces a new resource to be created.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"script": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The CrowdStrike Query Language (CQL) script used to parse incoming events.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"fields_to_tag": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The fields to use as tags on parsed events.",
+			},
+			"fields_to_remove": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The fields to remove from events before parsing.",
+			},
+			"test_cases": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Sample events and the expected parser output.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"event": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The raw sample event.",
+							Validators: []validator.String{
+								fwvalidators.StringNotWhitespace(),
+							},
+						},
+						"assertions": schema.ListNestedAttribute{
+							Optional:            true,
+							MarkdownDescription: "The expectations for the events produced by parsing the sample event.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"output_event_index": schema.Int64Attribute{
+										Optional:            true,
+										Computed:            true,
+										Default:             int64default.StaticInt64(0),
+										MarkdownDescription: "The index of the parsed output event the assertion applies to. Defaults to the first output event.",
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+									"expected_fields": schema.MapAttribute{
+										Optional:            true,
+										ElementType:         types.StringType,
+										MarkdownDescription: "Fields that must be present in the output event with the given values.",
+									},
+									"absent_fields": schema.ListAttribute{
+										Optional:            true,
+										ElementType:         types.StringType,
+										MarkdownDescription: "Fields that must not be present in the output event.",
+									},
+								},
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"validate_test_cases": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Run `test_cases` against `script` at plan time and fail the plan when an expectation is not met. Test cases are only run when the script or test cases change.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC850 timestamp of the last update to this resource by Terraform.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// ModifyPlan runs the parser test cases against the planned script.
+func (r *parserResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan parserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ValidateTestCases.ValueBool() || !utils.IsKnown(plan.Script) || !utils.IsKnown(plan.TestCases) {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state parserResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Script.Equal(state.Script) && plan.TestCases.Equal(state.TestCases) {
+			return
+		}
+	}
+
+	testCases, diags := expandParserTestCases(ctx, plan.TestCases)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || testCases == nil {
+		return
+	}
+
+	for i, tc := range testCases {
+		tflog.Debug(ctx, "Running parser test case", map[string]any{"index": i})
+
+		events, diag := runParserScript(ctx, r.client, plan.Script.ValueString(), tc.Event)
+		if diag != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("test_cases").AtListIndex(i),
+				diag.Summary(),
+				diag.Detail(),
+			)
+			continue
+		}
+
+		if failures := evaluateParserTestCase(tc, events); len(failures) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("test_cases").AtListIndex(i),
+				"Parser test case failed",
+				fmt.Sprintf(
+					"Test case %d did not produce the expected output:\n\n- %s\n\nSet validate_test_cases to false to skip plan-time testing.",
+					i,
+					strings.Join(failures, "\n- "),
+				),
+			)
+		}
+	}
+}
+
+func (r *parserResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan parserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Creating parser", map[string]any{"name": plan.Name.ValueString()})
+
+	testCases, diags := plan.expandTestCases(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := ngsiem.NewCreateParserParams()
+	params.Context = ctx
+	params.Body = &models.APICreateParserRequestV1{
+		Name:                           plan.Name.ValueStringPointer(),
+		Repository:                     plan.Repository.ValueStringPointer(),
+		Script:                         plan.Script.ValueStringPointer(),
+		FieldsToTag:                    flex.ExpandListAs[string](ctx, plan.FieldsToTag, &resp.Diagnostics),
+		FieldsToBeRemovedBeforeParsing: flex.ExpandListAs[string](ctx, plan.FieldsToRemove, &resp.Diagnostics),
+		TestCases:                      testCases,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.Ngsiem.CreateParser(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Create, err, apiScopes))
+		return
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == "" {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Create))
+		return
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Create, res.Payload.Errors); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	plan.ID = types.StringValue(res.Payload.Resources[0])
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parser, diag := r.getParser(ctx, plan.ID.ValueString(), plan.Repository.ValueString())
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.wrap(ctx, parser)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *parserResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state parserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	parser, diag := r.getParser(ctx, state.ID.ValueString(), state.Repository.ValueString())
+	if diag != nil {
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	resp.Diagnostics.Append(state.wrap(ctx, parser)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ValidateTestCases.IsNull() {
+		state.ValidateTestCases = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *parserResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan parserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Updating parser", map[string]any{"id": plan.ID.ValueString()})
+
+	testCases, diags := plan.expandTestCases(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := ngsiem.NewUpdateParserParams()
+	params.Context = ctx
+	params.Body = &models.APIUpdateParserRequestV1{
+		ID:                             plan.ID.ValueStringPointer(),
+		Repository:                     plan.Repository.ValueStringPointer(),
+		Script:                         plan.Script.ValueStringPointer(),
+		FieldsToTag:                    flex.ExpandListAs[string](ctx, plan.FieldsToTag, &resp.Diagnostics),
+		FieldsToBeRemovedBeforeParsing: flex.ExpandListAs[string](ctx, plan.FieldsToRemove, &resp.Diagnostics),
+		TestCases:                      testCases,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.Ngsiem.UpdateParser(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, apiScopes))
+		return
+	}
+
+	if res != nil && res.Payload != nil {
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Update, res.Payload.Errors); diag != nil {
+			resp.Diagnostics.Append(diag)
+			return
+		}
+	}
+
+	parser, diag := r.getParser(ctx, plan.ID.ValueString(), plan.Repository.ValueString())
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.wrap(ctx, parser)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *parserResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state parserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Deleting parser", map[string]any{"id": state.ID.ValueString()})
+
+	params := ngsiem.NewDeleteParserParams()
+	params.Context = ctx
+	params.Ids = state.ID.ValueStringPointer()
+	params.Repository = state.Repository.ValueStringPointer()
+
+	_, err := r.client.Ngsiem.DeleteParser(params)
+	if err != nil {
+		diag := tferrors.NewDiagnosticFromAPIError(tferrors.Delete, err, apiScopes)
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			return
+		}
+		resp.Diagnostics.Append(diag)
+	}
+}
+
+func (r *parserResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	repository, id, err := parseSearchDomainID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repository)...)
+}
+
+func (r *parserResource) getParser(
+	ctx context.Context,
+	id string,
+	repository string,
+) (*models.APIParser, diag.Diagnostic) {
+	params := ngsiem.NewGetParserParams()
+	params.Context = ctx
+	params.Ids = &id
+	params.Repository = &repository
+
+	res, err := r.client.Ngsiem.GetParser(params)
+	if err != nil {
+		return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes)
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		return nil, tferrors.NewNotFoundError(fmt.Sprintf("Parser %s not found in repository %s.", id, repository))
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		return nil, diag
+	}
+
+	return res.Payload.Resources[0], nil
+}
+
+// expandParserTestCases converts the test_cases attribute into parser test cases.
+func expandParserTestCases(ctx context.Context, list types.List) ([]parserTestCase, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	testCaseModels := flex.ExpandListAs[parserTestCaseModel](ctx, list, &diags)
+	if diags.HasError() || len(testCaseModels) == 0 {
+		return nil, diags
+	}
+
+	testCases := make([]parserTestCase, 0, len(testCaseModels))
+	for _, tcm := range testCaseModels {
+		tc := parserTestCase{Event: tcm.Event.ValueString()}
+
+		for _, am := range flex.ExpandListAs[parserTestAssertionModel](ctx, tcm.Assertions, &diags) {
+			assertion := parserTestAssertion{
+				OutputEventIndex: int(am.OutputEventIndex.ValueInt64()),
+				ExpectedFields:   map[string]string{},
+				AbsentFields:     flex.ExpandListAs[string](ctx, am.AbsentFields, &diags),
+			}
+			if utils.IsKnown(am.ExpectedFields) {
+				diags.Append(am.ExpectedFields.ElementsAs(ctx, &assertion.ExpectedFields, false)...)
+			}
+			tc.Assertions = append(tc.Assertions, assertion)
+		}
+
+		testCases = append(testCases, tc)
+	}
+
+	return testCases, diags
+}
+
+// expandTestCases converts the planned test cases into the API representation.
+func (m *parserResourceModel) expandTestCases(ctx context.Context) ([]*models.APIParserTestCase, diag.Diagnostics) {
+	testCases, diags := expandParserTestCases(ctx, m.TestCases)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiTestCases := make([]*models.APIParserTestCase, 0, len(testCases))
+	for _, tc := range testCases {
+		apiTestCase := &models.APIParserTestCase{
+			Event:            &models.APIParserTestEvent{RawString: &tc.Event},
+			OutputAssertions: []*models.APIParserTestCaseAssertionsForOutput{},
+		}
+
+		for _, assertion := range tc.Assertions {
+			index := int64(assertion.OutputEventIndex)
+			output := &models.APIParserTestCaseOutputAssertions{
+				FieldsHaveValues: []*models.APIFieldHasValue{},
+				FieldsNotPresent: assertion.AbsentFields,
+			}
+
+			for field, value := range assertion.ExpectedFields {
+				output.FieldsHaveValues = append(output.FieldsHaveValues, &models.APIFieldHasValue{
+					FieldName:     &field,
+					ExpectedValue: &value,
+				})
+			}
+
+			apiTestCase.OutputAssertions = append(apiTestCase.OutputAssertions, &models.APIParserTestCaseAssertionsForOutput{
+				OutputEventIndex: &index,
+				Assertions:       output,
+			})
+		}
+
+		apiTestCases = append(apiTestCases, apiTestCase)
+	}
+
+	return apiTestCases, diags
+}
+
+func (m *parserResourceModel) wrap(ctx context.Context, parser *models.APIParser) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringPointerValue(parser.ID)
+	m.Name = types.StringPointerValue(parser.Name)
+
+	// The API may reformat trailing whitespace in the script; keep the
+	// configured value when it is otherwise identical.
+	if parser.Script != nil && strings.TrimSpace(m.Script.ValueString()) != strings.TrimSpace(*parser.Script) {
+		m.Script = types.StringValue(*parser.Script)
+	}
+
+	var d diag.Diagnostics
+	m.FieldsToTag, d = flex.FlattenStringValueList(ctx, parser.FieldsToTag)
+	diags.Append(d...)
+	m.FieldsToRemove, d = flex.FlattenStringValueList(ctx, parser.FieldsToBeRemovedBeforeParsing)
+	diags.Append(d...)
+
+	m.TestCases, d = flattenParserTestCases(ctx, parser.TestCases)
+	diags.Append(d...)
+
+	return diags
+}
+
+// flattenParserTestCases converts API test cases into the test_cases attribute.
+func flattenParserTestCases(ctx context.Context, apiTestCases []*models.APIParserTestCase) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	objectType := types.ObjectType{AttrTypes: parserTestCaseModel{}.AttributeTypes()}
+	assertionType := types.ObjectType{AttrTypes: parserTestAssertionModel{}.AttributeTypes()}
+
+	if len(apiTestCases) == 0 {
+		return types.ListNull(objectType), diags
+	}
+
+	testCases := make([]parserTestCaseModel, 0, len(apiTestCases))
+	for _, apiTestCase := range apiTestCases {
+		if apiTestCase == nil || apiTestCase.Event == nil {
+			continue
+		}
+
+		tc := parserTestCaseModel{
+			Event:      types.StringPointerValue(apiTestCase.Event.RawString),
+			Assertions: types.ListNull(assertionType),
+		}
+
+		assertions := make([]parserTestAssertionModel, 0, len(apiTestCase.OutputAssertions))
+		for _, output := range apiTestCase.OutputAssertions {
+			if output == nil {
+				continue
+			}
+
+			am := parserTestAssertionModel{
+				OutputEventIndex: types.Int64PointerValue(output.OutputEventIndex),
+				ExpectedFields:   types.MapNull(types.StringType),
+				AbsentFields:     types.ListNull(types.StringType),
+			}
+			if am.OutputEventIndex.IsNull() {
+				am.OutputEventIndex = types.Int64Value(0)
+			}
+
+			if output.Assertions != nil {
+				expected := map[string]string{}
+				for _, f := range output.Assertions.FieldsHaveValues {
+					if f != nil && f.FieldName != nil && f.ExpectedValue != nil {
+						expected[*f.FieldName] = *f.ExpectedValue
+					}
+				}
+				if len(expected) > 0 {
+					var d diag.Diagnostics
+					am.ExpectedFields, d = types.MapValueFrom(ctx, types.StringType, expected)
+					diags.Append(d...)
+				}
+
+				var d diag.Diagnostics
+				am.AbsentFields, d = flex.FlattenStringValueList(ctx, output.Assertions.FieldsNotPresent)
+				diags.Append(d...)
+			}
+
+			assertions = append(assertions, am)
+		}
+
+		if len(assertions) > 0 {
+			var d diag.Diagnostics
+			tc.Assertions, d = types.ListValueFrom(ctx, assertionType, assertions)
+			diags.Append(d...)
+		}
+
+		testCases = append(testCases, tc)
+	}
+
+	list, d := types.ListValueFrom(ctx, objectType, testCases)
+	diags.Append(d...)
+	return list, diags
+}
@@ -0,0 +1,89 @@
+package nextgensiem_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccParserResource_basic(t *testing.T) {
+	rName := acctest.RandomResourceName()
+	resourceName := "crowdstrike_parser.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParserConfig(rName, "parseJson()", "alice"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("repository"), knownvalue.StringExact("parsers-repository")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("test_cases"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("validate_test_cases"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				// A script that no longer extracts the user must fail at plan time.
+				Config:      testAccParserConfig(rName, "parseJson(exclude=\"user\")", "alice"),
+				ExpectError: regexp.MustCompile(`Parser test case failed`),
+			},
+			{
+				Config: testAccParserConfig(rName, "parseJson()\n| event.module := \"terraform\"", "alice"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("script"), knownvalue.StringExact("parseJson()\n| event.module := \"terraform\"")),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccParserImportStateIDFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func testAccParserImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["repository"], rs.Primary.ID), nil
+	}
+}
+
+func testAccParserConfig(name, script, user string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_parser" "test" {
+  name       = %[1]q
+  repository = "parsers-repository"
+  script     = %[2]q
+
+  test_cases = [
+    {
+      event = jsonencode({ user = %[3]q, action = "login" })
+      assertions = [
+        {
+          expected_fields = {
+            user   = %[3]q
+            action = "login"
+          }
+          absent_fields = ["password"]
+        }
+      ]
+    }
+  ]
+}
+`, name, script, user)
+}
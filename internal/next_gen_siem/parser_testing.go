@@ -0,0 +1,115 @@
+package nextgensiem
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// parserTestRepository is the search domain used to run parser test queries.
+// createEvents() does not read stored events, so any readable domain works.
+const parserTestRepository = "search-all"
+
+// parserTestAssertion is a set of expectations for a single output event.
+type parserTestAssertion struct {
+	OutputEventIndex int
+	ExpectedFields   map[string]string
+	AbsentFields     []string
+}
+
+// parserTestCase is a raw sample event and the expected parser output.
+type parserTestCase struct {
+	Event      string
+	Assertions []parserTestAssertion
+}
+
+// cqlString quotes s as a CrowdStrike Query Language string literal.
+func cqlString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// parserTestQuery builds a query that runs script against the raw event.
+func parserTestQuery(script string, event string) string {
+	return fmt.Sprintf("createEvents([%s])\n| %s", cqlString(event), script)
+}
+
+// evaluateParserTestCase compares the parser output events against the test case
+// assertions and returns a description of every failed expectation.
+func evaluateParserTestCase(tc parserTestCase, events []map[string]any) []string {
+	var failures []string
+
+	for _, assertion := range tc.Assertions {
+		if assertion.OutputEventIndex < 0 || assertion.OutputEventIndex >= len(events) {
+			failures = append(failures, fmt.Sprintf(
+				"output event %d was not produced (parser returned %d events)",
+				assertion.OutputEventIndex,
+				len(events),
+			))
+			continue
+		}
+
+		event := events[assertion.OutputEventIndex]
+
+		fields := make([]string, 0, len(assertion.ExpectedFields))
+		for field := range assertion.ExpectedFields {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			expected := assertion.ExpectedFields[field]
+			actual, ok := event[field]
+			if !ok {
+				failures = append(failures, fmt.Sprintf(
+					"output event %d: field %q is missing, expected %q",
+					assertion.OutputEventIndex,
+					field,
+					expected,
+				))
+				continue
+			}
+			if got := fmt.Sprint(actual); got != expected {
+				failures = append(failures, fmt.Sprintf(
+					"output event %d: field %q is %q, expected %q",
+					assertion.OutputEventIndex,
+					field,
+					got,
+					expected,
+				))
+			}
+		}
+
+		for _, field := range assertion.AbsentFields {
+			if actual, ok := event[field]; ok {
+				failures = append(failures, fmt.Sprintf(
+					"output event %d: field %q should not be present, got %q",
+					assertion.OutputEventIndex,
+					field,
+					fmt.Sprint(actual),
+				))
+			}
+		}
+	}
+
+	return failures
+}
+
+// runParserScript runs script against a raw event using an NG-SIEM search and
+// returns the parsed output events.
+func runParserScript(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	script string,
+	event string,
+) ([]map[string]any, diag.Diagnostic) {
+	events, searchDiag := runSearch(ctx, client, parserTestRepository, parserTestQuery(script, event), "1m")
+	if searchDiag != nil && searchDiag.Summary() == searchFailedSummary {
+		return nil, diag.NewErrorDiagnostic("Parser script failed", searchDiag.Detail())
+	}
+	return events, searchDiag
+}
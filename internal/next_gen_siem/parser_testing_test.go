@@ -0,0 +1,88 @@
+package nextgensiem
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParserTestQuery(t *testing.T) {
+	got := parserTestQuery(`parseJson()`, `{"user":"a\"b","path":"C:\temp"}`)
+	want := "createEvents([\"{\\\"user\\\":\\\"a\\\\\\\"b\\\",\\\"path\\\":\\\"C:\\\\temp\\\"}\"])\n| parseJson()"
+	if got != want {
+		t.Errorf("parserTestQuery() = %q, want %q", got, want)
+	}
+}
+
+func TestEvaluateParserTestCase(t *testing.T) {
+	events := []map[string]any{
+		{"user.name": "alice", "event.outcome": "failure", "source.port": float64(22)},
+		{"user.name": "bob"},
+	}
+
+	tests := []struct {
+		name     string
+		tc       parserTestCase
+		failures int
+	}{
+		{
+			name: "all assertions pass",
+			tc: parserTestCase{Assertions: []parserTestAssertion{
+				{
+					ExpectedFields: map[string]string{"user.name": "alice", "source.port": "22"},
+					AbsentFields:   []string{"user.email"},
+				},
+				{OutputEventIndex: 1, ExpectedFields: map[string]string{"user.name": "bob"}},
+			}},
+		},
+		{
+			name: "wrong value",
+			tc: parserTestCase{Assertions: []parserTestAssertion{
+				{ExpectedFields: map[string]string{"event.outcome": "success"}},
+			}},
+			failures: 1,
+		},
+		{
+			name: "missing and unexpected fields",
+			tc: parserTestCase{Assertions: []parserTestAssertion{
+				{ExpectedFields: map[string]string{"user.email": "a@example.com"}, AbsentFields: []string{"user.name"}},
+			}},
+			failures: 2,
+		},
+		{
+			name: "output event not produced",
+			tc: parserTestCase{Assertions: []parserTestAssertion{
+				{OutputEventIndex: 2},
+			}},
+			failures: 1,
+		},
+		{
+			name: "no assertions",
+			tc:   parserTestCase{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := evaluateParserTestCase(tt.tc, events)
+			if len(got) != tt.failures {
+				t.Errorf("evaluateParserTestCase() returned %d failures %v, want %d", len(got), got, tt.failures)
+			}
+		})
+	}
+}
+
+func TestEvaluateParserTestCaseOrdersFailures(t *testing.T) {
+	tc := parserTestCase{Assertions: []parserTestAssertion{
+		{ExpectedFields: map[string]string{"b": "1", "a": "1", "c": "1"}},
+	}}
+
+	got := evaluateParserTestCase(tc, []map[string]any{{}})
+	want := []string{
+		`output event 0: field "a" is missing, expected "1"`,
+		`output event 0: field "b" is missing, expected "1"`,
+		`output event 0: field "c" is missing, expected "1"`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("evaluateParserTestCase() = %v, want %v", got, want)
+	}
+}
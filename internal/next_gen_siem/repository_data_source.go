@@ -0,0 +1,317 @@
+package nextgensiem
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/foundry_logscale"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultUsageWarningThreshold is the percentage of a limit at which usage warnings are raised.
+const defaultUsageWarningThreshold = 80
+
+var (
+	_ datasource.DataSource              = &repositoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &repositoryDataSource{}
+)
+
+func NewRepositoryDataSource() datasource.DataSource {
+	return &repositoryDataSource{}
+}
+
+type repositoryDataSource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type repositoryDataSourceModel struct {
+	Name                    types.String  `tfsdk:"name"`
+	ID                      types.String  `tfsdk:"id"`
+	DisplayName             types.String  `tfsdk:"display_name"`
+	Description             types.String  `tfsdk:"description"`
+	Type                    types.String  `tfsdk:"type"`
+	IncludeDailyIngest      types.Bool    `tfsdk:"include_daily_ingest"`
+	StorageBytes            types.Int64   `tfsdk:"storage_bytes"`
+	DailyIngestBytes        types.Int64   `tfsdk:"daily_ingest_bytes"`
+	StorageLimitBytes       types.Int64   `tfsdk:"storage_limit_bytes"`
+	DailyIngestLimitBytes   types.Int64   `tfsdk:"daily_ingest_limit_bytes"`
+	WarningThresholdPercent types.Int64   `tfsdk:"warning_threshold_percent"`
+	StorageUsagePercent     types.Float64 `tfsdk:"storage_usage_percent"`
+	DailyIngestUsagePercent types.Float64 `tfsdk:"daily_ingest_usage_percent"`
+}
+
+func (d *repositoryDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(repositoryScopes)...)
+}
+
+func (d *repositoryDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_repository"
+}
+
+func (d *repositoryDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Next-Gen SIEM",
+			"This data source retrieves a Next-Gen SIEM repository and its current usage.\n\n"+
+				"Set `storage_limit_bytes` or `daily_ingest_limit_bytes` to the limits that apply to your subscription and the data source raises a warning during `terraform plan` "+
+				"when usage reaches `warning_threshold_percent` of a limit. Measuring daily ingest runs a search over the last day of events in the repository and is only performed when `include_daily_ingest` is `true`.",
+			repositoryScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the repository.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the repository.",
+			},
+			"display_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The display name of the repository.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the repository.",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of the repository.",
+			},
+			"include_daily_ingest": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Measure the volume of events ingested into the repository over the last day. Defaults to `false`.",
+			},
+			"storage_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The storage currently used by the repository, in bytes.",
+			},
+			"daily_ingest_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The volume of events ingested into the repository over the last day, in bytes. Only set when `include_daily_ingest` is `true`.",
+			},
+			"storage_limit_bytes": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The storage limit for the repository, in bytes, used to calculate `storage_usage_percent`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"daily_ingest_limit_bytes": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The daily ingest limit for the repository, in bytes, used to calculate `daily_ingest_usage_percent`. Requires `include_daily_ingest`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"warning_threshold_percent": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The percentage of a limit at which a warning is raised. Defaults to `%d`.", defaultUsageWarningThreshold),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"storage_usage_percent": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The percentage of `storage_limit_bytes` currently used.",
+			},
+			"daily_ingest_usage_percent": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The percentage of `daily_ingest_limit_bytes` ingested over the last day.",
+			},
+		},
+	}
+}
+
+func (d *repositoryDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data repositoryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := foundry_logscale.NewListReposParams()
+	params.Context = ctx
+
+	res, err := d.client.FoundryLogscale.ListRepos(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, repositoryScopes))
+		return
+	}
+
+	if res == nil || res.Payload == nil {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+		return
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	var repository *models.ApidomainRepoViewListItemV1
+	for _, r := range res.Payload.Resources {
+		if r != nil && r.Name != nil && *r.Name == data.Name.ValueString() {
+			repository = r
+			break
+		}
+	}
+
+	if repository == nil {
+		resp.Diagnostics.Append(tferrors.NewNotFoundError(
+			fmt.Sprintf("Repository %s not found.", data.Name.ValueString()),
+		))
+		return
+	}
+
+	data.ID = flex.StringPointerToFramework(repository.ID)
+	data.DisplayName = flex.StringPointerToFramework(repository.DisplayName)
+	data.Description = flex.StringPointerToFramework(repository.Description)
+	data.Type = flex.StringPointerToFramework(repository.TypeName)
+	data.StorageBytes = types.Int64Value(repository.Size)
+	data.DailyIngestBytes = types.Int64Null()
+	data.StorageUsagePercent = types.Float64Null()
+	data.DailyIngestUsagePercent = types.Float64Null()
+
+	if data.IncludeDailyIngest.ValueBool() {
+		events, diag := runSearch(ctx, d.client, data.Name.ValueString(), dailyIngestQuery, "1d")
+		if diag != nil {
+			resp.Diagnostics.Append(diag)
+			return
+		}
+
+		ingested, err := dailyIngestFromEvents(events)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to measure daily ingest", err.Error())
+			return
+		}
+		data.DailyIngestBytes = types.Int64Value(ingested)
+	} else if !data.DailyIngestLimitBytes.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Daily ingest not measured",
+			"daily_ingest_limit_bytes is set but include_daily_ingest is not enabled, so daily ingest usage is not checked.",
+		)
+	}
+
+	threshold := float64(defaultUsageWarningThreshold)
+	if utils.IsKnown(data.WarningThresholdPercent) {
+		threshold = float64(data.WarningThresholdPercent.ValueInt64())
+	}
+
+	if utils.IsKnown(data.StorageLimitBytes) {
+		percent := usagePercent(data.StorageBytes.ValueInt64(), data.StorageLimitBytes.ValueInt64())
+		data.StorageUsagePercent = types.Float64Value(percent)
+		if percent >= threshold {
+			resp.Diagnostics.AddWarning(
+				"Repository storage limit nearly reached",
+				fmt.Sprintf(
+					"Repository %s uses %d of %d bytes of storage (%.1f%%).",
+					data.Name.ValueString(),
+					data.StorageBytes.ValueInt64(),
+					data.StorageLimitBytes.ValueInt64(),
+					percent,
+				),
+			)
+		}
+	}
+
+	if utils.IsKnown(data.DailyIngestLimitBytes) && !data.DailyIngestBytes.IsNull() {
+		percent := usagePercent(data.DailyIngestBytes.ValueInt64(), data.DailyIngestLimitBytes.ValueInt64())
+		data.DailyIngestUsagePercent = types.Float64Value(percent)
+		if percent >= threshold {
+			resp.Diagnostics.AddWarning(
+				"Repository daily ingest limit nearly reached",
+				fmt.Sprintf(
+					"Repository %s ingested %d of %d bytes over the last day (%.1f%%).",
+					data.Name.ValueString(),
+					data.DailyIngestBytes.ValueInt64(),
+					data.DailyIngestLimitBytes.ValueInt64(),
+					percent,
+				),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// dailyIngestQuery sums the size of the raw events in the search time range.
+const dailyIngestQuery = "length(@rawstring, as=_rawsize)\n| sum(_rawsize, as=ingested_bytes)"
+
+// dailyIngestFromEvents extracts the ingested byte count from the dailyIngestQuery result.
+func dailyIngestFromEvents(events []map[string]any) (int64, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	value, ok := events[0]["ingested_bytes"]
+	if !ok || value == nil {
+		return 0, nil
+	}
+
+	ingested, err := strconv.ParseFloat(fmt.Sprint(value), 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected ingested_bytes value %q: %w", fmt.Sprint(value), err)
+	}
+
+	return int64(ingested), nil
+}
+
+// usagePercent returns used as a percentage of limit, rounded to two decimals.
+func usagePercent(used, limit int64) float64 {
+	if limit <= 0 {
+		return 0
+	}
+	return math.Round(float64(used)/float64(limit)*10000) / 100
+}
@@ -0,0 +1,50 @@
+package nextgensiem
+
+import "testing"
+
+func TestDailyIngestFromEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		events  []map[string]any
+		want    int64
+		wantErr bool
+	}{
+		{name: "no events", events: nil, want: 0},
+		{name: "string value", events: []map[string]any{{"ingested_bytes": "1048576"}}, want: 1048576},
+		{name: "float value", events: []map[string]any{{"ingested_bytes": float64(2048)}}, want: 2048},
+		{name: "missing field", events: []map[string]any{{}}, want: 0},
+		{name: "invalid value", events: []map[string]any{{"ingested_bytes": "n/a"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dailyIngestFromEvents(tt.events)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("dailyIngestFromEvents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("dailyIngestFromEvents() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsagePercent(t *testing.T) {
+	tests := []struct {
+		used  int64
+		limit int64
+		want  float64
+	}{
+		{used: 0, limit: 100, want: 0},
+		{used: 80, limit: 100, want: 80},
+		{used: 1, limit: 3, want: 33.33},
+		{used: 150, limit: 100, want: 150},
+		{used: 10, limit: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := usagePercent(tt.used, tt.limit); got != tt.want {
+			t.Errorf("usagePercent(%d, %d) = %v, want %v", tt.used, tt.limit, got, tt.want)
+		}
+	}
+}
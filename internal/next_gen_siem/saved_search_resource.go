@@ -0,0 +1,414 @@
+package nextgensiem
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/ngsiem"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &savedSearchResource{}
+	_ resource.ResourceWithConfigure   = &savedSearchResource{}
+	_ resource.ResourceWithImportState = &savedSearchResource{}
+)
+
+func NewSavedSearchResource() resource.Resource {
+	return &savedSearchResource{}
+}
+
+type savedSearchResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type savedSearchResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Description  types.String   `tfsdk:"description"`
+	Query        types.String   `tfsdk:"query"`
+	SearchDomain types.String   `tfsdk:"search_domain"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *savedSearchResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+func (r *savedSearchResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_saved_search"
+}
+
+func (r *savedSearchResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Next-Gen SIEM",
+			"This resource manages saved searches in CrowdStrike Falcon Next-Gen SIEM. Saved searches are shared with every analyst that has access to the search domain.",
+			apiScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the saved search.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the saved search.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description for the saved search.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"query": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The CrowdStrike Query Language (CQL) query string for the saved search.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"search_domain": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The search domain (repository or view) the saved search belongs to, for example `all`, `falcon`, or `third-party`. Changing this value forces a new resource to be created.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC850 timestamp of the last update to this resource by Terraform.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *savedSearchResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan savedSearchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Creating saved search", map[string]any{"name": plan.Name.ValueString()})
+
+	template, diags := plan.template()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := ngsiem.NewCreateSavedQueryParams()
+	params.Context = ctx
+	params.SearchDomain = plan.SearchDomain.ValueStringPointer()
+	params.YamlTemplate = newTemplateFile(template, "saved_search.yaml")
+
+	res, err := r.client.Ngsiem.CreateSavedQuery(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Create, err, apiScopes))
+		return
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == "" {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Create))
+		return
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Create, res.Payload.Errors); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	plan.ID = types.StringValue(res.Payload.Resources[0])
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	savedQuery, diag := r.getSavedQuery(ctx, plan.ID.ValueString(), plan.SearchDomain.ValueString())
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.wrap(savedQuery)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *savedSearchResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state savedSearchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	savedQuery, diag := r.getSavedQuery(ctx, state.ID.ValueString(), state.SearchDomain.ValueString())
+	if diag != nil {
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	resp.Diagnostics.Append(state.wrap(savedQuery)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *savedSearchResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan savedSearchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Updating saved search", map[string]any{"id": plan.ID.ValueString()})
+
+	template, diags := plan.template()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := ngsiem.NewUpdateSavedQueryFromTemplateParams()
+	params.Context = ctx
+	params.Ids = plan.ID.ValueStringPointer()
+	params.SearchDomain = plan.SearchDomain.ValueStringPointer()
+	params.YamlTemplate = newTemplateFile(template, "saved_search.yaml")
+
+	res, err := r.client.Ngsiem.UpdateSavedQueryFromTemplate(params)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, apiScopes))
+		return
+	}
+
+	if res != nil && res.Payload != nil {
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Update, res.Payload.Errors); diag != nil {
+			resp.Diagnostics.Append(diag)
+			return
+		}
+	}
+
+	savedQuery, diag := r.getSavedQuery(ctx, plan.ID.ValueString(), plan.SearchDomain.ValueString())
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.wrap(savedQuery)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *savedSearchResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state savedSearchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	tflog.Info(ctx, "Deleting saved search", map[string]any{"id": state.ID.ValueString()})
+
+	params := ngsiem.NewDeleteSavedQueryParams()
+	params.Context = ctx
+	params.Ids = state.ID.ValueStringPointer()
+	params.SearchDomain = state.SearchDomain.ValueStringPointer()
+
+	_, err := r.client.Ngsiem.DeleteSavedQuery(params)
+	if err != nil {
+		diag := tferrors.NewDiagnosticFromAPIError(tferrors.Delete, err, apiScopes)
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			return
+		}
+		resp.Diagnostics.Append(diag)
+	}
+}
+
+func (r *savedSearchResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	searchDomain, id, err := parseSearchDomainID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("search_domain"), searchDomain)...)
+}
+
+func (r *savedSearchResource) getSavedQuery(
+	ctx context.Context,
+	id string,
+	searchDomain string,
+) (*models.APISavedQuery, diag.Diagnostic) {
+	params := ngsiem.NewGetSavedQueryTemplateParams()
+	params.Context = ctx
+	params.Ids = &id
+	params.SearchDomain = &searchDomain
+
+	res, err := r.client.Ngsiem.GetSavedQueryTemplate(params)
+	if err != nil {
+		return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes)
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		return nil, tferrors.NewNotFoundError(fmt.Sprintf("Saved search %s not found in search domain %s.", id, searchDomain))
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		return nil, diag
+	}
+
+	return res.Payload.Resources[0], nil
+}
+
+// template renders the saved query template for the planned configuration.
+func (m *savedSearchResourceModel) template() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	template, err := marshalSavedQueryTemplate(savedQueryTemplate{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		QueryString: m.Query.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Failed to build saved search template", err.Error())
+	}
+
+	return template, diags
+}
+
+func (m *savedSearchResourceModel) wrap(savedQuery *models.APISavedQuery) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringPointerValue(savedQuery.ID)
+
+	if savedQuery.YamlTemplate == nil {
+		m.Name = types.StringPointerValue(savedQuery.Name)
+		return diags
+	}
+
+	template, err := unmarshalSavedQueryTemplate(*savedQuery.YamlTemplate)
+	if err != nil {
+		diags.AddError("Failed to read saved search template", err.Error())
+		return diags
+	}
+
+	m.Name = types.StringValue(template.Name)
+	m.Description = utils.PlanAwareStringValue(m.Description, &template.Description)
+
+	// The API may reformat trailing whitespace in the query; keep the
+	// configured value when it is otherwise identical.
+	if strings.TrimSpace(m.Query.ValueString()) != strings.TrimSpace(template.QueryString) {
+		m.Query = types.StringValue(template.QueryString)
+	}
+
+	return diags
+}
@@ -0,0 +1,83 @@
+package nextgensiem_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccSavedSearchResource_basic(t *testing.T) {
+	rName := acctest.RandomResourceName()
+	rNameUpdated := rName + "-updated"
+	resourceName := "crowdstrike_saved_search.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSavedSearchConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("query"), knownvalue.StringExact("#event_simpleName=ProcessRollup2")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("search_domain"), knownvalue.StringExact("all")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("description"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("last_updated"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccSavedSearchConfig_full(rNameUpdated),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringExact(rNameUpdated)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("description"), knownvalue.StringExact("Saved search created by Terraform")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("query"), knownvalue.StringExact("#event_simpleName=UserLogonFailed2 | groupBy([UserName])")),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccSavedSearchImportStateIDFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func testAccSavedSearchImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["search_domain"], rs.Primary.ID), nil
+	}
+}
+
+func testAccSavedSearchConfig_basic(name string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_saved_search" "test" {
+  name          = %[1]q
+  query         = "#event_simpleName=ProcessRollup2"
+  search_domain = "all"
+}
+`, name)
+}
+
+func testAccSavedSearchConfig_full(name string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_saved_search" "test" {
+  name          = %[1]q
+  description   = "Saved search created by Terraform"
+  query         = "#event_simpleName=UserLogonFailed2 | groupBy([UserName])"
+  search_domain = "all"
+}
+`, name)
+}
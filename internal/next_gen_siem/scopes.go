@@ -0,0 +1,34 @@
+package nextgensiem
+
+import "github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+
+var apiScopes = []scopes.Scope{
+	{
+		Name:  "NGSIEM",
+		Read:  true,
+		Write: true,
+	},
+}
+
+var correlationRuleScopes = []scopes.Scope{
+	{
+		Name:  "Correlation Rules",
+		Read:  true,
+		Write: true,
+	},
+	{
+		Name: "Sensor Download",
+		Read: true,
+	},
+}
+
+var repositoryScopes = []scopes.Scope{
+	{
+		Name: "App Logs",
+		Read: true,
+	},
+	{
+		Name: "NGSIEM",
+		Read: true,
+	},
+}
@@ -0,0 +1,110 @@
+package nextgensiem
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/ngsiem"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	searchTimeout      = 2 * time.Minute
+	searchPollInterval = time.Second
+
+	// searchFailedSummary is the diagnostic summary used when a query returns an error.
+	searchFailedSummary = "Search failed"
+)
+
+// runSearch runs query against repository over the time range starting at start
+// (for example "1d") and returns the resulting events once the search completes.
+func runSearch(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	repository string,
+	query string,
+	start string,
+) ([]map[string]any, diag.Diagnostic) {
+	startParams := ngsiem.NewStartSearchV1Params()
+	startParams.Context = ctx
+	startParams.Repository = repository
+	startParams.Body = &models.APIQueryJobInput{
+		QueryString: &query,
+		Start:       start,
+	}
+
+	startRes, err := client.Ngsiem.StartSearchV1(startParams)
+	if err != nil {
+		return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes)
+	}
+
+	if startRes == nil || startRes.Payload == nil || startRes.Payload.ID == nil {
+		return nil, tferrors.NewEmptyResponseError(tferrors.Read)
+	}
+
+	jobID := *startRes.Payload.ID
+	defer func() {
+		stopParams := ngsiem.NewStopSearchV1Params()
+		stopParams.Context = ctx
+		stopParams.Repository = repository
+		stopParams.ID = jobID
+		_, _ = client.Ngsiem.StopSearchV1(stopParams)
+	}()
+
+	deadline := time.Now().Add(searchTimeout)
+	for {
+		statusParams := ngsiem.NewGetSearchStatusV1Params()
+		statusParams.Context = ctx
+		statusParams.Repository = repository
+		statusParams.ID = jobID
+
+		statusRes, err := client.Ngsiem.GetSearchStatusV1(statusParams)
+		if err != nil {
+			return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes)
+		}
+
+		if statusRes == nil || statusRes.Payload == nil {
+			return nil, tferrors.NewEmptyResponseError(tferrors.Read)
+		}
+
+		result := statusRes.Payload
+		if result.Done != nil && *result.Done {
+			for _, warning := range result.Warnings {
+				if warning != nil && warning.Message != nil && warning.Classification != nil &&
+					strings.EqualFold(*warning.Classification, "error") {
+					return nil, diag.NewErrorDiagnostic(searchFailedSummary, *warning.Message)
+				}
+			}
+
+			events := make([]map[string]any, 0, len(result.Events))
+			for _, e := range result.Events {
+				if fields, ok := e.(map[string]any); ok {
+					events = append(events, fields)
+				}
+			}
+			return events, nil
+		}
+
+		if result.Cancelled != nil && *result.Cancelled {
+			return nil, diag.NewErrorDiagnostic(searchFailedSummary, "The search was cancelled before it completed.")
+		}
+
+		if time.Now().After(deadline) {
+			return nil, diag.NewErrorDiagnostic(
+				"Search timed out",
+				fmt.Sprintf("The search did not complete within %s.", searchTimeout),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, diag.NewErrorDiagnostic("Search cancelled", ctx.Err().Error())
+		case <-time.After(searchPollInterval):
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package nextgensiem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/sensor_download"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"gopkg.in/yaml.v3"
+)
+
+// savedQuerySchema is the LogScale schema version used for saved query templates.
+const savedQuerySchema = "https://schemas.humio.com/query/v0.5.0"
+
+// templateFile implements runtime.NamedReadCloser for LogScale template uploads.
+type templateFile struct {
+	*strings.Reader
+	name string
+}
+
+func (f *templateFile) Close() error {
+	return nil
+}
+
+func (f *templateFile) Name() string {
+	return f.name
+}
+
+func newTemplateFile(content, name string) *templateFile {
+	return &templateFile{
+		Reader: strings.NewReader(content),
+		name:   name,
+	}
+}
+
+// savedQueryTemplate is the subset of the LogScale saved query template managed by the provider.
+type savedQueryTemplate struct {
+	Schema      string `yaml:"$schema"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	QueryString string `yaml:"queryString"`
+}
+
+// marshalSavedQueryTemplate renders a saved query template as YAML.
+func marshalSavedQueryTemplate(t savedQueryTemplate) (string, error) {
+	t.Schema = savedQuerySchema
+	out, err := yaml.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("failed to render saved query template: %w", err)
+	}
+	return string(out), nil
+}
+
+// unmarshalSavedQueryTemplate parses a saved query template returned by the API.
+func unmarshalSavedQueryTemplate(content string) (savedQueryTemplate, error) {
+	var t savedQueryTemplate
+	if err := yaml.Unmarshal([]byte(content), &t); err != nil {
+		return t, fmt.Errorf("failed to parse saved query template: %w", err)
+	}
+	return t, nil
+}
+
+// decodeDashboardDefinition parses a dashboard definition in JSON or YAML into
+// JSON compatible values, dropping the $schema key which the API manages.
+func decodeDashboardDefinition(content string) (map[string]any, error) {
+	var raw any
+	if err := yaml.Unmarshal([]byte(content), &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse dashboard definition: %w", err)
+	}
+
+	// Round trip through JSON so numbers and maps use the same types regardless
+	// of whether the definition came from the configuration or the API.
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse dashboard definition: %w", err)
+	}
+
+	var definition map[string]any
+	if err := json.Unmarshal(b, &definition); err != nil {
+		return nil, fmt.Errorf("dashboard definition must be an object: %w", err)
+	}
+
+	delete(definition, "$schema")
+	return definition, nil
+}
+
+// dashboardDefinitionMatches reports whether the remote definition contains
+// every value of the configured definition. Keys only present in the remote
+// definition are defaults filled in by the API and are ignored.
+func dashboardDefinitionMatches(configured, remote any) bool {
+	switch c := configured.(type) {
+	case map[string]any:
+		r, ok := remote.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range c {
+			rv, ok := r[k]
+			if !ok {
+				if v == nil {
+					continue
+				}
+				return false
+			}
+			if !dashboardDefinitionMatches(v, rv) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		r, ok := remote.([]any)
+		if !ok || len(c) != len(r) {
+			return false
+		}
+		for i := range c {
+			if !dashboardDefinitionMatches(c[i], r[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(configured, remote)
+	}
+}
+
+// parseSearchDomainID splits an import ID in the format <search_domain>/<id>.
+func parseSearchDomainID(importID string) (string, string, error) {
+	parts := strings.SplitN(importID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf(
+			"expected import ID in the format <search_domain>/<id>, got: %q",
+			importID,
+		)
+	}
+	return parts[0], parts[1], nil
+}
+
+// getCustomerID returns the CID of the authenticated API client.
+func getCustomerID(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	apiScopes []scopes.Scope,
+) (string, diag.Diagnostic) {
+	params := sensor_download.NewGetSensorInstallersCCIDByQueryParams()
+	params.Context = ctx
+
+	res, err := client.SensorDownload.GetSensorInstallersCCIDByQuery(params)
+	if err != nil {
+		return "", tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes)
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == "" {
+		return "", tferrors.NewEmptyResponseError(tferrors.Read)
+	}
+
+	// The CCID is returned as <CID>-<checksum>.
+	cid, _, _ := strings.Cut(res.Payload.Resources[0], "-")
+	return strings.ToLower(cid), nil
+}
@@ -0,0 +1,167 @@
+package nextgensiem
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSavedQueryTemplateRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		template savedQueryTemplate
+	}{
+		{
+			name: "with description",
+			template: savedQueryTemplate{
+				Name:        "Failed logins",
+				Description: "Failed logins in the last day",
+				QueryString: "#event_simpleName=UserLogonFailed2\n| groupBy([UserName])",
+			},
+		},
+		{
+			name: "without description",
+			template: savedQueryTemplate{
+				Name:        "Process executions",
+				QueryString: "#event_simpleName=ProcessRollup2",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := marshalSavedQueryTemplate(tt.template)
+			if err != nil {
+				t.Fatalf("marshalSavedQueryTemplate() error = %v", err)
+			}
+
+			if !strings.Contains(content, savedQuerySchema) {
+				t.Errorf("marshalSavedQueryTemplate() missing schema, got %q", content)
+			}
+
+			got, err := unmarshalSavedQueryTemplate(content)
+			if err != nil {
+				t.Fatalf("unmarshalSavedQueryTemplate() error = %v", err)
+			}
+
+			tt.template.Schema = savedQuerySchema
+			if got != tt.template {
+				t.Errorf("round trip = %+v, want %+v", got, tt.template)
+			}
+		})
+	}
+}
+
+func TestParseSearchDomainID(t *testing.T) {
+	tests := []struct {
+		name         string
+		importID     string
+		searchDomain string
+		id           string
+		wantErr      bool
+	}{
+		{
+			name:         "valid",
+			importID:     "all/abc123",
+			searchDomain: "all",
+			id:           "abc123",
+		},
+		{
+			name:     "missing search domain",
+			importID: "/abc123",
+			wantErr:  true,
+		},
+		{
+			name:     "missing id",
+			importID: "all/",
+			wantErr:  true,
+		},
+		{
+			name:     "no separator",
+			importID: "abc123",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			searchDomain, id, err := parseSearchDomainID(tt.importID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSearchDomainID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if searchDomain != tt.searchDomain || id != tt.id {
+				t.Errorf("parseSearchDomainID() = (%q, %q), want (%q, %q)", searchDomain, id, tt.searchDomain, tt.id)
+			}
+		})
+	}
+}
+
+func TestDashboardDefinitionMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		remote     string
+		want       bool
+	}{
+		{
+			name:       "json and yaml with same content",
+			configured: `{"name":"SOC overview","widgets":{"a":{"x":1,"y":0}}}`,
+			remote:     "$schema: https://schemas.humio.com/dashboard/v0.17.0\nname: SOC overview\nwidgets:\n  a:\n    x: 1\n    y: 0\n",
+			want:       true,
+		},
+		{
+			name:       "remote defaults are ignored",
+			configured: `{"name":"SOC overview"}`,
+			remote:     "name: SOC overview\nupdateFrequency: never\n",
+			want:       true,
+		},
+		{
+			name:       "configured nulls are ignored",
+			configured: `{"name":"SOC overview","description":null}`,
+			remote:     "name: SOC overview\n",
+			want:       true,
+		},
+		{
+			name:       "changed value",
+			configured: `{"name":"SOC overview"}`,
+			remote:     "name: SOC summary\n",
+			want:       false,
+		},
+		{
+			name:       "list length differs",
+			configured: `{"sections":[{"title":"a"}]}`,
+			remote:     "sections:\n  - title: a\n  - title: b\n",
+			want:       false,
+		},
+		{
+			name:       "missing configured key",
+			configured: `{"name":"SOC overview","labels":["soc"]}`,
+			remote:     "name: SOC overview\n",
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configured, err := decodeDashboardDefinition(tt.configured)
+			if err != nil {
+				t.Fatalf("decodeDashboardDefinition(configured) error = %v", err)
+			}
+			remote, err := decodeDashboardDefinition(tt.remote)
+			if err != nil {
+				t.Fatalf("decodeDashboardDefinition(remote) error = %v", err)
+			}
+
+			if got := dashboardDefinitionMatches(configured, remote); got != tt.want {
+				t.Errorf("dashboardDefinitionMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeDashboardDefinitionErrors(t *testing.T) {
+	for _, content := range []string{"[1, 2]", "{", "just a string"} {
+		if _, err := decodeDashboardDefinition(content); err == nil {
+			t.Errorf("decodeDashboardDefinition(%q) expected error", content)
+		}
+	}
+}
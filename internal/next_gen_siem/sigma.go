@@ -0,0 +1,500 @@
+package nextgensiem
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// sigmaRule is the subset of a Sigma rule used to build a query.
+type sigmaRule struct {
+	Detection map[string]any `yaml:"detection"`
+}
+
+// sigmaConverter converts Sigma detections into CrowdStrike Query Language.
+type sigmaConverter struct {
+	fieldMapping map[string]string
+	selections   map[string]string
+}
+
+// convertSigmaToCQL converts the detection of a Sigma YAML rule into a CQL filter
+// expression. Field names are renamed using fieldMapping when present.
+func convertSigmaToCQL(rule string, fieldMapping map[string]string) (string, error) {
+	var parsed sigmaRule
+	if err := yaml.Unmarshal([]byte(rule), &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse Sigma rule: %w", err)
+	}
+
+	if len(parsed.Detection) == 0 {
+		return "", fmt.Errorf("the Sigma rule does not contain a detection")
+	}
+
+	c := &sigmaConverter{
+		fieldMapping: fieldMapping,
+		selections:   map[string]string{},
+	}
+
+	var conditions []string
+	for name, value := range parsed.Detection {
+		switch name {
+		case "condition":
+			switch v := value.(type) {
+			case string:
+				conditions = append(conditions, v)
+			case []any:
+				for _, cond := range v {
+					s, ok := cond.(string)
+					if !ok {
+						return "", fmt.Errorf("condition must be a string, got %T", cond)
+					}
+					conditions = append(conditions, s)
+				}
+			default:
+				return "", fmt.Errorf("condition must be a string or list of strings, got %T", value)
+			}
+		case "timeframe":
+			// Correlation rules define their own lookback window.
+		default:
+			expr, err := c.convertSelection(value)
+			if err != nil {
+				return "", fmt.Errorf("selection %q: %w", name, err)
+			}
+			c.selections[name] = expr
+		}
+	}
+
+	if len(conditions) == 0 {
+		return "", fmt.Errorf("the Sigma rule detection does not contain a condition")
+	}
+
+	exprs := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		expr, err := c.convertCondition(condition)
+		if err != nil {
+			return "", fmt.Errorf("condition %q: %w", condition, err)
+		}
+		exprs = append(exprs, expr)
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return joinCQL(exprs, "or"), nil
+}
+
+// convertSelection converts a named detection item to a CQL expression.
+func (c *sigmaConverter) convertSelection(value any) (string, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		return c.convertFieldMap(v)
+	case []any:
+		if len(v) == 0 {
+			return "", fmt.Errorf("empty selection")
+		}
+		exprs := make([]string, 0, len(v))
+		for _, item := range v {
+			var expr string
+			var err error
+			if m, ok := item.(map[string]any); ok {
+				expr, err = c.convertFieldMap(m)
+			} else {
+				expr, err = convertSigmaKeyword(item)
+			}
+			if err != nil {
+				return "", err
+			}
+			exprs = append(exprs, expr)
+		}
+		return joinCQL(exprs, "or"), nil
+	default:
+		return convertSigmaKeyword(v)
+	}
+}
+
+// convertFieldMap converts a map of field conditions, all of which must match.
+func (c *sigmaConverter) convertFieldMap(m map[string]any) (string, error) {
+	if len(m) == 0 {
+		return "", fmt.Errorf("empty selection")
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	exprs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		expr, err := c.convertField(key, m[key])
+		if err != nil {
+			return "", err
+		}
+		exprs = append(exprs, expr)
+	}
+
+	return joinCQL(exprs, "and"), nil
+}
+
+// convertField converts a single field condition such as "CommandLine|contains: value".
+func (c *sigmaConverter) convertField(key string, value any) (string, error) {
+	parts := strings.Split(key, "|")
+	field := parts[0]
+	if mapped, ok := c.fieldMapping[field]; ok && mapped != "" {
+		field = mapped
+	}
+
+	matchAll := false
+	modifier := ""
+	for _, mod := range parts[1:] {
+		switch mod {
+		case "all":
+			matchAll = true
+		case "contains", "startswith", "endswith", "re", "cidr":
+			if modifier != "" {
+				return "", fmt.Errorf("field %q combines unsupported modifiers %q and %q", parts[0], modifier, mod)
+			}
+			modifier = mod
+		default:
+			return "", fmt.Errorf("field %q uses unsupported modifier %q", parts[0], mod)
+		}
+	}
+
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+	if len(values) == 0 {
+		return "", fmt.Errorf("field %q has no values", parts[0])
+	}
+
+	exprs := make([]string, 0, len(values))
+	for _, v := range values {
+		expr, err := convertSigmaFieldValue(field, modifier, v)
+		if err != nil {
+			return "", fmt.Errorf("field %q: %w", parts[0], err)
+		}
+		exprs = append(exprs, expr)
+	}
+
+	if matchAll {
+		return joinCQL(exprs, "and"), nil
+	}
+	return joinCQL(exprs, "or"), nil
+}
+
+// convertSigmaFieldValue converts one field value using the given modifier.
+func convertSigmaFieldValue(field, modifier string, value any) (string, error) {
+	field = cqlFieldName(field)
+
+	if value == nil {
+		if modifier != "" {
+			return "", fmt.Errorf("null values cannot be combined with modifier %q", modifier)
+		}
+		return fmt.Sprintf("not %s=*", field), nil
+	}
+
+	switch v := value.(type) {
+	case int, int64, float64, bool:
+		if modifier == "" {
+			return fmt.Sprintf("%s=%v", field, v), nil
+		}
+		value = fmt.Sprint(v)
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("unsupported value type %T", value)
+	}
+
+	switch modifier {
+	case "re":
+		return fmt.Sprintf("%s=/%s/", field, escapeCQLRegexDelimiter(s)), nil
+	case "cidr":
+		return fmt.Sprintf("cidr(%s, subnet=%s)", field, cqlString(s)), nil
+	case "contains":
+		return fmt.Sprintf("%s=/%s/i", field, sigmaPatternToRegex(s)), nil
+	case "startswith":
+		return fmt.Sprintf("%s=/^%s/i", field, sigmaPatternToRegex(s)), nil
+	case "endswith":
+		return fmt.Sprintf("%s=/%s$/i", field, sigmaPatternToRegex(s)), nil
+	default:
+		return fmt.Sprintf("%s=/^%s$/i", field, sigmaPatternToRegex(s)), nil
+	}
+}
+
+// convertSigmaKeyword converts a keyword search into a case-insensitive
+// free-text match.
+func convertSigmaKeyword(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return fmt.Sprintf("/%s/i", sigmaPatternToRegex(v)), nil
+	case int, int64, float64:
+		return fmt.Sprintf("/%s/i", sigmaPatternToRegex(fmt.Sprint(v))), nil
+	default:
+		return "", fmt.Errorf("unsupported keyword type %T", value)
+	}
+}
+
+// sigmaPatternToRegex converts a Sigma value with * and ? wildcards into a regex.
+func sigmaPatternToRegex(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\\' && i+1 < len(runes) && strings.ContainsRune(`*?\`, runes[i+1]):
+			b.WriteString(regexp.QuoteMeta(string(runes[i+1])))
+			i++
+		case r == '*':
+			b.WriteString(".*")
+		case r == '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return escapeCQLRegexDelimiter(b.String())
+}
+
+// escapeCQLRegexDelimiter escapes forward slashes inside a /regex/ literal.
+func escapeCQLRegexDelimiter(s string) string {
+	var b strings.Builder
+	escaped := false
+	for _, r := range s {
+		if r == '/' && !escaped {
+			b.WriteString(`\/`)
+			continue
+		}
+		escaped = r == '\\' && !escaped
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// cqlFieldName quotes field names that are not plain identifiers.
+func cqlFieldName(field string) string {
+	if regexp.MustCompile(`^[A-Za-z_@#][A-Za-z0-9_.@#\[\]]*$`).MatchString(field) {
+		return field
+	}
+	return strconv.Quote(field)
+}
+
+// joinCQL joins expressions with a boolean operator, adding parentheses when needed.
+func joinCQL(exprs []string, op string) string {
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	wrapped := make([]string, len(exprs))
+	for i, e := range exprs {
+		wrapped[i] = wrapCQL(e)
+	}
+	return strings.Join(wrapped, " "+op+" ")
+}
+
+// wrapCQL wraps expressions with a top-level boolean operator in parentheses.
+func wrapCQL(expr string) string {
+	if isCompoundCQL(expr) {
+		return "(" + expr + ")"
+	}
+	return expr
+}
+
+// isCompoundCQL reports whether expr contains "and" or "or" outside of
+// parentheses, regex literals and strings.
+func isCompoundCQL(expr string) bool {
+	depth := 0
+	inRegex, inString, escaped := false, false, false
+	for i, r := range expr {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '/' && !inString:
+			inRegex = !inRegex
+		case r == '"' && !inRegex:
+			inString = !inString
+		case inRegex || inString:
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ' ' && depth == 0:
+			rest := expr[i:]
+			if strings.HasPrefix(rest, " or ") || strings.HasPrefix(rest, " and ") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var sigmaConditionToken = regexp.MustCompile(`\(|\)|[^\s()]+`)
+
+// convertCondition converts a Sigma condition expression into CQL.
+func (c *sigmaConverter) convertCondition(condition string) (string, error) {
+	if strings.Contains(condition, "|") {
+		return "", fmt.Errorf("aggregation expressions are not supported")
+	}
+
+	p := &sigmaConditionParser{
+		tokens:    sigmaConditionToken.FindAllString(condition, -1),
+		converter: c,
+	}
+	if len(p.tokens) == 0 {
+		return "", fmt.Errorf("empty condition")
+	}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return "", err
+	}
+	if p.pos < len(p.tokens) {
+		return "", fmt.Errorf("unexpected token %q", p.tokens[p.pos])
+	}
+	return expr, nil
+}
+
+type sigmaConditionParser struct {
+	tokens    []string
+	pos       int
+	converter *sigmaConverter
+}
+
+func (p *sigmaConditionParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return strings.ToLower(p.tokens[p.pos])
+	}
+	return ""
+}
+
+func (p *sigmaConditionParser) next() string {
+	t := p.tokens[p.pos]
+	p.pos++
+	return t
+}
+
+func (p *sigmaConditionParser) parseOr() (string, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return "", err
+	}
+	exprs := []string{left}
+	for p.peek() == "or" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return "", err
+		}
+		exprs = append(exprs, right)
+	}
+	return joinCQL(exprs, "or"), nil
+}
+
+func (p *sigmaConditionParser) parseAnd() (string, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return "", err
+	}
+	exprs := []string{left}
+	for p.peek() == "and" {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return "", err
+		}
+		exprs = append(exprs, right)
+	}
+	return joinCQL(exprs, "and"), nil
+}
+
+func (p *sigmaConditionParser) parseNot() (string, error) {
+	if p.peek() == "not" {
+		p.next()
+		expr, err := p.parseNot()
+		if err != nil {
+			return "", err
+		}
+		return "not (" + expr + ")", nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *sigmaConditionParser) parsePrimary() (string, error) {
+	switch tok := p.peek(); tok {
+	case "":
+		return "", fmt.Errorf("unexpected end of condition")
+	case "(":
+		p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return "", err
+		}
+		if p.peek() != ")" {
+			return "", fmt.Errorf("missing closing parenthesis")
+		}
+		p.next()
+		return expr, nil
+	case ")", "and", "or":
+		return "", fmt.Errorf("unexpected token %q", p.next())
+	case "1", "all":
+		p.next()
+		if p.peek() != "of" {
+			return "", fmt.Errorf("expected \"of\" after %q", tok)
+		}
+		p.next()
+		if p.peek() == "" {
+			return "", fmt.Errorf("expected selection after \"of\"")
+		}
+		exprs, err := p.converter.matchSelections(p.next())
+		if err != nil {
+			return "", err
+		}
+		if tok == "all" {
+			return joinCQL(exprs, "and"), nil
+		}
+		return joinCQL(exprs, "or"), nil
+	default:
+		name := p.next()
+		expr, ok := p.converter.selections[name]
+		if !ok {
+			return "", fmt.Errorf("unknown selection %q", name)
+		}
+		return expr, nil
+	}
+}
+
+// matchSelections returns the expressions of the selections matching pattern,
+// where "them" matches every selection not starting with an underscore.
+func (c *sigmaConverter) matchSelections(pattern string) ([]string, error) {
+	var names []string
+	for name := range c.selections {
+		switch {
+		case pattern == "them":
+			if !strings.HasPrefix(name, "_") {
+				names = append(names, name)
+			}
+		case strings.HasSuffix(pattern, "*"):
+			if strings.HasPrefix(name, strings.TrimSuffix(pattern, "*")) {
+				names = append(names, name)
+			}
+		case name == pattern:
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no selections match %q", pattern)
+	}
+
+	sort.Strings(names)
+	exprs := make([]string, 0, len(names))
+	for _, name := range names {
+		exprs = append(exprs, c.selections[name])
+	}
+	return exprs, nil
+}
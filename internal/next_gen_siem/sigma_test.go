@@ -0,0 +1,251 @@
+package nextgensiem
+
+import (
+	"testing"
+)
+
+func TestConvertSigmaToCQL(t *testing.T) {
+	tests := []struct {
+		name         string
+		rule         string
+		fieldMapping map[string]string
+		want         string
+		wantErr      bool
+	}{
+		{
+			name: "single field",
+			rule: `
+detection:
+  selection:
+    Image: C:\Windows\System32\whoami.exe
+  condition: selection
+`,
+			want: `Image=/^C:\\Windows\\System32\\whoami\.exe$/i`,
+		},
+		{
+			name: "modifiers and value lists",
+			rule: `
+detection:
+  selection:
+    Image|endswith: '\whoami.exe'
+    CommandLine|contains:
+      - /all
+      - /priv
+  condition: selection
+`,
+			want: `(CommandLine=/\/all/i or CommandLine=/\/priv/i) and Image=/\\whoami\.exe$/i`,
+		},
+		{
+			name: "all modifier",
+			rule: `
+detection:
+  selection:
+    CommandLine|contains|all:
+      - net
+      - user
+  condition: selection
+`,
+			want: `CommandLine=/net/i and CommandLine=/user/i`,
+		},
+		{
+			name: "field mapping and wildcards",
+			rule: `
+detection:
+  selection:
+    Image: '*\powershell?.exe'
+  condition: selection
+`,
+			fieldMapping: map[string]string{"Image": "ImageFileName"},
+			want:         `ImageFileName=/^.*\\powershell.\.exe$/i`,
+		},
+		{
+			name: "not filter",
+			rule: `
+detection:
+  selection:
+    EventID: 4625
+  filter:
+    UserName|startswith: svc_
+  condition: selection and not filter
+`,
+			want: `EventID=4625 and not (UserName=/^svc_/i)`,
+		},
+		{
+			name: "one of pattern",
+			rule: `
+detection:
+  selection_a:
+    A: x
+  selection_b:
+    B: y
+  filter:
+    C: z
+  condition: 1 of selection_* and not filter
+`,
+			want: `(A=/^x$/i or B=/^y$/i) and not (C=/^z$/i)`,
+		},
+		{
+			name: "all of them skips underscore selections",
+			rule: `
+detection:
+  sel:
+    A: x
+  _hidden:
+    B: y
+  other:
+    C: z
+  condition: all of them
+`,
+			want: `C=/^z$/i and A=/^x$/i`,
+		},
+		{
+			name: "list of maps and null",
+			rule: `
+detection:
+  selection:
+    - A: x
+    - B: null
+  condition: selection
+`,
+			want: `A=/^x$/i or not B=*`,
+		},
+		{
+			name: "keywords",
+			rule: `
+detection:
+  keywords:
+    - mimikatz
+    - sekurlsa
+  condition: keywords
+`,
+			want: `/mimikatz/i or /sekurlsa/i`,
+		},
+		{
+			name: "regex and cidr",
+			rule: `
+detection:
+  selection:
+    CommandLine|re: 'a/b\d+'
+    RemoteAddressIP4|cidr: 10.0.0.0/8
+  condition: selection
+`,
+			want: `CommandLine=/a\/b\d+/ and cidr(RemoteAddressIP4, subnet="10.0.0.0/8")`,
+		},
+		{
+			name: "multiple conditions",
+			rule: `
+detection:
+  a:
+    A: x
+  b:
+    B: y
+  condition:
+    - a
+    - b
+`,
+			want: `A=/^x$/i or B=/^y$/i`,
+		},
+		{
+			name: "parentheses",
+			rule: `
+detection:
+  a:
+    A: x
+  b:
+    B: y
+  c:
+    C: z
+  condition: a and (b or c)
+`,
+			want: `A=/^x$/i and (B=/^y$/i or C=/^z$/i)`,
+		},
+		{
+			name: "not group",
+			rule: `
+detection:
+  a:
+    A: x
+  b:
+    B: y
+  c:
+    C: z
+  condition: not (a or b) and c
+`,
+			want: `not (A=/^x$/i or B=/^y$/i) and C=/^z$/i`,
+		},
+		{
+			name: "operators inside values",
+			rule: `
+detection:
+  a:
+    A|contains: ' or '
+  b:
+    B: y
+  condition: a and not b
+`,
+			want: `A=/ or /i and not (B=/^y$/i)`,
+		},
+		{
+			name: "unsupported modifier",
+			rule: `
+detection:
+  selection:
+    CommandLine|base64: x
+  condition: selection
+`,
+			wantErr: true,
+		},
+		{
+			name: "aggregation",
+			rule: `
+detection:
+  selection:
+    A: x
+  condition: selection | count() > 5
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown selection",
+			rule: `
+detection:
+  selection:
+    A: x
+  condition: other
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing condition",
+			rule: `
+detection:
+  selection:
+    A: x
+`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid yaml",
+			rule:    "detection: [",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := convertSigmaToCQL(tt.rule, tt.fieldMapping)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got query %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package nextgensiem
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &sigmaToCQLFunction{}
+
+func NewSigmaToCQLFunction() function.Function {
+	return &sigmaToCQLFunction{}
+}
+
+type sigmaToCQLFunction struct{}
+
+func (f *sigmaToCQLFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "sigma_to_cql"
+}
+
+func (f *sigmaToCQLFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a Sigma rule into a CrowdStrike Query Language filter.",
+		MarkdownDescription: "Converts the `detection` section of a [Sigma](https://sigmahq.io) rule into a CrowdStrike Query Language (CQL) filter expression " +
+			"that can be used as the `search.query` of a `crowdstrike_correlation_rule`.\n\n" +
+			"Selections are combined with the rule `condition`, including `and`, `or`, `not`, parentheses, `1 of` and `all of`. " +
+			"Values match case-insensitively and support the `*` and `?` wildcards. The `contains`, `startswith`, `endswith`, `re`, `cidr` and `all` field modifiers are supported; " +
+			"any other modifier, aggregation expressions and `near` return an error. The `logsource` section is ignored, so prefix the result with an event filter such as `#event_simpleName=ProcessRollup2` to scope the query.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "rule",
+				MarkdownDescription: "The Sigma rule as YAML.",
+			},
+			function.MapParameter{
+				Name:                "field_mapping",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+				MarkdownDescription: "A map of Sigma field names to Next-Gen SIEM field names, for example `{ CommandLine = \"CommandLine\", Image = \"ImageFileName\" }`. Fields without a mapping keep their Sigma name. May be `null`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *sigmaToCQLFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var rule string
+	var fieldMapping map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &rule, &fieldMapping))
+	if resp.Error != nil {
+		return
+	}
+
+	query, err := convertSigmaToCQL(rule, fieldMapping)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, query))
+}
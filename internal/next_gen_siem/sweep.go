@@ -0,0 +1,404 @@
+package nextgensiem
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/correlation_rules"
+	"github.com/crowdstrike/gofalcon/falcon/client/ngsiem"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/sweep"
+)
+
+// sweepSearchDomains are the search domains used by the acceptance tests.
+var sweepSearchDomains = []string{"all"}
+
+// sweepParserRepositories are the parser repositories used by the acceptance tests.
+var sweepParserRepositories = []string{"parsers-repository"}
+
+func RegisterSweepers() {
+	sweep.Register("crowdstrike_saved_search", sweepSavedSearches)
+	sweep.Register("crowdstrike_correlation_rule", sweepCorrelationRules)
+	sweep.Register("crowdstrike_data_connector", sweepDataConnectors)
+	sweep.Register("crowdstrike_dashboard", sweepDashboards)
+	sweep.Register("crowdstrike_parser", sweepParsers)
+}
+
+func sweepSavedSearches(ctx context.Context, client *client.CrowdStrikeAPISpecification) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	for _, searchDomain := range sweepSearchDomains {
+		filter := fmt.Sprintf("name:~'%s'", sweep.ResourcePrefix)
+
+		listParams := ngsiem.NewListSavedQueriesParams()
+		listParams.WithContext(ctx)
+		listParams.SearchDomain = &searchDomain
+		listParams.Filter = &filter
+
+		listResp, err := client.Ngsiem.ListSavedQueries(listParams)
+		if sweep.SkipSweepError(err) {
+			sweep.Warn("Skipping saved search sweep: %s", err)
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error listing saved searches: %w", err)
+		}
+
+		if listResp.Payload == nil {
+			continue
+		}
+
+		for _, id := range listResp.Payload.Resources {
+			getParams := ngsiem.NewGetSavedQueryTemplateParams()
+			getParams.WithContext(ctx)
+			getParams.Ids = &id
+			getParams.SearchDomain = &searchDomain
+
+			getResp, err := client.Ngsiem.GetSavedQueryTemplate(getParams)
+			if err != nil {
+				if sweep.ShouldIgnoreError(err) {
+					continue
+				}
+				return nil, fmt.Errorf("error getting saved search %s: %w", id, err)
+			}
+
+			if getResp.Payload == nil || len(getResp.Payload.Resources) == 0 || getResp.Payload.Resources[0].Name == nil {
+				continue
+			}
+
+			name := *getResp.Payload.Resources[0].Name
+			if !strings.HasPrefix(name, sweep.ResourcePrefix) {
+				sweep.Trace("Skipping saved search %s (not a test resource)", name)
+				continue
+			}
+
+			sweepables = append(sweepables, sweep.NewSweepResource(
+				searchDomain+"/"+id,
+				name,
+				deleteSavedSearch,
+			))
+		}
+	}
+
+	return sweepables, nil
+}
+
+func deleteSavedSearch(ctx context.Context, client *client.CrowdStrikeAPISpecification, id string) error {
+	searchDomain, savedSearchID, err := parseSearchDomainID(id)
+	if err != nil {
+		return err
+	}
+
+	params := ngsiem.NewDeleteSavedQueryParams()
+	params.WithContext(ctx)
+	params.Ids = &savedSearchID
+	params.SearchDomain = &searchDomain
+
+	_, err = client.Ngsiem.DeleteSavedQuery(params)
+	if err != nil {
+		if sweep.ShouldIgnoreError(err) {
+			sweep.Debug("Ignoring error for saved search %s: %s", id, err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func sweepCorrelationRules(ctx context.Context, client *client.CrowdStrikeAPISpecification) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	queryParams := correlation_rules.NewQueriesRulesGetV1Params()
+	queryParams.WithContext(ctx)
+
+	queryResp, err := client.CorrelationRules.QueriesRulesGetV1(queryParams)
+	if sweep.SkipSweepError(err) {
+		sweep.Warn("Skipping correlation rule sweep: %s", err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error listing correlation rules: %w", err)
+	}
+
+	if queryResp.Payload == nil || len(queryResp.Payload.Resources) == 0 {
+		return sweepables, nil
+	}
+
+	getParams := correlation_rules.NewEntitiesRulesGetV1Params()
+	getParams.WithContext(ctx)
+	getParams.Ids = queryResp.Payload.Resources
+
+	getResp, err := client.CorrelationRules.EntitiesRulesGetV1(getParams)
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			sweep.Warn("Skipping correlation rule sweep: %s", err)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting correlation rules: %w", err)
+	}
+
+	if getResp.Payload == nil {
+		return sweepables, nil
+	}
+
+	for _, rule := range getResp.Payload.Resources {
+		if rule == nil || rule.ID == nil || rule.Name == nil {
+			continue
+		}
+
+		if !strings.HasPrefix(*rule.Name, sweep.ResourcePrefix) {
+			sweep.Trace("Skipping correlation rule %s (not a test resource)", *rule.Name)
+			continue
+		}
+
+		sweepables = append(sweepables, sweep.NewSweepResource(
+			*rule.ID,
+			*rule.Name,
+			deleteCorrelationRule,
+		))
+	}
+
+	return sweepables, nil
+}
+
+func deleteCorrelationRule(ctx context.Context, client *client.CrowdStrikeAPISpecification, id string) error {
+	params := correlation_rules.NewEntitiesRulesDeleteV1Params()
+	params.WithContext(ctx)
+	params.Ids = []string{id}
+
+	_, err := client.CorrelationRules.EntitiesRulesDeleteV1(params)
+	if err != nil {
+		if sweep.ShouldIgnoreError(err) {
+			sweep.Debug("Ignoring error for correlation rule %s: %s", id, err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func sweepDataConnectors(ctx context.Context, client *client.CrowdStrikeAPISpecification) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	filter := fmt.Sprintf("name:~'%s'", sweep.ResourcePrefix)
+
+	params := ngsiem.NewExternalListDataConnectionsParams()
+	params.WithContext(ctx)
+	params.Filter = &filter
+
+	resp, err := client.Ngsiem.ExternalListDataConnections(params)
+	if sweep.SkipSweepError(err) {
+		sweep.Warn("Skipping data connector sweep: %s", err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error listing data connections: %w", err)
+	}
+
+	if resp.Payload == nil {
+		return sweepables, nil
+	}
+
+	for _, connection := range resp.Payload.Resources {
+		if connection == nil || connection.ID == nil || connection.Name == nil {
+			continue
+		}
+
+		if !strings.HasPrefix(*connection.Name, sweep.ResourcePrefix) {
+			sweep.Trace("Skipping data connection %s (not a test resource)", *connection.Name)
+			continue
+		}
+
+		sweepables = append(sweepables, sweep.NewSweepResource(
+			*connection.ID,
+			*connection.Name,
+			deleteDataConnector,
+		))
+	}
+
+	return sweepables, nil
+}
+
+func deleteDataConnector(ctx context.Context, client *client.CrowdStrikeAPISpecification, id string) error {
+	params := ngsiem.NewExternalDeleteDataConnectionParams()
+	params.WithContext(ctx)
+	params.Ids = id
+
+	_, err := client.Ngsiem.ExternalDeleteDataConnection(params)
+	if err != nil {
+		if sweep.ShouldIgnoreError(err) {
+			sweep.Debug("Ignoring error for data connection %s: %s", id, err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func sweepDashboards(ctx context.Context, client *client.CrowdStrikeAPISpecification) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	for _, searchDomain := range sweepSearchDomains {
+		filter := fmt.Sprintf("name:~'%s'", sweep.ResourcePrefix)
+
+		listParams := ngsiem.NewListDashboardsParams()
+		listParams.WithContext(ctx)
+		listParams.SearchDomain = &searchDomain
+		listParams.Filter = &filter
+
+		listResp, err := client.Ngsiem.ListDashboards(listParams)
+		if sweep.SkipSweepError(err) {
+			sweep.Warn("Skipping dashboard sweep: %s", err)
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error listing dashboards: %w", err)
+		}
+
+		if listResp.Payload == nil {
+			continue
+		}
+
+		for _, id := range listResp.Payload.Resources {
+			getParams := ngsiem.NewGetDashboardTemplateParams()
+			getParams.WithContext(ctx)
+			getParams.Ids = &id
+			getParams.SearchDomain = &searchDomain
+
+			getResp, err := client.Ngsiem.GetDashboardTemplate(getParams)
+			if err != nil {
+				if sweep.ShouldIgnoreError(err) {
+					continue
+				}
+				return nil, fmt.Errorf("error getting dashboard %s: %w", id, err)
+			}
+
+			if getResp.Payload == nil || len(getResp.Payload.Resources) == 0 || getResp.Payload.Resources[0].Name == nil {
+				continue
+			}
+
+			name := *getResp.Payload.Resources[0].Name
+			if !strings.HasPrefix(name, sweep.ResourcePrefix) {
+				sweep.Trace("Skipping dashboard %s (not a test resource)", name)
+				continue
+			}
+
+			sweepables = append(sweepables, sweep.NewSweepResource(
+				searchDomain+"/"+id,
+				name,
+				deleteDashboard,
+			))
+		}
+	}
+
+	return sweepables, nil
+}
+
+func deleteDashboard(ctx context.Context, client *client.CrowdStrikeAPISpecification, id string) error {
+	searchDomain, dashboardID, err := parseSearchDomainID(id)
+	if err != nil {
+		return err
+	}
+
+	params := ngsiem.NewDeleteDashboardParams()
+	params.WithContext(ctx)
+	params.Ids = &dashboardID
+	params.SearchDomain = &searchDomain
+
+	_, err = client.Ngsiem.DeleteDashboard(params)
+	if err != nil {
+		if sweep.ShouldIgnoreError(err) {
+			sweep.Debug("Ignoring error for dashboard %s: %s", id, err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func sweepParsers(ctx context.Context, client *client.CrowdStrikeAPISpecification) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	for _, repository := range sweepParserRepositories {
+		filter := fmt.Sprintf("name:~'%s'", sweep.ResourcePrefix)
+
+		listParams := ngsiem.NewListParsersParams()
+		listParams.WithContext(ctx)
+		listParams.Repository = &repository
+		listParams.Filter = &filter
+
+		listResp, err := client.Ngsiem.ListParsers(listParams)
+		if sweep.SkipSweepError(err) {
+			sweep.Warn("Skipping parser sweep: %s", err)
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error listing parsers: %w", err)
+		}
+
+		if listResp.Payload == nil {
+			continue
+		}
+
+		for _, id := range listResp.Payload.Resources {
+			getParams := ngsiem.NewGetParserParams()
+			getParams.WithContext(ctx)
+			getParams.Ids = &id
+			getParams.Repository = &repository
+
+			getResp, err := client.Ngsiem.GetParser(getParams)
+			if err != nil {
+				if sweep.ShouldIgnoreError(err) {
+					continue
+				}
+				return nil, fmt.Errorf("error getting parser %s: %w", id, err)
+			}
+
+			if getResp.Payload == nil || len(getResp.Payload.Resources) == 0 || getResp.Payload.Resources[0].Name == nil {
+				continue
+			}
+
+			name := *getResp.Payload.Resources[0].Name
+			if !strings.HasPrefix(name, sweep.ResourcePrefix) {
+				sweep.Trace("Skipping parser %s (not a test resource)", name)
+				continue
+			}
+
+			sweepables = append(sweepables, sweep.NewSweepResource(
+				repository+"/"+id,
+				name,
+				deleteParser,
+			))
+		}
+	}
+
+	return sweepables, nil
+}
+
+func deleteParser(ctx context.Context, client *client.CrowdStrikeAPISpecification, id string) error {
+	repository, parserID, err := parseSearchDomainID(id)
+	if err != nil {
+		return err
+	}
+
+	params := ngsiem.NewDeleteParserParams()
+	params.WithContext(ctx)
+	params.Ids = &parserID
+	params.Repository = &repository
+
+	_, err = client.Ngsiem.DeleteParser(params)
+	if err != nil {
+		if sweep.ShouldIgnoreError(err) {
+			sweep.Debug("Ignoring error for parser %s: %s", id, err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
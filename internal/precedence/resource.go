@@ -0,0 +1,378 @@
+package precedence
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+var (
+	_ resource.Resource              = &policyPrecedenceResource{}
+	_ resource.ResourceWithConfigure = &policyPrecedenceResource{}
+)
+
+const dynamicEnforcement = "dynamic"
+
+// Policies describes the policies of a policy type whose precedence is managed
+// by a resource created with NewResource.
+type Policies struct {
+	// TypeName is appended to the provider type name to form the type name of
+	// the resource, such as "_firewall_policy_precedence".
+	TypeName string
+	// Kind names the policies in descriptions and errors, such as "firewall"
+	// for firewall policies.
+	Kind                 string
+	DocumentationSection string
+	MarkdownDescription  string
+	Scopes               []scopes.Scope
+	// List returns the IDs of the policies of the platform platformName ordered
+	// by precedence. The last policy is the default policy of the platform.
+	List func(
+		ctx context.Context,
+		client *client.CrowdStrikeAPISpecification,
+		platformName string,
+	) ([]string, error)
+	// Set sets the precedence of the policies of the platform platformName to
+	// the order of policyIDs.
+	Set func(
+		ctx context.Context,
+		client *client.CrowdStrikeAPISpecification,
+		platformName string,
+		policyIDs []string,
+	) error
+}
+
+// NewResource returns a resource setting the precedence of policies based on
+// the order of their IDs.
+func NewResource(policies Policies) resource.Resource {
+	return &policyPrecedenceResource{policies: policies}
+}
+
+type policyPrecedenceResource struct {
+	policies Policies
+	client   *client.CrowdStrikeAPISpecification
+}
+
+type policyPrecedenceResourceModel struct {
+	IDs          types.List     `tfsdk:"ids"`
+	Enforcement  types.String   `tfsdk:"enforcement"`
+	PlatformName types.String   `tfsdk:"platform_name"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *policyPrecedenceResourceModel) wrap(
+	ctx context.Context,
+	policies []string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only the policies managed by a dynamic resource are kept in state.
+	if strings.EqualFold(d.Enforcement.ValueString(), dynamicEnforcement) &&
+		len(policies) > len(d.IDs.Elements()) {
+		policies = policies[:len(d.IDs.Elements())]
+	}
+
+	policyList, diag := types.ListValueFrom(ctx, types.StringType, policies)
+	diags.Append(diag...)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.IDs = policyList
+
+	return diags
+}
+
+func (r *policyPrecedenceResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(r.policies.Scopes)...)
+}
+
+func (r *policyPrecedenceResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + r.policies.TypeName
+}
+
+func (r *policyPrecedenceResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			r.policies.DocumentationSection,
+			r.policies.MarkdownDescription,
+			r.policies.Scopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The policy ids in order. The first ID specified will have the highest precedence and the last ID specified will have the lowest.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"enforcement": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: fmt.Sprintf(
+					"The enforcement type for this resource. `strict` requires all non-default %s policy ids for platform to be provided. `dynamic` will ensure the provided policies have precedence over others. When using dynamic, policy ids not included in `ids` will retain their current ordering after the managed ids.",
+					r.policies.Kind,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("strict", "dynamic"),
+				},
+			},
+			"platform_name": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("The platform of the %s policies. One of: Windows, Mac, Linux", r.policies.Kind),
+				Validators: []validator.String{
+					stringvalidator.OneOf("Windows", "Linux", "Mac"),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the resource.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *policyPrecedenceResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan policyPrecedenceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.setPrecedence(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *policyPrecedenceResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state policyPrecedenceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	policies, diags := r.getPoliciesByPrecedence(ctx, state.PlatformName.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(state.wrap(ctx, policies)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *policyPrecedenceResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan policyPrecedenceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.setPrecedence(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *policyPrecedenceResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+}
+
+// setPrecedence sets the precedence of the policies in plan and updates plan
+// with the resulting order.
+func (r *policyPrecedenceResource) setPrecedence(
+	ctx context.Context,
+	plan *policyPrecedenceResourceModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var policyIDs []string
+	diags.Append(plan.IDs.ElementsAs(ctx, &policyIDs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	platformName := plan.PlatformName.ValueString()
+
+	if strings.EqualFold(plan.Enforcement.ValueString(), dynamicEnforcement) {
+		allPolicies, d := r.getPoliciesByPrecedence(ctx, platformName)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		var missingPolicies []string
+		policyIDs, missingPolicies = dynamicPolicyOrder(policyIDs, allPolicies)
+		if len(missingPolicies) > 0 {
+			diags.AddAttributeError(
+				path.Root("ids"),
+				"Invalid policy ids provided.",
+				fmt.Sprintf(
+					"ids contains policy ids that do not exist for platform: %s, the following ids are invalid:\n\n%s",
+					platformName,
+					strings.Join(missingPolicies, "\n"),
+				),
+			)
+			return diags
+		}
+	}
+
+	err := r.policies.Set(ctx, r.client, titlePlatformName(platformName), policyIDs)
+	if err != nil {
+		d := tferrors.NewDiagnosticFromAPIError(
+			tferrors.Update,
+			err,
+			r.policies.Scopes,
+			tferrors.WithNotFoundDetail(
+				fmt.Sprintf(
+					"One or more %[1]s policy ids were not found. Verify all the %[1]s policy ids provided are valid for the platform you are targeting.",
+					r.policies.Kind,
+				),
+			),
+		)
+		if d != nil {
+			if d.Summary() == tferrors.NotFoundErrorSummary {
+				diags.AddAttributeError(path.Root("ids"), d.Summary(), d.Detail())
+			} else {
+				diags.Append(d)
+			}
+			return diags
+		}
+	}
+
+	policies, d := r.getPoliciesByPrecedence(ctx, platformName)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	diags.Append(plan.wrap(ctx, policies)...)
+
+	return diags
+}
+
+// getPoliciesByPrecedence returns policy ids ordered by precedence excluding the default policy.
+func (r *policyPrecedenceResource) getPoliciesByPrecedence(
+	ctx context.Context,
+	platformName string,
+) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policies, err := r.policies.List(ctx, r.client, titlePlatformName(platformName))
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, r.policies.Scopes))
+		return nil, diags
+	}
+
+	if len(policies) > 0 {
+		policies = policies[:len(policies)-1]
+	}
+
+	return policies, diags
+}
+
+// dynamicPolicyOrder returns the managed policies followed by the other
+// policies of allPolicies in their current order, and the managed policies
+// missing from allPolicies.
+func dynamicPolicyOrder(managedPolicyIDs, allPolicies []string) ([]string, []string) {
+	missingPolicies := utils.MissingElements(managedPolicyIDs, allPolicies)
+
+	policyIDs := slices.Clone(managedPolicyIDs)
+	for _, id := range allPolicies {
+		if !slices.Contains(managedPolicyIDs, id) {
+			policyIDs = append(policyIDs, id)
+		}
+	}
+
+	return policyIDs, missingPolicies
+}
+
+// titlePlatformName returns platformName as expected by the policy APIs,
+// such as Windows.
+func titlePlatformName(platformName string) string {
+	return cases.Title(language.English).String(platformName)
+}
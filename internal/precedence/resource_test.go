@@ -0,0 +1,78 @@
+package precedence
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicPolicyOrder(t *testing.T) {
+	tests := map[string]struct {
+		managed     []string
+		all         []string
+		wantOrder   []string
+		wantMissing []string
+	}{
+		"managed first": {
+			managed:     []string{"c", "a"},
+			all:         []string{"a", "b", "c", "d"},
+			wantOrder:   []string{"c", "a", "b", "d"},
+			wantMissing: []string{},
+		},
+		"all managed": {
+			managed:     []string{"b", "a"},
+			all:         []string{"a", "b"},
+			wantOrder:   []string{"b", "a"},
+			wantMissing: []string{},
+		},
+		"missing": {
+			managed:     []string{"a", "x"},
+			all:         []string{"a", "b"},
+			wantOrder:   []string{"a", "x", "b"},
+			wantMissing: []string{"x"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			order, missing := dynamicPolicyOrder(tt.managed, tt.all)
+			assert.Equal(t, tt.wantOrder, order)
+			assert.Equal(t, tt.wantMissing, missing)
+		})
+	}
+}
+
+func TestPolicyPrecedenceResourceModel_wrap(t *testing.T) {
+	ctx := t.Context()
+	policies := []string{"a", "b", "c"}
+
+	tests := map[string]struct {
+		enforcement string
+		ids         []string
+		want        []string
+	}{
+		"strict":             {enforcement: "strict", ids: []string{"a"}, want: policies},
+		"dynamic":            {enforcement: "dynamic", ids: []string{"a", "b"}, want: []string{"a", "b"}},
+		"dynamic mixed case": {enforcement: "Dynamic", ids: []string{"a"}, want: []string{"a"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ids, diags := types.ListValueFrom(ctx, types.StringType, tt.ids)
+			require.False(t, diags.HasError(), diags)
+
+			model := policyPrecedenceResourceModel{
+				IDs:         ids,
+				Enforcement: types.StringValue(tt.enforcement),
+			}
+			diags = model.wrap(ctx, policies)
+			require.False(t, diags.HasError(), diags)
+
+			var got []string
+			require.False(t, model.IDs.ElementsAs(ctx, &got, false).HasError())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
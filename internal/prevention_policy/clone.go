@@ -0,0 +1,123 @@
+package preventionpolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// policyAttributes are the attributes of a prevention policy resource that are
+// not prevention settings, and so are never copied from a cloned policy.
+var policyAttributes = map[string]bool{
+	"id":                   true,
+	"cid":                  true,
+	"last_updated":         true,
+	"name":                 true,
+	"description":          true,
+	"enabled":              true,
+	"host_groups":          true,
+	"ioa_rule_groups":      true,
+	"clone_from_policy_id": true,
+}
+
+// cloneFromPolicyIDAttribute returns the clone_from_policy_id attribute of a
+// prevention policy resource.
+func cloneFromPolicyIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "ID of an existing prevention policy of the same platform to copy the prevention settings from when the policy is created. Settings set in the configuration take precedence over the copied settings. Settings not set in the configuration keep the copied values and are not reverted to their defaults afterwards, so changes made outside of Terraform to those settings are not detected. The value must be known when planning. Changing it after the policy is created has no effect, while removing it reverts the settings not set in the configuration to their defaults.",
+		Validators: []validator.String{
+			fwvalidators.StringNotWhitespace(),
+		},
+	}
+}
+
+// getClonedPolicy returns the prevention policy policyID whose settings are
+// copied into a new policy of the platform platformName.
+func getClonedPolicy(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	policyID types.String,
+	platformName string,
+) (*models.PreventionPolicyV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(policyID) {
+		diags.AddAttributeError(
+			path.Root("clone_from_policy_id"),
+			"Unknown clone_from_policy_id",
+			"The settings of the prevention policy to clone are copied when planning, so clone_from_policy_id must be known when planning. Create the source policy in a separate apply first.",
+		)
+		return nil, diags
+	}
+
+	policy, diags := getPreventionPolicy(ctx, client, policyID.ValueString())
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if policy.PlatformName == nil || !strings.EqualFold(*policy.PlatformName, platformName) {
+		diags.AddAttributeError(
+			path.Root("clone_from_policy_id"),
+			"Invalid clone_from_policy_id",
+			fmt.Sprintf(
+				"Prevention policy %s is not a %s prevention policy. Only the settings of a prevention policy of the same platform can be copied.",
+				policyID.ValueString(),
+				platformName,
+			),
+		)
+		return nil, diags
+	}
+
+	return policy, diags
+}
+
+// planUnconfiguredSettings sets the prevention settings that are not set in
+// config to their values in source, instead of their defaults.
+func planUnconfiguredSettings(
+	ctx context.Context,
+	config tfsdk.Config,
+	source interface {
+		GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+	},
+	plan *tfsdk.Plan,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name := range plan.Schema.GetAttributes() {
+		if policyAttributes[name] {
+			continue
+		}
+
+		var configValue attr.Value
+		diags.Append(config.GetAttribute(ctx, path.Root(name), &configValue)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if !configValue.IsNull() {
+			continue
+		}
+
+		var sourceValue attr.Value
+		diags.Append(source.GetAttribute(ctx, path.Root(name), &sourceValue)...)
+		diags.Append(plan.SetAttribute(ctx, path.Root(name), sourceValue)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	ioarulegroup "github.com/crowdstrike/terraform-provider-crowdstrike/internal/ioa_rule_group"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -36,31 +37,32 @@ type defaultPreventionPolicyLinuxResourceModel struct {
 	ID          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
 
-	Description                          types.String `tfsdk:"description"`
-	RuleGroups                           types.Set    `tfsdk:"ioa_rule_groups"`
-	CloudAntiMalware                     types.Object `tfsdk:"cloud_anti_malware"`
-	OnSensorMLSlider                     types.Object `tfsdk:"sensor_anti_malware"`
-	UnknownDetectionRelatedExecutables   types.Bool   `tfsdk:"upload_unknown_detection_related_executables"`
-	UnknownExecutables                   types.Bool   `tfsdk:"upload_unknown_executables"`
-	ScriptBasedExecutionMonitoring       types.Bool   `tfsdk:"script_based_execution_monitoring"`
-	NextGenAV                            types.Bool   `tfsdk:"quarantine"`
-	CustomBlacklisting                   types.Bool   `tfsdk:"custom_blocking"`
-	PreventSuspiciousProcesses           types.Bool   `tfsdk:"prevent_suspicious_processes"`
-	DriftPrevention                      types.Bool   `tfsdk:"drift_prevention"`
-	FilesystemVisibility                 types.Bool   `tfsdk:"filesystem_visibility"`
-	NetworkVisibility                    types.Bool   `tfsdk:"network_visibility"`
-	HTTPVisibility                       types.Bool   `tfsdk:"http_visibility"`
-	FTPVisibility                        types.Bool   `tfsdk:"ftp_visibility"`
-	TLSVisibility                        types.Bool   `tfsdk:"tls_visibility"`
-	EmailProtocolVisibility              types.Bool   `tfsdk:"email_protocol_visibility"`
-	SensorTamperingProtection            types.Bool   `tfsdk:"sensor_tampering_protection"`
-	MemoryVisibility                     types.Bool   `tfsdk:"memory_visibility"`
-	OnWriteScriptFileVisibility          types.Bool   `tfsdk:"on_write_script_file_visibility"`
-	ExtendedCommandLineVisibility        types.Bool   `tfsdk:"extended_command_line_visibility"`
-	DBusVisibility                       types.Bool   `tfsdk:"dbus_visibility"`
-	EnhancePHPVisibility                 types.Bool   `tfsdk:"enhance_php_visibility"`
-	EnhanceEnvironmentVariableVisibility types.Bool   `tfsdk:"enhance_environment_variable_visibility"`
-	SuspiciousFileAnalysis               types.Bool   `tfsdk:"suspicious_file_analysis"`
+	Description                          types.String   `tfsdk:"description"`
+	RuleGroups                           types.Set      `tfsdk:"ioa_rule_groups"`
+	CloudAntiMalware                     types.Object   `tfsdk:"cloud_anti_malware"`
+	OnSensorMLSlider                     types.Object   `tfsdk:"sensor_anti_malware"`
+	UnknownDetectionRelatedExecutables   types.Bool     `tfsdk:"upload_unknown_detection_related_executables"`
+	UnknownExecutables                   types.Bool     `tfsdk:"upload_unknown_executables"`
+	ScriptBasedExecutionMonitoring       types.Bool     `tfsdk:"script_based_execution_monitoring"`
+	NextGenAV                            types.Bool     `tfsdk:"quarantine"`
+	CustomBlacklisting                   types.Bool     `tfsdk:"custom_blocking"`
+	PreventSuspiciousProcesses           types.Bool     `tfsdk:"prevent_suspicious_processes"`
+	DriftPrevention                      types.Bool     `tfsdk:"drift_prevention"`
+	FilesystemVisibility                 types.Bool     `tfsdk:"filesystem_visibility"`
+	NetworkVisibility                    types.Bool     `tfsdk:"network_visibility"`
+	HTTPVisibility                       types.Bool     `tfsdk:"http_visibility"`
+	FTPVisibility                        types.Bool     `tfsdk:"ftp_visibility"`
+	TLSVisibility                        types.Bool     `tfsdk:"tls_visibility"`
+	EmailProtocolVisibility              types.Bool     `tfsdk:"email_protocol_visibility"`
+	SensorTamperingProtection            types.Bool     `tfsdk:"sensor_tampering_protection"`
+	MemoryVisibility                     types.Bool     `tfsdk:"memory_visibility"`
+	OnWriteScriptFileVisibility          types.Bool     `tfsdk:"on_write_script_file_visibility"`
+	ExtendedCommandLineVisibility        types.Bool     `tfsdk:"extended_command_line_visibility"`
+	DBusVisibility                       types.Bool     `tfsdk:"dbus_visibility"`
+	EnhancePHPVisibility                 types.Bool     `tfsdk:"enhance_php_visibility"`
+	EnhanceEnvironmentVariableVisibility types.Bool     `tfsdk:"enhance_environment_variable_visibility"`
+	SuspiciousFileAnalysis               types.Bool     `tfsdk:"suspicious_file_analysis"`
+	Timeouts                             timeouts.Value `tfsdk:"timeouts"`
 }
 
 // wrap transforms Go values to their terraform wrapped values.
@@ -247,6 +249,7 @@ func (r *defaultPreventionPolicyLinuxResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
 }
 
 func (r *defaultPreventionPolicyLinuxResource) Metadata(
@@ -258,11 +261,11 @@ func (r *defaultPreventionPolicyLinuxResource) Metadata(
 }
 
 func (r *defaultPreventionPolicyLinuxResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
-	resp.Schema = generateLinuxSchema(true)
+	resp.Schema = generateLinuxSchema(ctx, true)
 }
 
 func (r *defaultPreventionPolicyLinuxResource) Create(
@@ -277,6 +280,9 @@ func (r *defaultPreventionPolicyLinuxResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getDefaultPolicy(ctx, r.client, linuxPlatformName)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -336,6 +342,9 @@ func (r *defaultPreventionPolicyLinuxResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getPreventionPolicy(ctx, r.client, state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -357,6 +366,9 @@ func (r *defaultPreventionPolicyLinuxResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var state defaultPreventionPolicyLinuxResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -11,6 +11,7 @@ import (
 	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
 	ioarulegroup "github.com/crowdstrike/terraform-provider-crowdstrike/internal/ioa_rule_group"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -34,30 +35,31 @@ type defaultPreventionPolicyMacResource struct {
 }
 
 type defaultPreventionPolicyMacResourceModel struct {
-	ID                                 types.String `tfsdk:"id"`
-	Description                        types.String `tfsdk:"description"`
-	RuleGroups                         types.Set    `tfsdk:"ioa_rule_groups"`
-	LastUpdated                        types.String `tfsdk:"last_updated"`
-	CloudAntiMalware                   types.Object `tfsdk:"cloud_anti_malware"`
-	AdwarePUP                          types.Object `tfsdk:"cloud_adware_and_pup"`
-	OnSensorMLSlider                   types.Object `tfsdk:"sensor_anti_malware"`
-	EndUserNotifications               types.Bool   `tfsdk:"notify_end_users"`
-	UnknownDetectionRelatedExecutables types.Bool   `tfsdk:"upload_unknown_detection_related_executables"`
-	UnknownExecutables                 types.Bool   `tfsdk:"upload_unknown_executables"`
-	SensorTamperingProtection          types.Bool   `tfsdk:"sensor_tampering_protection"`
-	ScriptBasedExecutionMonitoring     types.Bool   `tfsdk:"script_based_execution_monitoring"`
-	DetectOnWrite                      types.Bool   `tfsdk:"detect_on_write"`
-	QuarantineOnWrite                  types.Bool   `tfsdk:"quarantine_on_write"`
-	NextGenAV                          types.Bool   `tfsdk:"quarantine"`
-	CustomBlacklisting                 types.Bool   `tfsdk:"custom_blocking"`
-	PreventSuspiciousProcesses         types.Bool   `tfsdk:"prevent_suspicious_processes"`
-	IntelPrevention                    types.Bool   `tfsdk:"intelligence_sourced_threats"`
-	ChopperWebshell                    types.Bool   `tfsdk:"chopper_webshell"`
-	OnSensorMLAdwarePUPSlider          types.Object `tfsdk:"sensor_adware_and_pup"`
-	XPCOMShell                         types.Bool   `tfsdk:"xpcom_shell"`
-	EmpyreBackdoor                     types.Bool   `tfsdk:"empyre_backdoor"`
-	KcPasswordDecoded                  types.Bool   `tfsdk:"kc_password_decoded"`
-	HashCollector                      types.Bool   `tfsdk:"hash_collector"`
+	ID                                 types.String   `tfsdk:"id"`
+	Description                        types.String   `tfsdk:"description"`
+	RuleGroups                         types.Set      `tfsdk:"ioa_rule_groups"`
+	LastUpdated                        types.String   `tfsdk:"last_updated"`
+	CloudAntiMalware                   types.Object   `tfsdk:"cloud_anti_malware"`
+	AdwarePUP                          types.Object   `tfsdk:"cloud_adware_and_pup"`
+	OnSensorMLSlider                   types.Object   `tfsdk:"sensor_anti_malware"`
+	EndUserNotifications               types.Bool     `tfsdk:"notify_end_users"`
+	UnknownDetectionRelatedExecutables types.Bool     `tfsdk:"upload_unknown_detection_related_executables"`
+	UnknownExecutables                 types.Bool     `tfsdk:"upload_unknown_executables"`
+	SensorTamperingProtection          types.Bool     `tfsdk:"sensor_tampering_protection"`
+	ScriptBasedExecutionMonitoring     types.Bool     `tfsdk:"script_based_execution_monitoring"`
+	DetectOnWrite                      types.Bool     `tfsdk:"detect_on_write"`
+	QuarantineOnWrite                  types.Bool     `tfsdk:"quarantine_on_write"`
+	NextGenAV                          types.Bool     `tfsdk:"quarantine"`
+	CustomBlacklisting                 types.Bool     `tfsdk:"custom_blocking"`
+	PreventSuspiciousProcesses         types.Bool     `tfsdk:"prevent_suspicious_processes"`
+	IntelPrevention                    types.Bool     `tfsdk:"intelligence_sourced_threats"`
+	ChopperWebshell                    types.Bool     `tfsdk:"chopper_webshell"`
+	OnSensorMLAdwarePUPSlider          types.Object   `tfsdk:"sensor_adware_and_pup"`
+	XPCOMShell                         types.Bool     `tfsdk:"xpcom_shell"`
+	EmpyreBackdoor                     types.Bool     `tfsdk:"empyre_backdoor"`
+	KcPasswordDecoded                  types.Bool     `tfsdk:"kc_password_decoded"`
+	HashCollector                      types.Bool     `tfsdk:"hash_collector"`
+	Timeouts                           timeouts.Value `tfsdk:"timeouts"`
 }
 
 // wrap transforms Go values to their terraform wrapped values.
@@ -266,6 +268,7 @@ func (r *defaultPreventionPolicyMacResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
 }
 
 func (r *defaultPreventionPolicyMacResource) Metadata(
@@ -277,11 +280,11 @@ func (r *defaultPreventionPolicyMacResource) Metadata(
 }
 
 func (r *defaultPreventionPolicyMacResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
-	resp.Schema = generateMacSchema(true)
+	resp.Schema = generateMacSchema(ctx, true)
 }
 
 func (r *defaultPreventionPolicyMacResource) Create(
@@ -296,6 +299,9 @@ func (r *defaultPreventionPolicyMacResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getDefaultPolicy(ctx, r.client, macPlatformName)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -355,6 +361,9 @@ func (r *defaultPreventionPolicyMacResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getPreventionPolicy(ctx, r.client, state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -376,6 +385,9 @@ func (r *defaultPreventionPolicyMacResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var state defaultPreventionPolicyMacResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -11,6 +11,7 @@ import (
 	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
 	ioarulegroup "github.com/crowdstrike/terraform-provider-crowdstrike/internal/ioa_rule_group"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -37,72 +38,73 @@ type defaultPreventionPolicyWindowsResourceModel struct {
 	ID          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
 
-	Description                                types.String `tfsdk:"description"`
-	RuleGroups                                 types.Set    `tfsdk:"ioa_rule_groups"`
-	CloudAntiMalwareForMicrosoftOfficeFiles    types.Object `tfsdk:"cloud_anti_malware_microsoft_office_files"`
-	ExtendedUserModeDataSlider                 types.Object `tfsdk:"extended_user_mode_data"`
-	CloudBasedAnomalousProcessExecution        types.Object `tfsdk:"cloud_based_anomalous_process_execution"`
-	CloudAntiMalware                           types.Object `tfsdk:"cloud_anti_malware"`
-	AdwarePUP                                  types.Object `tfsdk:"adware_and_pup"`
-	OnSensorMLSlider                           types.Object `tfsdk:"sensor_anti_malware"`
-	OnSensorMLSliderForSensorEndUserScans      types.Object `tfsdk:"sensor_anti_malware_user_initiated"`
-	OnSensorMLSliderForCloudEndUserScans       types.Object `tfsdk:"cloud_anti_malware_user_initiated"`
-	CloudMLSliderForPupAdwareCloudEndUserScans types.Object `tfsdk:"cloud_adware_pup_user_initiated"`
-	AdditionalUserModeData                     types.Bool   `tfsdk:"additional_user_mode_data"`
-	EndUserNotifications                       types.Bool   `tfsdk:"notify_end_users"`
-	UnknownDetectionRelatedExecutables         types.Bool   `tfsdk:"upload_unknown_detection_related_executables"`
-	UnknownExecutables                         types.Bool   `tfsdk:"upload_unknown_executables"`
-	SensorTamperingProtection                  types.Bool   `tfsdk:"sensor_tampering_protection"`
-	InterpreterProtection                      types.Bool   `tfsdk:"interpreter_only"`
-	EngineProtectionV2                         types.Bool   `tfsdk:"engine_full_visibility"`
-	ScriptBasedExecutionMonitoring             types.Bool   `tfsdk:"script_based_execution_monitoring"`
-	HTTPDetections                             types.Bool   `tfsdk:"http_detections"`
-	RedactHTTPDetectionDetails                 types.Bool   `tfsdk:"redact_http_detection_details"`
-	HardwareEnhancedExploitDetection           types.Bool   `tfsdk:"hardware_enhanced_exploit_detection"`
-	EnhancedExploitationVisibility             types.Bool   `tfsdk:"enhanced_exploitation_visibility"`
-	DLLLoadVisibility                          types.Bool   `tfsdk:"enhanced_dll_load_visibility"`
-	MemoryScan                                 types.Bool   `tfsdk:"memory_scanning"`
-	CPUMemoryScan                              types.Bool   `tfsdk:"memory_scanning_scan_with_cpu"`
-	FirmwareAnalysisExtraction                 types.Bool   `tfsdk:"bios_deep_visibility"`
-	MLLargeFileHandling                        types.Bool   `tfsdk:"enhanced_ml_for_larger_files"`
-	USBInsertionTriggeredScan                  types.Bool   `tfsdk:"usb_insertion_triggered_scan"`
-	DetectOnWrite                              types.Bool   `tfsdk:"detect_on_write"`
-	QuarantineOnWrite                          types.Bool   `tfsdk:"quarantine_on_write"`
-	OnWriteScriptFileVisibility                types.Bool   `tfsdk:"on_write_script_file_visibility"`
-	NextGenAV                                  types.Bool   `tfsdk:"quarantine_and_security_center_registration"`
-	NextGenAVQuarantineOnRemovableMedia        types.Bool   `tfsdk:"quarantine_on_removable_media"`
-	MicrosoftOfficeFileSuspiciousMacroRemoval  types.Bool   `tfsdk:"microsoft_office_file_suspicious_macro_removal"`
-	CustomBlacklisting                         types.Bool   `tfsdk:"custom_blocking"`
-	PreventSuspiciousProcesses                 types.Bool   `tfsdk:"prevent_suspicious_processes"`
-	SuspiciousRegistryOperations               types.Bool   `tfsdk:"suspicious_registry_operations"`
-	MaliciousPowershell                        types.Bool   `tfsdk:"suspicious_scripts_and_commands"`
-	IntelPrevention                            types.Bool   `tfsdk:"intelligence_sourced_threats"`
-	SuspiciousKernelDrivers                    types.Bool   `tfsdk:"driver_load_prevention"`
-	VulnerableDriverProtection                 types.Bool   `tfsdk:"vulnerable_driver_protection"`
-	ForceASLR                                  types.Bool   `tfsdk:"force_aslr"`
-	ForceDEP                                   types.Bool   `tfsdk:"force_dep"`
-	HeapSprayPreallocation                     types.Bool   `tfsdk:"heap_spray_preallocation"`
-	NullPageAllocation                         types.Bool   `tfsdk:"null_page_allocation"`
-	SEHOverwriteProtection                     types.Bool   `tfsdk:"seh_overwrite_protection"`
-	BackupDeletion                             types.Bool   `tfsdk:"backup_deletion"`
-	Cryptowall                                 types.Bool   `tfsdk:"cryptowall"`
-	FileEncryption                             types.Bool   `tfsdk:"file_encryption"`
-	Locky                                      types.Bool   `tfsdk:"locky"`
-	FileSystemAccess                           types.Bool   `tfsdk:"file_system_access"`
-	VolumeShadowCopyAudit                      types.Bool   `tfsdk:"volume_shadow_copy_audit"`
-	VolumeShadowCopyProtect                    types.Bool   `tfsdk:"volume_shadow_copy_protect"`
-	ApplicationExploitationActivity            types.Bool   `tfsdk:"application_exploitation_activity"`
-	ChopperWebshell                            types.Bool   `tfsdk:"chopper_webshell"`
-	DriveByDownload                            types.Bool   `tfsdk:"drive_by_download"`
-	ProcessHollowing                           types.Bool   `tfsdk:"code_injection"`
-	JavaScriptViaRundll32                      types.Bool   `tfsdk:"javascript_via_rundll32"`
-	WindowsLogonBypassStickyKeys               types.Bool   `tfsdk:"windows_logon_bypass_sticky_keys"`
-	CredentialDumping                          types.Bool   `tfsdk:"credential_dumping"`
-	AutomatedRemediation                       types.Bool   `tfsdk:"advanced_remediation"`
-	FileSystemContainmentEnabled               types.Bool   `tfsdk:"file_system_containment"`
-	BootConfigurationDatabaseProtection        types.Bool   `tfsdk:"boot_configuration_database_protection"`
-	WSL2Visibility                             types.Bool   `tfsdk:"wsl2_visibility"`
-	SuspiciousFileAnalysis                     types.Bool   `tfsdk:"suspicious_file_analysis"`
+	Description                                types.String   `tfsdk:"description"`
+	RuleGroups                                 types.Set      `tfsdk:"ioa_rule_groups"`
+	CloudAntiMalwareForMicrosoftOfficeFiles    types.Object   `tfsdk:"cloud_anti_malware_microsoft_office_files"`
+	ExtendedUserModeDataSlider                 types.Object   `tfsdk:"extended_user_mode_data"`
+	CloudBasedAnomalousProcessExecution        types.Object   `tfsdk:"cloud_based_anomalous_process_execution"`
+	CloudAntiMalware                           types.Object   `tfsdk:"cloud_anti_malware"`
+	AdwarePUP                                  types.Object   `tfsdk:"adware_and_pup"`
+	OnSensorMLSlider                           types.Object   `tfsdk:"sensor_anti_malware"`
+	OnSensorMLSliderForSensorEndUserScans      types.Object   `tfsdk:"sensor_anti_malware_user_initiated"`
+	OnSensorMLSliderForCloudEndUserScans       types.Object   `tfsdk:"cloud_anti_malware_user_initiated"`
+	CloudMLSliderForPupAdwareCloudEndUserScans types.Object   `tfsdk:"cloud_adware_pup_user_initiated"`
+	AdditionalUserModeData                     types.Bool     `tfsdk:"additional_user_mode_data"`
+	EndUserNotifications                       types.Bool     `tfsdk:"notify_end_users"`
+	UnknownDetectionRelatedExecutables         types.Bool     `tfsdk:"upload_unknown_detection_related_executables"`
+	UnknownExecutables                         types.Bool     `tfsdk:"upload_unknown_executables"`
+	SensorTamperingProtection                  types.Bool     `tfsdk:"sensor_tampering_protection"`
+	InterpreterProtection                      types.Bool     `tfsdk:"interpreter_only"`
+	EngineProtectionV2                         types.Bool     `tfsdk:"engine_full_visibility"`
+	ScriptBasedExecutionMonitoring             types.Bool     `tfsdk:"script_based_execution_monitoring"`
+	HTTPDetections                             types.Bool     `tfsdk:"http_detections"`
+	RedactHTTPDetectionDetails                 types.Bool     `tfsdk:"redact_http_detection_details"`
+	HardwareEnhancedExploitDetection           types.Bool     `tfsdk:"hardware_enhanced_exploit_detection"`
+	EnhancedExploitationVisibility             types.Bool     `tfsdk:"enhanced_exploitation_visibility"`
+	DLLLoadVisibility                          types.Bool     `tfsdk:"enhanced_dll_load_visibility"`
+	MemoryScan                                 types.Bool     `tfsdk:"memory_scanning"`
+	CPUMemoryScan                              types.Bool     `tfsdk:"memory_scanning_scan_with_cpu"`
+	FirmwareAnalysisExtraction                 types.Bool     `tfsdk:"bios_deep_visibility"`
+	MLLargeFileHandling                        types.Bool     `tfsdk:"enhanced_ml_for_larger_files"`
+	USBInsertionTriggeredScan                  types.Bool     `tfsdk:"usb_insertion_triggered_scan"`
+	DetectOnWrite                              types.Bool     `tfsdk:"detect_on_write"`
+	QuarantineOnWrite                          types.Bool     `tfsdk:"quarantine_on_write"`
+	OnWriteScriptFileVisibility                types.Bool     `tfsdk:"on_write_script_file_visibility"`
+	NextGenAV                                  types.Bool     `tfsdk:"quarantine_and_security_center_registration"`
+	NextGenAVQuarantineOnRemovableMedia        types.Bool     `tfsdk:"quarantine_on_removable_media"`
+	MicrosoftOfficeFileSuspiciousMacroRemoval  types.Bool     `tfsdk:"microsoft_office_file_suspicious_macro_removal"`
+	CustomBlacklisting                         types.Bool     `tfsdk:"custom_blocking"`
+	PreventSuspiciousProcesses                 types.Bool     `tfsdk:"prevent_suspicious_processes"`
+	SuspiciousRegistryOperations               types.Bool     `tfsdk:"suspicious_registry_operations"`
+	MaliciousPowershell                        types.Bool     `tfsdk:"suspicious_scripts_and_commands"`
+	IntelPrevention                            types.Bool     `tfsdk:"intelligence_sourced_threats"`
+	SuspiciousKernelDrivers                    types.Bool     `tfsdk:"driver_load_prevention"`
+	VulnerableDriverProtection                 types.Bool     `tfsdk:"vulnerable_driver_protection"`
+	ForceASLR                                  types.Bool     `tfsdk:"force_aslr"`
+	ForceDEP                                   types.Bool     `tfsdk:"force_dep"`
+	HeapSprayPreallocation                     types.Bool     `tfsdk:"heap_spray_preallocation"`
+	NullPageAllocation                         types.Bool     `tfsdk:"null_page_allocation"`
+	SEHOverwriteProtection                     types.Bool     `tfsdk:"seh_overwrite_protection"`
+	BackupDeletion                             types.Bool     `tfsdk:"backup_deletion"`
+	Cryptowall                                 types.Bool     `tfsdk:"cryptowall"`
+	FileEncryption                             types.Bool     `tfsdk:"file_encryption"`
+	Locky                                      types.Bool     `tfsdk:"locky"`
+	FileSystemAccess                           types.Bool     `tfsdk:"file_system_access"`
+	VolumeShadowCopyAudit                      types.Bool     `tfsdk:"volume_shadow_copy_audit"`
+	VolumeShadowCopyProtect                    types.Bool     `tfsdk:"volume_shadow_copy_protect"`
+	ApplicationExploitationActivity            types.Bool     `tfsdk:"application_exploitation_activity"`
+	ChopperWebshell                            types.Bool     `tfsdk:"chopper_webshell"`
+	DriveByDownload                            types.Bool     `tfsdk:"drive_by_download"`
+	ProcessHollowing                           types.Bool     `tfsdk:"code_injection"`
+	JavaScriptViaRundll32                      types.Bool     `tfsdk:"javascript_via_rundll32"`
+	WindowsLogonBypassStickyKeys               types.Bool     `tfsdk:"windows_logon_bypass_sticky_keys"`
+	CredentialDumping                          types.Bool     `tfsdk:"credential_dumping"`
+	AutomatedRemediation                       types.Bool     `tfsdk:"advanced_remediation"`
+	FileSystemContainmentEnabled               types.Bool     `tfsdk:"file_system_containment"`
+	BootConfigurationDatabaseProtection        types.Bool     `tfsdk:"boot_configuration_database_protection"`
+	WSL2Visibility                             types.Bool     `tfsdk:"wsl2_visibility"`
+	SuspiciousFileAnalysis                     types.Bool     `tfsdk:"suspicious_file_analysis"`
+	Timeouts                                   timeouts.Value `tfsdk:"timeouts"`
 }
 
 // wrap transforms Go values to their terraform wrapped values.
@@ -528,6 +530,7 @@ func (r *defaultPreventionPolicyWindowsResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
 }
 
 func (r *defaultPreventionPolicyWindowsResource) Metadata(
@@ -539,11 +542,11 @@ func (r *defaultPreventionPolicyWindowsResource) Metadata(
 }
 
 func (r *defaultPreventionPolicyWindowsResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
-	resp.Schema = generateWindowsSchema(true)
+	resp.Schema = generateWindowsSchema(ctx, true)
 }
 
 func (r *defaultPreventionPolicyWindowsResource) Create(
@@ -558,6 +561,9 @@ func (r *defaultPreventionPolicyWindowsResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getDefaultPolicy(ctx, r.client, windowsPlatformName)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -617,6 +623,9 @@ func (r *defaultPreventionPolicyWindowsResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getPreventionPolicy(ctx, r.client, state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -638,6 +647,9 @@ func (r *defaultPreventionPolicyWindowsResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var state defaultPreventionPolicyWindowsResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -8,10 +8,13 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/client"
 	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,7 +25,9 @@ var (
 	_ resource.Resource                   = &preventionPolicyLinuxResource{}
 	_ resource.ResourceWithConfigure      = &preventionPolicyLinuxResource{}
 	_ resource.ResourceWithImportState    = &preventionPolicyLinuxResource{}
+	_ resource.ResourceWithIdentity       = &preventionPolicyLinuxResource{}
 	_ resource.ResourceWithValidateConfig = &preventionPolicyLinuxResource{}
+	_ resource.ResourceWithModifyPlan     = &preventionPolicyLinuxResource{}
 )
 
 // NewPreventionPolicyLinuxResource is a helper function to simplify the provider implementation.
@@ -32,41 +37,46 @@ func NewPreventionPolicyLinuxResource() resource.Resource {
 
 // preventionPolicyLinuxResource is the resource implementation.
 type preventionPolicyLinuxResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client         *client.CrowdStrikeAPISpecification
+	references     references.Validator
+	providerConfig config.ProviderConfig
 }
 
 // preventionPolicyLinuxResourceModel is the resource implementation.
 type preventionPolicyLinuxResourceModel struct {
-	ID                                   types.String `tfsdk:"id"`
-	Enabled                              types.Bool   `tfsdk:"enabled"`
-	Name                                 types.String `tfsdk:"name"`
-	Description                          types.String `tfsdk:"description"`
-	HostGroups                           types.Set    `tfsdk:"host_groups"`
-	RuleGroups                           types.Set    `tfsdk:"ioa_rule_groups"`
-	LastUpdated                          types.String `tfsdk:"last_updated"`
-	CloudAntiMalware                     types.Object `tfsdk:"cloud_anti_malware"`
-	OnSensorMLSlider                     types.Object `tfsdk:"sensor_anti_malware"`
-	UnknownDetectionRelatedExecutables   types.Bool   `tfsdk:"upload_unknown_detection_related_executables"`
-	UnknownExecutables                   types.Bool   `tfsdk:"upload_unknown_executables"`
-	ScriptBasedExecutionMonitoring       types.Bool   `tfsdk:"script_based_execution_monitoring"`
-	NextGenAV                            types.Bool   `tfsdk:"quarantine"`
-	CustomBlacklisting                   types.Bool   `tfsdk:"custom_blocking"`
-	PreventSuspiciousProcesses           types.Bool   `tfsdk:"prevent_suspicious_processes"`
-	DriftPrevention                      types.Bool   `tfsdk:"drift_prevention"`
-	FilesystemVisibility                 types.Bool   `tfsdk:"filesystem_visibility"`
-	NetworkVisibility                    types.Bool   `tfsdk:"network_visibility"`
-	HTTPVisibility                       types.Bool   `tfsdk:"http_visibility"`
-	FTPVisibility                        types.Bool   `tfsdk:"ftp_visibility"`
-	TLSVisibility                        types.Bool   `tfsdk:"tls_visibility"`
-	EmailProtocolVisibility              types.Bool   `tfsdk:"email_protocol_visibility"`
-	SensorTamperingProtection            types.Bool   `tfsdk:"sensor_tampering_protection"`
-	MemoryVisibility                     types.Bool   `tfsdk:"memory_visibility"`
-	OnWriteScriptFileVisibility          types.Bool   `tfsdk:"on_write_script_file_visibility"`
-	ExtendedCommandLineVisibility        types.Bool   `tfsdk:"extended_command_line_visibility"`
-	DBusVisibility                       types.Bool   `tfsdk:"dbus_visibility"`
-	EnhancePHPVisibility                 types.Bool   `tfsdk:"enhance_php_visibility"`
-	EnhanceEnvironmentVariableVisibility types.Bool   `tfsdk:"enhance_environment_variable_visibility"`
-	SuspiciousFileAnalysis               types.Bool   `tfsdk:"suspicious_file_analysis"`
+	ID                                   types.String   `tfsdk:"id"`
+	CID                                  types.String   `tfsdk:"cid"`
+	Enabled                              types.Bool     `tfsdk:"enabled"`
+	Name                                 types.String   `tfsdk:"name"`
+	Description                          types.String   `tfsdk:"description"`
+	HostGroups                           types.Set      `tfsdk:"host_groups"`
+	RuleGroups                           types.Set      `tfsdk:"ioa_rule_groups"`
+	LastUpdated                          types.String   `tfsdk:"last_updated"`
+	CloudAntiMalware                     types.Object   `tfsdk:"cloud_anti_malware"`
+	OnSensorMLSlider                     types.Object   `tfsdk:"sensor_anti_malware"`
+	UnknownDetectionRelatedExecutables   types.Bool     `tfsdk:"upload_unknown_detection_related_executables"`
+	UnknownExecutables                   types.Bool     `tfsdk:"upload_unknown_executables"`
+	ScriptBasedExecutionMonitoring       types.Bool     `tfsdk:"script_based_execution_monitoring"`
+	NextGenAV                            types.Bool     `tfsdk:"quarantine"`
+	CustomBlacklisting                   types.Bool     `tfsdk:"custom_blocking"`
+	PreventSuspiciousProcesses           types.Bool     `tfsdk:"prevent_suspicious_processes"`
+	DriftPrevention                      types.Bool     `tfsdk:"drift_prevention"`
+	FilesystemVisibility                 types.Bool     `tfsdk:"filesystem_visibility"`
+	NetworkVisibility                    types.Bool     `tfsdk:"network_visibility"`
+	HTTPVisibility                       types.Bool     `tfsdk:"http_visibility"`
+	FTPVisibility                        types.Bool     `tfsdk:"ftp_visibility"`
+	TLSVisibility                        types.Bool     `tfsdk:"tls_visibility"`
+	EmailProtocolVisibility              types.Bool     `tfsdk:"email_protocol_visibility"`
+	SensorTamperingProtection            types.Bool     `tfsdk:"sensor_tampering_protection"`
+	MemoryVisibility                     types.Bool     `tfsdk:"memory_visibility"`
+	OnWriteScriptFileVisibility          types.Bool     `tfsdk:"on_write_script_file_visibility"`
+	ExtendedCommandLineVisibility        types.Bool     `tfsdk:"extended_command_line_visibility"`
+	DBusVisibility                       types.Bool     `tfsdk:"dbus_visibility"`
+	EnhancePHPVisibility                 types.Bool     `tfsdk:"enhance_php_visibility"`
+	EnhanceEnvironmentVariableVisibility types.Bool     `tfsdk:"enhance_environment_variable_visibility"`
+	SuspiciousFileAnalysis               types.Bool     `tfsdk:"suspicious_file_analysis"`
+	CloneFromPolicyID                    types.String   `tfsdk:"clone_from_policy_id"`
+	Timeouts                             timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -94,6 +104,96 @@ func (r *preventionPolicyLinuxResource) Configure(
 	}
 
 	r.client = config.Client
+	r.providerConfig = config
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+// withCID returns a copy of the resource managing the prevention policies of the
+// member CID cid.
+func (r *preventionPolicyLinuxResource) withCID(cid types.String, diags *diag.Diagnostics) *preventionPolicyLinuxResource {
+	c, d := r.providerConfig.ClientForCID(cid)
+	diags.Append(d...)
+
+	withCID := *r
+	withCID.client = c
+	return &withCID
+}
+
+// ModifyPlan defers changes to the objects of a member CID that is not
+// available yet and validates that the objects referenced by the resource exist.
+func (r *preventionPolicyLinuxResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if r.providerConfig.DeferPlanForCID(ctx, req, resp) {
+		return
+	}
+
+	r.planClonedSettings(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cid types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cid"), &cid)...)
+	refs, diags := r.references.ForCID(cid)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(refs.Validate(
+		ctx,
+		req.Plan,
+		references.HostGroups(path.Root("host_groups")),
+		references.IOARuleGroups(path.Root("ioa_rule_groups")),
+	)...)
+}
+
+// planClonedSettings plans the settings not set in the configuration of a new
+// policy as the settings of the policy clone_from_policy_id, and keeps their
+// current values once the policy exists.
+func (r *preventionPolicyLinuxResource) planClonedSettings(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan preventionPolicyLinuxResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.CloneFromPolicyID.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planUnconfiguredSettings(ctx, req.Config, req.State, &resp.Plan)...)
+		return
+	}
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := getClonedPolicy(ctx, r.client, plan.CloneFromPolicyID, linuxPlatformName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.assignPreventionSettings(ctx, &plan, policy.PreventionSettings)...)
+	cloned := tfsdk.Plan{Schema: req.Plan.Schema, Raw: req.Plan.Raw.Copy()}
+	resp.Diagnostics.Append(cloned.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(planUnconfiguredSettings(ctx, req.Config, cloned, &resp.Plan)...)
 }
 
 // Metadata returns the resource type name.
@@ -107,11 +207,20 @@ func (r *preventionPolicyLinuxResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *preventionPolicyLinuxResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
-	resp.Schema = generateLinuxSchema(false)
+	resp.Schema = generateLinuxSchema(ctx, false)
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *preventionPolicyLinuxResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -127,6 +236,14 @@ func (r *preventionPolicyLinuxResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	preventionSettings, diagsGen := r.generatePreventionSettings(ctx, plan)
 	resp.Diagnostics.Append(diagsGen...)
 	if resp.Diagnostics.HasError() {
@@ -154,6 +271,7 @@ func (r *preventionPolicyLinuxResource) Create(
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -201,6 +319,7 @@ func (r *preventionPolicyLinuxResource) Create(
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -219,6 +338,14 @@ func (r *preventionPolicyLinuxResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	policy, diags := getPreventionPolicy(ctx, r.client, state.ID.ValueString())
 	for _, err := range diags.Errors() {
 		if err.Summary() == notFoundErrorSummary {
@@ -236,21 +363,7 @@ func (r *preventionPolicyLinuxResource) Read(
 		return
 	}
 
-	state.ID = types.StringValue(*policy.ID)
-	state.Name = types.StringValue(*policy.Name)
-	state.Description = types.StringValue(*policy.Description)
-	state.Enabled = types.BoolValue(*policy.Enabled)
-	resp.Diagnostics.Append(r.assignPreventionSettings(ctx, &state, policy.PreventionSettings)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(r.assignHostGroups(ctx, &state, policy.Groups)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(r.assignRuleGroups(ctx, &state, policy.IoaRuleGroups)...)
+	resp.Diagnostics.Append(r.wrap(ctx, &state, policy)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -258,6 +371,7 @@ func (r *preventionPolicyLinuxResource) Read(
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -277,6 +391,14 @@ func (r *preventionPolicyLinuxResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state preventionPolicyLinuxResourceModel
 	diags = req.State.Get(ctx, &state)
@@ -357,12 +479,14 @@ func (r *preventionPolicyLinuxResource) Update(
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -381,6 +505,14 @@ func (r *preventionPolicyLinuxResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 
 	resp.Diagnostics.Append(deletePreventionPolicy(ctx, r.client, id)...)
@@ -393,7 +525,7 @@ func (r *preventionPolicyLinuxResource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithCID(ctx, req, resp)
 }
 
 // ValidateConfig runs during validate, plan, and apply
@@ -435,6 +567,26 @@ func (r *preventionPolicyLinuxResource) ValidateConfig(
 	}
 }
 
+// wrap assigns the policy returned from the api into the resource model.
+func (r *preventionPolicyLinuxResource) wrap(
+	ctx context.Context,
+	state *preventionPolicyLinuxResourceModel,
+	policy *models.PreventionPolicyV1,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(*policy.ID)
+	state.Name = types.StringValue(*policy.Name)
+	state.Description = types.StringValue(*policy.Description)
+	state.Enabled = types.BoolValue(*policy.Enabled)
+
+	diags.Append(r.assignPreventionSettings(ctx, state, policy.PreventionSettings)...)
+	diags.Append(r.assignHostGroups(ctx, state, policy.Groups)...)
+	diags.Append(r.assignRuleGroups(ctx, state, policy.IoaRuleGroups)...)
+
+	return diags
+}
+
 // assignRuleGroups assigns the rule groups returned from the api into the resource model.
 func (r *preventionPolicyLinuxResource) assignRuleGroups(
 	ctx context.Context,
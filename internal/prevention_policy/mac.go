@@ -9,10 +9,13 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -23,7 +26,9 @@ var (
 	_ resource.Resource                   = &preventionPolicyMacResource{}
 	_ resource.ResourceWithConfigure      = &preventionPolicyMacResource{}
 	_ resource.ResourceWithImportState    = &preventionPolicyMacResource{}
+	_ resource.ResourceWithIdentity       = &preventionPolicyMacResource{}
 	_ resource.ResourceWithValidateConfig = &preventionPolicyMacResource{}
+	_ resource.ResourceWithModifyPlan     = &preventionPolicyMacResource{}
 )
 
 // NewPreventionPolicyMacResource is a helper function to simplify the provider implementation.
@@ -33,38 +38,43 @@ func NewPreventionPolicyMacResource() resource.Resource {
 
 // preventionPolicyMacResource is the resource implementation.
 type preventionPolicyMacResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client         *client.CrowdStrikeAPISpecification
+	references     references.Validator
+	providerConfig config.ProviderConfig
 }
 
 // preventionPolicyMacResourceModel is the resource implementation.
 type preventionPolicyMacResourceModel struct {
-	ID                                 types.String `tfsdk:"id"`
-	Enabled                            types.Bool   `tfsdk:"enabled"`
-	Name                               types.String `tfsdk:"name"`
-	Description                        types.String `tfsdk:"description"`
-	HostGroups                         types.Set    `tfsdk:"host_groups"`
-	RuleGroups                         types.Set    `tfsdk:"ioa_rule_groups"`
-	LastUpdated                        types.String `tfsdk:"last_updated"`
-	CloudAntiMalware                   types.Object `tfsdk:"cloud_anti_malware"`
-	AdwarePUP                          types.Object `tfsdk:"cloud_adware_and_pup"`
-	OnSensorMLSlider                   types.Object `tfsdk:"sensor_anti_malware"`
-	EndUserNotifications               types.Bool   `tfsdk:"notify_end_users"`
-	UnknownDetectionRelatedExecutables types.Bool   `tfsdk:"upload_unknown_detection_related_executables"`
-	UnknownExecutables                 types.Bool   `tfsdk:"upload_unknown_executables"`
-	SensorTamperingProtection          types.Bool   `tfsdk:"sensor_tampering_protection"`
-	ScriptBasedExecutionMonitoring     types.Bool   `tfsdk:"script_based_execution_monitoring"`
-	DetectOnWrite                      types.Bool   `tfsdk:"detect_on_write"`
-	QuarantineOnWrite                  types.Bool   `tfsdk:"quarantine_on_write"`
-	NextGenAV                          types.Bool   `tfsdk:"quarantine"`
-	CustomBlacklisting                 types.Bool   `tfsdk:"custom_blocking"`
-	PreventSuspiciousProcesses         types.Bool   `tfsdk:"prevent_suspicious_processes"`
-	IntelPrevention                    types.Bool   `tfsdk:"intelligence_sourced_threats"`
-	ChopperWebshell                    types.Bool   `tfsdk:"chopper_webshell"`
-	OnSensorMLAdwarePUPSlider          types.Object `tfsdk:"sensor_adware_and_pup"`
-	XPCOMShell                         types.Bool   `tfsdk:"xpcom_shell"`
-	EmpyreBackdoor                     types.Bool   `tfsdk:"empyre_backdoor"`
-	KcPasswordDecoded                  types.Bool   `tfsdk:"kc_password_decoded"`
-	HashCollector                      types.Bool   `tfsdk:"hash_collector"`
+	ID                                 types.String   `tfsdk:"id"`
+	CID                                types.String   `tfsdk:"cid"`
+	Enabled                            types.Bool     `tfsdk:"enabled"`
+	Name                               types.String   `tfsdk:"name"`
+	Description                        types.String   `tfsdk:"description"`
+	HostGroups                         types.Set      `tfsdk:"host_groups"`
+	RuleGroups                         types.Set      `tfsdk:"ioa_rule_groups"`
+	LastUpdated                        types.String   `tfsdk:"last_updated"`
+	CloudAntiMalware                   types.Object   `tfsdk:"cloud_anti_malware"`
+	AdwarePUP                          types.Object   `tfsdk:"cloud_adware_and_pup"`
+	OnSensorMLSlider                   types.Object   `tfsdk:"sensor_anti_malware"`
+	EndUserNotifications               types.Bool     `tfsdk:"notify_end_users"`
+	UnknownDetectionRelatedExecutables types.Bool     `tfsdk:"upload_unknown_detection_related_executables"`
+	UnknownExecutables                 types.Bool     `tfsdk:"upload_unknown_executables"`
+	SensorTamperingProtection          types.Bool     `tfsdk:"sensor_tampering_protection"`
+	ScriptBasedExecutionMonitoring     types.Bool     `tfsdk:"script_based_execution_monitoring"`
+	DetectOnWrite                      types.Bool     `tfsdk:"detect_on_write"`
+	QuarantineOnWrite                  types.Bool     `tfsdk:"quarantine_on_write"`
+	NextGenAV                          types.Bool     `tfsdk:"quarantine"`
+	CustomBlacklisting                 types.Bool     `tfsdk:"custom_blocking"`
+	PreventSuspiciousProcesses         types.Bool     `tfsdk:"prevent_suspicious_processes"`
+	IntelPrevention                    types.Bool     `tfsdk:"intelligence_sourced_threats"`
+	ChopperWebshell                    types.Bool     `tfsdk:"chopper_webshell"`
+	OnSensorMLAdwarePUPSlider          types.Object   `tfsdk:"sensor_adware_and_pup"`
+	XPCOMShell                         types.Bool     `tfsdk:"xpcom_shell"`
+	EmpyreBackdoor                     types.Bool     `tfsdk:"empyre_backdoor"`
+	KcPasswordDecoded                  types.Bool     `tfsdk:"kc_password_decoded"`
+	HashCollector                      types.Bool     `tfsdk:"hash_collector"`
+	CloneFromPolicyID                  types.String   `tfsdk:"clone_from_policy_id"`
+	Timeouts                           timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -92,6 +102,96 @@ func (r *preventionPolicyMacResource) Configure(
 	}
 
 	r.client = config.Client
+	r.providerConfig = config
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+// withCID returns a copy of the resource managing the prevention policies of the
+// member CID cid.
+func (r *preventionPolicyMacResource) withCID(cid types.String, diags *diag.Diagnostics) *preventionPolicyMacResource {
+	c, d := r.providerConfig.ClientForCID(cid)
+	diags.Append(d...)
+
+	withCID := *r
+	withCID.client = c
+	return &withCID
+}
+
+// ModifyPlan defers changes to the objects of a member CID that is not
+// available yet and validates that the objects referenced by the resource exist.
+func (r *preventionPolicyMacResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if r.providerConfig.DeferPlanForCID(ctx, req, resp) {
+		return
+	}
+
+	r.planClonedSettings(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cid types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cid"), &cid)...)
+	refs, diags := r.references.ForCID(cid)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(refs.Validate(
+		ctx,
+		req.Plan,
+		references.HostGroups(path.Root("host_groups")),
+		references.IOARuleGroups(path.Root("ioa_rule_groups")),
+	)...)
+}
+
+// planClonedSettings plans the settings not set in the configuration of a new
+// policy as the settings of the policy clone_from_policy_id, and keeps their
+// current values once the policy exists.
+func (r *preventionPolicyMacResource) planClonedSettings(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan preventionPolicyMacResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.CloneFromPolicyID.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planUnconfiguredSettings(ctx, req.Config, req.State, &resp.Plan)...)
+		return
+	}
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := getClonedPolicy(ctx, r.client, plan.CloneFromPolicyID, macPlatformName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.assignPreventionSettings(ctx, &plan, policy.PreventionSettings)...)
+	cloned := tfsdk.Plan{Schema: req.Plan.Schema, Raw: req.Plan.Raw.Copy()}
+	resp.Diagnostics.Append(cloned.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(planUnconfiguredSettings(ctx, req.Config, cloned, &resp.Plan)...)
 }
 
 // Metadata returns the resource type name.
@@ -105,11 +205,20 @@ func (r *preventionPolicyMacResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *preventionPolicyMacResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
-	resp.Schema = generateMacSchema(false)
+	resp.Schema = generateMacSchema(ctx, false)
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *preventionPolicyMacResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -125,6 +234,14 @@ func (r *preventionPolicyMacResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	preventionSettings, diagsGen := r.generatePreventionSettings(ctx, plan)
 	resp.Diagnostics.Append(diagsGen...)
 	if resp.Diagnostics.HasError() {
@@ -152,6 +269,7 @@ func (r *preventionPolicyMacResource) Create(
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -199,6 +317,7 @@ func (r *preventionPolicyMacResource) Create(
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -217,6 +336,14 @@ func (r *preventionPolicyMacResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	policy, diags := getPreventionPolicy(ctx, r.client, state.ID.ValueString())
 	for _, err := range diags.Errors() {
 		if err.Summary() == notFoundErrorSummary {
@@ -234,21 +361,7 @@ func (r *preventionPolicyMacResource) Read(
 		return
 	}
 
-	state.ID = types.StringValue(*policy.ID)
-	state.Name = types.StringValue(*policy.Name)
-	state.Description = types.StringValue(*policy.Description)
-	state.Enabled = types.BoolValue(*policy.Enabled)
-	resp.Diagnostics.Append(r.assignPreventionSettings(ctx, &state, policy.PreventionSettings)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(r.assignHostGroups(ctx, &state, policy.Groups)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(r.assignRuleGroups(ctx, &state, policy.IoaRuleGroups)...)
+	resp.Diagnostics.Append(r.wrap(ctx, &state, policy)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -256,6 +369,7 @@ func (r *preventionPolicyMacResource) Read(
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -275,6 +389,14 @@ func (r *preventionPolicyMacResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state preventionPolicyMacResourceModel
 	diags = req.State.Get(ctx, &state)
@@ -355,12 +477,14 @@ func (r *preventionPolicyMacResource) Update(
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -379,6 +503,14 @@ func (r *preventionPolicyMacResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 
 	resp.Diagnostics.Append(deletePreventionPolicy(ctx, r.client, id)...)
@@ -391,7 +523,7 @@ func (r *preventionPolicyMacResource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithCID(ctx, req, resp)
 }
 
 // ValidateConfig runs during validate, plan, and apply
@@ -471,6 +603,26 @@ func (r *preventionPolicyMacResource) ValidateConfig(
 	}
 }
 
+// wrap assigns the policy returned from the api into the resource model.
+func (r *preventionPolicyMacResource) wrap(
+	ctx context.Context,
+	state *preventionPolicyMacResourceModel,
+	policy *models.PreventionPolicyV1,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(*policy.ID)
+	state.Name = types.StringValue(*policy.Name)
+	state.Description = types.StringValue(*policy.Description)
+	state.Enabled = types.BoolValue(*policy.Enabled)
+
+	diags.Append(r.assignPreventionSettings(ctx, state, policy.PreventionSettings)...)
+	diags.Append(r.assignHostGroups(ctx, state, policy.Groups)...)
+	diags.Append(r.assignRuleGroups(ctx, state, policy.IoaRuleGroups)...)
+
+	return diags
+}
+
 // assignRuleGroups assigns the rule groups returned from the api into the resource model.
 func (r *preventionPolicyMacResource) assignRuleGroups(
 	ctx context.Context,
@@ -3,6 +3,7 @@ package preventionpolicy
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/crowdstrike/gofalcon/falcon/client"
@@ -11,6 +12,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
 	ioarulegroup "github.com/crowdstrike/terraform-provider-crowdstrike/internal/ioa_rule_group"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -20,12 +22,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
 	dataSourceDocumentationSection = "Prevention Policy"
 	dataSourceMarkdownDescription  = "This data source provides information about prevention policies in Falcon."
+	// preventionPoliciesQueryLimit is the page size used when querying
+	// prevention policies, and the largest page size accepted by the API.
+	preventionPoliciesQueryLimit = 5000
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -58,6 +62,7 @@ func (d *preventionPoliciesDataSource) Configure(
 	}
 
 	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(dataSourceApiScopes)...)
 }
 
 // preventionPoliciesDataSource is the data source implementation.
@@ -99,6 +104,8 @@ type preventionPoliciesDataSourceModel struct {
 	Filter       types.String `tfsdk:"filter"`
 	IDs          types.List   `tfsdk:"ids"`
 	Sort         types.String `tfsdk:"sort"`
+	Limit        types.Int64  `tfsdk:"limit"`
+	MaxItems     types.Int64  `tfsdk:"max_items"`
 	Name         types.String `tfsdk:"name"`
 	Description  types.String `tfsdk:"description"`
 	Enabled      types.Bool   `tfsdk:"enabled"`
@@ -210,6 +217,8 @@ func (d *preventionPoliciesDataSource) Schema(
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"limit":     utils.PageLimitAttribute("prevention policies", preventionPoliciesQueryLimit, preventionPoliciesQueryLimit),
+			"max_items": utils.MaxItemsAttribute("prevention policies"),
 			"name": schema.StringAttribute{
 				Optional:    true,
 				Description: "Filter policies by name. All provided filter attributes must match for a policy to be returned (omitted attributes are ignored). Supports wildcard matching with '*' where '*' matches any sequence of characters until the end of the string or until the next literal character in the pattern is found. Multiple wildcards can be used in a single pattern. Matching is case insensitive. Cannot be used together with 'filter' or 'ids'.",
@@ -336,85 +345,48 @@ func (d *preventionPoliciesDataSource) ValidateConfig(ctx context.Context, req d
 	}
 }
 
-// getPreventionPolicies returns all prevention policies matching filter.
+// getPreventionPolicies returns up to pagination.MaxItems prevention policies
+// matching filter in the order of sort, and the total number of matching
+// policies.
 func (d *preventionPoliciesDataSource) getPreventionPolicies(
 	ctx context.Context,
 	filter string,
 	sort string,
-) ([]*models.PreventionPolicyV1, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	var allPolicies []*models.PreventionPolicyV1
-
-	tflog.Debug(
-		ctx,
-		"[datasource] Getting all prevention policies",
-	)
+	pagination utils.Pagination,
+) ([]*models.PreventionPolicyV1, int64, diag.Diagnostics) {
+	return utils.QueryPages(pagination, func(offset, limit int64) ([]*models.PreventionPolicyV1, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
 
-	limit := int64(5000)
-	offset := int64(0)
-
-	for {
 		params := &prevention_policies.QueryCombinedPreventionPoliciesParams{
 			Context: ctx,
 			Limit:   &limit,
 			Offset:  &offset,
 		}
-
 		if filter != "" {
 			params.Filter = &filter
 		}
-
 		if sort != "" {
 			params.Sort = &sort
 		}
 
 		res, err := d.client.PreventionPolicies.QueryCombinedPreventionPolicies(params)
 		if err != nil {
-			diags.AddError(
-				"Failed to query prevention policies",
-				fmt.Sprintf("Failed to query prevention policies: %s", err.Error()),
-			)
-			return allPolicies, diags
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, dataSourceApiScopes))
+			return nil, 0, diags
 		}
 
-		if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 {
-			tflog.Debug(ctx, "[datasource] No more prevention policies to retrieve",
-				map[string]interface{}{
-					"total_retrieved": len(allPolicies),
-				})
-			break
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
 		}
 
-		allPolicies = append(allPolicies, res.Payload.Resources...)
-		tflog.Debug(ctx, "[datasource] Retrieved page of prevention policies",
-			map[string]interface{}{
-				"page_count":  len(res.Payload.Resources),
-				"total_count": len(allPolicies),
-				"offset":      offset,
-			})
-
-		if res.Payload.Meta == nil || res.Payload.Meta.Pagination == nil ||
-			res.Payload.Meta.Pagination.Offset == nil || res.Payload.Meta.Pagination.Total == nil {
-			tflog.Warn(ctx, "Missing pagination metadata in API response, using offset+limit for next page",
-				map[string]interface{}{
-					"meta": res.Payload.Meta,
-				})
-			offset += limit
-			continue
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
 		}
 
-		offset = int64(*res.Payload.Meta.Pagination.Offset)
-		if offset >= *res.Payload.Meta.Pagination.Total {
-			tflog.Info(ctx, "[datasource] Pagination complete",
-				map[string]interface{}{
-					"total_retrieved": len(allPolicies),
-					"total_available": *res.Payload.Meta.Pagination.Total,
-				})
-			break
-		}
-	}
-
-	return allPolicies, diags
+		return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+	})
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -429,7 +401,17 @@ func (d *preventionPoliciesDataSource) Read(
 		return
 	}
 
-	policies, diags := d.getPreventionPolicies(ctx, data.Filter.ValueString(), data.Sort.ValueString())
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, preventionPoliciesQueryLimit)
+
+	// ids and the individual filter attributes are matched against every
+	// policy, so max_items applies to the matching policies.
+	matchAll := utils.IsKnown(data.IDs) || data.hasIndividualFilters()
+	query := pagination
+	if matchAll {
+		query.MaxItems = math.MaxInt64
+	}
+
+	policies, total, diags := d.getPreventionPolicies(ctx, data.Filter.ValueString(), data.Sort.ValueString(), query)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -448,6 +430,12 @@ func (d *preventionPoliciesDataSource) Read(
 		policies = filterPoliciesByAttributes(policies, &data)
 	}
 
+	if matchAll {
+		policies, total = utils.Truncate(policies, pagination.MaxItems)
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("prevention policies", len(policies), total)...)
+
 	resp.Diagnostics.Append(data.wrap(ctx, policies)...)
 	if resp.Diagnostics.HasError() {
 		return
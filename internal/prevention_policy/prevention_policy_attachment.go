@@ -11,8 +11,10 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
 	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
 	ioarulegroup "github.com/crowdstrike/terraform-provider-crowdstrike/internal/ioa_rule_group"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -32,11 +34,13 @@ var (
 	_ resource.ResourceWithConfigure      = &preventionPolicyAttachmentResource{}
 	_ resource.ResourceWithImportState    = &preventionPolicyAttachmentResource{}
 	_ resource.ResourceWithValidateConfig = &preventionPolicyAttachmentResource{}
+	_ resource.ResourceWithModifyPlan     = &preventionPolicyAttachmentResource{}
+	_ resource.ResourceWithMoveState      = &preventionPolicyAttachmentResource{}
 )
 
 var (
 	documentationSection        string         = "Prevention Policy"
-	resourceMarkdownDescription string         = "This resource allows managing the host groups and ioa rule groups attached to a prevention policy. By default (when `exclusive` is true), this resource takes exclusive ownership over the host groups and ioa rule groups assigned to a prevention policy. When `exclusive` is false, this resource only manages the specific host groups and ioa rule groups defined in the configuration. If you want to fully create or manage a prevention policy please use the `prevention_policy_*` resource for the platform you want to manage."
+	resourceMarkdownDescription string         = "This resource allows managing the host groups and ioa rule groups attached to a prevention policy. By default (when `exclusive` is true), this resource takes exclusive ownership over the host groups and ioa rule groups assigned to a prevention policy. When `exclusive` is false, this resource only manages the specific host groups and ioa rule groups defined in the configuration. If you want to fully create or manage a prevention policy please use the `prevention_policy_*` resource for the platform you want to manage. A `crowdstrike_prevention_policy_*` resource can be moved to this resource with a `moved` block to take over its attachments without recreating the policy; the attachment starts with `exclusive` set to true."
 	requiredScopes              []scopes.Scope = apiScopes
 )
 
@@ -45,15 +49,17 @@ func NewPreventionPolicyAttachmentResource() resource.Resource {
 }
 
 type preventionPolicyAttachmentResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client     *client.CrowdStrikeAPISpecification
+	references references.Validator
 }
 
 type preventionPolicyAttachmentResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	HostGroups  types.Set    `tfsdk:"host_groups"`
-	RuleGroups  types.Set    `tfsdk:"ioa_rule_groups"`
-	Exclusive   types.Bool   `tfsdk:"exclusive"`
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	HostGroups  types.Set      `tfsdk:"host_groups"`
+	RuleGroups  types.Set      `tfsdk:"ioa_rule_groups"`
+	Exclusive   types.Bool     `tfsdk:"exclusive"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // wrap transforms Go values to their terraform wrapped values.
@@ -174,6 +180,23 @@ func (r *preventionPolicyAttachmentResource) Configure(
 	}
 
 	r.client = config.Client
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(requiredScopes)...)
+}
+
+// ModifyPlan validates that the objects referenced by the resource exist.
+func (r *preventionPolicyAttachmentResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	resp.Diagnostics.Append(r.references.Validate(
+		ctx,
+		req.Plan,
+		references.PreventionPolicy(path.Root("id")),
+		references.HostGroups(path.Root("host_groups")),
+		references.IOARuleGroups(path.Root("ioa_rule_groups")),
+	)...)
 }
 
 func (r *preventionPolicyAttachmentResource) Metadata(
@@ -185,7 +208,7 @@ func (r *preventionPolicyAttachmentResource) Metadata(
 }
 
 func (r *preventionPolicyAttachmentResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -236,6 +259,9 @@ func (r *preventionPolicyAttachmentResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -250,6 +276,9 @@ func (r *preventionPolicyAttachmentResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getPreventionPolicy(ctx, r.client, plan.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -317,6 +346,9 @@ func (r *preventionPolicyAttachmentResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getPreventionPolicy(ctx, r.client, state.ID.ValueString())
 	for _, err := range diags.Errors() {
 		if err.Summary() == notFoundErrorSummary {
@@ -354,6 +386,9 @@ func (r *preventionPolicyAttachmentResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	planHostGroups := plan.HostGroups
 	planRuleGroups := plan.RuleGroups
 
@@ -455,6 +490,9 @@ func (r *preventionPolicyAttachmentResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	emptySet := basetypes.SetValue{}
 
 	resp.Diagnostics.Append(
@@ -463,6 +501,32 @@ func (r *preventionPolicyAttachmentResource) Delete(
 		syncRuleGroups(ctx, r.client, emptySet, state.RuleGroups, state.ID.ValueString())...)
 }
 
+// MoveState allows moving a `crowdstrike_prevention_policy_windows`,
+// `crowdstrike_prevention_policy_linux` or `crowdstrike_prevention_policy_mac`
+// resource to this resource with a moved block, so the prevention policy is not
+// destroyed and recreated when its attachments are managed separately.
+func (r *preventionPolicyAttachmentResource) MoveState(
+	_ context.Context,
+) []resource.StateMover {
+	return []resource.StateMover{
+		utils.AttachmentStateMover(
+			"crowdstrike_prevention_policy_windows",
+			utils.MovedGroups{Source: "host_groups", Target: "host_groups"},
+			utils.MovedGroups{Source: "ioa_rule_groups", Target: "ioa_rule_groups"},
+		),
+		utils.AttachmentStateMover(
+			"crowdstrike_prevention_policy_linux",
+			utils.MovedGroups{Source: "host_groups", Target: "host_groups"},
+			utils.MovedGroups{Source: "ioa_rule_groups", Target: "ioa_rule_groups"},
+		),
+		utils.AttachmentStateMover(
+			"crowdstrike_prevention_policy_mac",
+			utils.MovedGroups{Source: "host_groups", Target: "host_groups"},
+			utils.MovedGroups{Source: "ioa_rule_groups", Target: "ioa_rule_groups"},
+		),
+	}
+}
+
 func (r *preventionPolicyAttachmentResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
@@ -0,0 +1,64 @@
+package preventionpolicy
+
+import (
+	"context"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/prevention_policies"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/ids"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// NewPreventionPolicyIDsDataSource is a helper function to simplify the provider implementation.
+func NewPreventionPolicyIDsDataSource() datasource.DataSource {
+	return ids.NewDataSource(ids.Options{
+		TypeName: "_prevention_policy_ids",
+		Section:  dataSourceDocumentationSection,
+		Objects:  "prevention policies",
+		Description: "This data source returns the IDs of all prevention policies matching an optional FQL filter. " +
+			"Use it with a `for_each` import block to adopt existing prevention policies as `crowdstrike_prevention_policy_*` resources. " +
+			"Filter on `platform_name` to select the policies of the platform being imported, and exclude the default policies with `name.raw:!'platform_default'` as they are managed by the `crowdstrike_default_prevention_policy_*` resources.",
+		Example:      "platform_name:'Windows'+name.raw:!'platform_default'",
+		Scopes:       dataSourceApiScopes,
+		SortExample:  "precedence.asc",
+		DefaultLimit: 5000,
+		MaxLimit:     5000,
+		Query: func(
+			ctx context.Context,
+			client *client.CrowdStrikeAPISpecification,
+			filter *string,
+			sort *string,
+		) utils.QueryPage[string] {
+			return func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+				var diags diag.Diagnostics
+
+				res, err := client.PreventionPolicies.QueryPreventionPolicies(&prevention_policies.QueryPreventionPoliciesParams{
+					Context: ctx,
+					Filter:  filter,
+					Sort:    sort,
+					Limit:   &limit,
+					Offset:  &offset,
+				})
+				if err != nil {
+					diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, dataSourceApiScopes))
+					return nil, 0, diags
+				}
+
+				if res == nil || res.Payload == nil {
+					diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+					return nil, 0, diags
+				}
+
+				if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+					diags.Append(diag)
+					return nil, 0, diags
+				}
+
+				return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+			}
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package preventionpolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPreventionPolicyIDsDataSource(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.crowdstrike_prevention_policy_ids.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_prevention_policy_windows" "test" {
+  count           = 3
+  name            = "%[1]s-${count.index}"
+  enabled         = false
+  description     = "made with terraform"
+  host_groups     = []
+  ioa_rule_groups = []
+}
+
+data "crowdstrike_prevention_policy_ids" "test" {
+  filter = "platform_name:'Windows'+name:['%[1]s-0','%[1]s-1','%[1]s-2']"
+  sort   = "name.asc"
+  limit  = 2
+
+  depends_on = [crowdstrike_prevention_policy_windows.test]
+}
+`, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "3"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "crowdstrike_prevention_policy_windows.test.0", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.1", "crowdstrike_prevention_policy_windows.test.1", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.2", "crowdstrike_prevention_policy_windows.test.2", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+package preventionpolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/prevention_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/listresource"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewPreventionPolicyWindowsListResource is a helper function to simplify the provider implementation.
+func NewPreventionPolicyWindowsListResource() list.ListResource {
+	r := &preventionPolicyWindowsResource{}
+	return newPreventionPolicyListResource(windowsPlatformName, r.wrap)
+}
+
+// NewPreventionPolicyLinuxListResource is a helper function to simplify the provider implementation.
+func NewPreventionPolicyLinuxListResource() list.ListResource {
+	r := &preventionPolicyLinuxResource{}
+	return newPreventionPolicyListResource(linuxPlatformName, r.wrap)
+}
+
+// NewPreventionPolicyMacListResource is a helper function to simplify the provider implementation.
+func NewPreventionPolicyMacListResource() list.ListResource {
+	r := &preventionPolicyMacResource{}
+	return newPreventionPolicyListResource(macPlatformName, r.wrap)
+}
+
+// newPreventionPolicyListResource returns a list resource for the prevention
+// policies of a platform. Default policies are managed by their own resources
+// and are not listed.
+func newPreventionPolicyListResource[M any](
+	platformName string,
+	wrap func(context.Context, *M, *models.PreventionPolicyV1) diag.Diagnostics,
+) list.ListResource {
+	return listresource.New(listresource.Options[*models.PreventionPolicyV1, M]{
+		TypeName: "_prevention_policy_" + strings.ToLower(platformName),
+		Objects:  platformName + " prevention policies",
+		Filter:   fmt.Sprintf(`platform_name:'%s'+name.raw:!'platform_default'`, platformName),
+		Scopes:   dataSourceApiScopes,
+		Query:    queryPreventionPolicies,
+		ID:       func(policy *models.PreventionPolicyV1) *string { return policy.ID },
+		Name:     func(policy *models.PreventionPolicyV1) *string { return policy.Name },
+		Wrap:     wrap,
+	})
+}
+
+// queryPreventionPolicies fetches a page of the prevention policies matching filter.
+func queryPreventionPolicies(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	filter *string,
+	offset int64,
+) (*listresource.Page[*models.PreventionPolicyV1], error) {
+	res, err := client.PreventionPolicies.QueryCombinedPreventionPolicies(
+		&prevention_policies.QueryCombinedPreventionPoliciesParams{
+			Context: ctx,
+			Filter:  filter,
+			Limit:   utils.Addr(utils.ListPageSize),
+			Offset:  &offset,
+		},
+	)
+	if err != nil || res == nil || res.Payload == nil {
+		return nil, err
+	}
+
+	return &listresource.Page[*models.PreventionPolicyV1]{
+		Resources: res.Payload.Resources,
+		Meta:      res.Payload.Meta,
+		Errors:    res.Payload.Errors,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package preventionpolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccPreventionPolicyWindowsListResource(t *testing.T) {
+	rName := acctest.RandomResourceName()
+	listName := "crowdstrike_prevention_policy_windows.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreventionPolicyWindowsListResourceConfig(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccPreventionPolicyWindowsListResourceQuery(rName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(listName, 1),
+					querycheck.ExpectResourceDisplayName(
+						listName,
+						queryfilter.ByDisplayName(knownvalue.StringExact(rName)),
+						knownvalue.StringExact(rName),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccPreventionPolicyWindowsListResourceConfig(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_prevention_policy_windows" "test" {
+  name            = %[1]q
+  enabled         = false
+  description     = "made with terraform"
+  host_groups     = []
+  ioa_rule_groups = []
+}
+`, rName)
+}
+
+func testAccPreventionPolicyWindowsListResourceQuery(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+list "crowdstrike_prevention_policy_windows" "test" {
+  provider = crowdstrike
+
+  config {
+    filter = "name:'%s'"
+  }
+}
+`, rName)
+}
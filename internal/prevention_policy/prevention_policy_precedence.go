@@ -12,6 +12,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -47,10 +48,11 @@ type preventionPolicyPrecedenceResource struct {
 }
 
 type preventionPolicyPrecedenceResourceModel struct {
-	IDs          types.List   `tfsdk:"ids"`
-	Enforcement  types.String `tfsdk:"enforcement"`
-	PlatformName types.String `tfsdk:"platform_name"`
-	LastUpdated  types.String `tfsdk:"last_updated"`
+	IDs          types.List     `tfsdk:"ids"`
+	Enforcement  types.String   `tfsdk:"enforcement"`
+	PlatformName types.String   `tfsdk:"platform_name"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (d *preventionPolicyPrecedenceResourceModel) wrap(
@@ -94,6 +96,7 @@ func (r *preventionPolicyPrecedenceResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(precedenceRequiredScopes)...)
 }
 
 func (r *preventionPolicyPrecedenceResource) Metadata(
@@ -105,7 +108,7 @@ func (r *preventionPolicyPrecedenceResource) Metadata(
 }
 
 func (r *preventionPolicyPrecedenceResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -144,6 +147,9 @@ func (r *preventionPolicyPrecedenceResource) Schema(
 				Description: "Timestamp of the last Terraform update of the resource.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -158,6 +164,9 @@ func (r *preventionPolicyPrecedenceResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var planPolicyIDs []string
 	resp.Diagnostics.Append(plan.IDs.ElementsAs(ctx, &planPolicyIDs, false)...)
 	if resp.Diagnostics.HasError() {
@@ -212,6 +221,9 @@ func (r *preventionPolicyPrecedenceResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policies, diags := r.getPreventionPoliciesByPrecedence(ctx, state.PlatformName.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -239,6 +251,9 @@ func (r *preventionPolicyPrecedenceResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var planPolicyIDs []string
 	resp.Diagnostics.Append(plan.IDs.ElementsAs(ctx, &planPolicyIDs, false)...)
 	if resp.Diagnostics.HasError() {
@@ -1,6 +1,7 @@
 package preventionpolicy
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -196,8 +197,11 @@ type apiMlSlider struct {
 }
 
 // generateWindowsSchema generates the schema.Schema for the windows prevention policy.
-func generateWindowsSchema(defaultPolicy bool) schema.Schema {
+func generateWindowsSchema(ctx context.Context, defaultPolicy bool) schema.Schema {
 	windowsSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -429,6 +433,8 @@ func generateWindowsSchema(defaultPolicy bool) schema.Schema {
 			scopes.GenerateScopeDescription(apiScopes),
 		)
 
+		windowsSchema.Attributes["cid"] = utils.CIDAttribute("prevention policy")
+
 		windowsSchema.Attributes["name"] = schema.StringAttribute{
 			Required:    true,
 			Description: "Name of the prevention policy.",
@@ -446,14 +452,19 @@ func generateWindowsSchema(defaultPolicy bool) schema.Schema {
 			ElementType: types.StringType,
 			Description: "Host Group ids to attach to the prevention policy.",
 		}
+
+		windowsSchema.Attributes["clone_from_policy_id"] = cloneFromPolicyIDAttribute()
 	}
 
 	return windowsSchema
 }
 
 // generateMacSchema generates the schema.Schema for the mac prevention policy.
-func generateMacSchema(defaultPolicy bool) schema.Schema {
+func generateMacSchema(ctx context.Context, defaultPolicy bool) schema.Schema {
 	macSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -547,6 +558,8 @@ func generateMacSchema(defaultPolicy bool) schema.Schema {
 			scopes.GenerateScopeDescription(apiScopes),
 		)
 
+		macSchema.Attributes["cid"] = utils.CIDAttribute("prevention policy")
+
 		macSchema.Attributes["name"] = schema.StringAttribute{
 			Required:    true,
 			Description: "Name of the prevention policy.",
@@ -564,14 +577,19 @@ func generateMacSchema(defaultPolicy bool) schema.Schema {
 			ElementType: types.StringType,
 			Description: "Host Group ids to attach to the prevention policy.",
 		}
+
+		macSchema.Attributes["clone_from_policy_id"] = cloneFromPolicyIDAttribute()
 	}
 
 	return macSchema
 }
 
 // generateLinuxSchema generates the schema.Schema for the linux prevention policy.
-func generateLinuxSchema(defaultPolicy bool) schema.Schema {
+func generateLinuxSchema(ctx context.Context, defaultPolicy bool) schema.Schema {
 	linuxSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -676,6 +694,8 @@ func generateLinuxSchema(defaultPolicy bool) schema.Schema {
 			scopes.GenerateScopeDescription(apiScopes),
 		)
 
+		linuxSchema.Attributes["cid"] = utils.CIDAttribute("prevention policy")
+
 		linuxSchema.Attributes["name"] = schema.StringAttribute{
 			Required:    true,
 			Description: "Name of the prevention policy.",
@@ -693,6 +713,8 @@ func generateLinuxSchema(defaultPolicy bool) schema.Schema {
 			ElementType: types.StringType,
 			Description: "Host Group ids to attach to the prevention policy.",
 		}
+
+		linuxSchema.Attributes["clone_from_policy_id"] = cloneFromPolicyIDAttribute()
 	}
 
 	return linuxSchema
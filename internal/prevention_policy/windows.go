@@ -9,10 +9,13 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -23,7 +26,9 @@ var (
 	_ resource.Resource                   = &preventionPolicyWindowsResource{}
 	_ resource.ResourceWithConfigure      = &preventionPolicyWindowsResource{}
 	_ resource.ResourceWithImportState    = &preventionPolicyWindowsResource{}
+	_ resource.ResourceWithIdentity       = &preventionPolicyWindowsResource{}
 	_ resource.ResourceWithValidateConfig = &preventionPolicyWindowsResource{}
+	_ resource.ResourceWithModifyPlan     = &preventionPolicyWindowsResource{}
 )
 
 // NewPreventionPolicyWindowsResource is a helper function to simplify the provider implementation.
@@ -33,82 +38,87 @@ func NewPreventionPolicyWindowsResource() resource.Resource {
 
 // preventionPolicyWindowsResource is the resource implementation.
 type preventionPolicyWindowsResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client         *client.CrowdStrikeAPISpecification
+	references     references.Validator
+	providerConfig config.ProviderConfig
 }
 
 // preventionPolicyWindowsResourceModel is the resource implementation.
 type preventionPolicyWindowsResourceModel struct {
-	ID                                         types.String `tfsdk:"id"`
-	Enabled                                    types.Bool   `tfsdk:"enabled"`
-	Name                                       types.String `tfsdk:"name"`
-	Description                                types.String `tfsdk:"description"`
-	HostGroups                                 types.Set    `tfsdk:"host_groups"`
-	RuleGroups                                 types.Set    `tfsdk:"ioa_rule_groups"`
-	LastUpdated                                types.String `tfsdk:"last_updated"`
-	CloudAntiMalwareForMicrosoftOfficeFiles    types.Object `tfsdk:"cloud_anti_malware_microsoft_office_files"`
-	ExtendedUserModeDataSlider                 types.Object `tfsdk:"extended_user_mode_data"`
-	CloudBasedAnomalousProcessExecution        types.Object `tfsdk:"cloud_based_anomalous_process_execution"`
-	CloudAntiMalware                           types.Object `tfsdk:"cloud_anti_malware"`
-	AdwarePUP                                  types.Object `tfsdk:"adware_and_pup"`
-	OnSensorMLSlider                           types.Object `tfsdk:"sensor_anti_malware"`
-	OnSensorMLSliderForSensorEndUserScans      types.Object `tfsdk:"sensor_anti_malware_user_initiated"`
-	OnSensorMLSliderForCloudEndUserScans       types.Object `tfsdk:"cloud_anti_malware_user_initiated"`
-	CloudMLSliderForPupAdwareCloudEndUserScans types.Object `tfsdk:"cloud_adware_pup_user_initiated"`
-	AdditionalUserModeData                     types.Bool   `tfsdk:"additional_user_mode_data"`
-	EndUserNotifications                       types.Bool   `tfsdk:"notify_end_users"`
-	UnknownDetectionRelatedExecutables         types.Bool   `tfsdk:"upload_unknown_detection_related_executables"`
-	UnknownExecutables                         types.Bool   `tfsdk:"upload_unknown_executables"`
-	SensorTamperingProtection                  types.Bool   `tfsdk:"sensor_tampering_protection"`
-	InterpreterProtection                      types.Bool   `tfsdk:"interpreter_only"`
-	EngineProtectionV2                         types.Bool   `tfsdk:"engine_full_visibility"`
-	ScriptBasedExecutionMonitoring             types.Bool   `tfsdk:"script_based_execution_monitoring"`
-	HTTPDetections                             types.Bool   `tfsdk:"http_detections"`
-	RedactHTTPDetectionDetails                 types.Bool   `tfsdk:"redact_http_detection_details"`
-	HardwareEnhancedExploitDetection           types.Bool   `tfsdk:"hardware_enhanced_exploit_detection"`
-	EnhancedExploitationVisibility             types.Bool   `tfsdk:"enhanced_exploitation_visibility"`
-	DLLLoadVisibility                          types.Bool   `tfsdk:"enhanced_dll_load_visibility"`
-	MemoryScan                                 types.Bool   `tfsdk:"memory_scanning"`
-	CPUMemoryScan                              types.Bool   `tfsdk:"memory_scanning_scan_with_cpu"`
-	FirmwareAnalysisExtraction                 types.Bool   `tfsdk:"bios_deep_visibility"`
-	MLLargeFileHandling                        types.Bool   `tfsdk:"enhanced_ml_for_larger_files"`
-	USBInsertionTriggeredScan                  types.Bool   `tfsdk:"usb_insertion_triggered_scan"`
-	DetectOnWrite                              types.Bool   `tfsdk:"detect_on_write"`
-	QuarantineOnWrite                          types.Bool   `tfsdk:"quarantine_on_write"`
-	OnWriteScriptFileVisibility                types.Bool   `tfsdk:"on_write_script_file_visibility"`
-	NextGenAV                                  types.Bool   `tfsdk:"quarantine_and_security_center_registration"`
-	NextGenAVQuarantineOnRemovableMedia        types.Bool   `tfsdk:"quarantine_on_removable_media"`
-	MicrosoftOfficeFileSuspiciousMacroRemoval  types.Bool   `tfsdk:"microsoft_office_file_suspicious_macro_removal"`
-	CustomBlacklisting                         types.Bool   `tfsdk:"custom_blocking"`
-	PreventSuspiciousProcesses                 types.Bool   `tfsdk:"prevent_suspicious_processes"`
-	SuspiciousRegistryOperations               types.Bool   `tfsdk:"suspicious_registry_operations"`
-	MaliciousPowershell                        types.Bool   `tfsdk:"suspicious_scripts_and_commands"`
-	IntelPrevention                            types.Bool   `tfsdk:"intelligence_sourced_threats"`
-	SuspiciousKernelDrivers                    types.Bool   `tfsdk:"driver_load_prevention"`
-	VulnerableDriverProtection                 types.Bool   `tfsdk:"vulnerable_driver_protection"`
-	ForceASLR                                  types.Bool   `tfsdk:"force_aslr"`
-	ForceDEP                                   types.Bool   `tfsdk:"force_dep"`
-	HeapSprayPreallocation                     types.Bool   `tfsdk:"heap_spray_preallocation"`
-	NullPageAllocation                         types.Bool   `tfsdk:"null_page_allocation"`
-	SEHOverwriteProtection                     types.Bool   `tfsdk:"seh_overwrite_protection"`
-	BackupDeletion                             types.Bool   `tfsdk:"backup_deletion"`
-	Cryptowall                                 types.Bool   `tfsdk:"cryptowall"`
-	FileEncryption                             types.Bool   `tfsdk:"file_encryption"`
-	Locky                                      types.Bool   `tfsdk:"locky"`
-	FileSystemAccess                           types.Bool   `tfsdk:"file_system_access"`
-	VolumeShadowCopyAudit                      types.Bool   `tfsdk:"volume_shadow_copy_audit"`
-	VolumeShadowCopyProtect                    types.Bool   `tfsdk:"volume_shadow_copy_protect"`
-	ApplicationExploitationActivity            types.Bool   `tfsdk:"application_exploitation_activity"`
-	ChopperWebshell                            types.Bool   `tfsdk:"chopper_webshell"`
-	DriveByDownload                            types.Bool   `tfsdk:"drive_by_download"`
-	ProcessHollowing                           types.Bool   `tfsdk:"code_injection"`
-	JavaScriptViaRundll32                      types.Bool   `tfsdk:"javascript_via_rundll32"`
-	WindowsLogonBypassStickyKeys               types.Bool   `tfsdk:"windows_logon_bypass_sticky_keys"`
-	CredentialDumping                          types.Bool   `tfsdk:"credential_dumping"`
-	AutomatedRemediation                       types.Bool   `tfsdk:"advanced_remediation"`
-	FileSystemContainmentEnabled               types.Bool   `tfsdk:"file_system_containment"`
-	BootConfigurationDatabaseProtection        types.Bool   `tfsdk:"boot_configuration_database_protection"`
-	WSL2Visibility                             types.Bool   `tfsdk:"wsl2_visibility"`
-	SuspiciousFileAnalysis                     types.Bool   `tfsdk:"suspicious_file_analysis"`
+	ID                                         types.String   `tfsdk:"id"`
+	CID                                        types.String   `tfsdk:"cid"`
+	Enabled                                    types.Bool     `tfsdk:"enabled"`
+	Name                                       types.String   `tfsdk:"name"`
+	Description                                types.String   `tfsdk:"description"`
+	HostGroups                                 types.Set      `tfsdk:"host_groups"`
+	RuleGroups                                 types.Set      `tfsdk:"ioa_rule_groups"`
+	LastUpdated                                types.String   `tfsdk:"last_updated"`
+	CloudAntiMalwareForMicrosoftOfficeFiles    types.Object   `tfsdk:"cloud_anti_malware_microsoft_office_files"`
+	ExtendedUserModeDataSlider                 types.Object   `tfsdk:"extended_user_mode_data"`
+	CloudBasedAnomalousProcessExecution        types.Object   `tfsdk:"cloud_based_anomalous_process_execution"`
+	CloudAntiMalware                           types.Object   `tfsdk:"cloud_anti_malware"`
+	AdwarePUP                                  types.Object   `tfsdk:"adware_and_pup"`
+	OnSensorMLSlider                           types.Object   `tfsdk:"sensor_anti_malware"`
+	OnSensorMLSliderForSensorEndUserScans      types.Object   `tfsdk:"sensor_anti_malware_user_initiated"`
+	OnSensorMLSliderForCloudEndUserScans       types.Object   `tfsdk:"cloud_anti_malware_user_initiated"`
+	CloudMLSliderForPupAdwareCloudEndUserScans types.Object   `tfsdk:"cloud_adware_pup_user_initiated"`
+	AdditionalUserModeData                     types.Bool     `tfsdk:"additional_user_mode_data"`
+	EndUserNotifications                       types.Bool     `tfsdk:"notify_end_users"`
+	UnknownDetectionRelatedExecutables         types.Bool     `tfsdk:"upload_unknown_detection_related_executables"`
+	UnknownExecutables                         types.Bool     `tfsdk:"upload_unknown_executables"`
+	SensorTamperingProtection                  types.Bool     `tfsdk:"sensor_tampering_protection"`
+	InterpreterProtection                      types.Bool     `tfsdk:"interpreter_only"`
+	EngineProtectionV2                         types.Bool     `tfsdk:"engine_full_visibility"`
+	ScriptBasedExecutionMonitoring             types.Bool     `tfsdk:"script_based_execution_monitoring"`
+	HTTPDetections                             types.Bool     `tfsdk:"http_detections"`
+	RedactHTTPDetectionDetails                 types.Bool     `tfsdk:"redact_http_detection_details"`
+	HardwareEnhancedExploitDetection           types.Bool     `tfsdk:"hardware_enhanced_exploit_detection"`
+	EnhancedExploitationVisibility             types.Bool     `tfsdk:"enhanced_exploitation_visibility"`
+	DLLLoadVisibility                          types.Bool     `tfsdk:"enhanced_dll_load_visibility"`
+	MemoryScan                                 types.Bool     `tfsdk:"memory_scanning"`
+	CPUMemoryScan                              types.Bool     `tfsdk:"memory_scanning_scan_with_cpu"`
+	FirmwareAnalysisExtraction                 types.Bool     `tfsdk:"bios_deep_visibility"`
+	MLLargeFileHandling                        types.Bool     `tfsdk:"enhanced_ml_for_larger_files"`
+	USBInsertionTriggeredScan                  types.Bool     `tfsdk:"usb_insertion_triggered_scan"`
+	DetectOnWrite                              types.Bool     `tfsdk:"detect_on_write"`
+	QuarantineOnWrite                          types.Bool     `tfsdk:"quarantine_on_write"`
+	OnWriteScriptFileVisibility                types.Bool     `tfsdk:"on_write_script_file_visibility"`
+	NextGenAV                                  types.Bool     `tfsdk:"quarantine_and_security_center_registration"`
+	NextGenAVQuarantineOnRemovableMedia        types.Bool     `tfsdk:"quarantine_on_removable_media"`
+	MicrosoftOfficeFileSuspiciousMacroRemoval  types.Bool     `tfsdk:"microsoft_office_file_suspicious_macro_removal"`
+	CustomBlacklisting                         types.Bool     `tfsdk:"custom_blocking"`
+	PreventSuspiciousProcesses                 types.Bool     `tfsdk:"prevent_suspicious_processes"`
+	SuspiciousRegistryOperations               types.Bool     `tfsdk:"suspicious_registry_operations"`
+	MaliciousPowershell                        types.Bool     `tfsdk:"suspicious_scripts_and_commands"`
+	IntelPrevention                            types.Bool     `tfsdk:"intelligence_sourced_threats"`
+	SuspiciousKernelDrivers                    types.Bool     `tfsdk:"driver_load_prevention"`
+	VulnerableDriverProtection                 types.Bool     `tfsdk:"vulnerable_driver_protection"`
+	ForceASLR                                  types.Bool     `tfsdk:"force_aslr"`
+	ForceDEP                                   types.Bool     `tfsdk:"force_dep"`
+	HeapSprayPreallocation                     types.Bool     `tfsdk:"heap_spray_preallocation"`
+	NullPageAllocation                         types.Bool     `tfsdk:"null_page_allocation"`
+	SEHOverwriteProtection                     types.Bool     `tfsdk:"seh_overwrite_protection"`
+	BackupDeletion                             types.Bool     `tfsdk:"backup_deletion"`
+	Cryptowall                                 types.Bool     `tfsdk:"cryptowall"`
+	FileEncryption                             types.Bool     `tfsdk:"file_encryption"`
+	Locky                                      types.Bool     `tfsdk:"locky"`
+	FileSystemAccess                           types.Bool     `tfsdk:"file_system_access"`
+	VolumeShadowCopyAudit                      types.Bool     `tfsdk:"volume_shadow_copy_audit"`
+	VolumeShadowCopyProtect                    types.Bool     `tfsdk:"volume_shadow_copy_protect"`
+	ApplicationExploitationActivity            types.Bool     `tfsdk:"application_exploitation_activity"`
+	ChopperWebshell                            types.Bool     `tfsdk:"chopper_webshell"`
+	DriveByDownload                            types.Bool     `tfsdk:"drive_by_download"`
+	ProcessHollowing                           types.Bool     `tfsdk:"code_injection"`
+	JavaScriptViaRundll32                      types.Bool     `tfsdk:"javascript_via_rundll32"`
+	WindowsLogonBypassStickyKeys               types.Bool     `tfsdk:"windows_logon_bypass_sticky_keys"`
+	CredentialDumping                          types.Bool     `tfsdk:"credential_dumping"`
+	AutomatedRemediation                       types.Bool     `tfsdk:"advanced_remediation"`
+	FileSystemContainmentEnabled               types.Bool     `tfsdk:"file_system_containment"`
+	BootConfigurationDatabaseProtection        types.Bool     `tfsdk:"boot_configuration_database_protection"`
+	WSL2Visibility                             types.Bool     `tfsdk:"wsl2_visibility"`
+	SuspiciousFileAnalysis                     types.Bool     `tfsdk:"suspicious_file_analysis"`
+	CloneFromPolicyID                          types.String   `tfsdk:"clone_from_policy_id"`
+	Timeouts                                   timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -136,6 +146,96 @@ func (r *preventionPolicyWindowsResource) Configure(
 	}
 
 	r.client = config.Client
+	r.providerConfig = config
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+// withCID returns a copy of the resource managing the prevention policies of the
+// member CID cid.
+func (r *preventionPolicyWindowsResource) withCID(cid types.String, diags *diag.Diagnostics) *preventionPolicyWindowsResource {
+	c, d := r.providerConfig.ClientForCID(cid)
+	diags.Append(d...)
+
+	withCID := *r
+	withCID.client = c
+	return &withCID
+}
+
+// ModifyPlan defers changes to the objects of a member CID that is not
+// available yet and validates that the objects referenced by the resource exist.
+func (r *preventionPolicyWindowsResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if r.providerConfig.DeferPlanForCID(ctx, req, resp) {
+		return
+	}
+
+	r.planClonedSettings(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cid types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cid"), &cid)...)
+	refs, diags := r.references.ForCID(cid)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(refs.Validate(
+		ctx,
+		req.Plan,
+		references.HostGroups(path.Root("host_groups")),
+		references.IOARuleGroups(path.Root("ioa_rule_groups")),
+	)...)
+}
+
+// planClonedSettings plans the settings not set in the configuration of a new
+// policy as the settings of the policy clone_from_policy_id, and keeps their
+// current values once the policy exists.
+func (r *preventionPolicyWindowsResource) planClonedSettings(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan preventionPolicyWindowsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.CloneFromPolicyID.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planUnconfiguredSettings(ctx, req.Config, req.State, &resp.Plan)...)
+		return
+	}
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := getClonedPolicy(ctx, r.client, plan.CloneFromPolicyID, windowsPlatformName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.assignPreventionSettings(ctx, &plan, policy.PreventionSettings)...)
+	cloned := tfsdk.Plan{Schema: req.Plan.Schema, Raw: req.Plan.Raw.Copy()}
+	resp.Diagnostics.Append(cloned.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(planUnconfiguredSettings(ctx, req.Config, cloned, &resp.Plan)...)
 }
 
 // Metadata returns the resource type name.
@@ -149,11 +249,20 @@ func (r *preventionPolicyWindowsResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *preventionPolicyWindowsResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
-	resp.Schema = generateWindowsSchema(false)
+	resp.Schema = generateWindowsSchema(ctx, false)
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *preventionPolicyWindowsResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -169,6 +278,14 @@ func (r *preventionPolicyWindowsResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	preventionSettings, diagsGen := r.generatePreventionSettings(ctx, plan)
 	resp.Diagnostics.Append(diagsGen...)
 	if resp.Diagnostics.HasError() {
@@ -196,6 +313,7 @@ func (r *preventionPolicyWindowsResource) Create(
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -239,6 +357,7 @@ func (r *preventionPolicyWindowsResource) Create(
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -257,6 +376,14 @@ func (r *preventionPolicyWindowsResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	policy, diags := getPreventionPolicy(ctx, r.client, state.ID.ValueString())
 	for _, err := range diags.Errors() {
 		if err.Summary() == notFoundErrorSummary {
@@ -274,18 +401,7 @@ func (r *preventionPolicyWindowsResource) Read(
 		return
 	}
 
-	state.ID = types.StringValue(*policy.ID)
-	state.Name = types.StringValue(*policy.Name)
-	state.Description = types.StringValue(*policy.Description)
-	state.Enabled = types.BoolValue(*policy.Enabled)
-	resp.Diagnostics.Append(r.assignPreventionSettings(ctx, &state, policy.PreventionSettings)...)
-
-	resp.Diagnostics.Append(r.assignHostGroups(ctx, &state, policy.Groups)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(r.assignRuleGroups(ctx, &state, policy.IoaRuleGroups)...)
+	resp.Diagnostics.Append(r.wrap(ctx, &state, policy)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -293,6 +409,7 @@ func (r *preventionPolicyWindowsResource) Read(
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -312,6 +429,14 @@ func (r *preventionPolicyWindowsResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state preventionPolicyWindowsResourceModel
 	diags = req.State.Get(ctx, &state)
@@ -389,12 +514,14 @@ func (r *preventionPolicyWindowsResource) Update(
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -413,6 +540,14 @@ func (r *preventionPolicyWindowsResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 
 	resp.Diagnostics.Append(deletePreventionPolicy(ctx, r.client, id)...)
@@ -425,7 +560,7 @@ func (r *preventionPolicyWindowsResource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithCID(ctx, req, resp)
 }
 
 // ValidateConfig runs during validate, plan, and apply
@@ -692,6 +827,26 @@ func (r *preventionPolicyWindowsResource) ValidateConfig(
 	}
 }
 
+// wrap assigns the policy returned from the api into the resource model.
+func (r *preventionPolicyWindowsResource) wrap(
+	ctx context.Context,
+	state *preventionPolicyWindowsResourceModel,
+	policy *models.PreventionPolicyV1,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(*policy.ID)
+	state.Name = types.StringValue(*policy.Name)
+	state.Description = types.StringValue(*policy.Description)
+	state.Enabled = types.BoolValue(*policy.Enabled)
+
+	diags.Append(r.assignPreventionSettings(ctx, state, policy.PreventionSettings)...)
+	diags.Append(r.assignHostGroups(ctx, state, policy.Groups)...)
+	diags.Append(r.assignRuleGroups(ctx, state, policy.IoaRuleGroups)...)
+
+	return diags
+}
+
 // assignRuleGroups assigns the rule groups returned from the api into the resource model.
 func (r *preventionPolicyWindowsResource) assignRuleGroups(
 	ctx context.Context,
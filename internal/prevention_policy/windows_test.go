@@ -342,3 +342,70 @@ func TestAccPreventionPolicyWindowsResource(t *testing.T) {
 		},
 	})
 }
+
+func testAccPreventionPolicyWindowsConfig_clone(rName string, clone bool) string {
+	config := acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_prevention_policy_windows" "source" {
+  name                           = "%[1]s-source"
+  enabled                        = false
+  host_groups                    = []
+  ioa_rule_groups                = []
+  description                    = "made with terraform"
+  additional_user_mode_data      = true
+  suspicious_registry_operations = true
+  cloud_based_anomalous_process_execution = {
+    detection = "MODERATE"
+  }
+}
+`, rName)
+
+	if clone {
+		config += fmt.Sprintf(`
+resource "crowdstrike_prevention_policy_windows" "test" {
+  name                           = "%[1]s-clone"
+  enabled                        = false
+  host_groups                    = []
+  ioa_rule_groups                = []
+  description                    = "made with terraform"
+  clone_from_policy_id           = crowdstrike_prevention_policy_windows.source.id
+  suspicious_registry_operations = false
+}
+`, rName)
+	}
+
+	return config
+}
+
+func TestAccPreventionPolicyWindowsResource_clone(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "crowdstrike_prevention_policy_windows.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			// The source policy must exist before the clone is planned.
+			{
+				Config: testAccPreventionPolicyWindowsConfig_clone(rName, false),
+			},
+			{
+				Config: testAccPreventionPolicyWindowsConfig_clone(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						resourceName,
+						"clone_from_policy_id",
+						"crowdstrike_prevention_policy_windows.source",
+						"id",
+					),
+					resource.TestCheckResourceAttr(resourceName, "additional_user_mode_data", "true"),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"cloud_based_anomalous_process_execution.detection",
+						"MODERATE",
+					),
+					resource.TestCheckResourceAttr(resourceName, "suspicious_registry_operations", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon"
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/mssp"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/transport"
+	httpruntime "github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"golang.org/x/oauth2"
+)
+
+// newFalconClient creates the CrowdStrike API client. It mirrors falcon.NewClient,
+// but authenticates through a single token cache shared by every request, which
+// refreshes the token before it expires and recovers from rejected tokens.
+//
+// Requests are sent through base, and retried according to retryConfig. The
+// cloud is discovered when ac.Cloud is falcon.CloudAutoDiscover, and a token is
+// requested before returning so invalid credentials, or credentials belonging to
+// a different cloud, are reported when the provider is configured. When
+// ac.AccessToken is set, it authenticates every request instead of the client
+// credentials. The API scopes granted to the API client are returned with the
+// client, or nil when the token does not list them.
+func newFalconClient(
+	ac *falcon.ApiConfig,
+	base http.RoundTripper,
+	retryConfig transport.RetryConfig,
+) (*client.CrowdStrikeAPISpecification, []string, error) {
+	headers := transport.NewHeaderTransport(base, ac.UserAgent(), falcon.Version.String())
+
+	var cache *transport.TokenCache
+	if ac.AccessToken != "" {
+		cache = staticTokenCache(ac.AccessToken)
+	} else {
+		var err error
+		cache, err = clientCredentialsTokenCache(ac, headers, retryConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	token, err := cache.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Requests are logged before they are authenticated so the logs never
+	// contain the access token.
+	var rt http.RoundTripper = transport.NewAuthTransport(headers, cache)
+	rt = transport.NewLoggingTransport(rt)
+	rt = transport.NewRetryTransport(rt, retryConfig)
+
+	httpClient := &http.Client{
+		Transport: rt,
+		Timeout:   ac.HttpTimeout(),
+	}
+
+	runtime := httptransport.NewWithClient(ac.Host(), ac.BasePath(), []string{}, httpClient)
+	runtime.Consumers["application/pdf"] = httpruntime.ByteStreamConsumer()
+	runtime.Consumers["application/x-7z-compressed"] = httpruntime.ByteStreamConsumer()
+
+	return client.New(runtime, strfmt.Default), grantedScopes(token), nil
+}
+
+// staticTokenCache returns a token cache always returning the pre-issued
+// accessToken. The token cannot be refreshed, so requests fail with the API
+// error once it expires or is revoked.
+func staticTokenCache(accessToken string) *transport.TokenCache {
+	token := &oauth2.Token{AccessToken: accessToken, TokenType: "Bearer"}
+	return transport.NewTokenCache(func() (*oauth2.Token, error) {
+		return token, nil
+	}, transport.DefaultTokenRefreshBefore)
+}
+
+// clientCredentialsTokenCache returns a token cache requesting tokens with the
+// client credentials of ac, discovering the cloud first when ac.Cloud is
+// falcon.CloudAutoDiscover.
+func clientCredentialsTokenCache(
+	ac *falcon.ApiConfig,
+	headers http.RoundTripper,
+	retryConfig transport.RetryConfig,
+) (*transport.TokenCache, error) {
+	// Token requests are not logged as they contain the client secret.
+	tokens := tokenClient{
+		httpClient: &http.Client{
+			Transport: transport.NewRetryTransport(headers, retryConfig),
+			Timeout:   ac.HttpTimeout(),
+		},
+		clientID:     ac.ClientId,
+		clientSecret: ac.ClientSecret,
+		memberCID:    ac.MemberCID,
+	}
+
+	var initialToken *oauth2.Token
+	if ac.HostOverride == "" && ac.Cloud == falcon.CloudAutoDiscover {
+		cloud, token, err := discoverCloud(ac.Context, tokens, cloudBaseURL)
+		if err != nil {
+			return nil, err
+		}
+		ac.Cloud = cloud
+		initialToken = token
+	}
+
+	return transport.NewTokenCache(func() (*oauth2.Token, error) {
+		if initialToken != nil {
+			token := initialToken
+			initialToken = nil
+			return token, nil
+		}
+
+		token, region, err := tokens.token(ac.Context, "https://"+ac.Host())
+		if err != nil {
+			var tokenErr *tokenError
+			if ac.HostOverride == "" && errors.As(err, &tokenErr) &&
+				(tokenErr.StatusCode == http.StatusUnauthorized || tokenErr.StatusCode == http.StatusForbidden) {
+				return nil, fmt.Errorf(
+					"%w. Ensure the client ID and client secret are valid, and that cloud is set to the cloud the API client was created in (currently %s)",
+					err, ac.Cloud)
+			}
+			return nil, err
+		}
+
+		if ac.HostOverride == "" {
+			if err := checkCloudRegion(ac.Cloud, region); err != nil {
+				tokens.revoke(ac.Context, "https://"+ac.Host(), token.AccessToken)
+				return nil, err
+			}
+		}
+
+		return token, nil
+	}, transport.DefaultTokenRefreshBefore), nil
+}
+
+// cloudBaseURL returns the base URL of the API of cloud.
+func cloudBaseURL(cloud falcon.CloudType) string {
+	return "https://" + cloud.Host()
+}
+
+// childCIDNotFound reports whether the Flight Control parent CID of parent has
+// no child CID cid. It reports false when the children of parent cannot be
+// looked up, so only member CIDs confirmed to be missing are reported as such.
+func childCIDNotFound(parent *client.CrowdStrikeAPISpecification, cid string) bool {
+	// CIDs are accepted with or without their checksum suffix.
+	cid, _, _ = strings.Cut(strings.ToLower(cid), "-")
+
+	params := mssp.NewGetChildrenV2Params()
+	params.SetBody(&models.MsaspecIdsRequest{Ids: []string{cid}})
+
+	ok, multi, err := parent.Mssp.GetChildrenV2(params)
+	if err != nil {
+		return false
+	}
+
+	var children []*models.DomainChildLink
+	if ok != nil && ok.Payload != nil {
+		children = ok.Payload.Resources
+	} else if multi != nil && multi.Payload != nil {
+		children = multi.Payload.Resources
+	}
+
+	for _, child := range children {
+		if child != nil && child.ChildCid != nil && strings.EqualFold(*child.ChildCid, cid) {
+			return false
+		}
+	}
+
+	return true
+}
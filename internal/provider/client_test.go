@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/crowdstrike/gofalcon/falcon"
+	"github.com/crowdstrike/gofalcon/falcon/client/host_group"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFalconClient_AccessToken(t *testing.T) {
+	var tokenRequests, unauthorized atomic.Int32
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/oauth2/") {
+			tokenRequests.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer pre-issued" {
+			unauthorized.Add(1)
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors":[{"code":401,"message":"access denied, invalid bearer token"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"meta":{"pagination":{"total":1}},"resources":["hg1"]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	query := func(token string) error {
+		ac := &falcon.ApiConfig{
+			AccessToken:  token,
+			Cloud:        falcon.CloudAutoDiscover,
+			HostOverride: strings.TrimPrefix(srv.URL, "https://"),
+			Context:      context.Background(),
+		}
+		c, _, err := newFalconClient(ac, srv.Client().Transport, transport.RetryConfig{})
+		if err != nil {
+			return err
+		}
+
+		_, err = c.HostGroup.QueryHostGroups(&host_group.QueryHostGroupsParams{Context: context.Background()})
+		return err
+	}
+
+	require.NoError(t, query("pre-issued"))
+	assert.Zero(t, tokenRequests.Load())
+	assert.Zero(t, unauthorized.Load())
+
+	require.Error(t, query("expired"))
+	assert.Zero(t, tokenRequests.Load())
+	assert.Equal(t, int32(1), unauthorized.Load(), "a rejected pre-issued token is not retried")
+}
+
+func TestChildCIDNotFound(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(string(body), "forbidden"):
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":[{"code":403,"message":"access denied, authorization failed"}]}`))
+		case strings.Contains(string(body), "child"):
+			_, _ = w.Write([]byte(`{"resources":[{"child_cid":"child","parent_cid":"parent"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"resources":[]}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	ac := &falcon.ApiConfig{
+		AccessToken:  "pre-issued",
+		Cloud:        falcon.CloudAutoDiscover,
+		HostOverride: strings.TrimPrefix(srv.URL, "https://"),
+		Context:      context.Background(),
+	}
+	c, _, err := newFalconClient(ac, srv.Client().Transport, transport.RetryConfig{})
+	require.NoError(t, err)
+
+	assert.False(t, childCIDNotFound(c, "child"))
+	assert.False(t, childCIDNotFound(c, "CHILD-A1"), "the checksum suffix is ignored")
+	assert.False(t, childCIDNotFound(c, "forbidden"), "a failed lookup does not report the CID missing")
+	assert.True(t, childCIDNotFound(c, "missing"))
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/crowdstrike/gofalcon/falcon"
+	"golang.org/x/oauth2"
+)
+
+// regionHeader is the token response header naming the cloud the credentials belong to.
+const regionHeader = "X-Cs-Region"
+
+// tokenClient requests OAuth2 tokens for a CrowdStrike API client.
+type tokenClient struct {
+	httpClient   *http.Client
+	clientID     string
+	clientSecret string
+	memberCID    string
+}
+
+// tokenError is an error response from the token endpoint.
+type tokenError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *tokenError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("token request failed with HTTP %d", e.StatusCode)
+	}
+	return fmt.Sprintf("token request failed with HTTP %d: %s", e.StatusCode, e.Message)
+}
+
+// token requests a new token from the API at baseURL, and returns it with the
+// cloud region the API reported for the credentials, if any.
+func (c tokenClient) token(ctx context.Context, baseURL string) (*oauth2.Token, string, error) {
+	form := url.Values{
+		"client_id":     {c.clientID},
+		"client_secret": {c.clientSecret},
+	}
+	if c.memberCID != "" {
+		form.Set("member_cid", c.memberCID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/oauth2/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, "", &tokenError{StatusCode: resp.StatusCode, Message: tokenErrorMessage(body)}
+	}
+
+	var payload struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+		Scope       string `json:"scope"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, "", fmt.Errorf("unable to parse token response: %w", err)
+	}
+	if payload.AccessToken == "" {
+		return nil, "", errors.New("token response did not contain an access token")
+	}
+
+	token := &oauth2.Token{
+		AccessToken: payload.AccessToken,
+		TokenType:   payload.TokenType,
+	}
+	if payload.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(payload.ExpiresIn) * time.Second)
+	}
+	if payload.Scope != "" {
+		token = token.WithExtra(map[string]any{"scope": payload.Scope})
+	}
+
+	return token, resp.Header.Get(regionHeader), nil
+}
+
+// revoke revokes token at the API at baseURL. Errors are ignored as the token
+// expires on its own.
+func (c tokenClient) revoke(ctx context.Context, baseURL string, token string) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/oauth2/revoke", strings.NewReader(url.Values{"token": {token}}.Encode()))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(c.clientID, c.clientSecret)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	resp.Body.Close()
+}
+
+// tokenErrorMessage returns the error messages in a token error response body.
+func tokenErrorMessage(body []byte) string {
+	var payload struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return ""
+	}
+
+	messages := make([]string, 0, len(payload.Errors))
+	for _, e := range payload.Errors {
+		if e.Message != "" {
+			messages = append(messages, e.Message)
+		}
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// discoverCloud finds the cloud the credentials belong to by requesting a
+// token from the us-1 cloud, which reports the region of every commercial
+// cloud. The token is returned when it can be used in the discovered cloud,
+// and revoked otherwise.
+//
+// The GovCloud clouds are isolated from the commercial clouds, so their
+// credentials cannot be discovered and fail to authenticate to us-1.
+func discoverCloud(ctx context.Context, c tokenClient, baseURL func(falcon.CloudType) string) (falcon.CloudType, *oauth2.Token, error) {
+	token, region, err := c.token(ctx, baseURL(falcon.CloudUs1))
+	if err != nil {
+		var tokenErr *tokenError
+		if errors.As(err, &tokenErr) && (tokenErr.StatusCode == http.StatusUnauthorized || tokenErr.StatusCode == http.StatusForbidden) {
+			if strings.Contains(tokenErr.Message, "access denied, authorization failed") {
+				return falcon.CloudAutoDiscover, nil, fmt.Errorf(
+					"cloud autodiscovery was denied, check the IP-based allowlisting settings in the Falcon console: %w", err)
+			}
+			return falcon.CloudAutoDiscover, nil, fmt.Errorf(
+				"cloud autodiscovery failed to authenticate: %w. Ensure the client ID and client secret are valid and part of the same API client. "+
+					"Autodiscovery only supports the us-1, us-2 and eu-1 clouds, set cloud to us-gov-1 or us-gov-2 explicitly for GovCloud credentials", err)
+		}
+		return falcon.CloudAutoDiscover, nil, fmt.Errorf("cloud autodiscovery failed: %w", err)
+	}
+
+	if region == "" {
+		return falcon.CloudUs1, token, nil
+	}
+
+	cloud, err := falcon.CloudValidate(region)
+	if err != nil {
+		c.revoke(ctx, baseURL(falcon.CloudUs1), token.AccessToken)
+		return falcon.CloudAutoDiscover, nil, fmt.Errorf("cloud autodiscovery returned an unsupported cloud %q, set cloud explicitly", region)
+	}
+
+	if cloud != falcon.CloudUs1 {
+		c.revoke(ctx, baseURL(cloud), token.AccessToken)
+		return cloud, nil, nil
+	}
+
+	return cloud, token, nil
+}
+
+// checkCloudRegion returns an error when the region reported by the token
+// endpoint shows the credentials belong to a different cloud than cloud.
+func checkCloudRegion(cloud falcon.CloudType, region string) error {
+	if region == "" {
+		return nil
+	}
+
+	actual, err := falcon.CloudValidate(region)
+	if err != nil || actual.Host() == cloud.Host() {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"the API credentials belong to the %s cloud, but the provider is configured for the %s cloud. Set cloud to %q, or to \"autodiscover\" for commercial clouds",
+		actual, cloud, actual.String())
+}
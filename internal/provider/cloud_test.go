@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crowdstrike/gofalcon/falcon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenServer is a fake token endpoint that issues tokens for region, or
+// responds with status and body when status is set.
+func tokenServer(t *testing.T, region string, status int, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var revoked atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/token":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "id", r.PostForm.Get("client_id"))
+			assert.Equal(t, "secret", r.PostForm.Get("client_secret"))
+
+			if region != "" {
+				w.Header().Set(regionHeader, region)
+			}
+			if status != 0 {
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(body))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"access_token":"token-` + r.PostForm.Get("member_cid") + `","token_type":"bearer","expires_in":1799}`))
+		case "/oauth2/revoke":
+			user, pass, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "id", user)
+			assert.Equal(t, "secret", pass)
+			revoked.Add(1)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &revoked
+}
+
+func testTokenClient(memberCID string) tokenClient {
+	return tokenClient{
+		httpClient:   http.DefaultClient,
+		clientID:     "id",
+		clientSecret: "secret",
+		memberCID:    memberCID,
+	}
+}
+
+func TestTokenClient_Token(t *testing.T) {
+	srv, _ := tokenServer(t, "us-2", 0, "")
+
+	token, region, err := testTokenClient("member").token(context.Background(), srv.URL)
+	require.NoError(t, err)
+
+	assert.Equal(t, "token-member", token.AccessToken)
+	assert.Equal(t, "us-2", region)
+	assert.WithinDuration(t, time.Now().Add(1799*time.Second), token.Expiry, time.Minute)
+}
+
+func TestTokenClient_TokenError(t *testing.T) {
+	srv, _ := tokenServer(t, "", http.StatusUnauthorized, `{"errors":[{"code":401,"message":"access denied, invalid client"}]}`)
+
+	_, _, err := testTokenClient("").token(context.Background(), srv.URL)
+	require.Error(t, err)
+
+	var tokenErr *tokenError
+	require.ErrorAs(t, err, &tokenErr)
+	assert.Equal(t, http.StatusUnauthorized, tokenErr.StatusCode)
+	assert.Equal(t, "access denied, invalid client", tokenErr.Message)
+}
+
+func TestDiscoverCloud(t *testing.T) {
+	tests := []struct {
+		name        string
+		region      string
+		status      int
+		body        string
+		wantCloud   falcon.CloudType
+		wantToken   bool
+		wantRevoked int32
+		wantErr     string
+	}{
+		{
+			name:      "us-1 reuses the token",
+			region:    "us-1",
+			wantCloud: falcon.CloudUs1,
+			wantToken: true,
+		},
+		{
+			name:        "other commercial cloud revokes the token",
+			region:      "eu-1",
+			wantCloud:   falcon.CloudEu1,
+			wantRevoked: 1,
+		},
+		{
+			name:      "missing region defaults to us-1",
+			wantCloud: falcon.CloudUs1,
+			wantToken: true,
+		},
+		{
+			name:        "unsupported region",
+			region:      "mars-1",
+			wantRevoked: 1,
+			wantErr:     "unsupported cloud",
+		},
+		{
+			name:    "authentication failure suggests GovCloud",
+			status:  http.StatusUnauthorized,
+			body:    `{"errors":[{"code":401,"message":"access denied, invalid client"}]}`,
+			wantErr: "us-gov-1 or us-gov-2",
+		},
+		{
+			name:    "ip allowlisting",
+			status:  http.StatusForbidden,
+			body:    `{"errors":[{"code":403,"message":"access denied, authorization failed"}]}`,
+			wantErr: "allowlisting",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, revoked := tokenServer(t, tt.region, tt.status, tt.body)
+
+			cloud, token, err := discoverCloud(context.Background(), testTokenClient(""), func(falcon.CloudType) string {
+				return srv.URL
+			})
+
+			assert.Equal(t, tt.wantRevoked, revoked.Load())
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCloud, cloud)
+			assert.Equal(t, tt.wantToken, token != nil)
+		})
+	}
+}
+
+func TestCheckCloudRegion(t *testing.T) {
+	tests := []struct {
+		name    string
+		cloud   falcon.CloudType
+		region  string
+		wantErr bool
+	}{
+		{name: "matching cloud", cloud: falcon.CloudUs2, region: "us-2"},
+		{name: "no region reported", cloud: falcon.CloudUs2},
+		{name: "unknown region is ignored", cloud: falcon.CloudUs2, region: "mars-1"},
+		{name: "gov alias of the same cloud", cloud: falcon.CloudUsGov1, region: "gov1"},
+		{name: "different commercial cloud", cloud: falcon.CloudUs1, region: "us-2", wantErr: true},
+		{name: "commercial credentials on GovCloud", cloud: falcon.CloudUsGov2, region: "eu-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkCloudRegion(tt.cloud, tt.region)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.region)
+		})
+	}
+}
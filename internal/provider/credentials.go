@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// credentialProcessTimeout is how long a credential process may run before it is killed.
+const credentialProcessTimeout = time.Minute
+
+// credentials are API credentials returned by a credential process.
+type credentials struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	MemberCID    string `json:"member_cid"`
+	AccessToken  string `json:"access_token"`
+}
+
+// runCredentialProcess runs command and parses the credentials it prints to stdout as JSON.
+func runCredentialProcess(ctx context.Context, command string) (credentials, error) {
+	var creds credentials
+
+	args, err := splitCommand(command)
+	if err != nil {
+		return creds, err
+	}
+	if len(args) == 0 {
+		return creds, errors.New("credential process command is empty")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return creds, fmt.Errorf("credential process failed: %w: %s", err, msg)
+		}
+		return creds, fmt.Errorf("credential process failed: %w", err)
+	}
+
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		// The output is not included in the error as it may contain the secret.
+		return creds, errors.New("credential process output is not a JSON object with client_id and client_secret, or access_token")
+	}
+
+	return creds, nil
+}
+
+// splitCommand splits command into arguments on whitespace. Single and double
+// quotes group an argument containing whitespace, and a backslash outside of
+// single quotes escapes the next character.
+func splitCommand(command string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	var quote rune
+	inArg, escaped := false, false
+
+	for _, r := range command {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, errors.New("credential process command has an unterminated quote or escape")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
+
+// readCredentialFile returns the contents of the file named by the environment
+// variable envVar with surrounding whitespace removed, or an empty string when
+// the variable is not set.
+func readCredentialFile(envVar string) (string, error) {
+	name := os.Getenv(envVar)
+	if name == "" {
+		return "", nil
+	}
+
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the file named by %s: %w", envVar, err)
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
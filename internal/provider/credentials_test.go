@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "simple",
+			command: "vault read -field=secret path",
+			want:    []string{"vault", "read", "-field=secret", "path"},
+		},
+		{
+			name:    "extra whitespace",
+			command: "  get-creds \t --json  ",
+			want:    []string{"get-creds", "--json"},
+		},
+		{
+			name:    "double quotes",
+			command: `op read "op://Infra/Falcon API/credential"`,
+			want:    []string{"op", "read", "op://Infra/Falcon API/credential"},
+		},
+		{
+			name:    "single quotes keep backslashes",
+			command: `helper 'C:\creds dir\falcon.json'`,
+			want:    []string{"helper", `C:\creds dir\falcon.json`},
+		},
+		{
+			name:    "escaped space",
+			command: `helper my\ creds`,
+			want:    []string{"helper", "my creds"},
+		},
+		{
+			name:    "empty quoted argument",
+			command: `helper ""`,
+			want:    []string{"helper", ""},
+		},
+		{
+			name:    "empty",
+			command: "   ",
+		},
+		{
+			name:    "unterminated quote",
+			command: `helper "creds`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitCommand(tt.command)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRunCredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses a shell script")
+	}
+
+	dir := t.TempDir()
+	script := func(name, body string) string {
+		p := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(p, []byte("#!/bin/sh\n"+body+"\n"), 0o700))
+		return p
+	}
+
+	t.Run("success", func(t *testing.T) {
+		p := script("ok.sh", `echo '{"client_id":"id","client_secret":"'"$1"'","member_cid":"cid"}'`)
+
+		creds, err := runCredentialProcess(context.Background(), p+" 'se cret'")
+		require.NoError(t, err)
+		assert.Equal(t, credentials{ClientID: "id", ClientSecret: "se cret", MemberCID: "cid"}, creds)
+	})
+
+	t.Run("access token", func(t *testing.T) {
+		p := script("token.sh", `echo '{"access_token":"token"}'`)
+
+		creds, err := runCredentialProcess(context.Background(), p)
+		require.NoError(t, err)
+		assert.Equal(t, credentials{AccessToken: "token"}, creds)
+	})
+
+	t.Run("failure includes stderr", func(t *testing.T) {
+		p := script("fail.sh", "echo 'not logged in' >&2; exit 1")
+
+		_, err := runCredentialProcess(context.Background(), p)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not logged in")
+	})
+
+	t.Run("invalid output is not echoed", func(t *testing.T) {
+		p := script("invalid.sh", "echo supersecret")
+
+		_, err := runCredentialProcess(context.Background(), p)
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), "supersecret")
+	})
+}
+
+func TestReadCredentialFile(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(p, []byte("  secret\n"), 0o600))
+
+	t.Setenv("TEST_FALCON_SECRET_FILE", p)
+	got, err := readCredentialFile("TEST_FALCON_SECRET_FILE")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", got)
+
+	t.Setenv("TEST_FALCON_SECRET_FILE", "")
+	got, err = readCredentialFile("TEST_FALCON_SECRET_FILE")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	t.Setenv("TEST_FALCON_SECRET_FILE", filepath.Join(t.TempDir(), "missing"))
+	_, err = readCredentialFile("TEST_FALCON_SECRET_FILE")
+	assert.Error(t, err)
+}
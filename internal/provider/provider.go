@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"regexp"
+	"time"
 
 	"github.com/crowdstrike/gofalcon/falcon"
 	"github.com/crowdstrike/gofalcon/falcon/client"
@@ -15,21 +17,38 @@ import (
 	cloudsecurity "github.com/crowdstrike/terraform-provider-crowdstrike/internal/cloud_security"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	contentupdatepolicy "github.com/crowdstrike/terraform-provider-crowdstrike/internal/content_update_policy"
+	customioc "github.com/crowdstrike/terraform-provider-crowdstrike/internal/custom_ioc"
 	dataprotection "github.com/crowdstrike/terraform-provider-crowdstrike/internal/data_protection"
+	devicecontrolpolicy "github.com/crowdstrike/terraform-provider-crowdstrike/internal/device_control_policy"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/discover"
+	exposuremanagement "github.com/crowdstrike/terraform-provider-crowdstrike/internal/exposure_management"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/fcs"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/fim"
+	firewallpolicy "github.com/crowdstrike/terraform-provider-crowdstrike/internal/firewall_policy"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/foundry"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/fql"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
 	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/hosts"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/intel"
 	ioarulegroup "github.com/crowdstrike/terraform-provider-crowdstrike/internal/ioa_rule_group"
 	itautomation "github.com/crowdstrike/terraform-provider-crowdstrike/internal/it_automation"
+	nextgensiem "github.com/crowdstrike/terraform-provider-crowdstrike/internal/next_gen_siem"
 	preventionpolicy "github.com/crowdstrike/terraform-provider-crowdstrike/internal/prevention_policy"
 	responsepolicy "github.com/crowdstrike/terraform-provider-crowdstrike/internal/response_policy"
+	sensordownload "github.com/crowdstrike/terraform-provider-crowdstrike/internal/sensor_download"
 	sensorupdatepolicy "github.com/crowdstrike/terraform-provider-crowdstrike/internal/sensor_update_policy"
 	sensorvisibilityexclusion "github.com/crowdstrike/terraform-provider-crowdstrike/internal/sensor_visibility_exclusion"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/spotlight"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/testconfig"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/transport"
 	usergroup "github.com/crowdstrike/terraform-provider-crowdstrike/internal/user_group"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -37,13 +56,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 )
 
+// printableASCII matches values that are valid in an HTTP header.
+var printableASCII = regexp.MustCompile(`^[\x20-\x7E]+$`)
+
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
 var (
-	_ provider.Provider              = &CrowdStrikeProvider{}
-	_ provider.ProviderWithFunctions = &CrowdStrikeProvider{}
+	_ provider.Provider                       = &CrowdStrikeProvider{}
+	_ provider.ProviderWithFunctions          = &CrowdStrikeProvider{}
+	_ provider.ProviderWithListResources      = &CrowdStrikeProvider{}
+	_ provider.ProviderWithEphemeralResources = &CrowdStrikeProvider{}
 )
 
 // CrowdStrikeProvider defines the provider implementation.
@@ -56,10 +79,24 @@ type CrowdStrikeProvider struct {
 
 // CrowdStrikeProviderModel  the provider data model.
 type CrowdStrikeProviderModel struct {
-	Cloud        types.String `tfsdk:"cloud"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	ClientId     types.String `tfsdk:"client_id"`
-	MemberCID    types.String `tfsdk:"member_cid"`
+	Cloud              types.String `tfsdk:"cloud"`
+	ClientSecret       types.String `tfsdk:"client_secret"`
+	ClientId           types.String `tfsdk:"client_id"`
+	AccessToken        types.String `tfsdk:"access_token"`
+	MemberCID          types.String `tfsdk:"member_cid"`
+	CredentialProcess  types.String `tfsdk:"credential_process"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryMinDelay      types.String `tfsdk:"retry_min_delay"`
+	RetryMaxDelay      types.String `tfsdk:"retry_max_delay"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	CABundleFile       types.String `tfsdk:"ca_bundle_file"`
+	Insecure           types.Bool   `tfsdk:"insecure_skip_tls_verify"`
+	UserAgentSuffix    types.String `tfsdk:"user_agent_suffix"`
+	AuditComment       types.String `tfsdk:"audit_comment"`
+	ValidateReferences types.Bool   `tfsdk:"validate_references"`
+	ReadCacheTTL       types.String `tfsdk:"read_cache_ttl"`
+	WriteConcurrency   types.Int64  `tfsdk:"write_concurrency"`
+	VerifyScopes       types.Bool   `tfsdk:"verify_scopes"`
 }
 
 func (p *CrowdStrikeProvider) Metadata(
@@ -80,22 +117,42 @@ func (p *CrowdStrikeProvider) Schema(
 		MarkdownDescription: "Use the CrowdStrike provider to interact & manage many resources supported by the CrowdStrike Falcon Platform. You must configure the provider with your CrowdStrike API credentials before you can use it.",
 		Attributes: map[string]schema.Attribute{
 			"client_id": schema.StringAttribute{
-				MarkdownDescription: "Falcon Client Id for authenticating to the CrowdStrike APIs. Will use FALCON_CLIENT_ID environment variable when left blank.",
+				MarkdownDescription: "Falcon Client Id for authenticating to the CrowdStrike APIs. Will use FALCON_CLIENT_ID environment variable, then the contents of the file named by the FALCON_CLIENT_ID_FILE environment variable, when left blank.",
 				Optional:            true,
 				Sensitive:           true,
 			},
 			"client_secret": schema.StringAttribute{
-				MarkdownDescription: "Falcon Client Secret used for authenticating to the CrowdStrike APIs. Will use FALCON_CLIENT_SECRET environment variable when left blank.",
+				MarkdownDescription: "Falcon Client Secret used for authenticating to the CrowdStrike APIs. Will use FALCON_CLIENT_SECRET environment variable, then the contents of the file named by the FALCON_CLIENT_SECRET_FILE environment variable, when left blank.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "A pre-issued OAuth2 access token for the CrowdStrike APIs, such as a token minted by a central credential broker, used instead of `client_id` and `client_secret`. The token is not refreshed by the provider, so it must remain valid for the whole run. `cloud` must be set to a cloud other than autodiscover, and the token must have been issued for the member CID to manage when using a MSSP Master CID. Resources registering cloud accounts with the API client, such as `crowdstrike_cloud_azure_tenant` and `crowdstrike_cloud_google_registration`, require client credentials. Will use FALCON_ACCESS_TOKEN environment variable, then the contents of the file named by the FALCON_ACCESS_TOKEN_FILE environment variable, when left blank and no client credentials are configured.",
 				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("client_id"),
+						path.MatchRoot("client_secret"),
+						path.MatchRoot("member_cid"),
+					),
+				},
+			},
+			"credential_process": schema.StringAttribute{
+				MarkdownDescription: "A command that prints the API credentials to stdout as a JSON object with `client_id`, `client_secret` and optionally `member_cid` keys, or with an `access_token` key, such as a secret manager CLI or a credential helper script. The command is run directly, not through a shell, and arguments containing spaces can be quoted. Credentials returned by the command are used when `client_id`, `client_secret` or `member_cid` are not set in the configuration, and take precedence over the environment variables. Will use FALCON_CREDENTIAL_PROCESS environment variable when left blank.",
+				Optional:            true,
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
 			},
 			"member_cid": schema.StringAttribute{
-				MarkdownDescription: "For MSSP Master CIDs, optionally lock the token to act on behalf of this member CID",
+				MarkdownDescription: "For MSSP Master CIDs, optionally lock the token to act on behalf of this member CID. Resources with a `cid` attribute can instead manage the objects of several member CIDs with a single provider configuration.",
 				Optional:            true,
 				Sensitive:           false,
 			},
 			"cloud": schema.StringAttribute{
-				MarkdownDescription: "Falcon Cloud to authenticate to. Valid values are autodiscover, us-1, us-2, eu-1, us-gov-1, us-gov-2. Will use FALCON_CLOUD environment variable when left blank.",
+				MarkdownDescription: "Falcon Cloud to authenticate to. Valid values are autodiscover, us-1, us-2, eu-1, us-gov-1, us-gov-2. Defaults to autodiscover, which finds the cloud of the API client and supports the us-1, us-2 and eu-1 clouds. GovCloud API clients must set us-gov-1 or us-gov-2 explicitly. The provider reports an error when the API client belongs to a different cloud than the one configured. Will use FALCON_CLOUD environment variable when left blank.",
 				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOfCaseInsensitive(
@@ -108,6 +165,82 @@ func (p *CrowdStrikeProvider) Schema(
 					),
 				},
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of times an API request is retried when the API responds with HTTP 429, or with HTTP 500, 502, 503 or 504 for requests that are safe to repeat. Set to `0` to disable retries. Defaults to `%d`.", transport.DefaultMaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"retry_min_delay": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The delay before the first retry of a failed API request, as a duration such as `500ms` or `2s`. Later retries back off exponentially. Defaults to `%s`.", transport.DefaultRetryMinDelay),
+				Optional:            true,
+				Validators: []validator.String{
+					fwvalidators.StringIsDuration(),
+				},
+			},
+			"read_cache_ttl": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long the results of lookups repeated by many data sources, such as the sensor update builds, content category versions, cloud security rules and cloud compliance controls, are reused within a single Terraform run, as a duration such as `30s` or `10m`. Set to `0s` to request them for every data source. Defaults to `%s`. Will use FALCON_READ_CACHE_TTL environment variable when left blank.", config.DefaultReadCacheTTL),
+				Optional:            true,
+				Validators: []validator.String{
+					fwvalidators.StringIsDuration(),
+				},
+			},
+			"write_concurrency": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("How many API requests resources that create or update many objects in a single apply, such as the controls of custom compliance frameworks, make concurrently. Lower this when applies are rate limited. Defaults to `%d`.", config.DefaultWriteConcurrency),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+			},
+			"retry_max_delay": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The maximum delay between retries of a failed API request, as a duration such as `30s` or `1m`. Delays requested by the API through the `Retry-After` or `X-RateLimit-RetryAfter` headers are honored up to this value. Defaults to `%s`.", transport.DefaultRetryMaxDelay),
+				Optional:            true,
+				Validators: []validator.String{
+					fwvalidators.StringIsDuration(),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the HTTP(S) proxy used to connect to the CrowdStrike APIs, for example `http://proxy.example.com:3128`. Will use the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables when left blank.",
+				Optional:            true,
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a PEM encoded file of certificate authorities to trust in addition to the system certificate pool, such as the root certificate of a TLS inspecting proxy. Will use FALCON_CA_BUNDLE_FILE environment variable when left blank.",
+				Optional:            true,
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"insecure_skip_tls_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable verification of the CrowdStrike API server certificate. This should only be used for troubleshooting, prefer `ca_bundle_file` instead. Defaults to `false`.",
+				Optional:            true,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				MarkdownDescription: "Text appended to the User-Agent header of every API request, such as `pipeline/deploy-prod run/1234`. The User-Agent is recorded in the CrowdStrike audit logs, so this can be used to attribute changes to a specific pipeline, repository or run. Will use FALCON_USER_AGENT_SUFFIX environment variable when left blank.",
+				Optional:            true,
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+					stringvalidator.RegexMatches(printableASCII, "must only contain printable ASCII characters"),
+				},
+			},
+			"audit_comment": schema.StringAttribute{
+				MarkdownDescription: "Text added to the comments recorded in the CrowdStrike audit logs by resources that support change comments, such as `crowdstrike_sensor_visibility_exclusion` and `crowdstrike_ioc_feed`. Will use FALCON_AUDIT_COMMENT environment variable when left blank.",
+				Optional:            true,
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"validate_references": schema.BoolAttribute{
+				MarkdownDescription: "Verify at plan time that the host groups, policies and rule groups referenced by ID in resources exist, so a mistyped or deleted ID fails the plan instead of the apply. This makes additional API requests during every plan. Defaults to `false`.",
+				Optional:            true,
+			},
+			"verify_scopes": schema.BoolAttribute{
+				MarkdownDescription: "Verify that the API client was granted the API scopes required by the resources and data sources of the configuration before they make API requests, so missing scopes fail the plan with the list of scopes to add instead of with a 403 error during the apply. The scopes are read from the access token, and scopes documented under a name the provider cannot match to a token scope are not verified. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -125,6 +258,16 @@ func (p *CrowdStrikeProvider) Configure(
 		return
 	}
 
+	// Credentials derived from resources created in the same apply, such as
+	// the API client of a new child CID, are unknown until that apply. The
+	// changes of every resource are then deferred to a later apply when
+	// Terraform supports it, rather than failing the plan.
+	if req.ClientCapabilities.DeferralAllowed && !req.Config.Raw.IsFullyKnown() {
+		tflog.Info(ctx, "Deferring changes until the provider configuration is known")
+		resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+		return
+	}
+
 	if model.Cloud.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("cloud"),
@@ -152,6 +295,33 @@ func (p *CrowdStrikeProvider) Configure(
 		)
 	}
 
+	if model.AccessToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Unknown CrowdStrike API Access Token",
+			"The provider cannot create the CrowdStrike API client as there is an unknown configuration value for the CrowdStrike API access token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the FALCON_ACCESS_TOKEN environment variable.",
+		)
+	}
+
+	if model.MemberCID.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("member_cid"),
+			"Unknown CrowdStrike Member CID",
+			"The provider cannot create the CrowdStrike API client as there is an unknown configuration value for the member CID. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if model.CredentialProcess.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credential_process"),
+			"Unknown CrowdStrike API Credential Process",
+			"The provider cannot create the CrowdStrike API client as there is an unknown configuration value for the credential process. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the FALCON_CREDENTIAL_PROCESS environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -161,6 +331,80 @@ func (p *CrowdStrikeProvider) Configure(
 	cloud := os.Getenv("FALCON_CLOUD")
 	clientId := os.Getenv("FALCON_CLIENT_ID")
 	clientSecret := os.Getenv("FALCON_CLIENT_SECRET")
+	memberCID := model.MemberCID.ValueString()
+
+	if clientId == "" {
+		var err error
+		clientId, err = readCredentialFile("FALCON_CLIENT_ID_FILE")
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_id"),
+				"Unable to Read CrowdStrike API Client ID",
+				err.Error(),
+			)
+		}
+	}
+
+	if clientSecret == "" {
+		var err error
+		clientSecret, err = readCredentialFile("FALCON_CLIENT_SECRET_FILE")
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_secret"),
+				"Unable to Read CrowdStrike API Client Secret",
+				err.Error(),
+			)
+		}
+	}
+
+	accessToken := os.Getenv("FALCON_ACCESS_TOKEN")
+	if accessToken == "" {
+		var err error
+		accessToken, err = readCredentialFile("FALCON_ACCESS_TOKEN_FILE")
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("access_token"),
+				"Unable to Read CrowdStrike API Access Token",
+				err.Error(),
+			)
+		}
+	}
+
+	credentialProcess := os.Getenv("FALCON_CREDENTIAL_PROCESS")
+	if !model.CredentialProcess.IsNull() {
+		credentialProcess = model.CredentialProcess.ValueString()
+	}
+
+	// The credential process is not run when the configuration already
+	// provides both credentials or an access token.
+	if credentialProcess != "" && model.AccessToken.IsNull() &&
+		(model.ClientId.IsNull() || model.ClientSecret.IsNull()) {
+		creds, err := runCredentialProcess(ctx, credentialProcess)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("credential_process"),
+				"Unable to Source CrowdStrike API Credentials",
+				"The provider cannot create the CrowdStrike API client as the credential process failed: "+err.Error(),
+			)
+			return
+		}
+
+		if creds.ClientID != "" {
+			clientId = creds.ClientID
+		}
+
+		if creds.ClientSecret != "" {
+			clientSecret = creds.ClientSecret
+		}
+
+		if memberCID == "" {
+			memberCID = creds.MemberCID
+		}
+
+		if creds.AccessToken != "" {
+			accessToken = creds.AccessToken
+		}
+	}
 
 	if !model.Cloud.IsNull() {
 		cloud = model.Cloud.ValueString()
@@ -170,6 +414,28 @@ func (p *CrowdStrikeProvider) Configure(
 		cloud = "autodiscover"
 	}
 
+	if _, err := falcon.CloudValidate(cloud); err != nil {
+		if !model.Cloud.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cloud"),
+				"Invalid CrowdStrike API Cloud",
+				fmt.Sprintf(
+					"The cloud attribute is set to an unsupported cloud %q. Valid values are autodiscover, us-1, us-2, eu-1, us-gov-1, us-gov-2.",
+					cloud,
+				),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Invalid CrowdStrike API Cloud",
+				fmt.Sprintf(
+					"The FALCON_CLOUD environment variable is set to an unsupported cloud %q. Valid values are autodiscover, us-1, us-2, eu-1, us-gov-1, us-gov-2.",
+					cloud,
+				),
+			)
+		}
+		return
+	}
+
 	if !model.ClientId.IsNull() {
 		clientId = model.ClientId.ValueString()
 	}
@@ -178,26 +444,163 @@ func (p *CrowdStrikeProvider) Configure(
 		clientSecret = model.ClientSecret.ValueString()
 	}
 
-	if clientId == "" {
+	if !model.AccessToken.IsNull() {
+		accessToken = model.AccessToken.ValueString()
+	}
+
+	// Client credentials set in the configuration take precedence over an
+	// access token from the environment or the credential process.
+	if !model.ClientId.IsNull() || !model.ClientSecret.IsNull() {
+		accessToken = ""
+	}
+
+	if accessToken != "" {
+		// Cloud autodiscovery requests a token with the client credentials.
+		if cloud == "autodiscover" && os.Getenv("HOST_OVERRIDE") == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cloud"),
+				"Missing CrowdStrike API Cloud",
+				"The provider cannot discover the cloud of the API client when authenticating with an access token. "+
+					"Set the cloud value in the configuration or use the FALCON_CLOUD environment variable.",
+			)
+		}
+
+		// The client credentials are not used with an access token.
+		clientId, clientSecret = "", ""
+	}
+
+	if clientId == "" && accessToken == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_id"),
 			"Missing CrowdStrike API Client ID",
 			"The provider cannot create the CrowdStrike API client as there is a missing or empty value for the CrowdStrike API Client ID. "+
-				"Set the client_id value in the configuration or use the FALCON_CLIENT_ID environment variable. "+
+				"Set the client_id value in the configuration, use the FALCON_CLIENT_ID or FALCON_CLIENT_ID_FILE environment variables, configure a credential_process, or authenticate with an access_token. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if clientSecret == "" {
+	if clientSecret == "" && accessToken == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_secret"),
 			"Missing CrowdStrike API Client Secret",
 			"The provider cannot create the CrowdStrike API client as there is a missing or empty value for the CrowdStrike API Client Secret. "+
-				"Set the client_secret value in the configuration or use the FALCON_CLIENT_SECRET environment variable. "+
+				"Set the client_secret value in the configuration, use the FALCON_CLIENT_SECRET or FALCON_CLIENT_SECRET_FILE environment variables, configure a credential_process, or authenticate with an access_token. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	retryConfig := transport.RetryConfig{
+		MaxRetries: transport.DefaultMaxRetries,
+		MinDelay:   transport.DefaultRetryMinDelay,
+		MaxDelay:   transport.DefaultRetryMaxDelay,
+	}
+
+	if !model.MaxRetries.IsNull() {
+		retryConfig.MaxRetries = int(model.MaxRetries.ValueInt64())
+	}
+
+	// The durations are already validated by the schema.
+	if !model.RetryMinDelay.IsNull() {
+		retryConfig.MinDelay, _ = time.ParseDuration(model.RetryMinDelay.ValueString())
+	}
+
+	if !model.RetryMaxDelay.IsNull() {
+		retryConfig.MaxDelay, _ = time.ParseDuration(model.RetryMaxDelay.ValueString())
+	}
+
+	httpConfig := transport.HTTPConfig{
+		ProxyURL:           model.ProxyURL.ValueString(),
+		CABundleFile:       os.Getenv("FALCON_CA_BUNDLE_FILE"),
+		InsecureSkipVerify: model.Insecure.ValueBool(),
+	}
+
+	if !model.CABundleFile.IsNull() {
+		httpConfig.CABundleFile = model.CABundleFile.ValueString()
+	}
+
+	// The base transport is used for token, cloud autodiscovery and API requests.
+	var baseTransport http.RoundTripper = http.DefaultTransport
+	if !httpConfig.IsDefault() {
+		customTransport, err := transport.NewBaseTransport(httpConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid CrowdStrike API Connection Settings",
+				"The provider cannot create the CrowdStrike API client as the proxy or TLS settings are invalid: "+err.Error(),
+			)
+			return
+		}
+
+		baseTransport = customTransport
+
+		if httpConfig.InsecureSkipVerify {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("insecure_skip_tls_verify"),
+				"TLS Verification Disabled",
+				"The CrowdStrike API server certificate is not verified. Use ca_bundle_file to trust a custom certificate authority instead.",
+			)
+		}
+	}
+
+	userAgent := fmt.Sprintf("terraform-provider-crowdstrike/%s", p.version)
+	userAgentSuffix := os.Getenv("FALCON_USER_AGENT_SUFFIX")
+	if !model.UserAgentSuffix.IsNull() {
+		userAgentSuffix = model.UserAgentSuffix.ValueString()
+	}
+
+	if userAgentSuffix != "" {
+		if !printableASCII.MatchString(userAgentSuffix) {
+			resp.Diagnostics.AddError(
+				"Invalid User-Agent Suffix",
+				"The FALCON_USER_AGENT_SUFFIX environment variable must only contain printable ASCII characters.",
+			)
+			return
+		}
+		userAgent += " " + userAgentSuffix
+	}
+
+	auditComment := os.Getenv("FALCON_AUDIT_COMMENT")
+	if !model.AuditComment.IsNull() {
+		auditComment = model.AuditComment.ValueString()
+	}
+
+	readCacheTTL := config.DefaultReadCacheTTL
+	readCacheTTLValue := os.Getenv("FALCON_READ_CACHE_TTL")
+	if !model.ReadCacheTTL.IsNull() {
+		readCacheTTLValue = model.ReadCacheTTL.ValueString()
+	}
+
+	if readCacheTTLValue != "" {
+		ttl, err := time.ParseDuration(readCacheTTLValue)
+		if err != nil || ttl < 0 {
+			if !model.ReadCacheTTL.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("read_cache_ttl"),
+					"Invalid Read Cache TTL",
+					fmt.Sprintf("The read_cache_ttl attribute must be a non-negative duration such as `10m`, got: %q", readCacheTTLValue),
+				)
+			} else {
+				resp.Diagnostics.AddError(
+					"Invalid Read Cache TTL",
+					fmt.Sprintf("The FALCON_READ_CACHE_TTL environment variable must be a non-negative duration such as `10m`, got: %q", readCacheTTLValue),
+				)
+			}
+			return
+		}
+		readCacheTTL = ttl
+	}
+
+	if retryConfig.MinDelay > retryConfig.MaxDelay {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_delay"),
+			"Invalid Retry Delay",
+			fmt.Sprintf(
+				"retry_min_delay (%s) must not be greater than retry_max_delay (%s).",
+				retryConfig.MinDelay,
+				retryConfig.MaxDelay,
+			),
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -205,13 +608,17 @@ func (p *CrowdStrikeProvider) Configure(
 	ctx = tflog.SetField(ctx, "crowdstrike_cloud", cloud)
 	ctx = tflog.SetField(ctx, "crowdstrike_client_id", clientId)
 	ctx = tflog.SetField(ctx, "crowdstrike_client_secret", clientSecret)
-	ctx = tflog.SetField(ctx, "crowdstrike_member_cid", model.MemberCID.ValueString())
+	ctx = tflog.SetField(ctx, "crowdstrike_access_token", accessToken)
+	ctx = tflog.SetField(ctx, "crowdstrike_member_cid", memberCID)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "crowdstrike_client_id")
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "crowdstrike_client_secret")
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "crowdstrike_access_token")
 
 	tflog.Debug(ctx, "Configuring CrowdStrike client")
 
 	var falconClient *client.CrowdStrikeAPISpecification
+	var memberClients *config.MemberClients
+	var granted []string
 	var err error
 
 	// During acceptance tests, use the cached client to avoid re-authentication
@@ -231,21 +638,16 @@ func (p *CrowdStrikeProvider) Configure(
 		tflog.Debug(ctx, "Creating new CrowdStrike API client")
 		apiConfig := falcon.ApiConfig{
 			Cloud:             falcon.Cloud(cloud),
+			AccessToken:       accessToken,
 			ClientId:          clientId,
 			ClientSecret:      clientSecret,
-			UserAgentOverride: fmt.Sprintf("terraform-provider-crowdstrike/%s", p.version),
+			UserAgentOverride: userAgent,
 			Context:           context.Background(),
 			HostOverride:      os.Getenv("HOST_OVERRIDE"),
-			TransportDecorator: falcon.TransportDecorator(func(r http.RoundTripper) http.RoundTripper {
-				return logging.NewLoggingHTTPTransport(r)
-			}),
+			MemberCID:         memberCID,
 		}
 
-		if !model.MemberCID.IsNull() {
-			apiConfig.MemberCID = model.MemberCID.ValueString()
-		}
-
-		falconClient, err = falcon.NewClient(&apiConfig)
+		falconClient, granted, err = newFalconClient(&apiConfig, baseTransport, retryConfig)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Create CrowdStrike API Client",
@@ -255,14 +657,41 @@ func (p *CrowdStrikeProvider) Configure(
 			)
 			return
 		}
+
+		// Resources managing the objects of a member CID exchange the client
+		// credentials for a token of the member CID, in the cloud found above.
+		if accessToken == "" {
+			memberClients = config.NewMemberClients(func(cid string) (*client.CrowdStrikeAPISpecification, error) {
+				memberConfig := apiConfig
+				memberConfig.MemberCID = cid
+				memberClient, _, err := newFalconClient(&memberConfig, baseTransport, retryConfig)
+				if err != nil && childCIDNotFound(falconClient, cid) {
+					return nil, fmt.Errorf("%w: %w", config.ErrMemberCIDNotFound, err)
+				}
+				return memberClient, err
+			})
+		}
 	}
 
 	providerConfig := config.ProviderConfig{
-		ClientId: clientId,
-		Client:   falconClient,
+		ClientId:           clientId,
+		Client:             falconClient,
+		AuditComment:       auditComment,
+		ValidateReferences: model.ValidateReferences.ValueBool(),
+		MemberClients:      memberClients,
+		ReadCache:          config.NewReadCache(readCacheTTL),
+		WriteConcurrency:   config.DefaultWriteConcurrency,
+	}
+	if !model.WriteConcurrency.IsNull() {
+		providerConfig.WriteConcurrency = int(model.WriteConcurrency.ValueInt64())
+	}
+	if model.VerifyScopes.ValueBool() {
+		providerConfig.ScopeCheck = config.NewScopeCheck(granted)
 	}
 	resp.DataSourceData = providerConfig
 	resp.ResourceData = providerConfig
+	resp.ListResourceData = providerConfig
+	resp.EphemeralResourceData = providerConfig
 
 	tflog.Info(ctx, "Configured CrowdStrike client", map[string]any{"success": true})
 }
@@ -275,6 +704,7 @@ func (p *CrowdStrikeProvider) Resources(ctx context.Context) []func() resource.R
 		sensorupdatepolicy.NewSensorUpdatePolicyHostGroupAttachmentResource,
 		sensorupdatepolicy.NewSensorUpdatePolicyPrecedenceResource,
 		hostgroups.NewHostGroupResource,
+		hosts.NewHiddenHostsResource,
 		preventionpolicy.NewPreventionPolicyWindowsResource,
 		preventionpolicy.NewDefaultPreventionPolicyMacResource,
 		preventionpolicy.NewDefaultPreventionPolicyLinuxResource,
@@ -288,6 +718,9 @@ func (p *CrowdStrikeProvider) Resources(ctx context.Context) []func() resource.R
 		fim.NewFilevantagePolicyPrecedenceResource,
 		fim.NewFilevantagePolicyAttachmentResource,
 		fcs.NewCloudAWSAccountResource,
+		fcs.NewCloudAzureSubscriptionResource,
+		fcs.NewCloudSecurityPolicySettingResource,
+		fcs.NewCloudScanScheduleResource,
 		fcs.NewCloudAzureTenantEventhubSettingsResource,
 		fcs.NewCloudAzureTenantResource,
 		cloudgoogleregistration.NewCloudGoogleRegistrationResource,
@@ -306,15 +739,50 @@ func (p *CrowdStrikeProvider) Resources(ctx context.Context) []func() resource.R
 		cloudsecurity.NewCloudSecurityCustomRuleResource,
 		cloudsecurity.NewCloudSecurityKacPolicyResource,
 		cloudsecurity.NewCloudSecurityKacPolicyPrecedenceResource,
+		cloudsecurity.NewCloudSecurityImageAssessmentExclusionsResource,
+		cloudsecurity.NewCloudSecurityContainerBaseImageResource,
 		cloudsecurity.NewCloudSecurityKacCustomRuleResource,
 		cloudcompliance.NewCloudComplianceCustomFrameworkResource,
+		cloudcompliance.NewCloudComplianceControlResource,
 		cloudgroup.NewCloudGroupResource,
 		cloudsecurity.NewCloudSecuritySuppressionRuleResource,
 		dataprotection.NewDataProtectionContentPatternResource,
 		responsepolicy.NewResponsePolicyResource,
 		responsepolicy.NewResponsePolicyPrecedenceResource,
+		responsepolicy.NewResponsePolicyAttachmentResource,
+		firewallpolicy.NewFirewallPolicyPrecedenceResource,
+		devicecontrolpolicy.NewDeviceControlPolicyPrecedenceResource,
 		ioarulegroup.NewIOARuleGroupResource,
 		usergroup.NewUserGroupResource,
+		nextgensiem.NewSavedSearchResource,
+		nextgensiem.NewCorrelationRuleResource,
+		nextgensiem.NewDataConnectorResource,
+		nextgensiem.NewDashboardResource,
+		nextgensiem.NewParserResource,
+		foundry.NewCollectionObjectResource,
+		exposuremanagement.NewExternalAssetResource,
+		customioc.NewIOCFeedResource,
+		sensordownload.NewProvisioningTokenResource,
+	}
+}
+
+func (p *CrowdStrikeProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		hostgroups.NewHostGroupListResource,
+		preventionpolicy.NewPreventionPolicyWindowsListResource,
+		preventionpolicy.NewPreventionPolicyLinuxListResource,
+		preventionpolicy.NewPreventionPolicyMacListResource,
+		sensorupdatepolicy.NewSensorUpdatePolicyListResource,
+		responsepolicy.NewResponsePolicyListResource,
+		contentupdatepolicy.NewContentUpdatePolicyListResource,
+		sensorvisibilityexclusion.NewSensorVisibilityExclusionListResource,
+		ioarulegroup.NewIOARuleGroupListResource,
+	}
+}
+
+func (p *CrowdStrikeProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		sensordownload.NewProvisioningTokenEphemeralResource,
 	}
 }
 
@@ -323,20 +791,43 @@ func (p *CrowdStrikeProvider) DataSources(ctx context.Context) []func() datasour
 		sensorupdatepolicy.NewSensorUpdateBuildsDataSource,
 		sensorupdatepolicy.NewSensorUpdatePoliciesDataSource,
 		sensorvisibilityexclusion.NewSensorVisibilityExclusionsDataSource,
+		fcs.NewCloudAccountsDataSource,
 		fcs.NewCloudAwsAccountsDataSource,
 		fcs.NewCloudAwsAccountValidationDataSource,
 		contentupdatepolicy.NewContentCategoryVersionsDataSource,
 		contentupdatepolicy.NewContentUpdatePoliciesDataSource,
 		cloudsecurity.NewCloudSecurityRulesDataSource,
 		cloudsecurity.NewCloudRiskFindingsDataSource,
+		cloudsecurity.NewKubernetesClustersDataSource,
 		cloudcompliance.NewCloudComplianceFrameworkControlDataSource,
+		cloudcompliance.NewCloudComplianceRulesDataSource,
+		cloudcompliance.NewCloudComplianceCustomFrameworkDataSource,
+		cloudcompliance.NewCloudComplianceBenchmarksDataSource,
+		cloudcompliance.NewCloudComplianceAssessmentDataSource,
 		preventionpolicy.NewPreventionPoliciesDataSource,
 		fim.NewFilevantagePoliciesDataSource,
+		foundry.NewCollectionDataSource,
+		nextgensiem.NewRepositoryDataSource,
+		spotlight.NewVulnerabilityEvaluationLogicDataSource,
+		exposuremanagement.NewExternalAssetsDataSource,
+		discover.NewApplicationsDataSource,
+		intel.NewActorsDataSource,
+		hostgroups.NewHostGroupIDsDataSource,
+		hostgroups.NewHostGroupMembersDataSource,
+		hosts.NewHostsDataSource,
+		preventionpolicy.NewPreventionPolicyIDsDataSource,
+		sensorvisibilityexclusion.NewSensorVisibilityExclusionIDsDataSource,
+		customioc.NewIOCIDsDataSource,
+		sensordownload.NewCIDDataSource,
 	}
 }
 
 func (p *CrowdStrikeProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		nextgensiem.NewSigmaToCQLFunction,
+		fql.NewEscapeFunction,
+		fql.NewAndFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
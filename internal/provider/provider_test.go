@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListResources(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	require.NoError(t, err)
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	for _, d := range resp.Diagnostics {
+		assert.NotEqual(t, tfprotov6.DiagnosticSeverityError, d.Severity, "%s: %s", d.Summary, d.Detail)
+	}
+
+	assert.Len(t, resp.ListResourceSchemas, 9)
+	for name := range resp.ListResourceSchemas {
+		assert.Contains(t, resp.ResourceSchemas, name, "list resource %s must list a managed resource", name)
+		require.Len(t, resp.ListResourceSchemas[name].Block.Attributes, 1)
+		assert.Equal(t, "filter", resp.ListResourceSchemas[name].Block.Attributes[0].Name)
+	}
+
+	identities, err := server.GetResourceIdentitySchemas(context.Background(), &tfprotov6.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+	for name := range resp.ListResourceSchemas {
+		assert.Contains(t, identities.IdentitySchemas, name, "list resource %s must have a resource identity", name)
+	}
+}
+
+// TestSecretInputsAreWriteOnly ensures secrets set in resource configurations,
+// such as credentials, are write-only so they are never stored in the plan or
+// state. Secrets computed by the API are not inputs and may be stored.
+func TestSecretInputsAreWriteOnly(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	require.NoError(t, err)
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+
+	var walkAttributes func(resource, path string, attrs []*tfprotov6.SchemaAttribute)
+	var walkBlock func(resource, path string, block *tfprotov6.SchemaBlock)
+	walkBlock = func(resource, path string, block *tfprotov6.SchemaBlock) {
+		walkAttributes(resource, path, block.Attributes)
+		for _, nested := range block.BlockTypes {
+			walkBlock(resource, path+nested.TypeName+".", nested.Block)
+		}
+	}
+	walkAttributes = func(resource, path string, attrs []*tfprotov6.SchemaAttribute) {
+		for _, attr := range attrs {
+			if attr.Sensitive && (attr.Required || attr.Optional) && !attr.Computed {
+				assert.True(t, attr.WriteOnly, "%s: sensitive input %s%s must be write-only", resource, path, attr.Name)
+			}
+			if attr.NestedType != nil {
+				walkAttributes(resource, path+attr.Name+".", attr.NestedType.Attributes)
+			}
+		}
+	}
+
+	for name, s := range resp.ResourceSchemas {
+		walkBlock(name, "", s.Block)
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// grantedScopes returns the API scopes granted to the API client of token,
+// such as "host-group:read", from the scope of the token response or else
+// from the scp claim of the access token. It returns nil when token does not
+// list its scopes. The access token is not verified, as the scopes are only
+// used to report missing scopes before the API would.
+func grantedScopes(token *oauth2.Token) []string {
+	if token == nil {
+		return nil
+	}
+
+	if scope, ok := token.Extra("scope").(string); ok && scope != "" {
+		return strings.Fields(scope)
+	}
+
+	parts := strings.Split(token.AccessToken, ".")
+	if len(parts) != 3 {
+		return nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil
+	}
+
+	var claims struct {
+		Scopes []string `json:"scp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Scopes == nil {
+		return nil
+	}
+
+	return claims.Scopes
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func TestGrantedScopes(t *testing.T) {
+	jwt := func(claims string) string {
+		return "e30." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".c2ln"
+	}
+
+	tests := map[string]struct {
+		token *oauth2.Token
+		want  []string
+	}{
+		"token response scope": {
+			token: (&oauth2.Token{AccessToken: "opaque"}).WithExtra(map[string]any{"scope": "host-group:read host-group:write"}),
+			want:  []string{"host-group:read", "host-group:write"},
+		},
+		"access token claim": {
+			token: &oauth2.Token{AccessToken: jwt(`{"scp":["prevention-policies:read"]}`)},
+			want:  []string{"prevention-policies:read"},
+		},
+		"no scopes":    {token: &oauth2.Token{AccessToken: jwt(`{"sub":"client"}`)}},
+		"opaque token": {token: &oauth2.Token{AccessToken: "opaque"}},
+		"invalid claims": {
+			token: &oauth2.Token{AccessToken: "e30.!!!.c2ln"},
+		},
+		"nil token": {},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, grantedScopes(tt.token))
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package references
+
+import (
+	"context"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/content_update_policies"
+	"github.com/crowdstrike/gofalcon/falcon/client/custom_ioa"
+	"github.com/crowdstrike/gofalcon/falcon/client/filevantage"
+	"github.com/crowdstrike/gofalcon/falcon/client/host_group"
+	"github.com/crowdstrike/gofalcon/falcon/client/prevention_policies"
+	"github.com/crowdstrike/gofalcon/falcon/client/response_policies"
+	"github.com/crowdstrike/gofalcon/falcon/client/sensor_update_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	hostGroup = Kind{
+		name:   "host group",
+		scopes: []scopes.Scope{{Name: "Host groups", Read: true}},
+		get: func(ctx context.Context, client *client.CrowdStrikeAPISpecification, ids []string) ([]string, error) {
+			res, err := client.HostGroup.GetHostGroups(&host_group.GetHostGroupsParams{Context: ctx, Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			return resourceIDs(res.Payload.Resources, func(r *models.HostGroupsHostGroupV1) *string { return r.ID }), nil
+		},
+	}
+
+	preventionPolicy = Kind{
+		name:   "prevention policy",
+		scopes: []scopes.Scope{{Name: "Prevention policies", Read: true}},
+		get: func(ctx context.Context, client *client.CrowdStrikeAPISpecification, ids []string) ([]string, error) {
+			res, err := client.PreventionPolicies.GetPreventionPolicies(&prevention_policies.GetPreventionPoliciesParams{Context: ctx, Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			return resourceIDs(res.Payload.Resources, func(r *models.PreventionPolicyV1) *string { return r.ID }), nil
+		},
+	}
+
+	sensorUpdatePolicy = Kind{
+		name:   "sensor update policy",
+		scopes: []scopes.Scope{{Name: "Sensor update policies", Read: true}},
+		get: func(ctx context.Context, client *client.CrowdStrikeAPISpecification, ids []string) ([]string, error) {
+			res, err := client.SensorUpdatePolicies.GetSensorUpdatePoliciesV2(&sensor_update_policies.GetSensorUpdatePoliciesV2Params{Context: ctx, Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			return resourceIDs(res.Payload.Resources, func(r *models.SensorUpdatePolicyV2) *string { return r.ID }), nil
+		},
+	}
+
+	contentUpdatePolicy = Kind{
+		name:   "content update policy",
+		scopes: []scopes.Scope{{Name: "Content Update Policy", Read: true}},
+		get: func(ctx context.Context, client *client.CrowdStrikeAPISpecification, ids []string) ([]string, error) {
+			res, err := client.ContentUpdatePolicies.GetContentUpdatePolicies(&content_update_policies.GetContentUpdatePoliciesParams{Context: ctx, Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			return resourceIDs(res.Payload.Resources, func(r *models.ContentUpdatePolicyV1) *string { return r.ID }), nil
+		},
+	}
+
+	responsePolicy = Kind{
+		name:   "response policy",
+		scopes: []scopes.Scope{{Name: "Response policies", Read: true}},
+		get: func(ctx context.Context, client *client.CrowdStrikeAPISpecification, ids []string) ([]string, error) {
+			res, err := client.ResponsePolicies.GetRTResponsePolicies(&response_policies.GetRTResponsePoliciesParams{Context: ctx, Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			return resourceIDs(res.Payload.Resources, func(r *models.RemoteResponsePolicyV1) *string { return r.ID }), nil
+		},
+	}
+
+	fileVantagePolicy = Kind{
+		name:   "FileVantage policy",
+		scopes: []scopes.Scope{{Name: "Falcon FileVantage", Read: true}},
+		get: func(ctx context.Context, client *client.CrowdStrikeAPISpecification, ids []string) ([]string, error) {
+			res, err := client.Filevantage.GetPolicies(&filevantage.GetPoliciesParams{Context: ctx, Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			return resourceIDs(res.Payload.Resources, func(r *models.PoliciesPolicy) *string { return r.ID }), nil
+		},
+	}
+
+	fileVantageRuleGroup = Kind{
+		name:   "FileVantage rule group",
+		scopes: []scopes.Scope{{Name: "Falcon FileVantage", Read: true}},
+		get: func(ctx context.Context, client *client.CrowdStrikeAPISpecification, ids []string) ([]string, error) {
+			res, err := client.Filevantage.GetRuleGroups(&filevantage.GetRuleGroupsParams{Context: ctx, Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			return resourceIDs(res.Payload.Resources, func(r *models.RulegroupsRuleGroup) *string { return r.ID }), nil
+		},
+	}
+
+	ioaRuleGroup = Kind{
+		name:   "IOA rule group",
+		scopes: []scopes.Scope{{Name: "Custom IOA Rules", Read: true}},
+		get: func(ctx context.Context, client *client.CrowdStrikeAPISpecification, ids []string) ([]string, error) {
+			res, err := client.CustomIoa.GetRuleGroupsMixin0(&custom_ioa.GetRuleGroupsMixin0Params{Context: ctx, Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			return resourceIDs(res.Payload.Resources, func(r *models.APIRuleGroupV1) *string { return r.ID }), nil
+		},
+	}
+)
+
+// HostGroups returns a reference to the host groups held by the attribute at p.
+func HostGroups(p path.Path) Reference {
+	return Reference{kind: hostGroup, path: p}
+}
+
+// PreventionPolicy returns a reference to the prevention policy held by the attribute at p.
+func PreventionPolicy(p path.Path) Reference {
+	return Reference{kind: preventionPolicy, path: p}
+}
+
+// SensorUpdatePolicy returns a reference to the sensor update policy held by the attribute at p.
+func SensorUpdatePolicy(p path.Path) Reference {
+	return Reference{kind: sensorUpdatePolicy, path: p}
+}
+
+// ContentUpdatePolicy returns a reference to the content update policy held by the attribute at p.
+func ContentUpdatePolicy(p path.Path) Reference {
+	return Reference{kind: contentUpdatePolicy, path: p}
+}
+
+// ResponsePolicy returns a reference to the response policy held by the attribute at p.
+func ResponsePolicy(p path.Path) Reference {
+	return Reference{kind: responsePolicy, path: p}
+}
+
+// FileVantagePolicy returns a reference to the FileVantage policy held by the attribute at p.
+func FileVantagePolicy(p path.Path) Reference {
+	return Reference{kind: fileVantagePolicy, path: p}
+}
+
+// FileVantageRuleGroups returns a reference to the FileVantage rule groups held by the attribute at p.
+func FileVantageRuleGroups(p path.Path) Reference {
+	return Reference{kind: fileVantageRuleGroup, path: p}
+}
+
+// IOARuleGroups returns a reference to the IOA rule groups held by the attribute at p.
+func IOARuleGroups(p path.Path) Reference {
+	return Reference{kind: ioaRuleGroup, path: p}
+}
+
+// resourceIDs returns the IDs of resources returned by the API.
+func resourceIDs[T any](resources []T, id func(T) *string) []string {
+	ids := make([]string, 0, len(resources))
+	for _, r := range resources {
+		if v := id(r); v != nil {
+			ids = append(ids, *v)
+		}
+	}
+	return ids
+}
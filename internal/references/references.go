@@ -0,0 +1,183 @@
+// Package references validates at plan time that the objects a resource
+// references by ID exist in the CID.
+package references
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Kind is a type of object that resources reference by ID.
+type Kind struct {
+	// name is the singular name of the object type, used in error messages.
+	name string
+	// scopes are the API scopes required to look up the objects.
+	scopes []scopes.Scope
+	// get returns the IDs in ids that exist, or an error when the lookup fails.
+	get func(ctx context.Context, client *client.CrowdStrikeAPISpecification, ids []string) ([]string, error)
+}
+
+// Reference is an attribute of a resource holding the IDs of objects of a Kind.
+type Reference struct {
+	kind Kind
+	path path.Path
+}
+
+// Validator validates references when the provider is configured with
+// validate_references enabled.
+type Validator struct {
+	client  *client.CrowdStrikeAPISpecification
+	config  config.ProviderConfig
+	enabled bool
+}
+
+// NewValidator returns a Validator for the provider configuration.
+func NewValidator(config config.ProviderConfig) Validator {
+	return Validator{client: config.Client, config: config, enabled: config.ValidateReferences}
+}
+
+// ForCID returns a Validator looking up the objects of the member CID cid, or
+// the provider CID when cid is null. Nothing is validated while cid is unknown.
+func (v Validator) ForCID(cid types.String) (Validator, diag.Diagnostics) {
+	if !v.enabled || cid.IsNull() {
+		return v, nil
+	}
+
+	if cid.IsUnknown() {
+		v.enabled = false
+		return v, nil
+	}
+
+	c, diags := v.config.ClientForCID(cid)
+	if diags.HasError() {
+		return v, diags
+	}
+	v.client = c
+
+	return v, diags
+}
+
+// Validate returns an error diagnostic for each ID held by the references in
+// plan that does not exist. Unknown IDs are skipped, and nothing is validated
+// when the resource is being destroyed or validation is disabled.
+func (v Validator) Validate(ctx context.Context, plan tfsdk.Plan, refs ...Reference) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !v.enabled || v.client == nil || plan.Raw.IsNull() {
+		return diags
+	}
+
+	for _, ref := range refs {
+		var value attr.Value
+		diags.Append(plan.GetAttribute(ctx, ref.path, &value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		ids := knownIDs(value)
+		if len(ids) == 0 {
+			continue
+		}
+
+		missing, err := ref.kind.missing(ctx, v.client, ids)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, ref.kind.scopes))
+			continue
+		}
+
+		for _, id := range missing {
+			diags.AddAttributeError(
+				ref.path,
+				fmt.Sprintf("Referenced %s does not exist", ref.kind.name),
+				fmt.Sprintf(
+					"The %s %s does not exist in the CID. Check the ID is correct and the %s was not deleted outside of Terraform.",
+					ref.kind.name,
+					id,
+					ref.kind.name,
+				),
+			)
+		}
+	}
+
+	return diags
+}
+
+// missing returns the IDs in ids that do not exist. The API fails the whole
+// request with HTTP 404 when any ID does not exist, so IDs are then looked up
+// one by one to find the missing ones.
+func (k Kind) missing(ctx context.Context, client *client.CrowdStrikeAPISpecification, ids []string) ([]string, error) {
+	found, err := k.get(ctx, client, ids)
+	if err == nil {
+		return slices.DeleteFunc(slices.Clone(ids), func(id string) bool {
+			return slices.Contains(found, id)
+		}), nil
+	}
+	if !isNotFound(err) {
+		return nil, err
+	}
+	if len(ids) == 1 {
+		return ids, nil
+	}
+
+	var missing []string
+	for _, id := range ids {
+		_, err := k.get(ctx, client, []string{id})
+		switch {
+		case isNotFound(err):
+			missing = append(missing, id)
+		case err != nil:
+			return nil, err
+		}
+	}
+
+	return missing, nil
+}
+
+// isNotFound reports whether err is an HTTP 404 response.
+func isNotFound(err error) bool {
+	statusErr, ok := err.(runtime.ClientResponseStatus)
+	return ok && statusErr.IsCode(404)
+}
+
+// knownIDs returns the known, non-empty IDs held by a string, list or set value.
+func knownIDs(value attr.Value) []string {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var elements []attr.Value
+	switch v := value.(type) {
+	case types.String:
+		elements = []attr.Value{v}
+	case types.Set:
+		elements = v.Elements()
+	case types.List:
+		elements = v.Elements()
+	}
+
+	var ids []string
+	for _, element := range elements {
+		s, ok := element.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() || strings.TrimSpace(s.ValueString()) == "" {
+			continue
+		}
+		if !slices.Contains(ids, s.ValueString()) {
+			ids = append(ids, s.ValueString())
+		}
+	}
+
+	return ids
+}
@@ -0,0 +1,167 @@
+package references
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// notFoundError is an API error response with HTTP status 404.
+type notFoundError struct{}
+
+func (notFoundError) Error() string        { return "not found" }
+func (notFoundError) IsSuccess() bool      { return false }
+func (notFoundError) IsRedirect() bool     { return false }
+func (notFoundError) IsClientError() bool  { return true }
+func (notFoundError) IsServerError() bool  { return false }
+func (notFoundError) IsCode(code int) bool { return code == 404 }
+func (notFoundError) Code() int            { return 404 }
+
+// fakeKind returns a Kind whose objects are existing, and which fails batch
+// lookups with HTTP 404 when any ID is missing, like the CrowdStrike APIs do.
+func fakeKind(existing ...string) (Kind, *int) {
+	calls := 0
+	return Kind{
+		name: "host group",
+		get: func(_ context.Context, _ *client.CrowdStrikeAPISpecification, ids []string) ([]string, error) {
+			calls++
+			for _, id := range ids {
+				if id == "error" {
+					return nil, errors.New("boom")
+				}
+				if !slices.Contains(existing, id) {
+					return nil, notFoundError{}
+				}
+			}
+			return ids, nil
+		},
+	}, &calls
+}
+
+func TestKindMissing(t *testing.T) {
+	tests := []struct {
+		name      string
+		ids       []string
+		want      []string
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "all exist", ids: []string{"a", "b"}, wantCalls: 1},
+		{name: "single missing", ids: []string{"x"}, want: []string{"x"}, wantCalls: 1},
+		{name: "some missing", ids: []string{"a", "x", "b", "y"}, want: []string{"x", "y"}, wantCalls: 5},
+		{name: "lookup error", ids: []string{"error"}, wantErr: true, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, calls := fakeKind("a", "b")
+
+			got, err := kind.missing(context.Background(), nil, tt.ids)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.ElementsMatch(t, tt.want, got)
+			}
+			assert.Equal(t, tt.wantCalls, *calls)
+		})
+	}
+}
+
+func TestKnownIDs(t *testing.T) {
+	tests := []struct {
+		name  string
+		value attr.Value
+		want  []string
+	}{
+		{name: "null", value: types.SetNull(types.StringType)},
+		{name: "unknown", value: types.SetUnknown(types.StringType)},
+		{name: "string", value: types.StringValue("a"), want: []string{"a"}},
+		{name: "empty string", value: types.StringValue(" ")},
+		{
+			name: "set with unknown element",
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("a"),
+				types.StringUnknown(),
+				types.StringValue("b"),
+			}),
+			want: []string{"a", "b"},
+		},
+		{
+			name: "list with duplicates",
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("a"),
+				types.StringValue("a"),
+			}),
+			want: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, knownIDs(tt.value))
+		})
+	}
+}
+
+func TestValidatorValidate(t *testing.T) {
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Required: true},
+			"host_groups": schema.SetAttribute{Optional: true, ElementType: types.StringType},
+		},
+	}
+	objectType := s.Type().TerraformType(ctx)
+	setType := tftypes.Set{ElementType: tftypes.String}
+
+	plan := tfsdk.Plan{
+		Schema: s,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "a"),
+			"host_groups": tftypes.NewValue(setType, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "x"),
+			}),
+		}),
+	}
+
+	kind, calls := fakeKind("a")
+	refs := []Reference{
+		{kind: kind, path: path.Root("id")},
+		{kind: kind, path: path.Root("host_groups")},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		v := Validator{client: &client.CrowdStrikeAPISpecification{}}
+		assert.False(t, v.Validate(ctx, plan, refs...).HasError())
+		assert.Zero(t, *calls)
+	})
+
+	t.Run("destroy", func(t *testing.T) {
+		v := Validator{client: &client.CrowdStrikeAPISpecification{}, enabled: true}
+		destroy := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(objectType, nil)}
+		assert.False(t, v.Validate(ctx, destroy, refs...).HasError())
+		assert.Zero(t, *calls)
+	})
+
+	t.Run("missing reference", func(t *testing.T) {
+		v := Validator{client: &client.CrowdStrikeAPISpecification{}, enabled: true}
+		diags := v.Validate(ctx, plan, refs...)
+		require.Equal(t, 1, diags.ErrorsCount(), diags)
+		assert.Equal(t, "Referenced host group does not exist", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), "The host group x does not exist")
+	})
+}
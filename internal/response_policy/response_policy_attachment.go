@@ -0,0 +1,455 @@
+package responsepolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &responsePolicyAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &responsePolicyAttachmentResource{}
+	_ resource.ResourceWithImportState = &responsePolicyAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &responsePolicyAttachmentResource{}
+	_ resource.ResourceWithMoveState   = &responsePolicyAttachmentResource{}
+)
+
+var (
+	attachmentDocumentationSection        string         = "Host Setup and Management"
+	attachmentResourceMarkdownDescription string         = "This resource allows managing the host groups attached to a response policy. By default (when `exclusive` is true), this resource takes exclusive ownership over the host groups assigned to a response policy. When `exclusive` is false, this resource only manages the specific host groups defined in the configuration, so several teams can attach their host groups to the same policy. If you want to fully create or manage a response policy please use the `response_policy` resource. A `crowdstrike_response_policy` resource can be moved to this resource with a `moved` block to take over its attachments without recreating the policy; the attachment starts with `exclusive` set to true."
+	attachmentRequiredScopes              []scopes.Scope = apiScopesReadWrite
+)
+
+func newPolicyNotFoundError(policyID string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Response Policy Not Found",
+		fmt.Sprintf(
+			"Response policy with ID %q does not exist. "+
+				"This resource manages attachments to an existing policy and does not create a policy. "+
+				"Ensure the correct policy ID was provided or use the crowdstrike_response_policy resource to create a policy.",
+			policyID,
+		),
+	)
+}
+
+func NewResponsePolicyAttachmentResource() resource.Resource {
+	return &responsePolicyAttachmentResource{}
+}
+
+type responsePolicyAttachmentResource struct {
+	client     *client.CrowdStrikeAPISpecification
+	references references.Validator
+}
+
+type responsePolicyAttachmentResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	HostGroups  types.Set      `tfsdk:"host_groups"`
+	Exclusive   types.Bool     `tfsdk:"exclusive"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *responsePolicyAttachmentResourceModel) wrap(
+	ctx context.Context,
+	policy models.RemoteResponsePolicyV1,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringPointerValue(policy.ID)
+
+	hostGroups := types.SetNull(types.StringType)
+
+	if m.Exclusive.ValueBool() {
+		hostGroupSet, diag := hostgroups.ConvertHostGroupsToSet(ctx, policy.Groups)
+		diags.Append(diag...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if len(hostGroupSet.Elements()) != 0 {
+			hostGroups = hostGroupSet
+		}
+	} else {
+		existingHostGroups := make(map[string]bool)
+		for _, hg := range policy.Groups {
+			if hg != nil && hg.ID != nil {
+				existingHostGroups[*hg.ID] = true
+			}
+		}
+
+		if !m.HostGroups.IsNull() {
+			planHostGroups := flex.ExpandSetAs[types.String](ctx, m.HostGroups, &diags)
+			if diags.HasError() {
+				return diags
+			}
+
+			var currentHostGroups []types.String
+			for _, hg := range planHostGroups {
+				if existingHostGroups[hg.ValueString()] {
+					currentHostGroups = append(currentHostGroups, hg)
+				}
+			}
+
+			hgSet, diag := types.SetValueFrom(ctx, types.StringType, currentHostGroups)
+			diags.Append(diag...)
+			if diags.HasError() {
+				return diags
+			}
+			hostGroups = hgSet
+		}
+	}
+	m.HostGroups = hostGroups
+
+	return diags
+}
+
+func (r *responsePolicyAttachmentResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = config.Client
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(attachmentRequiredScopes)...)
+}
+
+// ModifyPlan validates that the objects referenced by the resource exist.
+func (r *responsePolicyAttachmentResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	resp.Diagnostics.Append(r.references.Validate(
+		ctx,
+		req.Plan,
+		references.ResponsePolicy(path.Root("id")),
+		references.HostGroups(path.Root("host_groups")),
+	)...)
+}
+
+func (r *responsePolicyAttachmentResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_response_policy_attachment"
+}
+
+func (r *responsePolicyAttachmentResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			attachmentDocumentationSection,
+			attachmentResourceMarkdownDescription,
+			attachmentRequiredScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The response policy id you want to attach to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the resource.",
+			},
+			"exclusive": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "When true (default), this resource takes exclusive ownership of all host groups attached to the response policy. When false, this resource only manages the specific host groups defined in the configuration, leaving other groups untouched.",
+			},
+			"host_groups": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Host Group IDs to attach to the response policy.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						validators.StringNotWhitespace(),
+					),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *responsePolicyAttachmentResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan responsePolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	policy, diags := r.getPolicy(ctx, plan.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existingHostGroups, diag := hostgroups.ConvertHostGroupsToSet(ctx, policy.Groups)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planHostGroups := plan.HostGroups
+
+	if !plan.Exclusive.ValueBool() {
+		planHostGroups = flex.MergeStringSet(ctx, existingHostGroups, plan.HostGroups, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(
+		r.syncHostGroups(ctx, planHostGroups, existingHostGroups, plan.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags = r.getPolicy(ctx, plan.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(plan.wrap(ctx, *policy)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *responsePolicyAttachmentResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state responsePolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	policy, diags := getResponsePolicy(ctx, r.client, state.ID.ValueString())
+	if diags.HasError() {
+		if tferrors.HasNotFoundError(diags) {
+			resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(state.wrap(ctx, *policy)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *responsePolicyAttachmentResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan responsePolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state responsePolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	planHostGroups := plan.HostGroups
+
+	if !plan.Exclusive.ValueBool() {
+		hostGroupsToRemove := flex.DiffStringSet(ctx, state.HostGroups, plan.HostGroups, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		policy, diags := r.getPolicy(ctx, plan.ID.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		removeMap := make(map[string]bool)
+		for _, id := range hostGroupsToRemove {
+			removeMap[id.ValueString()] = true
+		}
+
+		var existingHostGroups []*models.HostGroupsHostGroupV1
+		for _, hg := range policy.Groups {
+			if hg != nil && hg.ID != nil && !removeMap[*hg.ID] {
+				existingHostGroups = append(existingHostGroups, hg)
+			}
+		}
+
+		existingHostGroupSet, diag := hostgroups.ConvertHostGroupsToSet(ctx, existingHostGroups)
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		planHostGroups = flex.MergeStringSet(ctx, existingHostGroupSet, plan.HostGroups, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(
+		r.syncHostGroups(ctx, planHostGroups, state.HostGroups, plan.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := r.getPolicy(ctx, plan.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	resp.Diagnostics.Append(plan.wrap(ctx, *policy)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *responsePolicyAttachmentResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state responsePolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	resp.Diagnostics.Append(
+		r.syncHostGroups(
+			ctx,
+			types.SetNull(types.StringType),
+			state.HostGroups,
+			state.ID.ValueString(),
+		)...)
+}
+
+// MoveState allows moving a `crowdstrike_response_policy` resource to this
+// resource with a moved block, so the response policy is not destroyed and
+// recreated when its attachments are managed separately.
+func (r *responsePolicyAttachmentResource) MoveState(
+	_ context.Context,
+) []resource.StateMover {
+	return []resource.StateMover{
+		utils.AttachmentStateMover("crowdstrike_response_policy", utils.MovedGroups{Source: "host_groups", Target: "host_groups"}),
+	}
+}
+
+func (r *responsePolicyAttachmentResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exclusive"), true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getPolicy returns the response policy policyID, reporting a missing policy
+// as an error since this resource does not create the policy.
+func (r *responsePolicyAttachmentResource) getPolicy(
+	ctx context.Context,
+	policyID string,
+) (*models.RemoteResponsePolicyV1, diag.Diagnostics) {
+	policy, diags := getResponsePolicy(ctx, r.client, policyID)
+	if diags.HasError() && tferrors.HasNotFoundError(diags) {
+		return nil, diag.Diagnostics{newPolicyNotFoundError(policyID)}
+	}
+	return policy, diags
+}
+
+// syncHostGroups attaches and detaches host groups so the policy's host groups
+// managed by this resource change from stateGroups to planGroups.
+func (r *responsePolicyAttachmentResource) syncHostGroups(
+	ctx context.Context,
+	planGroups, stateGroups types.Set,
+	policyID string,
+) diag.Diagnostics {
+	groupsToAdd, groupsToRemove, diags := utils.SetIDsToModify(ctx, planGroups, stateGroups)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, d := syncHostGroups(ctx, r.client, policyID, groupsToAdd, groupsToRemove); d != nil {
+		diags.Append(d)
+	}
+
+	return diags
+}
@@ -0,0 +1,124 @@
+package responsepolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResponsePolicyAttachmentResource(t *testing.T) {
+	rName := acctest.RandomResourceName()
+	resourceName := "crowdstrike_response_policy_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePolicyAttachmentConfig(rName, true, "crowdstrike_host_group.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "true"),
+					resource.TestCheckResourceAttr(resourceName, "host_groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "host_groups.*", "crowdstrike_host_group.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "exclusive"},
+			},
+		},
+	})
+}
+
+func TestAccResponsePolicyAttachmentResource_exclusiveFalse(t *testing.T) {
+	rName := acctest.RandomResourceName()
+	resourceName := "crowdstrike_response_policy_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePolicyAttachmentConfig(rName, false, "crowdstrike_host_group.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "host_groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "host_groups.*", "crowdstrike_host_group.test", "id"),
+				),
+			},
+			{
+				Config: testAccResponsePolicyAttachmentConfig(
+					rName,
+					false,
+					"crowdstrike_host_group.test.id, crowdstrike_host_group.test2.id",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "host_groups.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "host_groups.*", "crowdstrike_host_group.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "host_groups.*", "crowdstrike_host_group.test2", "id"),
+				),
+			},
+			{
+				Config: testAccResponsePolicyAttachmentConfig(rName, false, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "host_groups"),
+				),
+			},
+		},
+	})
+}
+
+// testAccResponsePolicyAttachmentConfig returns a configuration attaching the
+// host groups hostGroups to a response policy that already has a host group
+// attached outside of the attachment resource.
+func testAccResponsePolicyAttachmentConfig(rName string, exclusive bool, hostGroups string) string {
+	hostGroupsAttribute := ""
+	if hostGroups != "" {
+		hostGroupsAttribute = fmt.Sprintf("\n  host_groups = [%s]", hostGroups)
+	}
+
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_host_group" "existing" {
+  name        = "%[1]s-existing"
+  description = "existing host group attached to policy"
+  type        = "staticByID"
+  host_ids    = []
+}
+
+resource "crowdstrike_response_policy" "test" {
+  name          = %[1]q
+  description   = "test policy for attachment tests"
+  platform_name = "Windows"
+  host_groups   = [crowdstrike_host_group.existing.id]
+
+  lifecycle {
+    ignore_changes = [host_groups]
+  }
+}
+
+resource "crowdstrike_host_group" "test" {
+  name        = "%[1]s-hg"
+  description = "test host group for attachment tests"
+  type        = "staticByID"
+  host_ids    = []
+}
+
+resource "crowdstrike_host_group" "test2" {
+  name        = "%[1]s-hg2"
+  description = "second test host group for attachment tests"
+  type        = "staticByID"
+  host_ids    = []
+}
+
+resource "crowdstrike_response_policy_attachment" "test" {
+  id          = crowdstrike_response_policy.test.id
+  exclusive   = %[2]t%[3]s
+}
+`, rName, exclusive, hostGroupsAttribute)
+}
@@ -0,0 +1,56 @@
+package responsepolicy
+
+import (
+	"context"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/response_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/listresource"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewResponsePolicyListResource is a helper function to simplify the provider implementation.
+func NewResponsePolicyListResource() list.ListResource {
+	return listresource.New(listresource.Options[*models.RemoteResponsePolicyV1, responsePolicyResourceModel]{
+		TypeName: "_response_policy",
+		Objects:  "response policies",
+		// Default policies are managed by their own resources and are not listed.
+		Filter: "name.raw:!'platform_default'",
+		Scopes: apiScopesReadWrite,
+		Query:  queryResponsePolicies,
+		ID:     func(policy *models.RemoteResponsePolicyV1) *string { return policy.ID },
+		Name:   func(policy *models.RemoteResponsePolicyV1) *string { return policy.Name },
+		Wrap: func(ctx context.Context, m *responsePolicyResourceModel, policy *models.RemoteResponsePolicyV1) diag.Diagnostics {
+			return m.wrap(ctx, policy)
+		},
+	})
+}
+
+// queryResponsePolicies fetches a page of the response policies matching filter.
+func queryResponsePolicies(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	filter *string,
+	offset int64,
+) (*listresource.Page[*models.RemoteResponsePolicyV1], error) {
+	res, err := client.ResponsePolicies.QueryCombinedRTResponsePolicies(
+		&response_policies.QueryCombinedRTResponsePoliciesParams{
+			Context: ctx,
+			Filter:  filter,
+			Limit:   utils.Addr(utils.ListPageSize),
+			Offset:  &offset,
+		},
+	)
+	if err != nil || res == nil || res.Payload == nil {
+		return nil, err
+	}
+
+	return &listresource.Page[*models.RemoteResponsePolicyV1]{
+		Resources: res.Payload.Resources,
+		Meta:      res.Payload.Meta,
+		Errors:    res.Payload.Errors,
+	}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -47,10 +48,11 @@ type responsePolicyPrecedenceResource struct {
 }
 
 type responsePolicyPrecedenceResourceModel struct {
-	IDs          types.List   `tfsdk:"ids"`
-	Enforcement  types.String `tfsdk:"enforcement"`
-	PlatformName types.String `tfsdk:"platform_name"`
-	LastUpdated  types.String `tfsdk:"last_updated"`
+	IDs          types.List     `tfsdk:"ids"`
+	Enforcement  types.String   `tfsdk:"enforcement"`
+	PlatformName types.String   `tfsdk:"platform_name"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (d *responsePolicyPrecedenceResourceModel) wrap(
@@ -94,6 +96,7 @@ func (r *responsePolicyPrecedenceResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(precedenceRequiredScopes)...)
 }
 
 func (r *responsePolicyPrecedenceResource) Metadata(
@@ -105,7 +108,7 @@ func (r *responsePolicyPrecedenceResource) Metadata(
 }
 
 func (r *responsePolicyPrecedenceResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -144,6 +147,9 @@ func (r *responsePolicyPrecedenceResource) Schema(
 				Description: "Timestamp of the last Terraform update of the resource.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -158,6 +164,9 @@ func (r *responsePolicyPrecedenceResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var planPolicyIDs []string
 	resp.Diagnostics.Append(plan.IDs.ElementsAs(ctx, &planPolicyIDs, false)...)
 	if resp.Diagnostics.HasError() {
@@ -212,6 +221,9 @@ func (r *responsePolicyPrecedenceResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policies, diags := r.getResponsePoliciesByPrecedence(ctx, state.PlatformName.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -239,6 +251,9 @@ func (r *responsePolicyPrecedenceResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	var planPolicyIDs []string
 	resp.Diagnostics.Append(plan.IDs.ElementsAs(ctx, &planPolicyIDs, false)...)
 	if resp.Diagnostics.HasError() {
@@ -10,8 +10,10 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
 	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -30,7 +32,9 @@ var (
 	_ resource.Resource                   = &responsePolicyResource{}
 	_ resource.ResourceWithConfigure      = &responsePolicyResource{}
 	_ resource.ResourceWithImportState    = &responsePolicyResource{}
+	_ resource.ResourceWithIdentity       = &responsePolicyResource{}
 	_ resource.ResourceWithValidateConfig = &responsePolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &responsePolicyResource{}
 )
 
 func NewResponsePolicyResource() resource.Resource {
@@ -38,26 +42,30 @@ func NewResponsePolicyResource() resource.Resource {
 }
 
 type responsePolicyResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client         *client.CrowdStrikeAPISpecification
+	references     references.Validator
+	providerConfig config.ProviderConfig
 }
 
 type responsePolicyResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	PlatformName     types.String `tfsdk:"platform_name"`
-	Enabled          types.Bool   `tfsdk:"enabled"`
-	HostGroups       types.Set    `tfsdk:"host_groups"`
-	RealTimeResponse types.Bool   `tfsdk:"real_time_response"`
-	CustomScripts    types.Bool   `tfsdk:"custom_scripts"`
-	GetCommand       types.Bool   `tfsdk:"get_command"`
-	PutCommand       types.Bool   `tfsdk:"put_command"`
-	ExecCommand      types.Bool   `tfsdk:"exec_command"`
-	FalconScripts    types.Bool   `tfsdk:"falcon_scripts"`
-	MemdumpCommand   types.Bool   `tfsdk:"memdump_command"`
-	XmemdumpCommand  types.Bool   `tfsdk:"xmemdump_command"`
-	PutAndRunCommand types.Bool   `tfsdk:"put_and_run_command"`
-	LastUpdated      types.String `tfsdk:"last_updated"`
+	ID               types.String   `tfsdk:"id"`
+	CID              types.String   `tfsdk:"cid"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	PlatformName     types.String   `tfsdk:"platform_name"`
+	Enabled          types.Bool     `tfsdk:"enabled"`
+	HostGroups       types.Set      `tfsdk:"host_groups"`
+	RealTimeResponse types.Bool     `tfsdk:"real_time_response"`
+	CustomScripts    types.Bool     `tfsdk:"custom_scripts"`
+	GetCommand       types.Bool     `tfsdk:"get_command"`
+	PutCommand       types.Bool     `tfsdk:"put_command"`
+	ExecCommand      types.Bool     `tfsdk:"exec_command"`
+	FalconScripts    types.Bool     `tfsdk:"falcon_scripts"`
+	MemdumpCommand   types.Bool     `tfsdk:"memdump_command"`
+	XmemdumpCommand  types.Bool     `tfsdk:"xmemdump_command"`
+	PutAndRunCommand types.Bool     `tfsdk:"put_and_run_command"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *responsePolicyResource) Configure(
@@ -84,6 +92,46 @@ func (r *responsePolicyResource) Configure(
 	}
 
 	r.client = config.Client
+	r.providerConfig = config
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
+}
+
+// withCID returns a copy of the resource managing the response policies of the
+// member CID cid.
+func (r *responsePolicyResource) withCID(cid types.String, diags *diag.Diagnostics) *responsePolicyResource {
+	c, d := r.providerConfig.ClientForCID(cid)
+	diags.Append(d...)
+
+	withCID := *r
+	withCID.client = c
+	return &withCID
+}
+
+// ModifyPlan defers changes to the objects of a member CID that is not
+// available yet and validates that the objects referenced by the resource exist.
+func (r *responsePolicyResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if r.providerConfig.DeferPlanForCID(ctx, req, resp) {
+		return
+	}
+
+	var cid types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cid"), &cid)...)
+	refs, diags := r.references.ForCID(cid)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(refs.Validate(
+		ctx,
+		req.Plan,
+		references.HostGroups(path.Root("host_groups")),
+	)...)
 }
 
 func (r *responsePolicyResource) Metadata(
@@ -95,7 +143,7 @@ func (r *responsePolicyResource) Metadata(
 }
 
 func (r *responsePolicyResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -113,6 +161,7 @@ func (r *responsePolicyResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"cid": utils.CIDAttribute("response policy"),
 			"last_updated": schema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp of the last Terraform update of the resource.",
@@ -208,9 +257,21 @@ func (r *responsePolicyResource) Schema(
 				Default:     booldefault.StaticBool(false),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *responsePolicyResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 func (m *responsePolicyResourceModel) wrap(
 	ctx context.Context,
 	policy *models.RemoteResponsePolicyV1,
@@ -320,6 +381,14 @@ func (r *responsePolicyResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	settings := plan.expandSettings()
 
 	policyParams := response_policies.CreateRTResponsePoliciesParams{
@@ -390,7 +459,7 @@ func (r *responsePolicyResource) Create(
 			return
 		}
 
-		updatedPolicy, diag := r.syncHostGroups(ctx, plan.ID.ValueString(), hostGroupIDs, nil)
+		updatedPolicy, diag := syncHostGroups(ctx, r.client, plan.ID.ValueString(), hostGroupIDs, nil)
 		if diag != nil {
 			resp.Diagnostics.Append(diag)
 			return
@@ -402,6 +471,7 @@ func (r *responsePolicyResource) Create(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, policy)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *responsePolicyResource) Read(
@@ -417,11 +487,19 @@ func (r *responsePolicyResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Retrieving response policy", map[string]interface{}{
 		"policy_id": state.ID.ValueString(),
 	})
 
-	policy, diags := r.getResponsePolicy(ctx, state.ID.ValueString())
+	policy, diags := getResponsePolicy(ctx, r.client, state.ID.ValueString())
 	if diags.HasError() {
 		if tferrors.HasNotFoundError(diags) {
 			resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
@@ -434,6 +512,7 @@ func (r *responsePolicyResource) Read(
 
 	resp.Diagnostics.Append(state.wrap(ctx, policy)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *responsePolicyResource) Update(
@@ -449,6 +528,14 @@ func (r *responsePolicyResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state responsePolicyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -511,7 +598,7 @@ func (r *responsePolicyResource) Update(
 	}
 
 	if len(hostGroupsToAdd) > 0 || len(hostGroupsToRemove) > 0 {
-		updatedPolicy, diag := r.syncHostGroups(ctx, plan.ID.ValueString(), hostGroupsToAdd, hostGroupsToRemove)
+		updatedPolicy, diag := syncHostGroups(ctx, r.client, plan.ID.ValueString(), hostGroupsToAdd, hostGroupsToRemove)
 		if diag != nil {
 			resp.Diagnostics.Append(diag)
 			return
@@ -524,6 +611,7 @@ func (r *responsePolicyResource) Update(
 	plan.LastUpdated = utils.GenerateUpdateTimestamp()
 	resp.Diagnostics.Append(plan.wrap(ctx, policy)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *responsePolicyResource) Delete(
@@ -539,6 +627,14 @@ func (r *responsePolicyResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if state.Enabled.ValueBool() {
 		tflog.Debug(ctx, "Disabling response policy before deletion", map[string]interface{}{
 			"policy_id": state.ID.ValueString(),
@@ -575,7 +671,7 @@ func (r *responsePolicyResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithCID(ctx, req, resp)
 }
 
 func (r *responsePolicyResource) ValidateConfig(
@@ -670,98 +766,3 @@ func (r *responsePolicyResource) setResponsePolicyEnabled(
 	}
 	return nil, nil
 }
-
-func (r *responsePolicyResource) syncHostGroups(
-	ctx context.Context,
-	policyID string,
-	groupsToAdd []string,
-	groupsToRemove []string,
-) (*models.RemoteResponsePolicyV1, diag.Diagnostic) {
-	var lastPolicy *models.RemoteResponsePolicyV1
-
-	if len(groupsToAdd) > 0 {
-		nameStr := "group_id"
-		var actionParams []*models.MsaspecActionParameter
-		for _, groupID := range groupsToAdd {
-			groupIDCopy := groupID
-			actionParams = append(actionParams, &models.MsaspecActionParameter{
-				Name:  &nameStr,
-				Value: &groupIDCopy,
-			})
-		}
-
-		res, err := r.client.ResponsePolicies.PerformRTResponsePoliciesAction(&response_policies.PerformRTResponsePoliciesActionParams{
-			Context:    ctx,
-			ActionName: "add-host-group",
-			Body: &models.MsaEntityActionRequestV2{
-				Ids:              []string{policyID},
-				ActionParameters: actionParams,
-			},
-		})
-		if err != nil {
-			return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, apiScopesReadWrite)
-		}
-		if res != nil && res.Payload != nil && len(res.Payload.Resources) > 0 && res.Payload.Resources[0] != nil {
-			lastPolicy = res.Payload.Resources[0]
-		}
-	}
-
-	if len(groupsToRemove) > 0 {
-		nameStr := "group_id"
-		var actionParams []*models.MsaspecActionParameter
-		for _, groupID := range groupsToRemove {
-			groupIDCopy := groupID
-			actionParams = append(actionParams, &models.MsaspecActionParameter{
-				Name:  &nameStr,
-				Value: &groupIDCopy,
-			})
-		}
-
-		res, err := r.client.ResponsePolicies.PerformRTResponsePoliciesAction(&response_policies.PerformRTResponsePoliciesActionParams{
-			Context:    ctx,
-			ActionName: "remove-host-group",
-			Body: &models.MsaEntityActionRequestV2{
-				Ids:              []string{policyID},
-				ActionParameters: actionParams,
-			},
-		})
-		if err != nil {
-			return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, apiScopesReadWrite)
-		}
-		if res != nil && res.Payload != nil && len(res.Payload.Resources) > 0 && res.Payload.Resources[0] != nil {
-			lastPolicy = res.Payload.Resources[0]
-		}
-	}
-
-	return lastPolicy, nil
-}
-
-func (r *responsePolicyResource) getResponsePolicy(
-	ctx context.Context,
-	policyID string,
-) (*models.RemoteResponsePolicyV1, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	params := response_policies.GetRTResponsePoliciesParams{
-		Context: ctx,
-		Ids:     []string{policyID},
-	}
-
-	res, err := r.client.ResponsePolicies.GetRTResponsePolicies(&params)
-	if err != nil {
-		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesReadWrite))
-		return nil, diags
-	}
-
-	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
-		diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
-		return nil, diags
-	}
-
-	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
-		diags.Append(diag)
-		return nil, diags
-	}
-
-	return res.Payload.Resources[0], diags
-}
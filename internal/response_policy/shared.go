@@ -0,0 +1,110 @@
+package responsepolicy
+
+import (
+	"context"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/response_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// syncHostGroups adds and removes the host groups attached to the response policy policyID.
+func syncHostGroups(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	policyID string,
+	groupsToAdd []string,
+	groupsToRemove []string,
+) (*models.RemoteResponsePolicyV1, diag.Diagnostic) {
+	var lastPolicy *models.RemoteResponsePolicyV1
+
+	if len(groupsToAdd) > 0 {
+		nameStr := "group_id"
+		var actionParams []*models.MsaspecActionParameter
+		for _, groupID := range groupsToAdd {
+			groupIDCopy := groupID
+			actionParams = append(actionParams, &models.MsaspecActionParameter{
+				Name:  &nameStr,
+				Value: &groupIDCopy,
+			})
+		}
+
+		res, err := client.ResponsePolicies.PerformRTResponsePoliciesAction(&response_policies.PerformRTResponsePoliciesActionParams{
+			Context:    ctx,
+			ActionName: "add-host-group",
+			Body: &models.MsaEntityActionRequestV2{
+				Ids:              []string{policyID},
+				ActionParameters: actionParams,
+			},
+		})
+		if err != nil {
+			return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, apiScopesReadWrite)
+		}
+		if res != nil && res.Payload != nil && len(res.Payload.Resources) > 0 && res.Payload.Resources[0] != nil {
+			lastPolicy = res.Payload.Resources[0]
+		}
+	}
+
+	if len(groupsToRemove) > 0 {
+		nameStr := "group_id"
+		var actionParams []*models.MsaspecActionParameter
+		for _, groupID := range groupsToRemove {
+			groupIDCopy := groupID
+			actionParams = append(actionParams, &models.MsaspecActionParameter{
+				Name:  &nameStr,
+				Value: &groupIDCopy,
+			})
+		}
+
+		res, err := client.ResponsePolicies.PerformRTResponsePoliciesAction(&response_policies.PerformRTResponsePoliciesActionParams{
+			Context:    ctx,
+			ActionName: "remove-host-group",
+			Body: &models.MsaEntityActionRequestV2{
+				Ids:              []string{policyID},
+				ActionParameters: actionParams,
+			},
+		})
+		if err != nil {
+			return nil, tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, apiScopesReadWrite)
+		}
+		if res != nil && res.Payload != nil && len(res.Payload.Resources) > 0 && res.Payload.Resources[0] != nil {
+			lastPolicy = res.Payload.Resources[0]
+		}
+	}
+
+	return lastPolicy, nil
+}
+
+// getResponsePolicy returns the response policy policyID.
+func getResponsePolicy(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	policyID string,
+) (*models.RemoteResponsePolicyV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := response_policies.GetRTResponsePoliciesParams{
+		Context: ctx,
+		Ids:     []string{policyID},
+	}
+
+	res, err := client.ResponsePolicies.GetRTResponsePolicies(&params)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesReadWrite))
+		return nil, diags
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+		return nil, diags
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		diags.Append(diag)
+		return nil, diags
+	}
+
+	return res.Payload.Resources[0], diags
+}
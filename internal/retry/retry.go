@@ -10,7 +10,14 @@ import (
 // RetryUntilNoError repeatedly calls fn until it returns nil, the timeout is reached, or the context is cancelled.
 // It waits interval between attempts, and logs each attempt and outcome.
 func RetryUntilNoError(ctx context.Context, timeout, interval time.Duration, fn func() error) error {
+	return RetryWithBackoff(ctx, timeout, interval, interval, fn)
+}
+
+// RetryWithBackoff repeatedly calls fn until it returns nil, the timeout is reached, or the context is cancelled.
+// It waits minInterval after the first attempt and doubles the wait after each later attempt, up to maxInterval.
+func RetryWithBackoff(ctx context.Context, timeout, minInterval, maxInterval time.Duration, fn func() error) error {
 	deadline := time.Now().Add(timeout)
+	interval := minInterval
 	var lastErr error
 	for attempt := 1; ; attempt++ {
 		err := fn()
@@ -32,5 +39,6 @@ func RetryUntilNoError(ctx context.Context, timeout, interval time.Duration, fn
 			return ctx.Err()
 		case <-timer.C:
 		}
+		interval = min(interval*2, maxInterval)
 	}
 }
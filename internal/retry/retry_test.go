@@ -0,0 +1,56 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryWithBackoff(t *testing.T) {
+	var waits []time.Duration
+	last := time.Now()
+	attempts := 0
+
+	err := RetryWithBackoff(context.Background(), time.Second, 5*time.Millisecond, 20*time.Millisecond, func() error {
+		now := time.Now()
+		if attempts > 0 {
+			waits = append(waits, now.Sub(last))
+		}
+		last = now
+		attempts++
+		if attempts < 5 {
+			return errors.New("not yet")
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, attempts)
+	for i, minWait := range []time.Duration{5, 10, 20, 20} {
+		assert.GreaterOrEqual(t, waits[i], minWait*time.Millisecond, "wait %d", i)
+	}
+}
+
+func TestRetryWithBackoff_Timeout(t *testing.T) {
+	notYet := errors.New("not yet")
+
+	err := RetryWithBackoff(context.Background(), 20*time.Millisecond, time.Millisecond, 5*time.Millisecond, func() error {
+		return notYet
+	})
+
+	assert.ErrorIs(t, err, notYet)
+}
+
+func TestRetryWithBackoff_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := RetryWithBackoff(ctx, time.Minute, time.Minute, time.Minute, func() error {
+		return errors.New("not yet")
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -41,3 +41,52 @@ func GenerateScopeDescription(scopes []Scope) string {
 
 	return sb.String()
 }
+
+// scopeIDs maps the lowercased names of API scopes, as shown in the Falcon
+// console, to their identifiers in the access tokens of API clients.
+var scopeIDs = map[string]string{
+	"actors (falcon intelligence)": "falconx-actors",
+	"content update policy":        "content-update-policies",
+	"custom ioa rules":             "custom-ioa",
+	"falcon filevantage":           "filevantage",
+	"flight control":               "mssp",
+	"host groups":                  "host-group",
+	"hosts":                        "devices",
+	"installation tokens":          "installation-tokens",
+	"prevention policies":          "prevention-policies",
+	"response policies":            "response-policies",
+	"sensor download":              "sensor-installers",
+	"sensor update policies":       "sensor-update-policies",
+	"sensor visibility exclusions": "sensor-visibility-exclusions",
+	"vulnerabilities":              "spotlight-vulnerabilities",
+}
+
+// Missing returns the scopes of required that are not granted, where granted
+// holds the scopes of an access token such as "host-group:read". Only the
+// missing access of each scope is set. Scopes whose identifier is not known
+// are not checked.
+func Missing(required []Scope, granted []string) []Scope {
+	grantedSet := make(map[string]bool, len(granted))
+	for _, g := range granted {
+		grantedSet[strings.ToLower(g)] = true
+	}
+
+	var missing []Scope
+	for _, scope := range required {
+		id, ok := scopeIDs[strings.ToLower(scope.Name)]
+		if !ok {
+			continue
+		}
+
+		m := Scope{
+			Name:  scope.Name,
+			Read:  scope.Read && !grantedSet[id+":read"],
+			Write: scope.Write && !grantedSet[id+":write"],
+		}
+		if m.Read || m.Write {
+			missing = append(missing, m)
+		}
+	}
+
+	return missing
+}
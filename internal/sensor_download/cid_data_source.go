@@ -0,0 +1,220 @@
+package sensordownload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/installation_tokens"
+	"github.com/crowdstrike/gofalcon/falcon/client/sensor_download"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &cidDataSource{}
+	_ datasource.DataSourceWithConfigure = &cidDataSource{}
+)
+
+func NewCIDDataSource() datasource.DataSource {
+	return &cidDataSource{}
+}
+
+type cidDataSource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type cidDataSourceModel struct {
+	CID                    types.String `tfsdk:"cid"`
+	TokensRequired         types.Bool   `tfsdk:"tokens_required"`
+	ProvisioningTokenLabel types.String `tfsdk:"provisioning_token_label"`
+	ProvisioningToken      types.String `tfsdk:"provisioning_token"`
+}
+
+func (d *cidDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+func (d *cidDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cid"
+}
+
+func (d *cidDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Sensor Download",
+			"This data source returns the customer ID (CID) with checksum of the API client, and a provisioning token when the CID requires one to install sensors. "+
+				"Use it to render user data or cloud-init templates that install the Falcon sensor.",
+			apiScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"cid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The customer ID with checksum, as passed to the sensor installer.",
+			},
+			"tokens_required": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the CID requires a provisioning token to install sensors.",
+			},
+			"provisioning_token_label": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The label of the installation token to return as `provisioning_token`. When not set, the most recently created valid token is returned.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"provisioning_token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "A valid installation token to pass to the sensor installer. Null when the CID does not require provisioning tokens and `provisioning_token_label` is not set.",
+			},
+		},
+	}
+}
+
+func (d *cidDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data cidDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cidParams := sensor_download.NewGetSensorInstallersCCIDByQueryParams()
+	cidParams.Context = ctx
+
+	cidRes, err := d.client.SensorDownload.GetSensorInstallersCCIDByQuery(cidParams)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes))
+		return
+	}
+
+	if cidRes == nil || cidRes.Payload == nil || len(cidRes.Payload.Resources) == 0 ||
+		cidRes.Payload.Resources[0] == "" {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+		return
+	}
+
+	data.CID = flex.StringValueToFramework(cidRes.Payload.Resources[0])
+
+	settingsParams := installation_tokens.NewCustomerSettingsReadParams()
+	settingsParams.Context = ctx
+
+	settingsRes, err := d.client.InstallationTokens.CustomerSettingsRead(settingsParams)
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes))
+		return
+	}
+
+	if settingsRes == nil || settingsRes.Payload == nil || len(settingsRes.Payload.Resources) == 0 ||
+		settingsRes.Payload.Resources[0] == nil {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+		return
+	}
+
+	data.TokensRequired = types.BoolPointerValue(settingsRes.Payload.Resources[0].TokensRequired)
+	data.ProvisioningToken = types.StringNull()
+
+	if data.TokensRequired.ValueBool() || !data.ProvisioningTokenLabel.IsNull() {
+		token, diags := d.provisioningToken(ctx, data.ProvisioningTokenLabel.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.ProvisioningToken = types.StringValue(token)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// provisioningToken returns the value of the most recently created valid
+// installation token, restricted to the tokens labeled label when it is set.
+func (d *cidDataSource) provisioningToken(ctx context.Context, label string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter := "status:'valid'"
+	if label != "" {
+		filter += "+label:" + utils.FQLQuote(label)
+	}
+
+	queryParams := installation_tokens.NewTokensQueryParams()
+	queryParams.Context = ctx
+	queryParams.Filter = &filter
+	queryParams.Sort = utils.Addr("created_timestamp.desc")
+	queryParams.Limit = utils.Addr(int64(1))
+
+	queryRes, err := d.client.InstallationTokens.TokensQuery(queryParams)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes))
+		return "", diags
+	}
+
+	if queryRes == nil || queryRes.Payload == nil || len(queryRes.Payload.Resources) == 0 {
+		detail := "The CID requires a provisioning token to install sensors, but it has no valid installation token. Create one in the Falcon console."
+		if label != "" {
+			detail = fmt.Sprintf("No valid installation token is labeled %q.", label)
+		}
+		diags.Append(tferrors.NewNotFoundError(detail))
+		return "", diags
+	}
+
+	readParams := installation_tokens.NewTokensReadParams()
+	readParams.Context = ctx
+	readParams.Ids = queryRes.Payload.Resources[:1]
+
+	readRes, err := d.client.InstallationTokens.TokensRead(readParams)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes))
+		return "", diags
+	}
+
+	if readRes == nil || readRes.Payload == nil || len(readRes.Payload.Resources) == 0 ||
+		readRes.Payload.Resources[0] == nil || readRes.Payload.Resources[0].Value == nil {
+		diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+		return "", diags
+	}
+
+	return *readRes.Payload.Resources[0].Value, diags
+}
@@ -0,0 +1,53 @@
+package sensordownload_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccCIDDataSource_basic(t *testing.T) {
+	dataSourceName := "data.crowdstrike_cid.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_cid" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						dataSourceName,
+						tfjsonpath.New("cid"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[0-9A-F]{32}-[0-9A-F]{2}$`)),
+					),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("tokens_required"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCIDDataSource_unknownTokenLabel(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_cid" "test" {
+  provisioning_token_label = "tf-acc-does-not-exist"
+}
+`,
+				ExpectError: regexp.MustCompile(`No valid installation token is labeled`),
+			},
+		},
+	})
+}
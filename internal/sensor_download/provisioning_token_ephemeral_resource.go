@@ -0,0 +1,133 @@
+package sensordownload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &provisioningTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &provisioningTokenEphemeralResource{}
+)
+
+func NewProvisioningTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &provisioningTokenEphemeralResource{}
+}
+
+type provisioningTokenEphemeralResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type provisioningTokenEphemeralResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Label     types.String `tfsdk:"label"`
+	Status    types.String `tfsdk:"status"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Value     types.String `tfsdk:"value"`
+}
+
+func (r *provisioningTokenEphemeralResource) Metadata(
+	_ context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_provisioning_token"
+}
+
+func (r *provisioningTokenEphemeralResource) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(config.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerConfig.Client
+	resp.Diagnostics.Append(providerConfig.CheckScopes(apiScopes)...)
+}
+
+func (r *provisioningTokenEphemeralResource) Schema(
+	_ context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Sensor Download",
+			"This ephemeral resource returns the value of an installation token, such as one managed by the `crowdstrike_provisioning_token` resource, without storing it in the plan or state. "+
+				"Use it to pass the provisioning token to image builds or launch templates that install sensors.",
+			apiScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the installation token.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Computed:    true,
+				Description: "Label of the installation token.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the installation token, such as valid, expired, or revoked.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiration time of the installation token. Null when the token never expires.",
+			},
+			"value": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The installation token to pass to the sensor installer as the provisioning token.",
+			},
+		},
+	}
+}
+
+func (r *provisioningTokenEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	var data provisioningTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, diags := readToken(ctx, r.client, data.ID.ValueString(), apiScopes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Label = flex.StringPointerToFramework(token.Label)
+	data.Status = flex.StringPointerToFramework(token.Status)
+	data.ExpiresAt = dateTimeToFramework(token.ExpiresTimestamp)
+	data.Value = flex.StringPointerToFramework(token.Value)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,458 @@
+package sensordownload
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/installation_tokens"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &provisioningTokenResource{}
+	_ resource.ResourceWithConfigure   = &provisioningTokenResource{}
+	_ resource.ResourceWithImportState = &provisioningTokenResource{}
+	_ resource.ResourceWithIdentity    = &provisioningTokenResource{}
+)
+
+func NewProvisioningTokenResource() resource.Resource {
+	return &provisioningTokenResource{}
+}
+
+type provisioningTokenResource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type provisioningTokenResourceModel struct {
+	ID          types.String      `tfsdk:"id"`
+	LastUpdated types.String      `tfsdk:"last_updated"`
+	Label       types.String      `tfsdk:"label"`
+	ExpiresAt   timetypes.RFC3339 `tfsdk:"expires_at"`
+	Revoked     types.Bool        `tfsdk:"revoked"`
+	Status      types.String      `tfsdk:"status"`
+	Type        types.String      `tfsdk:"type"`
+	CreatedAt   types.String      `tfsdk:"created_at"`
+	Timeouts    timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// tokenRequest is the body of requests creating and updating installation
+// tokens. models.APITokenCreateRequestV1 and models.APITokenPatchRequestV1
+// cannot clear the expiration of a token, as a zero strfmt.DateTime is sent
+// as a timestamp rather than null, nor un-revoke it, as revoked is omitted
+// when false.
+type tokenRequest struct {
+	Label            string           `json:"label"`
+	ExpiresTimestamp *strfmt.DateTime `json:"expires_timestamp"`
+	Revoked          *bool            `json:"revoked,omitempty"`
+}
+
+// withTokenRequest sends body as the body of an installation tokens request.
+func withTokenRequest(body tokenRequest) installation_tokens.ClientOption {
+	return func(op *runtime.ClientOperation) {
+		params := op.Params
+		op.Params = runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+			if err := params.WriteToRequest(r, reg); err != nil {
+				return err
+			}
+			return r.SetBodyParam(body)
+		})
+	}
+}
+
+func (r *provisioningTokenResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_provisioning_token"
+}
+
+func (r *provisioningTokenResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(config.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerConfig.Client
+	resp.Diagnostics.Append(providerConfig.CheckScopes(provisioningTokenScopes)...)
+}
+
+func (r *provisioningTokenResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Sensor Download",
+			"This resource manages an installation token, the provisioning token required to install sensors when the CID enforces installation tokens. "+
+				"The token value is not stored in state; read it with the `crowdstrike_provisioning_token` ephemeral resource. "+
+				"To rotate the token, replace the resource with the `replace_triggered_by` lifecycle argument, for example on a `time_rotating` resource.",
+			provisioningTokenScopes,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the installation token.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Required:    true,
+				Description: "Label of the installation token.",
+				Validators: []validator.String{
+					fwvalidators.StringNotWhitespace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				Optional:            true,
+				MarkdownDescription: "Expiration time of the installation token in RFC3339 format (e.g., `2025-08-11T10:00:00Z`). The token never expires when not set.",
+			},
+			"revoked": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the installation token is revoked. Revoked tokens can no longer install sensors, and can be restored by setting `revoked` to `false`. Defaults to `false`.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the installation token, such as valid, expired, or revoked.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the installation token.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation time of the installation token.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the resource.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *provisioningTokenResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
+func (r *provisioningTokenResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan provisioningTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	body, diags := plan.tokenRequest()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := installation_tokens.NewTokensCreateParams().WithContext(ctx)
+
+	res, err := r.client.InstallationTokens.TokensCreate(params, withTokenRequest(body))
+	if err != nil {
+		resp.Diagnostics.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Create, err, provisioningTokenScopes))
+		return
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		resp.Diagnostics.Append(tferrors.NewEmptyResponseError(tferrors.Create))
+		return
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Create, res.Payload.Errors); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	token := res.Payload.Resources[0]
+	plan.ID = flex.StringPointerToFramework(token.ID)
+
+	// Set the ID early so that a token failing to be revoked is tracked.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tokens are always created valid, so a token planned as revoked is
+	// revoked once created.
+	if plan.Revoked.ValueBool() {
+		body.Revoked = utils.Addr(true)
+		token, diags = r.updateToken(ctx, plan.ID.ValueString(), body)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	plan.wrap(*token)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *provisioningTokenResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state provisioningTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	token, diags := readToken(ctx, r.client, state.ID.ValueString(), provisioningTokenScopes)
+	if tferrors.HasNotFoundError(diags) {
+		resp.Diagnostics.Append(tferrors.NewResourceNotFoundWarningDiagnostic())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.wrap(*token)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func (r *provisioningTokenResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan provisioningTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	body, diags := plan.tokenRequest()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	body.Revoked = plan.Revoked.ValueBoolPointer()
+
+	token, diags := r.updateToken(ctx, plan.ID.ValueString(), body)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = utils.GenerateUpdateTimestamp()
+	plan.wrap(*token)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *provisioningTokenResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state provisioningTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	params := installation_tokens.NewTokensDeleteParams().
+		WithContext(ctx).
+		WithIds([]string{state.ID.ValueString()})
+
+	_, err := r.client.InstallationTokens.TokensDelete(params)
+	if err != nil {
+		diag := tferrors.NewDiagnosticFromAPIError(tferrors.Delete, err, provisioningTokenScopes)
+		if diag.Summary() == tferrors.NotFoundErrorSummary {
+			return
+		}
+		resp.Diagnostics.Append(diag)
+		return
+	}
+}
+
+func (r *provisioningTokenResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// updateToken updates the installation token with ID id and returns it.
+func (r *provisioningTokenResource) updateToken(
+	ctx context.Context,
+	id string,
+	body tokenRequest,
+) (*models.APITokenDetailsResourceV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := installation_tokens.NewTokensUpdateParams().
+		WithContext(ctx).
+		WithIds([]string{id})
+
+	if _, err := r.client.InstallationTokens.TokensUpdate(params, withTokenRequest(body)); err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Update, err, provisioningTokenScopes))
+		return nil, diags
+	}
+
+	return readToken(ctx, r.client, id, provisioningTokenScopes)
+}
+
+// readToken returns the installation token with ID id, or a not found error
+// when it does not exist.
+func readToken(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	id string,
+	apiScopes []scopes.Scope,
+) (*models.APITokenDetailsResourceV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := installation_tokens.NewTokensReadParams().
+		WithContext(ctx).
+		WithIds([]string{id})
+
+	res, err := client.InstallationTokens.TokensRead(params)
+	if err != nil {
+		diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes))
+		return nil, diags
+	}
+
+	if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 || res.Payload.Resources[0] == nil {
+		diags.Append(tferrors.NewNotFoundError(fmt.Sprintf("Installation token %s not found.", id)))
+		return nil, diags
+	}
+
+	if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+		diags.Append(diag)
+		return nil, diags
+	}
+
+	return res.Payload.Resources[0], diags
+}
+
+// tokenRequest returns the body of the requests creating and updating the
+// installation token, leaving revoked for the caller to set.
+func (m *provisioningTokenResourceModel) tokenRequest() (tokenRequest, diag.Diagnostics) {
+	body := tokenRequest{Label: m.Label.ValueString()}
+
+	if m.ExpiresAt.IsNull() {
+		return body, nil
+	}
+
+	expiresAt, diags := m.ExpiresAt.ValueRFC3339Time()
+	if diags.HasError() {
+		return body, diags
+	}
+	body.ExpiresTimestamp = utils.Addr(strfmt.DateTime(expiresAt))
+
+	return body, diags
+}
+
+// wrap converts the API model to the Terraform model.
+func (m *provisioningTokenResourceModel) wrap(token models.APITokenDetailsResourceV1) {
+	m.ID = flex.StringPointerToFramework(token.ID)
+	m.Label = flex.StringPointerToFramework(token.Label)
+	m.Status = flex.StringPointerToFramework(token.Status)
+	m.Type = flex.StringPointerToFramework(token.Type)
+	m.CreatedAt = dateTimeToFramework(token.CreatedTimestamp)
+	m.Revoked = types.BoolValue(token.RevokedTimestamp != nil && !token.RevokedTimestamp.IsZero())
+
+	// Keep the configured expiration when it is the same time in another
+	// format, so the plan does not show a difference.
+	if token.ExpiresTimestamp == nil || token.ExpiresTimestamp.IsZero() {
+		m.ExpiresAt = timetypes.NewRFC3339Null()
+		return
+	}
+
+	if !m.ExpiresAt.IsNull() {
+		expiresAt, diags := m.ExpiresAt.ValueRFC3339Time()
+		if !diags.HasError() && expiresAt.Equal(time.Time(*token.ExpiresTimestamp)) {
+			return
+		}
+	}
+
+	m.ExpiresAt = timetypes.NewRFC3339TimeValue(time.Time(*token.ExpiresTimestamp))
+}
+
+// dateTimeToFramework converts an API timestamp to an RFC3339 string, or null
+// when it is not set.
+func dateTimeToFramework(v *strfmt.DateTime) types.String {
+	if v == nil || v.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Time(*v).UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,22 @@
+package sensordownload
+
+import "github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+
+var apiScopes = []scopes.Scope{
+	{
+		Name: "Sensor Download",
+		Read: true,
+	},
+	{
+		Name: "Installation tokens",
+		Read: true,
+	},
+}
+
+var provisioningTokenScopes = []scopes.Scope{
+	{
+		Name:  "Installation tokens",
+		Read:  true,
+		Write: true,
+	},
+}
@@ -0,0 +1,63 @@
+package sensordownload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/installation_tokens"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/sweep"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+)
+
+func RegisterSweepers() {
+	sweep.Register("crowdstrike_provisioning_token", sweepProvisioningTokens)
+}
+
+func sweepProvisioningTokens(ctx context.Context, client *client.CrowdStrikeAPISpecification) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	params := installation_tokens.NewTokensQueryParams()
+	params.WithContext(ctx)
+	params.Filter = utils.Addr(fmt.Sprintf("label:~'%s'", sweep.ResourcePrefix))
+
+	resp, err := client.InstallationTokens.TokensQuery(params)
+	if sweep.SkipSweepError(err) {
+		sweep.Warn("Skipping Provisioning Token sweep: %s", err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error listing provisioning tokens: %w", err)
+	}
+
+	if resp == nil || resp.Payload == nil || resp.Payload.Resources == nil {
+		return sweepables, nil
+	}
+
+	for _, id := range resp.Payload.Resources {
+		sweepables = append(sweepables, sweep.NewSweepResource(
+			id,
+			id,
+			deleteProvisioningToken,
+		))
+	}
+
+	return sweepables, nil
+}
+
+func deleteProvisioningToken(ctx context.Context, client *client.CrowdStrikeAPISpecification, id string) error {
+	params := installation_tokens.NewTokensDeleteParams()
+	params.WithContext(ctx)
+	params.Ids = []string{id}
+
+	_, err := client.InstallationTokens.TokensDelete(params)
+	if err != nil {
+		if sweep.ShouldIgnoreError(err) {
+			sweep.Debug("Ignoring error for provisioning token %s: %s", id, err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
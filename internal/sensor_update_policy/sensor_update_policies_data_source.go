@@ -3,6 +3,7 @@ package sensorupdatepolicy
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/crowdstrike/gofalcon/falcon/client"
@@ -22,12 +23,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
 	dataSourceDocumentationSection = "Sensor Update Policy"
 	dataSourceMarkdownDescription  = "This data source provides information about sensor update policies in Falcon."
+	// sensorUpdatePoliciesQueryLimit is the page size used when querying sensor update
+	// policies, and the largest page size accepted by the API.
+	sensorUpdatePoliciesQueryLimit = 5000
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -60,6 +63,7 @@ func (d *sensorUpdatePoliciesDataSource) Configure(
 	}
 
 	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesRead)...)
 }
 
 // sensorUpdatePoliciesDataSource is the data source implementation.
@@ -108,6 +112,8 @@ type sensorUpdatePoliciesDataSourceModel struct {
 	Filter       types.String `tfsdk:"filter"`
 	IDs          types.List   `tfsdk:"ids"`
 	Sort         types.String `tfsdk:"sort"`
+	Limit        types.Int64  `tfsdk:"limit"`
+	MaxItems     types.Int64  `tfsdk:"max_items"`
 	Name         types.String `tfsdk:"name"`
 	Description  types.String `tfsdk:"description"`
 	Enabled      types.Bool   `tfsdk:"enabled"`
@@ -291,6 +297,8 @@ func (d *sensorUpdatePoliciesDataSource) Schema(
 					fwvalidators.StringNotWhitespace(),
 				},
 			},
+			"limit":     utils.PageLimitAttribute("sensor update policies", sensorUpdatePoliciesQueryLimit, sensorUpdatePoliciesQueryLimit),
+			"max_items": utils.MaxItemsAttribute("sensor update policies"),
 			"name": schema.StringAttribute{
 				Optional:    true,
 				Description: "Filter policies by name. All provided filter attributes must match for a policy to be returned (omitted attributes are ignored). Supports wildcard matching with '*' where '*' matches any sequence of characters until the end of the string or until the next literal character in the pattern is found. Multiple wildcards can be used in a single pattern. Matching is case insensitive. Cannot be used together with 'filter' or 'ids'.",
@@ -459,82 +467,48 @@ func (d *sensorUpdatePoliciesDataSource) ValidateConfig(ctx context.Context, req
 	}
 }
 
-// getSensorUpdatePolicies returns all sensor update policies matching filter.
+// getSensorUpdatePolicies returns up to pagination.MaxItems sensor update
+// policies matching filter in the order of sort, and the total number of
+// matching policies.
 func (d *sensorUpdatePoliciesDataSource) getSensorUpdatePolicies(
 	ctx context.Context,
 	filter string,
 	sort string,
-) ([]*models.SensorUpdatePolicyV2, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	var allPolicies []*models.SensorUpdatePolicyV2
-
-	tflog.Debug(
-		ctx,
-		"[datasource] Getting all sensor update policies",
-	)
+	pagination utils.Pagination,
+) ([]*models.SensorUpdatePolicyV2, int64, diag.Diagnostics) {
+	return utils.QueryPages(pagination, func(offset, limit int64) ([]*models.SensorUpdatePolicyV2, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
 
-	limit := int64(5000)
-	offset := int64(0)
-
-	for {
 		params := &sensor_update_policies.QueryCombinedSensorUpdatePoliciesV2Params{
 			Context: ctx,
 			Limit:   &limit,
 			Offset:  &offset,
 		}
-
 		if filter != "" {
 			params.Filter = &filter
 		}
-
 		if sort != "" {
 			params.Sort = &sort
 		}
 
 		res, err := d.client.SensorUpdatePolicies.QueryCombinedSensorUpdatePoliciesV2(params)
 		if err != nil {
-			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
-			return allPolicies, diags
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesRead))
+			return nil, 0, diags
 		}
 
-		if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 {
-			tflog.Debug(ctx, "[datasource] No more sensor update policies to retrieve",
-				map[string]interface{}{
-					"total_retrieved": len(allPolicies),
-				})
-			break
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
 		}
 
-		allPolicies = append(allPolicies, res.Payload.Resources...)
-		tflog.Debug(ctx, "[datasource] Retrieved page of sensor update policies",
-			map[string]interface{}{
-				"page_count":  len(res.Payload.Resources),
-				"total_count": len(allPolicies),
-				"offset":      offset,
-			})
-
-		if res.Payload.Meta == nil || res.Payload.Meta.Pagination == nil ||
-			res.Payload.Meta.Pagination.Offset == nil || res.Payload.Meta.Pagination.Total == nil {
-			tflog.Warn(ctx, "Missing pagination metadata in API response, using offset+limit for next page",
-				map[string]interface{}{
-					"meta": res.Payload.Meta,
-				})
-			offset += limit
-			continue
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
 		}
 
-		offset = int64(*res.Payload.Meta.Pagination.Offset)
-		if offset >= *res.Payload.Meta.Pagination.Total {
-			tflog.Info(ctx, "[datasource] Pagination complete",
-				map[string]interface{}{
-					"total_retrieved": len(allPolicies),
-					"total_available": *res.Payload.Meta.Pagination.Total,
-				})
-			break
-		}
-	}
-
-	return allPolicies, diags
+		return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+	})
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -549,7 +523,17 @@ func (d *sensorUpdatePoliciesDataSource) Read(
 		return
 	}
 
-	policies, diags := d.getSensorUpdatePolicies(ctx, data.Filter.ValueString(), data.Sort.ValueString())
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, sensorUpdatePoliciesQueryLimit)
+
+	// ids and the individual filter attributes are matched against every
+	// policy, so max_items applies to the matching policies.
+	matchAll := utils.IsKnown(data.IDs) || data.hasIndividualFilters()
+	query := pagination
+	if matchAll {
+		query.MaxItems = math.MaxInt64
+	}
+
+	policies, total, diags := d.getSensorUpdatePolicies(ctx, data.Filter.ValueString(), data.Sort.ValueString(), query)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -568,6 +552,12 @@ func (d *sensorUpdatePoliciesDataSource) Read(
 		policies = filterPoliciesByAttributes(policies, &data)
 	}
 
+	if matchAll {
+		policies, total = utils.Truncate(policies, pagination.MaxItems)
+	}
+
+	resp.Diagnostics.Append(utils.TruncatedWarning("sensor update policies", len(policies), total)...)
+
 	resp.Diagnostics.Append(data.wrap(ctx, policies)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -13,6 +13,7 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -98,7 +99,8 @@ type sensorBuild struct {
 
 // sensorUpdatePolicyBuildsDataSource is the data source implementation.
 type sensorUpdatePolicyBuildsDataSource struct {
-	client *client.CrowdStrikeAPISpecification
+	client    *client.CrowdStrikeAPISpecification
+	readCache *config.ReadCache
 }
 
 // Metadata returns the data source type name.
@@ -158,18 +160,28 @@ func (d *sensorUpdatePolicyBuildsDataSource) Read(
 ) {
 	var state sensorUpdatePolicyBuildsDataSourceModel
 
-	builds, err := d.client.SensorUpdatePolicies.QueryCombinedSensorUpdateBuilds(
-		&sensor_update_policies.QueryCombinedSensorUpdateBuildsParams{
-			Context: ctx,
-		},
-	)
-	if err != nil {
-		var forbiddenErr *sensor_update_policies.QueryCombinedSensorUpdateBuildsForbidden
-		if errors.As(err, &forbiddenErr) {
-			resp.Diagnostics.Append(tferrors.NewForbiddenError(tferrors.Read, apiScopesRead))
-			return
+	builds, diags := config.Cached(d.readCache, "sensor_update_builds", func() (*sensor_update_policies.QueryCombinedSensorUpdateBuildsOK, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		builds, err := d.client.SensorUpdatePolicies.QueryCombinedSensorUpdateBuilds(
+			&sensor_update_policies.QueryCombinedSensorUpdateBuildsParams{
+				Context: ctx,
+			},
+		)
+		if err != nil {
+			var forbiddenErr *sensor_update_policies.QueryCombinedSensorUpdateBuildsForbidden
+			if errors.As(err, &forbiddenErr) {
+				diags.Append(tferrors.NewForbiddenError(tferrors.Read, apiScopesRead))
+				return nil, diags
+			}
+			diags.Append(tferrors.NewOperationError(tferrors.Read, err))
+			return nil, diags
 		}
-		resp.Diagnostics.Append(tferrors.NewOperationError(tferrors.Read, err))
+
+		return builds, diags
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -219,8 +231,7 @@ func (d *sensorUpdatePolicyBuildsDataSource) Read(
 	state.LinuxArm64 = linuxArm64PlatformBuilds
 	state.Mac = macPlatformBuilds
 
-	diags := resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -249,6 +260,8 @@ func (d *sensorUpdatePolicyBuildsDataSource) Configure(
 	}
 
 	d.client = config.Client
+	d.readCache = config.ReadCache
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesRead)...)
 }
 
 // mapBuild checks if a build is latest, n-1, or n-2 and adds the build to the appropriate attribute.
@@ -9,8 +9,10 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
 	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,11 +28,13 @@ var (
 	_ resource.ResourceWithConfigure      = &sensorUpdatePolicyHostGroupAttachmentResource{}
 	_ resource.ResourceWithImportState    = &sensorUpdatePolicyHostGroupAttachmentResource{}
 	_ resource.ResourceWithValidateConfig = &sensorUpdatePolicyHostGroupAttachmentResource{}
+	_ resource.ResourceWithModifyPlan     = &sensorUpdatePolicyHostGroupAttachmentResource{}
+	_ resource.ResourceWithMoveState      = &sensorUpdatePolicyHostGroupAttachmentResource{}
 )
 
 var (
 	documentationSection        string         = "Sensor Update Policy"
-	resourceMarkdownDescription string         = "This resource allows managing the host groups attached to a sensor update policy. By default (when `exclusive` is true), this resource takes exclusive ownership over the host groups assigned to a sensor update policy. When `exclusive` is false, this resource only manages the specific host groups defined in the configuration. If you want to fully create or manage a sensor update policy please use the `crowdstrike_sensor_update_policy` resource."
+	resourceMarkdownDescription string         = "This resource allows managing the host groups attached to a sensor update policy. By default (when `exclusive` is true), this resource takes exclusive ownership over the host groups assigned to a sensor update policy. When `exclusive` is false, this resource only manages the specific host groups defined in the configuration. If you want to fully create or manage a sensor update policy please use the `crowdstrike_sensor_update_policy` resource. A `crowdstrike_sensor_update_policy` resource can be moved to this resource with a `moved` block to take over its attachments without recreating the policy; the attachment starts with `exclusive` set to true."
 	requiredScopes              []scopes.Scope = []scopes.Scope{
 		{
 			Name:  "Sensor update policies",
@@ -45,14 +49,16 @@ func NewSensorUpdatePolicyHostGroupAttachmentResource() resource.Resource {
 }
 
 type sensorUpdatePolicyHostGroupAttachmentResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client     *client.CrowdStrikeAPISpecification
+	references references.Validator
 }
 
 type sensorUpdatePolicyHostGroupAttachmentResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	HostGroups  types.Set    `tfsdk:"host_groups"`
-	Exclusive   types.Bool   `tfsdk:"exclusive"`
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	HostGroups  types.Set      `tfsdk:"host_groups"`
+	Exclusive   types.Bool     `tfsdk:"exclusive"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // wrap transforms Go values to their terraform wrapped values.
@@ -134,6 +140,22 @@ func (r *sensorUpdatePolicyHostGroupAttachmentResource) Configure(
 	}
 
 	r.client = config.Client
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(requiredScopes)...)
+}
+
+// ModifyPlan validates that the objects referenced by the resource exist.
+func (r *sensorUpdatePolicyHostGroupAttachmentResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	resp.Diagnostics.Append(r.references.Validate(
+		ctx,
+		req.Plan,
+		references.SensorUpdatePolicy(path.Root("id")),
+		references.HostGroups(path.Root("host_groups")),
+	)...)
 }
 
 func (r *sensorUpdatePolicyHostGroupAttachmentResource) Metadata(
@@ -145,7 +167,7 @@ func (r *sensorUpdatePolicyHostGroupAttachmentResource) Metadata(
 }
 
 func (r *sensorUpdatePolicyHostGroupAttachmentResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -176,6 +198,9 @@ func (r *sensorUpdatePolicyHostGroupAttachmentResource) Schema(
 				Description: "Host Group ids to attach to the sensor update policy.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -190,6 +215,9 @@ func (r *sensorUpdatePolicyHostGroupAttachmentResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getSensorUpdatePolicy(ctx, r.client, plan.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -239,6 +267,9 @@ func (r *sensorUpdatePolicyHostGroupAttachmentResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	policy, diags := getSensorUpdatePolicy(ctx, r.client, state.ID.ValueString())
 	for _, err := range diags.Errors() {
 		if err.Summary() == notFoundErrorSummary {
@@ -275,6 +306,9 @@ func (r *sensorUpdatePolicyHostGroupAttachmentResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	planHostGroups := plan.HostGroups
 
 	if !plan.Exclusive.ValueBool() {
@@ -337,6 +371,12 @@ func (r *sensorUpdatePolicyHostGroupAttachmentResource) Delete(
 ) {
 	var state sensorUpdatePolicyHostGroupAttachmentResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
 
 	resp.Diagnostics.Append(
 		syncHostGroups(
@@ -348,6 +388,17 @@ func (r *sensorUpdatePolicyHostGroupAttachmentResource) Delete(
 		)...)
 }
 
+// MoveState allows moving a `crowdstrike_sensor_update_policy` resource to this
+// resource with a moved block, so the sensor update policy is not destroyed and
+// recreated when its attachments are managed separately.
+func (r *sensorUpdatePolicyHostGroupAttachmentResource) MoveState(
+	_ context.Context,
+) []resource.StateMover {
+	return []resource.StateMover{
+		utils.AttachmentStateMover("crowdstrike_sensor_update_policy", utils.MovedGroups{Source: "host_groups", Target: "host_groups"}),
+	}
+}
+
 func (r *sensorUpdatePolicyHostGroupAttachmentResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
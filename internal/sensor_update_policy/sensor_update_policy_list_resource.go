@@ -0,0 +1,56 @@
+package sensorupdatepolicy
+
+import (
+	"context"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/sensor_update_policies"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/listresource"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewSensorUpdatePolicyListResource is a helper function to simplify the provider implementation.
+func NewSensorUpdatePolicyListResource() list.ListResource {
+	return listresource.New(listresource.Options[*models.SensorUpdatePolicyV2, sensorUpdatePolicyResourceModel]{
+		TypeName: "_sensor_update_policy",
+		Objects:  "sensor update policies",
+		// Default policies are managed by their own resources and are not listed.
+		Filter: "name.raw:!'platform_default'",
+		Scopes: apiScopesRead,
+		Query:  querySensorUpdatePolicies,
+		ID:     func(policy *models.SensorUpdatePolicyV2) *string { return policy.ID },
+		Name:   func(policy *models.SensorUpdatePolicyV2) *string { return policy.Name },
+		Wrap: func(ctx context.Context, m *sensorUpdatePolicyResourceModel, policy *models.SensorUpdatePolicyV2) diag.Diagnostics {
+			return m.wrap(ctx, *policy, false, false)
+		},
+	})
+}
+
+// querySensorUpdatePolicies fetches a page of the sensor update policies matching filter.
+func querySensorUpdatePolicies(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	filter *string,
+	offset int64,
+) (*listresource.Page[*models.SensorUpdatePolicyV2], error) {
+	res, err := client.SensorUpdatePolicies.QueryCombinedSensorUpdatePoliciesV2(
+		&sensor_update_policies.QueryCombinedSensorUpdatePoliciesV2Params{
+			Context: ctx,
+			Filter:  filter,
+			Limit:   utils.Addr(utils.ListPageSize),
+			Offset:  &offset,
+		},
+	)
+	if err != nil || res == nil || res.Payload == nil {
+		return nil, err
+	}
+
+	return &listresource.Page[*models.SensorUpdatePolicyV2]{
+		Resources: res.Payload.Resources,
+		Meta:      res.Payload.Meta,
+		Errors:    res.Payload.Errors,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package sensorupdatepolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSensorUpdatePolicyListResource(t *testing.T) {
+	rName := acctest.RandomResourceName()
+	listName := "crowdstrike_sensor_update_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSensorUpdatePolicyListResourceConfig(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccSensorUpdatePolicyListResourceQuery(rName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(listName, 1),
+					querycheck.ExpectResourceDisplayName(
+						listName,
+						queryfilter.ByDisplayName(knownvalue.StringExact(rName)),
+						knownvalue.StringExact(rName),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccSensorUpdatePolicyListResourceConfig(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+data "crowdstrike_sensor_update_policy_builds" "all" {}
+
+resource "crowdstrike_sensor_update_policy" "test" {
+  name                 = %[1]q
+  enabled              = true
+  description          = "made with terraform"
+  host_groups          = []
+  platform_name        = "Windows"
+  build                = data.crowdstrike_sensor_update_policy_builds.all.windows.n1.build
+  uninstall_protection = false
+  schedule = {
+    enabled = false
+  }
+}
+`, rName)
+}
+
+func testAccSensorUpdatePolicyListResourceQuery(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+list "crowdstrike_sensor_update_policy" "test" {
+  provider = crowdstrike
+
+  config {
+    filter = "name:'%s'"
+  }
+}
+`, rName)
+}
@@ -11,10 +11,12 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -36,7 +38,9 @@ var (
 	_ resource.Resource                   = &sensorUpdatePolicyResource{}
 	_ resource.ResourceWithConfigure      = &sensorUpdatePolicyResource{}
 	_ resource.ResourceWithImportState    = &sensorUpdatePolicyResource{}
+	_ resource.ResourceWithIdentity       = &sensorUpdatePolicyResource{}
 	_ resource.ResourceWithValidateConfig = &sensorUpdatePolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &sensorUpdatePolicyResource{}
 )
 
 // NewSensorUpdatePolicyResource is a helper function to simplify the provider implementation.
@@ -46,12 +50,15 @@ func NewSensorUpdatePolicyResource() resource.Resource {
 
 // sensorUpdatePolicyResource is the resource implementation.
 type sensorUpdatePolicyResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client         *client.CrowdStrikeAPISpecification
+	references     references.Validator
+	providerConfig config.ProviderConfig
 }
 
 // sensorUpdatePolicyResourceModel is the resource model.
 type sensorUpdatePolicyResourceModel struct {
 	ID                  types.String `tfsdk:"id"`
+	CID                 types.String `tfsdk:"cid"`
 	Enabled             types.Bool   `tfsdk:"enabled"`
 	Name                types.String `tfsdk:"name"`
 	Build               types.String `tfsdk:"build"`
@@ -65,6 +72,7 @@ type sensorUpdatePolicyResourceModel struct {
 	Schedule            types.Object `tfsdk:"schedule"`
 
 	schedule *policySchedule `tfsdk:"-"`
+	Timeouts timeouts.Value  `tfsdk:"timeouts"`
 }
 
 // extract extracts the Go values form their terraform wrapped values.
@@ -278,6 +286,46 @@ func (r *sensorUpdatePolicyResource) Configure(
 	}
 
 	r.client = config.Client
+	r.providerConfig = config
+	r.references = references.NewValidator(config)
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesReadWrite)...)
+}
+
+// withCID returns a copy of the resource managing the sensor update policies of the
+// member CID cid.
+func (r *sensorUpdatePolicyResource) withCID(cid types.String, diags *diag.Diagnostics) *sensorUpdatePolicyResource {
+	c, d := r.providerConfig.ClientForCID(cid)
+	diags.Append(d...)
+
+	withCID := *r
+	withCID.client = c
+	return &withCID
+}
+
+// ModifyPlan defers changes to the objects of a member CID that is not
+// available yet and validates that the objects referenced by the resource exist.
+func (r *sensorUpdatePolicyResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if r.providerConfig.DeferPlanForCID(ctx, req, resp) {
+		return
+	}
+
+	var cid types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cid"), &cid)...)
+	refs, diags := r.references.ForCID(cid)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(refs.Validate(
+		ctx,
+		req.Plan,
+		references.HostGroups(path.Root("host_groups")),
+	)...)
 }
 
 // Metadata returns the resource type name.
@@ -291,7 +339,7 @@ func (r *sensorUpdatePolicyResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *sensorUpdatePolicyResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -308,6 +356,7 @@ func (r *sensorUpdatePolicyResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"cid": utils.CIDAttribute("sensor update policy"),
 			"last_updated": schema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp of the last Terraform update of the resource.",
@@ -408,9 +457,21 @@ func (r *sensorUpdatePolicyResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *sensorUpdatePolicyResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *sensorUpdatePolicyResource) Create(
 	ctx context.Context,
@@ -424,6 +485,14 @@ func (r *sensorUpdatePolicyResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	policyParams := sensor_update_policies.CreateSensorUpdatePoliciesV2Params{
 		Context: ctx,
 		Body: &models.SensorUpdateCreatePoliciesReqV2{
@@ -547,6 +616,7 @@ func (r *sensorUpdatePolicyResource) Create(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, *policy, true, true)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -564,6 +634,14 @@ func (r *sensorUpdatePolicyResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	res, err := r.client.SensorUpdatePolicies.GetSensorUpdatePoliciesV2(
 		&sensor_update_policies.GetSensorUpdatePoliciesV2Params{
 			Context: ctx,
@@ -594,6 +672,7 @@ func (r *sensorUpdatePolicyResource) Read(
 
 	resp.Diagnostics.Append(state.wrap(ctx, *policy, false, false)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -612,6 +691,14 @@ func (r *sensorUpdatePolicyResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(plan.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// Retrieve values from state
 	var state sensorUpdatePolicyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -784,6 +871,7 @@ func (r *sensorUpdatePolicyResource) Update(
 
 	resp.Diagnostics.Append(plan.wrap(ctx, *policy, true, true)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -802,6 +890,14 @@ func (r *sensorUpdatePolicyResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
+	r = r.withCID(state.CID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// need to make sure the policy is disabled before delete
 	_, err := r.updatePolicyEnabledState(
 		ctx,
@@ -858,7 +954,7 @@ func (r *sensorUpdatePolicyResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	utils.ImportStateWithCID(ctx, req, resp)
 }
 
 // ValidateConfig runs during validate, plan, and apply
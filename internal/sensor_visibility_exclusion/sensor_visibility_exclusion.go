@@ -10,11 +10,13 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon/models"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
 	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -34,7 +36,9 @@ var (
 	_ resource.Resource                   = &sensorVisibilityExclusionResource{}
 	_ resource.ResourceWithConfigure      = &sensorVisibilityExclusionResource{}
 	_ resource.ResourceWithImportState    = &sensorVisibilityExclusionResource{}
+	_ resource.ResourceWithIdentity       = &sensorVisibilityExclusionResource{}
 	_ resource.ResourceWithValidateConfig = &sensorVisibilityExclusionResource{}
+	_ resource.ResourceWithModifyPlan     = &sensorVisibilityExclusionResource{}
 )
 
 var apiScopes = []scopes.Scope{
@@ -52,23 +56,26 @@ func NewSensorVisibilityExclusionResource() resource.Resource {
 
 // sensorVisibilityExclusionResource is the resource implementation.
 type sensorVisibilityExclusionResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client     *client.CrowdStrikeAPISpecification
+	config     config.ProviderConfig
+	references references.Validator
 }
 
 // SensorVisibilityExclusionResourceModel maps the resource schema data.
 type SensorVisibilityExclusionResourceModel struct {
-	ID                         types.String `tfsdk:"id"`
-	Value                      types.String `tfsdk:"value"`
-	ApplyToDescendantProcesses types.Bool   `tfsdk:"apply_to_descendant_processes"`
-	ApplyGlobally              types.Bool   `tfsdk:"apply_globally"`
-	HostGroups                 types.Set    `tfsdk:"host_groups"`
-	RegexpValue                types.String `tfsdk:"regexp_value"`
-	ValueHash                  types.String `tfsdk:"value_hash"`
-	LastModified               types.String `tfsdk:"last_modified"`
-	ModifiedBy                 types.String `tfsdk:"modified_by"`
-	CreatedOn                  types.String `tfsdk:"created_on"`
-	CreatedBy                  types.String `tfsdk:"created_by"`
-	LastUpdated                types.String `tfsdk:"last_updated"`
+	ID                         types.String   `tfsdk:"id"`
+	Value                      types.String   `tfsdk:"value"`
+	ApplyToDescendantProcesses types.Bool     `tfsdk:"apply_to_descendant_processes"`
+	ApplyGlobally              types.Bool     `tfsdk:"apply_globally"`
+	HostGroups                 types.Set      `tfsdk:"host_groups"`
+	RegexpValue                types.String   `tfsdk:"regexp_value"`
+	ValueHash                  types.String   `tfsdk:"value_hash"`
+	LastModified               types.String   `tfsdk:"last_modified"`
+	ModifiedBy                 types.String   `tfsdk:"modified_by"`
+	CreatedOn                  types.String   `tfsdk:"created_on"`
+	CreatedBy                  types.String   `tfsdk:"created_by"`
+	LastUpdated                types.String   `tfsdk:"last_updated"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
 }
 
 // wrap transforms the API response data to Terraform model values.
@@ -169,6 +176,22 @@ func (r *sensorVisibilityExclusionResource) Configure(
 	}
 
 	r.client = config.Client
+	r.references = references.NewValidator(config)
+	r.config = config
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
+}
+
+// ModifyPlan validates that the objects referenced by the resource exist.
+func (r *sensorVisibilityExclusionResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	resp.Diagnostics.Append(r.references.Validate(
+		ctx,
+		req.Plan,
+		references.HostGroups(path.Root("host_groups")),
+	)...)
 }
 
 // Metadata returns the resource type name.
@@ -182,7 +205,7 @@ func (r *sensorVisibilityExclusionResource) Metadata(
 
 // Schema defines the schema for the resource.
 func (r *sensorVisibilityExclusionResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -259,9 +282,21 @@ func (r *sensorVisibilityExclusionResource) Schema(
 				MarkdownDescription: "The user who created the exclusion.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *sensorVisibilityExclusionResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 // ValidateConfig runs during validate, plan, and apply
 // validate resource is configured as expected.
 func (r *sensorVisibilityExclusionResource) ValidateConfig(
@@ -315,6 +350,9 @@ func (r *sensorVisibilityExclusionResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	tflog.Info(ctx, "Starting sensor visibility exclusion creation", map[string]any{
 		"value":                         plan.Value.ValueString(),
 		"apply_to_descendant_processes": plan.ApplyToDescendantProcesses.ValueBool(),
@@ -348,7 +386,7 @@ func (r *sensorVisibilityExclusionResource) Create(
 
 	createReq := &models.SvExclusionsCreateReqV1{
 		Value:               plan.Value.ValueString(),
-		Comment:             "created by terraform crowdstrike provider",
+		Comment:             r.config.Comment("created by terraform crowdstrike provider"),
 		Groups:              groups,
 		IsDescendantProcess: plan.ApplyToDescendantProcesses.ValueBool(),
 	}
@@ -427,6 +465,7 @@ func (r *sensorVisibilityExclusionResource) Create(
 
 	tflog.Debug(ctx, "Setting final state for sensor visibility exclusion")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to set state for sensor visibility exclusion")
 		return
@@ -450,6 +489,9 @@ func (r *sensorVisibilityExclusionResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	exclusionID := state.ID.ValueString()
 	tflog.Info(ctx, "Starting sensor visibility exclusion read", map[string]any{
 		"exclusion_id": exclusionID,
@@ -494,6 +536,7 @@ func (r *sensorVisibilityExclusionResource) Read(
 
 	tflog.Debug(ctx, "Setting updated state for sensor visibility exclusion")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to set state for sensor visibility exclusion read")
 		return
@@ -517,6 +560,9 @@ func (r *sensorVisibilityExclusionResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	exclusionID := plan.ID.ValueString()
 	tflog.Info(ctx, "Starting sensor visibility exclusion update", map[string]any{
 		"exclusion_id":                  exclusionID,
@@ -558,7 +604,7 @@ func (r *sensorVisibilityExclusionResource) Update(
 	updateReq := &models.SvExclusionsUpdateReqV1{
 		ID:                  &id,
 		Value:               plan.Value.ValueString(),
-		Comment:             "updated by terraform crowdstrike provider",
+		Comment:             r.config.Comment("updated by terraform crowdstrike provider"),
 		Groups:              groups,
 		IsDescendantProcess: plan.ApplyToDescendantProcesses.ValueBool(),
 	}
@@ -655,6 +701,7 @@ func (r *sensorVisibilityExclusionResource) Update(
 
 	tflog.Debug(ctx, "Setting updated state for sensor visibility exclusion")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to set state for sensor visibility exclusion update")
 		return
@@ -678,6 +725,9 @@ func (r *sensorVisibilityExclusionResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	exclusionID := state.ID.ValueString()
 	tflog.Info(ctx, "Starting sensor visibility exclusion deletion", map[string]any{
 		"exclusion_id":    exclusionID,
@@ -757,7 +807,7 @@ func (r *sensorVisibilityExclusionResource) ImportState(
 		"import_id": importID,
 	})
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Failed to import sensor visibility exclusion", map[string]any{
@@ -11,9 +11,11 @@ import (
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
 	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/references"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -32,11 +34,13 @@ var (
 	_ resource.Resource                = &sensorVisibilityExclusionAttachmentResource{}
 	_ resource.ResourceWithConfigure   = &sensorVisibilityExclusionAttachmentResource{}
 	_ resource.ResourceWithImportState = &sensorVisibilityExclusionAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &sensorVisibilityExclusionAttachmentResource{}
+	_ resource.ResourceWithMoveState   = &sensorVisibilityExclusionAttachmentResource{}
 )
 
 var (
 	attachmentDocumentationSection        string         = "Sensor Visibility Exclusion"
-	attachmentResourceMarkdownDescription string         = "This resource allows managing the host groups attached to a sensor visibility exclusion policy. By default (when `exclusive` is true), this resource takes exclusive ownership over the host groups assigned to a sensor visibility exclusion policy. When `exclusive` is false, this resource only manages the specific host groups defined in the configuration. If you want to fully create or manage a sensor visibility exclusion please use the `sensor_visibility_exclusion` resource."
+	attachmentResourceMarkdownDescription string         = "This resource allows managing the host groups attached to a sensor visibility exclusion policy. By default (when `exclusive` is true), this resource takes exclusive ownership over the host groups assigned to a sensor visibility exclusion policy. When `exclusive` is false, this resource only manages the specific host groups defined in the configuration. If you want to fully create or manage a sensor visibility exclusion please use the `sensor_visibility_exclusion` resource. A `crowdstrike_sensor_visibility_exclusion` resource can be moved to this resource with a `moved` block to take over its attachments without recreating the exclusion; the attachment starts with `exclusive` set to true."
 	attachmentRequiredScopes              []scopes.Scope = apiScopes
 )
 
@@ -45,14 +49,17 @@ func NewSensorVisibilityExclusionAttachmentResource() resource.Resource {
 }
 
 type sensorVisibilityExclusionAttachmentResource struct {
-	client *client.CrowdStrikeAPISpecification
+	client     *client.CrowdStrikeAPISpecification
+	config     config.ProviderConfig
+	references references.Validator
 }
 
 type sensorVisibilityExclusionAttachmentResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	HostGroups  types.Set    `tfsdk:"host_groups"`
-	Exclusive   types.Bool   `tfsdk:"exclusive"`
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	HostGroups  types.Set      `tfsdk:"host_groups"`
+	Exclusive   types.Bool     `tfsdk:"exclusive"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *sensorVisibilityExclusionAttachmentResourceModel) wrap(
@@ -130,6 +137,22 @@ func (r *sensorVisibilityExclusionAttachmentResource) Configure(
 	}
 
 	r.client = config.Client
+	r.references = references.NewValidator(config)
+	r.config = config
+	resp.Diagnostics.Append(config.CheckScopes(attachmentRequiredScopes)...)
+}
+
+// ModifyPlan validates that the objects referenced by the resource exist.
+func (r *sensorVisibilityExclusionAttachmentResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	resp.Diagnostics.Append(r.references.Validate(
+		ctx,
+		req.Plan,
+		references.HostGroups(path.Root("host_groups")),
+	)...)
 }
 
 func (r *sensorVisibilityExclusionAttachmentResource) Metadata(
@@ -141,7 +164,7 @@ func (r *sensorVisibilityExclusionAttachmentResource) Metadata(
 }
 
 func (r *sensorVisibilityExclusionAttachmentResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -181,6 +204,9 @@ func (r *sensorVisibilityExclusionAttachmentResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -195,6 +221,9 @@ func (r *sensorVisibilityExclusionAttachmentResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	exclusion, diags := getSensorVisibilityExclusion(ctx, r.client, plan.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -244,6 +273,9 @@ func (r *sensorVisibilityExclusionAttachmentResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	exclusion, diags := getSensorVisibilityExclusion(ctx, r.client, state.ID.ValueString())
 	if tferrors.HasNotFoundError(diags) {
 		tflog.Warn(
@@ -279,6 +311,9 @@ func (r *sensorVisibilityExclusionAttachmentResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	planHostGroups := plan.HostGroups
 
 	exclusion, diags := getSensorVisibilityExclusion(ctx, r.client, plan.ID.ValueString())
@@ -345,6 +380,9 @@ func (r *sensorVisibilityExclusionAttachmentResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	exclusion, diags := getSensorVisibilityExclusion(ctx, r.client, state.ID.ValueString())
 	if tferrors.HasNotFoundError(diags) {
 		return
@@ -360,6 +398,17 @@ func (r *sensorVisibilityExclusionAttachmentResource) Delete(
 		r.syncHostGroups(ctx, emptySet, state.HostGroups, state.ID.ValueString(), exclusion)...)
 }
 
+// MoveState allows moving a `crowdstrike_sensor_visibility_exclusion` resource
+// to this resource with a moved block, so the sensor visibility exclusion is
+// not destroyed and recreated when its attachments are managed separately.
+func (r *sensorVisibilityExclusionAttachmentResource) MoveState(
+	_ context.Context,
+) []resource.StateMover {
+	return []resource.StateMover{
+		utils.AttachmentStateMover("crowdstrike_sensor_visibility_exclusion", utils.MovedGroups{Source: "host_groups", Target: "host_groups"}),
+	}
+}
+
 func (r *sensorVisibilityExclusionAttachmentResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
@@ -427,7 +476,7 @@ func (r *sensorVisibilityExclusionAttachmentResource) syncHostGroups(
 			Value:               *currentExclusion.Value,
 			Groups:              updatedGroups,
 			IsDescendantProcess: currentExclusion.IsDescendantProcess,
-			Comment:             "updated by terraform crowdstrike provider",
+			Comment:             r.config.Comment("updated by terraform crowdstrike provider"),
 		}
 
 		params := sensor_visibility_exclusions.NewUpdateSensorVisibilityExclusionsV1ParamsWithContext(ctx)
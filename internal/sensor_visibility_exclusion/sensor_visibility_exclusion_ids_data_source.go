@@ -0,0 +1,70 @@
+package sensorvisibilityexclusion
+
+import (
+	"context"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/sensor_visibility_exclusions"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/ids"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var exclusionIDsScopes = []scopes.Scope{
+	{
+		Name: "Sensor Visibility Exclusions",
+		Read: true,
+	},
+}
+
+// NewSensorVisibilityExclusionIDsDataSource is a helper function to simplify the provider implementation.
+func NewSensorVisibilityExclusionIDsDataSource() datasource.DataSource {
+	return ids.NewDataSource(ids.Options{
+		TypeName:     "_sensor_visibility_exclusion_ids",
+		Section:      dataSourceDocumentationSection,
+		Objects:      "sensor visibility exclusions",
+		Description:  "This data source returns the IDs of all sensor visibility exclusions matching an optional FQL filter. Use it with a `for_each` import block to adopt existing exclusions as `crowdstrike_sensor_visibility_exclusion` resources.",
+		Example:      "applied_globally:true",
+		Scopes:       exclusionIDsScopes,
+		SortExample:  "value.asc",
+		DefaultLimit: 500,
+		MaxLimit:     500,
+		Query: func(
+			ctx context.Context,
+			client *client.CrowdStrikeAPISpecification,
+			filter *string,
+			sort *string,
+		) utils.QueryPage[string] {
+			return func(offset, limit int64) ([]string, int64, diag.Diagnostics) {
+				var diags diag.Diagnostics
+
+				res, err := client.SensorVisibilityExclusions.QuerySensorVisibilityExclusionsV1(&sensor_visibility_exclusions.QuerySensorVisibilityExclusionsV1Params{
+					Context: ctx,
+					Filter:  filter,
+					Sort:    sort,
+					Limit:   &limit,
+					Offset:  &offset,
+				})
+				if err != nil {
+					diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, exclusionIDsScopes))
+					return nil, 0, diags
+				}
+
+				if res == nil || res.Payload == nil {
+					diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+					return nil, 0, diags
+				}
+
+				if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+					diags.Append(diag)
+					return nil, 0, diags
+				}
+
+				return res.Payload.Resources, utils.ListTotal(res.Payload.Meta), diags
+			}
+		},
+	})
+}
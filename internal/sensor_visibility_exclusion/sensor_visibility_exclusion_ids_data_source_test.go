@@ -0,0 +1,46 @@
+package sensorvisibilityexclusion_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSensorVisibilityExclusionIDsDataSource(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.crowdstrike_sensor_visibility_exclusion_ids.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_sensor_visibility_exclusion" "test" {
+  count                         = 3
+  value                         = "/test/path/%[1]s/${count.index}"
+  apply_to_descendant_processes = false
+  apply_globally                = true
+}
+
+data "crowdstrike_sensor_visibility_exclusion_ids" "test" {
+  filter = "value:['/test/path/%[1]s/0','/test/path/%[1]s/1','/test/path/%[1]s/2']"
+  sort   = "value.asc"
+  limit  = 2
+
+  depends_on = [crowdstrike_sensor_visibility_exclusion.test]
+}
+`, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "3"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "crowdstrike_sensor_visibility_exclusion.test.0", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.1", "crowdstrike_sensor_visibility_exclusion.test.1", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.2", "crowdstrike_sensor_visibility_exclusion.test.2", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+package sensorvisibilityexclusion
+
+import (
+	"context"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/sensor_visibility_exclusions"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/listresource"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewSensorVisibilityExclusionListResource is a helper function to simplify the provider implementation.
+func NewSensorVisibilityExclusionListResource() list.ListResource {
+	return listresource.New(listresource.Options[*models.SvExclusionsSVExclusionV1, SensorVisibilityExclusionResourceModel]{
+		TypeName: "_sensor_visibility_exclusion",
+		Objects:  "sensor visibility exclusions",
+		Scopes:   apiScopes,
+		Query:    queryExclusions,
+		ID:       func(exclusion *models.SvExclusionsSVExclusionV1) *string { return exclusion.ID },
+		Name:     func(exclusion *models.SvExclusionsSVExclusionV1) *string { return exclusion.Value },
+		Wrap: func(
+			ctx context.Context,
+			m *SensorVisibilityExclusionResourceModel,
+			exclusion *models.SvExclusionsSVExclusionV1,
+		) diag.Diagnostics {
+			return m.wrap(ctx, exclusion, false)
+		},
+	})
+}
+
+// queryExclusions fetches the IDs of a page of the sensor visibility
+// exclusions matching filter, then the exclusions themselves.
+func queryExclusions(
+	ctx context.Context,
+	client *client.CrowdStrikeAPISpecification,
+	filter *string,
+	offset int64,
+) (*listresource.Page[*models.SvExclusionsSVExclusionV1], error) {
+	query, err := client.SensorVisibilityExclusions.QuerySensorVisibilityExclusionsV1(
+		&sensor_visibility_exclusions.QuerySensorVisibilityExclusionsV1Params{
+			Context: ctx,
+			Filter:  filter,
+			Limit:   utils.Addr(utils.ListPageSize),
+			Offset:  &offset,
+		},
+	)
+	if err != nil || query == nil || query.Payload == nil {
+		return nil, err
+	}
+
+	if len(query.Payload.Resources) == 0 || len(query.Payload.Errors) > 0 {
+		return &listresource.Page[*models.SvExclusionsSVExclusionV1]{
+			Meta:   query.Payload.Meta,
+			Errors: query.Payload.Errors,
+		}, nil
+	}
+
+	res, err := client.SensorVisibilityExclusions.GetSensorVisibilityExclusionsV1(
+		&sensor_visibility_exclusions.GetSensorVisibilityExclusionsV1Params{
+			Context: ctx,
+			Ids:     query.Payload.Resources,
+		},
+	)
+	if err != nil || res == nil || res.Payload == nil {
+		return nil, err
+	}
+
+	return &listresource.Page[*models.SvExclusionsSVExclusionV1]{
+		Resources: res.Payload.Resources,
+		Meta:      query.Payload.Meta,
+		Errors:    res.Payload.Errors,
+	}, nil
+}
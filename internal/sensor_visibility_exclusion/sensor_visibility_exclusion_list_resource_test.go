@@ -0,0 +1,65 @@
+package sensorvisibilityexclusion_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSensorVisibilityExclusionListResource(t *testing.T) {
+	rName := "/test/path/" + acctest.RandomResourceName()
+	listName := "crowdstrike_sensor_visibility_exclusion.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSensorVisibilityExclusionListResourceConfig(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccSensorVisibilityExclusionListResourceQuery(rName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(listName, 1),
+					querycheck.ExpectResourceDisplayName(
+						listName,
+						queryfilter.ByDisplayName(knownvalue.StringExact(rName)),
+						knownvalue.StringExact(rName),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccSensorVisibilityExclusionListResourceConfig(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+resource "crowdstrike_sensor_visibility_exclusion" "test" {
+  value                         = %[1]q
+  apply_to_descendant_processes = false
+  apply_globally                = true
+}
+`, rName)
+}
+
+func testAccSensorVisibilityExclusionListResourceQuery(rName string) string {
+	return acctest.ProviderConfig + fmt.Sprintf(`
+list "crowdstrike_sensor_visibility_exclusion" "test" {
+  provider = crowdstrike
+
+  config {
+    filter = "value:'%s'"
+  }
+}
+`, rName)
+}
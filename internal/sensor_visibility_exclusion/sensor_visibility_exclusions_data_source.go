@@ -3,6 +3,7 @@ package sensorvisibilityexclusion
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/crowdstrike/gofalcon/falcon/client"
 	"github.com/crowdstrike/gofalcon/falcon/client/sensor_visibility_exclusions"
@@ -27,6 +28,9 @@ import (
 const (
 	dataSourceDocumentationSection = "Sensor Visibility Exclusion"
 	dataSourceMarkdownDescription  = "This data source provides information about sensor visibility exclusions in Falcon."
+	// exclusionsQueryLimit is the page size used when querying sensor
+	// visibility exclusions, and the largest page size accepted by the API.
+	exclusionsQueryLimit = 500
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -59,6 +63,7 @@ func (d *sensorVisibilityExclusionsDataSource) Configure(
 	}
 
 	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
 }
 
 // sensorVisibilityExclusionsDataSource is the data source implementation.
@@ -101,6 +106,8 @@ type SensorVisibilityExclusionsDataSourceModel struct {
 	Filter          types.String `tfsdk:"filter"`
 	IDs             types.List   `tfsdk:"ids"`
 	Sort            types.String `tfsdk:"sort"`
+	Limit           types.Int64  `tfsdk:"limit"`
+	MaxItems        types.Int64  `tfsdk:"max_items"`
 	AppliedGlobally types.Bool   `tfsdk:"applied_globally"`
 	CreatedBy       types.String `tfsdk:"created_by"`
 	ModifiedBy      types.String `tfsdk:"modified_by"`
@@ -205,6 +212,8 @@ func (d *sensorVisibilityExclusionsDataSource) Schema(
 					fwvalidators.StringNotWhitespace(),
 				},
 			},
+			"limit":     utils.PageLimitAttribute("sensor visibility exclusions", exclusionsQueryLimit, exclusionsQueryLimit),
+			"max_items": utils.MaxItemsAttribute("sensor visibility exclusions"),
 			"applied_globally": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Filter exclusions by whether they are applied globally. All provided filter attributes must match for an exclusion to be returned (omitted attributes are ignored). Cannot be used together with 'filter' or 'ids'.",
@@ -316,34 +325,26 @@ func (d *sensorVisibilityExclusionsDataSource) ValidateConfig(ctx context.Contex
 	}
 }
 
-// querySensorVisibilityExclusions returns all sensor visibility exclusions matching filter.
+// querySensorVisibilityExclusions returns up to pagination.MaxItems sensor
+// visibility exclusions matching filter in the order of sort, and the total
+// number of matching exclusions.
 func (d *sensorVisibilityExclusionsDataSource) querySensorVisibilityExclusions(
 	ctx context.Context,
 	filter string,
 	sort string,
-) ([]*models.SvExclusionsSVExclusionV1, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	var allExclusions []*models.SvExclusionsSVExclusionV1
-
-	tflog.Debug(
-		ctx,
-		"[datasource] Getting all sensor visibility exclusions",
-	)
+	pagination utils.Pagination,
+) ([]*models.SvExclusionsSVExclusionV1, int64, diag.Diagnostics) {
+	return utils.QueryPages(pagination, func(offset, limit int64) ([]*models.SvExclusionsSVExclusionV1, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
 
-	limit := int64(5000)
-	offset := int64(0)
-
-	for {
 		params := &sensor_visibility_exclusions.QuerySensorVisibilityExclusionsV1Params{
 			Context: ctx,
 			Limit:   &limit,
 			Offset:  &offset,
 		}
-
 		if filter != "" {
 			params.Filter = &filter
 		}
-
 		if sort != "" {
 			params.Sort = &sort
 		}
@@ -353,57 +354,34 @@ func (d *sensorVisibilityExclusionsDataSource) querySensorVisibilityExclusions(
 			diag := tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopes)
 			if diag.Summary() == tferrors.NotFoundErrorSummary {
 				tflog.Debug(ctx, "[datasource] No sensor visibility exclusions found (404), returning empty list")
-				return allExclusions, diags
+				return nil, offset, diags
 			}
 			diags.Append(diag)
-			return allExclusions, diags
+			return nil, 0, diags
 		}
 
-		if res == nil || res.Payload == nil || len(res.Payload.Resources) == 0 {
-			tflog.Debug(ctx, "[datasource] No more sensor visibility exclusions to retrieve",
-				map[string]interface{}{
-					"total_retrieved": len(allExclusions),
-				})
-			break
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, 0, diags
 		}
 
-		// Get detailed exclusion information for the IDs returned by the query
-		exclusions, exclusionDiags := d.getSensorVisibilityExclusions(ctx, res.Payload.Resources)
-		diags.Append(exclusionDiags...)
-		if diags.HasError() {
-			return allExclusions, diags
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, 0, diags
 		}
 
-		allExclusions = append(allExclusions, exclusions...)
-		tflog.Debug(ctx, "[datasource] Retrieved page of sensor visibility exclusions",
-			map[string]interface{}{
-				"page_count":  len(exclusions),
-				"total_count": len(allExclusions),
-				"offset":      offset,
-			})
-
-		if res.Payload.Meta == nil || res.Payload.Meta.Pagination == nil ||
-			res.Payload.Meta.Pagination.Offset == nil || res.Payload.Meta.Pagination.Total == nil {
-			tflog.Warn(ctx, "Missing pagination metadata in API response, using offset+limit for next page",
-				map[string]interface{}{
-					"meta": res.Payload.Meta,
-				})
-			offset += limit
-			continue
+		if len(res.Payload.Resources) == 0 {
+			return nil, utils.ListTotal(res.Payload.Meta), diags
 		}
 
-		offset = int64(*res.Payload.Meta.Pagination.Offset)
-		if offset >= *res.Payload.Meta.Pagination.Total {
-			tflog.Info(ctx, "[datasource] Pagination complete",
-				map[string]interface{}{
-					"total_retrieved": len(allExclusions),
-					"total_available": *res.Payload.Meta.Pagination.Total,
-				})
-			break
+		exclusions, exclusionDiags := d.getSensorVisibilityExclusions(ctx, res.Payload.Resources)
+		diags.Append(exclusionDiags...)
+		if diags.HasError() {
+			return nil, 0, diags
 		}
-	}
 
-	return allExclusions, diags
+		return exclusions, utils.ListTotal(res.Payload.Meta), diags
+	})
 }
 
 // getSensorVisibilityExclusions returns sensor visibility exclusions matching the provided IDs.
@@ -471,7 +449,10 @@ func (d *sensorVisibilityExclusionsDataSource) Read(
 		return
 	}
 
+	pagination := utils.NewPagination(data.Limit, data.MaxItems, exclusionsQueryLimit)
+
 	var exclusions []*models.SvExclusionsSVExclusionV1
+	var total int64
 	var diags diag.Diagnostics
 
 	// If specific IDs are requested, get them directly
@@ -486,9 +467,18 @@ func (d *sensorVisibilityExclusionsDataSource) Read(
 		if resp.Diagnostics.HasError() {
 			return
 		}
+
+		exclusions, total = utils.Truncate(exclusions, pagination.MaxItems)
 	} else {
-		// Otherwise, query all exclusions and filter as needed
-		exclusions, diags = d.querySensorVisibilityExclusions(ctx, data.Filter.ValueString(), data.Sort.ValueString())
+		// Otherwise, query the exclusions and filter as needed. The individual
+		// filter attributes are matched against every exclusion, so max_items
+		// applies to the matching exclusions.
+		query := pagination
+		if data.hasIndividualFilters() {
+			query.MaxItems = math.MaxInt64
+		}
+
+		exclusions, total, diags = d.querySensorVisibilityExclusions(ctx, data.Filter.ValueString(), data.Sort.ValueString(), query)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -496,9 +486,12 @@ func (d *sensorVisibilityExclusionsDataSource) Read(
 
 		if data.hasIndividualFilters() {
 			exclusions = filterExclusionsByAttributes(exclusions, &data)
+			exclusions, total = utils.Truncate(exclusions, pagination.MaxItems)
 		}
 	}
 
+	resp.Diagnostics.Append(utils.TruncatedWarning("sensor visibility exclusions", len(exclusions), total)...)
+
 	resp.Diagnostics.Append(data.wrap(ctx, exclusions)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,11 @@
+package spotlight
+
+import "github.com/crowdstrike/terraform-provider-crowdstrike/internal/scopes"
+
+var apiScopesRead = []scopes.Scope{
+	{
+		Name:  "Vulnerabilities",
+		Read:  true,
+		Write: false,
+	},
+}
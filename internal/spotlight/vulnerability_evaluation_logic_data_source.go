@@ -0,0 +1,495 @@
+package spotlight
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/crowdstrike/gofalcon/falcon/client"
+	"github.com/crowdstrike/gofalcon/falcon/client/spotlight_evaluation_logic"
+	"github.com/crowdstrike/gofalcon/falcon/client/spotlight_vulnerabilities"
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/config"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/flex"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxIDsPerRequest is the maximum number of IDs accepted by the Spotlight entity endpoints.
+const maxIDsPerRequest = 400
+
+var (
+	_ datasource.DataSource              = &vulnerabilityEvaluationLogicDataSource{}
+	_ datasource.DataSourceWithConfigure = &vulnerabilityEvaluationLogicDataSource{}
+)
+
+func NewVulnerabilityEvaluationLogicDataSource() datasource.DataSource {
+	return &vulnerabilityEvaluationLogicDataSource{}
+}
+
+type vulnerabilityEvaluationLogicDataSource struct {
+	client *client.CrowdStrikeAPISpecification
+}
+
+type vulnerabilityEvaluationLogicDataSourceModel struct {
+	IDs             types.List `tfsdk:"ids"`
+	Vulnerabilities types.List `tfsdk:"vulnerabilities"`
+}
+
+type vulnerabilityModel struct {
+	ID               types.String `tfsdk:"id"`
+	AID              types.String `tfsdk:"aid"`
+	CVEID            types.String `tfsdk:"cve_id"`
+	Status           types.String `tfsdk:"status"`
+	DataProviders    types.List   `tfsdk:"data_providers"`
+	AffectedProducts types.List   `tfsdk:"affected_products"`
+}
+
+func (m vulnerabilityModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":             types.StringType,
+		"aid":            types.StringType,
+		"cve_id":         types.StringType,
+		"status":         types.StringType,
+		"data_providers": types.ListType{ElemType: types.StringType},
+		"affected_products": types.ListType{
+			ElemType: types.ObjectType{AttrTypes: affectedProductModel{}.AttributeTypes()},
+		},
+	}
+}
+
+type affectedProductModel struct {
+	Vendor               types.String `tfsdk:"vendor"`
+	Product              types.String `tfsdk:"product"`
+	ProductNameVersion   types.String `tfsdk:"product_name_version"`
+	SubStatus            types.String `tfsdk:"sub_status"`
+	EvaluationLogicID    types.String `tfsdk:"evaluation_logic_id"`
+	ComplexCheckOperator types.String `tfsdk:"complex_check_operator"`
+	Checks               types.List   `tfsdk:"checks"`
+}
+
+func (m affectedProductModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"vendor":                 types.StringType,
+		"product":                types.StringType,
+		"product_name_version":   types.StringType,
+		"sub_status":             types.StringType,
+		"evaluation_logic_id":    types.StringType,
+		"complex_check_operator": types.StringType,
+		"checks": types.ListType{
+			ElemType: types.ObjectType{AttrTypes: evaluationCheckModel{}.AttributeTypes()},
+		},
+	}
+}
+
+type evaluationCheckModel struct {
+	Title                  types.String `tfsdk:"title"`
+	Type                   types.String `tfsdk:"type"`
+	Status                 types.String `tfsdk:"status"`
+	Details                types.String `tfsdk:"details"`
+	ExistenceCheck         types.String `tfsdk:"existence_check"`
+	ComparisonCheck        types.String `tfsdk:"comparison_check"`
+	Negate                 types.Bool   `tfsdk:"negate"`
+	DeterminedByComparison types.Bool   `tfsdk:"determined_by_comparison"`
+}
+
+func (m evaluationCheckModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"title":                    types.StringType,
+		"type":                     types.StringType,
+		"status":                   types.StringType,
+		"details":                  types.StringType,
+		"existence_check":          types.StringType,
+		"comparison_check":         types.StringType,
+		"negate":                   types.BoolType,
+		"determined_by_comparison": types.BoolType,
+	}
+}
+
+func (d *vulnerabilityEvaluationLogicDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(config.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected config.ProviderConfig, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopesRead)...)
+}
+
+func (d *vulnerabilityEvaluationLogicDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_vulnerability_evaluation_logic"
+}
+
+func (d *vulnerabilityEvaluationLogicDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: utils.MarkdownDescription(
+			"Falcon Exposure Management",
+			"This data source retrieves the Falcon Spotlight evaluation logic for vulnerabilities: the affected products and the checks used to detect each of them on the host.",
+			apiScopesRead,
+		),
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The Spotlight vulnerability IDs to retrieve evaluation logic for.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"vulnerabilities": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The vulnerabilities, in the order of `ids`. IDs that no longer exist are omitted.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The Spotlight vulnerability ID.",
+						},
+						"aid": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The agent ID of the host the vulnerability was found on.",
+						},
+						"cve_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The CVE identifier of the vulnerability.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the vulnerability.",
+						},
+						"data_providers": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The sources that detected the vulnerability, such as the Falcon sensor or a third-party scanner.",
+						},
+						"affected_products": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The products affected by the vulnerability and how each was evaluated.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"vendor": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The normalized vendor name.",
+									},
+									"product": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The normalized product name.",
+									},
+									"product_name_version": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The product name and version found on the host.",
+									},
+									"sub_status": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The status of the vulnerability for this product.",
+									},
+									"evaluation_logic_id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The ID of the evaluation logic used for this product.",
+									},
+									"complex_check_operator": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The operator combining the results of `checks`.",
+									},
+									"checks": schema.ListNestedAttribute{
+										Computed:            true,
+										MarkdownDescription: "The checks that determine whether the product is vulnerable.",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"title": schema.StringAttribute{
+													Computed:            true,
+													MarkdownDescription: "The title of the check.",
+												},
+												"type": schema.StringAttribute{
+													Computed:            true,
+													MarkdownDescription: "The detection method of the check, for example a file, registry or package check.",
+												},
+												"status": schema.StringAttribute{
+													Computed:            true,
+													MarkdownDescription: "The result of the check.",
+												},
+												"details": schema.StringAttribute{
+													Computed:            true,
+													MarkdownDescription: "Details about the check.",
+												},
+												"existence_check": schema.StringAttribute{
+													Computed:            true,
+													MarkdownDescription: "How the existence of matching items is evaluated.",
+												},
+												"comparison_check": schema.StringAttribute{
+													Computed:            true,
+													MarkdownDescription: "How matching items are compared against the expected state.",
+												},
+												"negate": schema.BoolAttribute{
+													Computed:            true,
+													MarkdownDescription: "Whether the result of the check is negated.",
+												},
+												"determined_by_comparison": schema.BoolAttribute{
+													Computed:            true,
+													MarkdownDescription: "Whether the result was determined by the state comparison.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vulnerabilityEvaluationLogicDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data vulnerabilityEvaluationLogicDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := utils.ListTypeAs[string](ctx, data.IDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vulnerabilities, diags := d.getVulnerabilities(ctx, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var logicIDs []string
+	for _, v := range vulnerabilities {
+		for _, app := range v.Apps {
+			if app != nil && app.EvaluationLogic != nil && app.EvaluationLogic.ID != nil {
+				logicIDs = append(logicIDs, *app.EvaluationLogic.ID)
+			}
+		}
+	}
+
+	logic, diags := d.getEvaluationLogic(ctx, flex.Unique(logicIDs))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.wrap(ctx, ids, vulnerabilities, logic)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getVulnerabilities returns the vulnerabilities with the given IDs keyed by ID.
+func (d *vulnerabilityEvaluationLogicDataSource) getVulnerabilities(
+	ctx context.Context,
+	ids []string,
+) (map[string]*models.DomainAPIVulnerabilityV2, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	vulnerabilities := make(map[string]*models.DomainAPIVulnerabilityV2, len(ids))
+
+	for batch := range slices.Chunk(ids, maxIDsPerRequest) {
+		params := spotlight_vulnerabilities.NewGetVulnerabilitiesParams()
+		params.Context = ctx
+		params.Ids = batch
+
+		res, err := d.client.SpotlightVulnerabilities.GetVulnerabilities(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesRead))
+			return nil, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, diags
+		}
+
+		for _, v := range res.Payload.Resources {
+			if v != nil && v.ID != nil {
+				vulnerabilities[*v.ID] = v
+			}
+		}
+	}
+
+	return vulnerabilities, diags
+}
+
+// getEvaluationLogic returns the evaluation logic with the given IDs keyed by ID.
+func (d *vulnerabilityEvaluationLogicDataSource) getEvaluationLogic(
+	ctx context.Context,
+	ids []string,
+) (map[string]*models.DomainAPIEvaluationLogicV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	logic := make(map[string]*models.DomainAPIEvaluationLogicV1, len(ids))
+
+	for batch := range slices.Chunk(ids, maxIDsPerRequest) {
+		params := spotlight_evaluation_logic.NewGetEvaluationLogicParams()
+		params.Context = ctx
+		params.Ids = batch
+
+		res, err := d.client.SpotlightEvaluationLogic.GetEvaluationLogic(params)
+		if err != nil {
+			diags.Append(tferrors.NewDiagnosticFromAPIError(tferrors.Read, err, apiScopesRead))
+			return nil, diags
+		}
+
+		if res == nil || res.Payload == nil {
+			diags.Append(tferrors.NewEmptyResponseError(tferrors.Read))
+			return nil, diags
+		}
+
+		if diag := tferrors.NewDiagnosticFromPayloadErrors(tferrors.Read, res.Payload.Errors); diag != nil {
+			diags.Append(diag)
+			return nil, diags
+		}
+
+		for _, l := range res.Payload.Resources {
+			if l != nil && l.ID != nil {
+				logic[*l.ID] = l
+			}
+		}
+	}
+
+	return logic, diags
+}
+
+// wrap transforms the API responses into the data source model, keeping the order of ids.
+func (m *vulnerabilityEvaluationLogicDataSourceModel) wrap(
+	ctx context.Context,
+	ids []string,
+	vulnerabilities map[string]*models.DomainAPIVulnerabilityV2,
+	logic map[string]*models.DomainAPIEvaluationLogicV1,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vulnModels := make([]vulnerabilityModel, 0, len(ids))
+	for _, id := range ids {
+		v, ok := vulnerabilities[id]
+		if !ok {
+			continue
+		}
+
+		vm := vulnerabilityModel{
+			ID:     flex.StringPointerToFramework(v.ID),
+			AID:    flex.StringPointerToFramework(v.Aid),
+			Status: flex.StringPointerToFramework(v.Status),
+			CVEID:  types.StringNull(),
+		}
+		if v.Cve != nil {
+			vm.CVEID = flex.StringPointerToFramework(v.Cve.ID)
+		}
+
+		var providers []string
+		for _, p := range v.DataProviders {
+			if p != nil && p.Provider != "" {
+				providers = append(providers, p.Provider)
+			}
+		}
+		vm.DataProviders = utils.SliceToListTypeString(ctx, flex.Unique(providers), &diags)
+
+		products := make([]affectedProductModel, 0, len(v.Apps))
+		for _, app := range v.Apps {
+			if app == nil {
+				continue
+			}
+			products = append(products, newAffectedProductModel(ctx, app, logic, &diags))
+		}
+		vm.AffectedProducts = utils.SliceToListTypeObject(ctx, products, affectedProductModel{}.AttributeTypes(), &diags)
+
+		vulnModels = append(vulnModels, vm)
+	}
+
+	m.Vulnerabilities = utils.SliceToListTypeObject(ctx, vulnModels, vulnerabilityModel{}.AttributeTypes(), &diags)
+
+	return diags
+}
+
+// newAffectedProductModel builds the model for an affected product, resolving
+// its evaluation logic when it was returned by the API.
+func newAffectedProductModel(
+	ctx context.Context,
+	app *models.DomainAPIVulnerabilityExtendedAppV2,
+	logic map[string]*models.DomainAPIEvaluationLogicV1,
+	diags *diag.Diagnostics,
+) affectedProductModel {
+	product := affectedProductModel{
+		Vendor:               flex.StringPointerToFramework(app.VendorNormalized),
+		Product:              flex.StringPointerToFramework(app.ProductNameNormalized),
+		ProductNameVersion:   flex.StringPointerToFramework(app.ProductNameVersion),
+		SubStatus:            flex.StringValueToFramework(app.SubStatus),
+		EvaluationLogicID:    types.StringNull(),
+		ComplexCheckOperator: types.StringNull(),
+	}
+
+	var checks []evaluationCheckModel
+	if app.EvaluationLogic != nil && app.EvaluationLogic.ID != nil {
+		product.EvaluationLogicID = types.StringValue(*app.EvaluationLogic.ID)
+
+		if l, ok := logic[*app.EvaluationLogic.ID]; ok {
+			product.ComplexCheckOperator = flex.StringValueToFramework(l.ComplexCheckOperator)
+			for _, item := range l.Logic {
+				if item == nil {
+					continue
+				}
+				checks = append(checks, evaluationCheckModel{
+					Title:                  flex.StringPointerToFramework(item.Title),
+					Type:                   flex.StringPointerToFramework(item.Type),
+					Status:                 flex.StringPointerToFramework(item.Status),
+					Details:                flex.StringValueToFramework(item.Details),
+					ExistenceCheck:         flex.StringValueToFramework(item.ExistenceCheck),
+					ComparisonCheck:        flex.StringValueToFramework(item.ComparisonCheck),
+					Negate:                 types.BoolValue(item.Negate),
+					DeterminedByComparison: types.BoolValue(item.DeterminedByComparison),
+				})
+			}
+		}
+	}
+
+	product.Checks = utils.SliceToListTypeObject(ctx, checks, evaluationCheckModel{}.AttributeTypes(), diags)
+
+	return product
+}
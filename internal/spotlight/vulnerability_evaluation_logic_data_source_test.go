@@ -0,0 +1,68 @@
+package spotlight_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccVulnerabilityEvaluationLogicDataSource_basic(t *testing.T) {
+	dataSourceName := "data.crowdstrike_vulnerability_evaluation_logic.test"
+	vulnerabilityID := os.Getenv(string(acctest.RequireVulnerabilityID))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t, acctest.RequireVulnerabilityID) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + fmt.Sprintf(`
+data "crowdstrike_vulnerability_evaluation_logic" "test" {
+  ids = [%q]
+}
+`, vulnerabilityID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("vulnerabilities"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(
+						dataSourceName,
+						tfjsonpath.New("vulnerabilities").AtSliceIndex(0).AtMapKey("id"),
+						knownvalue.StringExact(vulnerabilityID),
+					),
+					statecheck.ExpectKnownValue(
+						dataSourceName,
+						tfjsonpath.New("vulnerabilities").AtSliceIndex(0).AtMapKey("cve_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						dataSourceName,
+						tfjsonpath.New("vulnerabilities").AtSliceIndex(0).AtMapKey("affected_products"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccVulnerabilityEvaluationLogicDataSource_validation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+data "crowdstrike_vulnerability_evaluation_logic" "test" {
+  ids = []
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
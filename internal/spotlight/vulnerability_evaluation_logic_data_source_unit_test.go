@@ -0,0 +1,100 @@
+package spotlight
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+)
+
+func TestVulnerabilityEvaluationLogicWrap(t *testing.T) {
+	ctx := context.Background()
+
+	vulnerabilities := map[string]*models.DomainAPIVulnerabilityV2{
+		"vuln-1": {
+			ID:     utils.Addr("vuln-1"),
+			Aid:    utils.Addr("aid-1"),
+			Status: utils.Addr("open"),
+			Cve:    &models.DomainAPIVulnerabilityCVEDetailsFacetV2{ID: utils.Addr("CVE-2024-0001")},
+			DataProviders: []*models.DomainAPIVulnerabilityDataProviderV1{
+				{Provider: "Falcon sensor"},
+				{Provider: "Falcon sensor"},
+			},
+			Apps: []*models.DomainAPIVulnerabilityExtendedAppV2{
+				{
+					VendorNormalized:      utils.Addr("Example"),
+					ProductNameNormalized: utils.Addr("Widget"),
+					ProductNameVersion:    utils.Addr("Widget 1.0"),
+					EvaluationLogic:       &models.DomainAPIEvaluationLogicV1{ID: utils.Addr("logic-1")},
+				},
+				{
+					VendorNormalized:      utils.Addr("Example"),
+					ProductNameNormalized: utils.Addr("Gadget"),
+				},
+			},
+		},
+		"vuln-2": {ID: utils.Addr("vuln-2")},
+	}
+
+	logic := map[string]*models.DomainAPIEvaluationLogicV1{
+		"logic-1": {
+			ID:                   utils.Addr("logic-1"),
+			ComplexCheckOperator: "AND",
+			Logic: []*models.DomainAPIEvaluationLogicItemV1{
+				{Title: utils.Addr("widget.dll version"), Type: utils.Addr("file"), Status: utils.Addr("vulnerable")},
+			},
+		},
+	}
+
+	var m vulnerabilityEvaluationLogicDataSourceModel
+	diags := m.wrap(ctx, []string{"vuln-2", "missing", "vuln-1"}, vulnerabilities, logic)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var vulns []vulnerabilityModel
+	diags.Append(m.Vulnerabilities.ElementsAs(ctx, &vulns, false)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(vulns) != 2 {
+		t.Fatalf("expected 2 vulnerabilities, got %d", len(vulns))
+	}
+	if vulns[0].ID.ValueString() != "vuln-2" || vulns[1].ID.ValueString() != "vuln-1" {
+		t.Fatalf("expected vulnerabilities in the order of ids, got %s, %s", vulns[0].ID, vulns[1].ID)
+	}
+
+	v := vulns[1]
+	if v.CVEID.ValueString() != "CVE-2024-0001" {
+		t.Errorf("expected cve_id CVE-2024-0001, got %s", v.CVEID)
+	}
+	if len(v.DataProviders.Elements()) != 1 {
+		t.Errorf("expected duplicate data providers to be removed, got %s", v.DataProviders)
+	}
+
+	var products []affectedProductModel
+	diags.Append(v.AffectedProducts.ElementsAs(ctx, &products, false)...)
+	if len(products) != 2 {
+		t.Fatalf("expected 2 affected products, got %d", len(products))
+	}
+
+	if products[0].ComplexCheckOperator.ValueString() != "AND" {
+		t.Errorf("expected complex_check_operator AND, got %s", products[0].ComplexCheckOperator)
+	}
+
+	var checks []evaluationCheckModel
+	diags.Append(products[0].Checks.ElementsAs(ctx, &checks, false)...)
+	if len(checks) != 1 || checks[0].Type.ValueString() != "file" {
+		t.Errorf("expected a single file check, got %v", checks)
+	}
+
+	if !products[1].EvaluationLogicID.IsNull() || len(products[1].Checks.Elements()) != 0 {
+		t.Errorf("expected no evaluation logic for a product without one, got %s", products[1].EvaluationLogicID)
+	}
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
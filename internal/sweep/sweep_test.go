@@ -8,13 +8,17 @@ import (
 	cloudgroup "github.com/crowdstrike/terraform-provider-crowdstrike/internal/cloud_group"
 	cloudsecurity "github.com/crowdstrike/terraform-provider-crowdstrike/internal/cloud_security"
 	contentupdatepolicy "github.com/crowdstrike/terraform-provider-crowdstrike/internal/content_update_policy"
+	customioc "github.com/crowdstrike/terraform-provider-crowdstrike/internal/custom_ioc"
 	dataprotection "github.com/crowdstrike/terraform-provider-crowdstrike/internal/data_protection"
+	exposuremanagement "github.com/crowdstrike/terraform-provider-crowdstrike/internal/exposure_management"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/fcs"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/fim"
 	hostgroups "github.com/crowdstrike/terraform-provider-crowdstrike/internal/host_groups"
 	ioarulegroup "github.com/crowdstrike/terraform-provider-crowdstrike/internal/ioa_rule_group"
 	itautomation "github.com/crowdstrike/terraform-provider-crowdstrike/internal/it_automation"
+	nextgensiem "github.com/crowdstrike/terraform-provider-crowdstrike/internal/next_gen_siem"
 	preventionpolicy "github.com/crowdstrike/terraform-provider-crowdstrike/internal/prevention_policy"
+	sensordownload "github.com/crowdstrike/terraform-provider-crowdstrike/internal/sensor_download"
 	sensorupdatepolicy "github.com/crowdstrike/terraform-provider-crowdstrike/internal/sensor_update_policy"
 	sensorvisibilityexclusion "github.com/crowdstrike/terraform-provider-crowdstrike/internal/sensor_visibility_exclusion"
 	usergroup "github.com/crowdstrike/terraform-provider-crowdstrike/internal/user_group"
@@ -42,4 +46,8 @@ func registerSweepers() {
 	cloudsecurity.RegisterSweepers()
 	itautomation.RegisterSweepers()
 	usergroup.RegisterSweepers()
+	nextgensiem.RegisterSweepers()
+	exposuremanagement.RegisterSweepers()
+	customioc.RegisterSweepers()
+	sensordownload.RegisterSweepers()
 }
@@ -0,0 +1,132 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// DefaultTokenRefreshBefore is how long before expiry a cached token is refreshed.
+// CrowdStrike API tokens are valid for 30 minutes.
+const DefaultTokenRefreshBefore = 5 * time.Minute
+
+// TokenCache caches an OAuth2 token and shares it between all requests made by
+// the provider. The token is refreshed proactively before it expires, and
+// concurrent callers wait for a single in-flight refresh instead of each
+// requesting a new token.
+type TokenCache struct {
+	mu            sync.Mutex
+	fetch         func() (*oauth2.Token, error)
+	token         *oauth2.Token
+	refreshBefore time.Duration
+	now           func() time.Time
+}
+
+// NewTokenCache returns a TokenCache that obtains new tokens from fetch, and
+// refreshes cached tokens refreshBefore their expiry.
+func NewTokenCache(fetch func() (*oauth2.Token, error), refreshBefore time.Duration) *TokenCache {
+	return &TokenCache{
+		fetch:         fetch,
+		refreshBefore: refreshBefore,
+		now:           time.Now,
+	}
+}
+
+// Token returns the cached token, fetching a new one when there is no cached
+// token or it expires within the refresh window.
+func (c *TokenCache) Token() (*oauth2.Token, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.valid() {
+		return c.token, nil
+	}
+
+	token, err := c.fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	c.token = token
+	return token, nil
+}
+
+// Invalidate discards the cached token if it is still accessToken, so the
+// next call to Token fetches a new one.
+func (c *TokenCache) Invalidate(accessToken string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != nil && c.token.AccessToken == accessToken {
+		c.token = nil
+	}
+}
+
+// valid reports whether the cached token can be used. c.mu must be held.
+func (c *TokenCache) valid() bool {
+	if c.token == nil || c.token.AccessToken == "" {
+		return false
+	}
+	if c.token.Expiry.IsZero() {
+		return true
+	}
+	return c.now().Add(c.refreshBefore).Before(c.token.Expiry)
+}
+
+// authTransport authenticates requests with a token from a TokenCache.
+type authTransport struct {
+	next   http.RoundTripper
+	tokens *TokenCache
+}
+
+// NewAuthTransport returns an http.RoundTripper that sets the Authorization
+// header of requests sent through next using tokens. When the API rejects a
+// token with HTTP 401, the token is discarded and the request is sent once
+// more with a new token.
+func NewAuthTransport(next http.RoundTripper, tokens *TokenCache) http.RoundTripper {
+	return &authTransport{next: next, tokens: tokens}
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokens.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	authReq := req.Clone(req.Context())
+	token.SetAuthHeader(authReq)
+
+	resp, err := t.next.RoundTrip(authReq)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	if !replayable {
+		return resp, nil
+	}
+
+	t.tokens.Invalidate(token.AccessToken)
+	newToken, err := t.tokens.Token()
+	if err != nil || newToken.AccessToken == token.AccessToken {
+		return resp, nil
+	}
+
+	retryReq := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retryReq.Body = body
+	}
+	newToken.SetAuthHeader(retryReq)
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	resp.Body.Close()
+
+	return t.next.RoundTrip(retryReq)
+}
@@ -0,0 +1,148 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// countingFetch returns a token fetcher that issues token-1, token-2, ... valid for ttl.
+func countingFetch(now func() time.Time, ttl time.Duration) (func() (*oauth2.Token, error), *atomic.Int32) {
+	var calls atomic.Int32
+	return func() (*oauth2.Token, error) {
+		n := calls.Add(1)
+		return &oauth2.Token{
+			AccessToken: fmt.Sprintf("token-%d", n),
+			TokenType:   "Bearer",
+			Expiry:      now().Add(ttl),
+		}, nil
+	}, &calls
+}
+
+func TestTokenCache(t *testing.T) {
+	clock := time.Unix(1_700_000_000, 0)
+	now := func() time.Time { return clock }
+
+	fetch, calls := countingFetch(now, 30*time.Minute)
+	cache := NewTokenCache(fetch, 5*time.Minute)
+	cache.now = now
+
+	token, err := cache.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken)
+
+	clock = clock.Add(20 * time.Minute)
+	token, err = cache.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken, "token is reused until the refresh window")
+
+	clock = clock.Add(6 * time.Minute)
+	token, err = cache.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token.AccessToken, "token is refreshed before it expires")
+
+	cache.Invalidate("token-1")
+	token, err = cache.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token.AccessToken, "invalidating a stale token keeps the current one")
+
+	cache.Invalidate("token-2")
+	token, err = cache.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-3", token.AccessToken)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestTokenCache_SingleFlight(t *testing.T) {
+	var calls atomic.Int32
+	cache := NewTokenCache(func() (*oauth2.Token, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return &oauth2.Token{AccessToken: "token", Expiry: time.Now().Add(time.Hour)}, nil
+	}, time.Minute)
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cache.Token()
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestTokenCache_FetchError(t *testing.T) {
+	cache := NewTokenCache(func() (*oauth2.Token, error) {
+		return nil, errors.New("unauthorized")
+	}, time.Minute)
+
+	_, err := cache.Token()
+	assert.Error(t, err)
+}
+
+func TestAuthTransport(t *testing.T) {
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		seen = append(seen, auth)
+		if auth == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	fetch, calls := countingFetch(time.Now, 30*time.Minute)
+	rt := NewAuthTransport(http.DefaultTransport, NewTokenCache(fetch, 5*time.Minute))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, strings.NewReader("{}"))
+	require.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, seen)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Empty(t, req.Header.Get("Authorization"), "the original request must not be modified")
+}
+
+func TestAuthTransport_UnauthorizedWithSameToken(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	static := NewTokenCache(func() (*oauth2.Token, error) {
+		return &oauth2.Token{AccessToken: "static"}, nil
+	}, time.Minute)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := NewAuthTransport(http.DefaultTransport, static).RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, int32(1), requests.Load(), "the request is not repeated when no new token is available")
+}
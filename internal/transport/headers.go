@@ -0,0 +1,28 @@
+package transport
+
+import "net/http"
+
+// headerTransport sets the headers gofalcon sets on every API request.
+type headerTransport struct {
+	next       http.RoundTripper
+	userAgent  string
+	sdkVersion string
+}
+
+// NewHeaderTransport returns an http.RoundTripper that sets the User-Agent and
+// CrowdStrike-SDK headers of requests sent through next. It also defaults the
+// Content-Type header to application/json, which the API requires even for
+// requests without a body.
+func NewHeaderTransport(next http.RoundTripper, userAgent, sdkVersion string) http.RoundTripper {
+	return &headerTransport{next: next, userAgent: userAgent, sdkVersion: sdkVersion}
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+	req.Header.Set("CrowdStrike-SDK", "crowdstrike-gofalcon/"+t.sdkVersion)
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return t.next.RoundTrip(req)
+}
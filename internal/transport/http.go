@@ -0,0 +1,76 @@
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// HTTPConfig configures the connection to the CrowdStrike API.
+type HTTPConfig struct {
+	// ProxyURL is the URL of the HTTP(S) proxy used for all requests. When
+	// empty, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are used.
+	ProxyURL string
+	// CABundleFile is the path to a PEM encoded file of certificate authorities
+	// trusted in addition to the system certificate pool.
+	CABundleFile string
+	// InsecureSkipVerify disables verification of the API server certificate.
+	InsecureSkipVerify bool
+}
+
+// IsDefault reports whether c leaves the default transport unchanged.
+func (c HTTPConfig) IsDefault() bool {
+	return c == HTTPConfig{}
+}
+
+// NewBaseTransport returns an http.Transport for connecting to the CrowdStrike
+// API through the configured proxy and trusting the configured certificate authorities.
+func NewBaseTransport(c HTTPConfig) (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, errors.New("invalid proxy URL: must be an absolute URL such as http://proxy.example.com:3128")
+		}
+		t.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if c.CABundleFile == "" && !c.InsecureSkipVerify {
+		return t, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	if c.CABundleFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		pem, err := os.ReadFile(c.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA bundle: %w", err)
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM encoded certificates found in CA bundle %s", c.CABundleFile)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	t.TLSClientConfig = tlsConfig
+
+	return t, nil
+}
@@ -0,0 +1,91 @@
+package transport
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeServerCA(t *testing.T, srv *httptest.Server) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	block := &pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(block), 0o600))
+
+	return file
+}
+
+func TestNewBaseTransport_CABundle(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	t.Run("untrusted without bundle", func(t *testing.T) {
+		tr, err := NewBaseTransport(HTTPConfig{})
+		require.NoError(t, err)
+
+		_, err = (&http.Client{Transport: tr}).Get(srv.URL)
+		assert.Error(t, err)
+	})
+
+	t.Run("trusted with bundle", func(t *testing.T) {
+		tr, err := NewBaseTransport(HTTPConfig{CABundleFile: writeServerCA(t, srv)})
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: tr}).Get(srv.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("insecure skip verify", func(t *testing.T) {
+		tr, err := NewBaseTransport(HTTPConfig{InsecureSkipVerify: true})
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: tr}).Get(srv.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestNewBaseTransport_Errors(t *testing.T) {
+	notPEM := filepath.Join(t.TempDir(), "not.pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a certificate"), 0o600))
+
+	tests := []struct {
+		name   string
+		config HTTPConfig
+	}{
+		{name: "relative proxy URL", config: HTTPConfig{ProxyURL: "proxy.example.com:3128"}},
+		{name: "malformed proxy URL", config: HTTPConfig{ProxyURL: "http://[::1"}},
+		{name: "missing CA bundle", config: HTTPConfig{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")}},
+		{name: "CA bundle without certificates", config: HTTPConfig{CABundleFile: notPEM}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBaseTransport(tt.config)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewBaseTransport_Proxy(t *testing.T) {
+	tr, err := NewBaseTransport(HTTPConfig{ProxyURL: "http://proxy.example.com:3128"})
+	require.NoError(t, err)
+
+	req := &http.Request{URL: &url.URL{Scheme: "https", Host: "api.crowdstrike.com"}}
+	proxyURL, err := tr.Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy.example.com:3128", proxyURL.String())
+}
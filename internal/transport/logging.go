@@ -0,0 +1,232 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// maxLoggedBodySize is the largest request or response body logged. Larger
+	// bodies are not logged.
+	maxLoggedBodySize = 64 << 10
+
+	// redacted replaces the values of secrets in logs.
+	redacted = "<redacted>"
+)
+
+// sensitiveKeys are substrings of JSON keys and query parameters whose values
+// are redacted from logs.
+var sensitiveKeys = []string{
+	"secret",
+	"password",
+	"passwd",
+	"token",
+	"api_key",
+	"apikey",
+	"private_key",
+	"privatekey",
+	"credential",
+	"authorization",
+	"passphrase",
+}
+
+// loggingTransport logs API requests and responses to tflog.
+type loggingTransport struct {
+	next http.RoundTripper
+	now  func() time.Time
+}
+
+// NewLoggingTransport returns an http.RoundTripper that logs requests sent
+// through next and their responses. The method, path, status, duration and
+// request ID are logged at debug level, and JSON bodies at trace level. Values
+// of keys and query parameters that look like secrets are redacted.
+func NewLoggingTransport(next http.RoundTripper) http.RoundTripper {
+	return &loggingTransport{next: next, now: time.Now}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]any{
+		"http_method": req.Method,
+		"http_path":   req.URL.Path,
+	}
+	if req.URL.RawQuery != "" {
+		fields["http_query"] = redactQuery(req.URL.Query())
+	}
+
+	tflog.Debug(ctx, "Sending CrowdStrike API request", fields)
+
+	if body, ok := requestBody(req); ok {
+		tflog.Trace(ctx, "CrowdStrike API request body", withField(fields, "http_request_body", body))
+	}
+
+	start := t.now()
+	resp, err := t.next.RoundTrip(req)
+	fields["duration_ms"] = t.now().Sub(start).Milliseconds()
+
+	if err != nil {
+		tflog.Debug(ctx, "CrowdStrike API request failed", withField(fields, "error", err.Error()))
+		return resp, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	if id := resp.Header.Get("X-Cs-Traceid"); id != "" {
+		fields["request_id"] = id
+	}
+	if remaining := resp.Header.Get("X-Ratelimit-Remaining"); remaining != "" {
+		fields["rate_limit_remaining"] = remaining
+	}
+
+	tflog.Debug(ctx, "Received CrowdStrike API response", fields)
+
+	if body, ok := responseBody(resp); ok {
+		tflog.Trace(ctx, "CrowdStrike API response body", withField(fields, "http_response_body", body))
+	}
+
+	return resp, nil
+}
+
+// withField returns a copy of fields with key set to value.
+func withField(fields map[string]any, key string, value any) map[string]any {
+	out := make(map[string]any, len(fields)+1)
+	for k, v := range fields {
+		out[k] = v
+	}
+	out[key] = value
+	return out
+}
+
+// requestBody returns the redacted body of req if it is JSON and small enough to log.
+func requestBody(req *http.Request) (string, bool) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody == nil || !isJSON(req.Header) {
+		return "", false
+	}
+	if req.ContentLength < 0 || req.ContentLength > maxLoggedBodySize {
+		return "", false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return "", false
+	}
+	defer body.Close()
+
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return "", false
+	}
+
+	return string(redactJSON(b)), true
+}
+
+// responseBody returns the redacted body of resp if it is JSON and small enough
+// to log, leaving the body readable by the caller.
+func responseBody(resp *http.Response) (string, bool) {
+	if resp.Body == nil || resp.Body == http.NoBody || !isJSON(resp.Header) {
+		return "", false
+	}
+	if resp.ContentLength > maxLoggedBodySize {
+		return "", false
+	}
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodySize+1))
+	resp.Body = readCloser{
+		Reader: io.MultiReader(bytes.NewReader(b), resp.Body),
+		Closer: resp.Body,
+	}
+	if err != nil || len(b) > maxLoggedBodySize {
+		return "", false
+	}
+
+	return string(redactJSON(b)), true
+}
+
+// readCloser combines a reader with the closer of the body it wraps.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func isJSON(h http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(h.Get("Content-Type"))
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// isSensitiveKey reports whether the value of key should be redacted from logs.
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactJSON returns body with the values of sensitive keys replaced. Bodies
+// that are not valid JSON are replaced entirely, as secrets in them cannot be found.
+func redactJSON(body []byte) []byte {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return []byte(redacted)
+	}
+
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redactValue(v)); err != nil {
+		return []byte(redacted)
+	}
+
+	return bytes.TrimSuffix(out.Bytes(), []byte("\n"))
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			if isSensitiveKey(k) {
+				if child != nil && child != "" {
+					v[k] = redacted
+				}
+				continue
+			}
+			v[k] = redactValue(child)
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = redactValue(child)
+		}
+	}
+	return v
+}
+
+// redactQuery returns the encoded query with the values of sensitive parameters replaced.
+func redactQuery(query url.Values) string {
+	redactedQuery := make(url.Values, len(query))
+	for k, values := range query {
+		if !isSensitiveKey(k) {
+			redactedQuery[k] = values
+			continue
+		}
+		redactedQuery[k] = make([]string, len(values))
+		for i := range values {
+			redactedQuery[k][i] = redacted
+		}
+	}
+
+	s, err := url.QueryUnescape(redactedQuery.Encode())
+	if err != nil {
+		return redactedQuery.Encode()
+	}
+	return s
+}
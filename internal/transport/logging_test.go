@@ -0,0 +1,102 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "no secrets",
+			body: `{"name":"policy","enabled":true}`,
+			want: `{"enabled":true,"name":"policy"}`,
+		},
+		{
+			name: "nested secrets",
+			body: `{"resources":[{"id":"1","client_secret":"abc","config":{"Password":"p","api_key":"k"}}]}`,
+			want: `{"resources":[{"client_secret":"<redacted>","config":{"Password":"<redacted>","api_key":"<redacted>"},"id":"1"}]}`,
+		},
+		{
+			name: "empty secrets are kept",
+			body: `{"access_token":"","refresh_token":null}`,
+			want: `{"access_token":"","refresh_token":null}`,
+		},
+		{
+			name: "secret objects are replaced",
+			body: `{"credentials":{"user":"u","pass":"p"}}`,
+			want: `{"credentials":"<redacted>"}`,
+		},
+		{
+			name: "invalid json",
+			body: `client_secret=abc`,
+			want: `<redacted>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(redactJSON([]byte(tt.body))))
+		})
+	}
+}
+
+func TestRedactQuery(t *testing.T) {
+	query := url.Values{
+		"filter":       {"name:'test'"},
+		"access_token": {"abc"},
+	}
+
+	assert.Equal(t, "access_token=<redacted>&filter=name:'test'", redactQuery(query))
+}
+
+func TestLoggingTransport_PreservesBodies(t *testing.T) {
+	largeBody := `{"data":"` + strings.Repeat("a", maxLoggedBodySize) + `"}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"client_secret":"abc"}`, string(b))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Cs-Traceid", "trace-1")
+		if r.URL.Query().Get("large") != "" {
+			_, _ = w.Write([]byte(largeBody))
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"secret"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	for _, tc := range []struct {
+		query string
+		want  string
+	}{
+		{want: `{"access_token":"secret"}`},
+		{query: "?large=1", want: largeBody},
+	} {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL+tc.query, strings.NewReader(`{"client_secret":"abc"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := NewLoggingTransport(http.DefaultTransport).RoundTrip(req)
+		require.NoError(t, err)
+
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		assert.Equal(t, tc.want, string(b))
+	}
+}
@@ -0,0 +1,172 @@
+package transport
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times a failed request is retried.
+	DefaultMaxRetries = 5
+	// DefaultRetryMinDelay is the default delay before the first retry.
+	DefaultRetryMinDelay = time.Second
+	// DefaultRetryMaxDelay is the default upper bound for the delay between retries.
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// RetryConfig configures how failed requests are retried.
+type RetryConfig struct {
+	// MaxRetries is the number of times a request is retried. Zero disables retries.
+	MaxRetries int
+	// MinDelay is the delay before the first retry. Later retries back off exponentially.
+	MinDelay time.Duration
+	// MaxDelay is the upper bound for the delay between retries, including
+	// delays requested by the API.
+	MaxDelay time.Duration
+}
+
+// retryTransport retries requests that fail with HTTP 429 or a transient 5xx status.
+type retryTransport struct {
+	next   http.RoundTripper
+	config RetryConfig
+}
+
+// NewRetryTransport returns an http.RoundTripper that retries requests sent
+// through next when the API responds with HTTP 429, or with HTTP 500, 502, 503
+// or 504 for idempotent methods. The delay honors the Retry-After and
+// X-RateLimit-RetryAfter response headers, bounded by config.MaxDelay, and
+// otherwise backs off exponentially from config.MinDelay.
+//
+// Requests whose body cannot be replayed are never retried. Retries stop
+// when the request context is done.
+func NewRetryTransport(next http.RoundTripper, config RetryConfig) http.RoundTripper {
+	return &retryTransport{next: next, config: config}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if err != nil || !t.shouldRetry(req, resp, attempt) {
+			return resp, err
+		}
+
+		delay := t.delay(resp, attempt, time.Now())
+
+		tflog.Warn(ctx, "Retrying CrowdStrike API request", map[string]any{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"status":  resp.StatusCode,
+			"attempt": attempt + 1,
+			"delay":   delay.String(),
+		})
+
+		// Drain the body so the connection can be reused.
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether req should be sent again after receiving resp.
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, attempt int) bool {
+	if attempt >= t.config.MaxRetries {
+		return false
+	}
+
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+// delay returns how long to wait before the next attempt.
+func (t *retryTransport) delay(resp *http.Response, attempt int, now time.Time) time.Duration {
+	if d, ok := retryAfter(resp.Header, now); ok {
+		return min(max(d, t.config.MinDelay), t.config.MaxDelay)
+	}
+
+	backoff := t.config.MinDelay << min(attempt, 30)
+	if backoff <= 0 || backoff > t.config.MaxDelay {
+		backoff = t.config.MaxDelay
+	}
+
+	// Add up to 20% jitter so parallel requests do not retry in lockstep.
+	if jitter := int64(backoff) / 5; jitter > 0 {
+		backoff += time.Duration(rand.Int64N(jitter))
+	}
+
+	return min(backoff, t.config.MaxDelay)
+}
+
+// retryAfter returns the delay requested by the API, if any.
+func retryAfter(h http.Header, now time.Time) (time.Duration, bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return max(time.Duration(seconds)*time.Second, 0), true
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return max(at.Sub(now), 0), true
+		}
+	}
+
+	if v := h.Get("X-RateLimit-RetryAfter"); v != "" {
+		if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return max(RateLimitResetTime(epoch).Sub(now), 0), true
+		}
+	}
+
+	return 0, false
+}
+
+// RateLimitResetTime converts the X-RateLimit-RetryAfter header value, the time
+// the rate limit window resets since the Unix epoch, to a time.Time. The API
+// documents the value in seconds, but millisecond values are also accepted.
+func RateLimitResetTime(epoch int64) time.Time {
+	// Epoch milliseconds passed 1e12 in 2001, while epoch seconds will not for millennia.
+	if epoch >= 1e12 {
+		return time.UnixMilli(epoch)
+	}
+	return time.Unix(epoch, 0)
+}
+
+// isIdempotent reports whether requests using method can safely be sent more than once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,232 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryConfig = RetryConfig{
+	MaxRetries: 3,
+	MinDelay:   time.Millisecond,
+	MaxDelay:   10 * time.Millisecond,
+}
+
+// statusSequenceServer responds with the given statuses in order, then 200.
+func statusSequenceServer(t *testing.T, statuses []int, bodies *[]string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		if bodies != nil {
+			b, _ := io.ReadAll(r.Body)
+			*bodies = append(*bodies, string(b))
+		}
+		if n <= len(statuses) {
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &calls
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		statuses   []int
+		wantStatus int
+		wantCalls  int32
+	}{
+		{
+			name:       "success",
+			method:     http.MethodGet,
+			wantStatus: http.StatusOK,
+			wantCalls:  1,
+		},
+		{
+			name:       "rate limited then success",
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusTooManyRequests},
+			wantStatus: http.StatusOK,
+			wantCalls:  3,
+		},
+		{
+			name:       "server error on idempotent method",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusServiceUnavailable},
+			wantStatus: http.StatusOK,
+			wantCalls:  2,
+		},
+		{
+			name:       "server error on non-idempotent method is not retried",
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusInternalServerError},
+			wantStatus: http.StatusInternalServerError,
+			wantCalls:  1,
+		},
+		{
+			name:       "client error is not retried",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusBadRequest},
+			wantStatus: http.StatusBadRequest,
+			wantCalls:  1,
+		},
+		{
+			name:       "retries exhausted",
+			method:     http.MethodGet,
+			statuses:   []int{429, 429, 429, 429, 429},
+			wantStatus: http.StatusTooManyRequests,
+			wantCalls:  4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bodies []string
+			srv, calls := statusSequenceServer(t, tt.statuses, &bodies)
+
+			var body io.Reader
+			if tt.method == http.MethodPost {
+				body = strings.NewReader(`{"name":"test"}`)
+			}
+
+			req, err := http.NewRequestWithContext(context.Background(), tt.method, srv.URL, body)
+			require.NoError(t, err)
+
+			resp, err := NewRetryTransport(http.DefaultTransport, testRetryConfig).RoundTrip(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantCalls, calls.Load())
+
+			if tt.method == http.MethodPost {
+				for _, b := range bodies {
+					assert.JSONEq(t, `{"name":"test"}`, b, "request body must be replayed on every attempt")
+				}
+			}
+		})
+	}
+}
+
+func TestRetryTransport_NonReplayableBody(t *testing.T) {
+	srv, calls := statusSequenceServer(t, []int{http.StatusTooManyRequests}, nil)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, io.NopCloser(strings.NewReader("{}")))
+	require.NoError(t, err)
+	req.GetBody = nil
+
+	resp, err := NewRetryTransport(http.DefaultTransport, testRetryConfig).RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_ContextCancelled(t *testing.T) {
+	srv, _ := statusSequenceServer(t, []int{http.StatusTooManyRequests}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	_, err = NewRetryTransport(http.DefaultTransport, RetryConfig{MaxRetries: 3, MinDelay: time.Hour, MaxDelay: time.Hour}).RoundTrip(req)
+	assert.True(t, errors.Is(err, context.Canceled), "got error %v", err)
+}
+
+func TestRetryTransport_Delay(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	rt := &retryTransport{config: RetryConfig{MaxRetries: 5, MinDelay: time.Second, MaxDelay: 30 * time.Second}}
+
+	tests := []struct {
+		name    string
+		header  http.Header
+		attempt int
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{
+			name:    "first backoff",
+			header:  http.Header{},
+			attempt: 0,
+			wantMin: time.Second,
+			wantMax: 1200 * time.Millisecond,
+		},
+		{
+			name:    "exponential backoff",
+			header:  http.Header{},
+			attempt: 3,
+			wantMin: 8 * time.Second,
+			wantMax: 9600 * time.Millisecond,
+		},
+		{
+			name:    "backoff is capped",
+			header:  http.Header{},
+			attempt: 10,
+			wantMin: 30 * time.Second,
+			wantMax: 30 * time.Second,
+		},
+		{
+			name:    "retry-after seconds",
+			header:  http.Header{"Retry-After": []string{"5"}},
+			wantMin: 5 * time.Second,
+			wantMax: 5 * time.Second,
+		},
+		{
+			name:    "retry-after date",
+			header:  http.Header{"Retry-After": []string{now.Add(7 * time.Second).UTC().Format(http.TimeFormat)}},
+			wantMin: 7 * time.Second,
+			wantMax: 7 * time.Second,
+		},
+		{
+			name:    "rate limit reset in seconds",
+			header:  http.Header{"X-Ratelimit-Retryafter": []string{strconv.FormatInt(now.Add(4*time.Second).Unix(), 10)}},
+			wantMin: 4 * time.Second,
+			wantMax: 4 * time.Second,
+		},
+		{
+			name:    "rate limit reset in milliseconds",
+			header:  http.Header{"X-Ratelimit-Retryafter": []string{strconv.FormatInt(now.Add(3*time.Second).UnixMilli(), 10)}},
+			wantMin: 3 * time.Second,
+			wantMax: 3 * time.Second,
+		},
+		{
+			name:    "requested delay is bounded by max delay",
+			header:  http.Header{"Retry-After": []string{"3600"}},
+			wantMin: 30 * time.Second,
+			wantMax: 30 * time.Second,
+		},
+		{
+			name:    "requested delay is bounded by min delay",
+			header:  http.Header{"Retry-After": []string{"0"}},
+			wantMin: time.Second,
+			wantMax: time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rt.delay(&http.Response{Header: tt.header}, tt.attempt, now)
+			assert.GreaterOrEqual(t, got, tt.wantMin)
+			assert.LessOrEqual(t, got, tt.wantMax)
+		})
+	}
+}
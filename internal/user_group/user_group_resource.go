@@ -13,6 +13,7 @@ import (
 	fwvalidators "github.com/crowdstrike/terraform-provider-crowdstrike/internal/framework/validators"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/tferrors"
 	"github.com/crowdstrike/terraform-provider-crowdstrike/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                = &userGroupResource{}
 	_ resource.ResourceWithConfigure   = &userGroupResource{}
 	_ resource.ResourceWithImportState = &userGroupResource{}
+	_ resource.ResourceWithIdentity    = &userGroupResource{}
 )
 
 func NewUserGroupResource() resource.Resource {
@@ -39,12 +41,13 @@ type userGroupResource struct {
 }
 
 type userGroupResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	UserIDs     types.Set    `tfsdk:"user_ids"`
-	Cid         types.String `tfsdk:"cid"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	UserIDs     types.Set      `tfsdk:"user_ids"`
+	Cid         types.String   `tfsdk:"cid"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *userGroupResource) Configure(
@@ -71,6 +74,7 @@ func (r *userGroupResource) Configure(
 	}
 
 	r.client = config.Client
+	resp.Diagnostics.Append(config.CheckScopes(apiScopes)...)
 }
 
 func (r *userGroupResource) Metadata(
@@ -82,7 +86,7 @@ func (r *userGroupResource) Metadata(
 }
 
 func (r *userGroupResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -135,9 +139,21 @@ func (r *userGroupResource) Schema(
 				MarkdownDescription: "The RFC850 timestamp of the last update to this resource by Terraform.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+// IdentitySchema defines the identity of the resource, used to import it by identity.
+func (r *userGroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = utils.IDIdentitySchema()
+}
+
 func (r *userGroupResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -149,6 +165,9 @@ func (r *userGroupResource) Create(
 		return
 	}
 
+	ctx, cancel := utils.CreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	createParams := mssp.NewCreateUserGroupsParams()
 	createParams.Context = ctx
 	createParams.Body = &models.DomainUserGroupsRequestV1{
@@ -206,6 +225,7 @@ func (r *userGroupResource) Create(
 
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *userGroupResource) Read(
@@ -219,6 +239,9 @@ func (r *userGroupResource) Read(
 		return
 	}
 
+	ctx, cancel := utils.ReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	userGroup, diag := r.getUserGroupByID(ctx, state.ID.ValueString())
 	if diag != nil {
 		if diag.Summary() == tferrors.NotFoundErrorSummary {
@@ -250,6 +273,7 @@ func (r *userGroupResource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *userGroupResource) Update(
@@ -266,6 +290,9 @@ func (r *userGroupResource) Update(
 		return
 	}
 
+	ctx, cancel := utils.UpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	updateParams := mssp.NewUpdateUserGroupsParams()
 	updateParams.Context = ctx
 	updateParams.Body = &models.DomainUserGroupsRequestV1{
@@ -337,6 +364,7 @@ func (r *userGroupResource) Update(
 
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(utils.SetIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *userGroupResource) Delete(
@@ -350,6 +378,9 @@ func (r *userGroupResource) Delete(
 		return
 	}
 
+	ctx, cancel := utils.DeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+	defer cancel()
+
 	if !state.UserIDs.IsNull() && len(state.UserIDs.Elements()) > 0 {
 		var userUuids []string
 		resp.Diagnostics.Append(state.UserIDs.ElementsAs(ctx, &userUuids, false)...)
@@ -393,7 +424,7 @@ func (r *userGroupResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *userGroupResource) getUserGroupByID(ctx context.Context, userGroupID string) (*models.DomainUserGroup, diag.Diagnostic) {
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// CIDAttribute returns the cid attribute of resources that can manage the
+// objects of a member CID with the provider credentials.
+func CIDAttribute(objects string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		MarkdownDescription: fmt.Sprintf(
+			"The member CID of the %s, for a Flight Control parent CID managing several member CIDs with a single provider configuration. "+
+				"The provider exchanges its client credentials for a token of the member CID, so its API client must have access to the member CID. "+
+				"Defaults to the CID of the provider credentials. Changing this value forces a new resource. "+
+				"When Terraform supports deferred changes, changes are deferred while the member CID is unknown or cannot be authenticated to yet, such as a child CID created in the same apply. "+
+				"To import the %s of a member CID, use an import ID of the form `<cid>/<id>`.",
+			objects,
+			objects,
+		),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// ImportStateWithCID imports a resource with a cid attribute by ID or identity.
+// An import ID of the form `<cid>/<id>` imports the object of a member CID.
+func ImportStateWithCID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cid, id, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	if cid == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <id> or <cid>/<id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cid"), cid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func importStateWithCID(t *testing.T, id string) (*resource.ImportStateResponse, types.String, types.String) {
+	t.Helper()
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":  schema.StringAttribute{Computed: true},
+			"cid": CIDAttribute("objects"),
+		},
+	}
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+		Identity: newIDIdentity(),
+	}
+
+	ImportStateWithCID(ctx, resource.ImportStateRequest{ID: id}, resp)
+
+	var gotID, gotCID types.String
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &gotID)...)
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("cid"), &gotCID)...)
+	}
+
+	return resp, gotID, gotCID
+}
+
+func TestImportStateWithCID(t *testing.T) {
+	resp, id, cid := importStateWithCID(t, "policy1")
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, "policy1", id.ValueString())
+	assert.True(t, cid.IsNull())
+
+	resp, id, cid = importStateWithCID(t, "member/policy1")
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, "policy1", id.ValueString())
+	assert.Equal(t, "member", cid.ValueString())
+
+	resp, _, _ = importStateWithCID(t, "member/")
+	assert.True(t, resp.Diagnostics.HasError())
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DefaultReadConcurrency is how many API calls resources make concurrently
+// when reading the child objects of a resource.
+const DefaultReadConcurrency = 8
+
+// ConcurrentMap calls fn for each item with at most limit calls in flight, and
+// returns the results and diagnostics in the order of items. No further calls
+// are started once a call returns an error, so the results of items after the
+// failing one may be missing.
+func ConcurrentMap[T, R any](
+	ctx context.Context,
+	items []T,
+	limit int,
+	fn func(ctx context.Context, item T) (R, diag.Diagnostics),
+) ([]R, diag.Diagnostics) {
+	results := make([]R, len(items))
+	itemDiags := make([]diag.Diagnostics, len(items))
+
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(limit, 1))
+
+	for i, item := range items {
+		sem <- struct{}{}
+		if failed.Load() || ctx.Err() != nil {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			results[i], itemDiags[i] = fn(ctx, item)
+			if itemDiags[i].HasError() {
+				failed.Store(true)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var diags diag.Diagnostics
+	for _, d := range itemDiags {
+		diags.Append(d...)
+	}
+
+	if err := ctx.Err(); err != nil && !diags.HasError() {
+		diags.AddError("Operation canceled", "The operation was canceled before all objects were read: "+err.Error())
+	}
+
+	return results, diags
+}
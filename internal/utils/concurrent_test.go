@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	results, diags := ConcurrentMap(context.Background(), items, 3, func(_ context.Context, item int) (int, diag.Diagnostics) {
+		n := inFlight.Add(1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		inFlight.Add(-1)
+
+		return item * 10, nil
+	})
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, []int{10, 20, 30, 40, 50, 60, 70, 80, 90, 100}, results)
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(3))
+}
+
+func TestConcurrentMap_Error(t *testing.T) {
+	var calls atomic.Int32
+
+	items := make([]int, 100)
+	_, diags := ConcurrentMap(context.Background(), items, 1, func(_ context.Context, _ int) (int, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		if calls.Add(1) == 2 {
+			diags.AddError("failed", "")
+		}
+		return 0, diags
+	})
+
+	assert.True(t, diags.HasError())
+	assert.Equal(t, int32(2), calls.Load(), "no calls are started after an error")
+}
+
+func TestConcurrentMap_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, diags := ConcurrentMap(ctx, []int{1, 2}, 2, func(_ context.Context, _ int) (int, diag.Diagnostics) {
+		return 0, nil
+	})
+	assert.True(t, diags.HasError())
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DeferPlanIfUnknown defers the planned change of a resource when the value
+// of any of paths is unknown, such as the ID of a cloud account or member CID
+// created in the same apply, and Terraform supports deferred changes. It
+// reports whether the change was deferred.
+func DeferPlanIfUnknown(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	paths ...path.Path,
+) bool {
+	if !req.ClientCapabilities.DeferralAllowed || req.Plan.Raw.IsNull() {
+		return false
+	}
+
+	for _, p := range paths {
+		var value attr.Value
+		diags := req.Plan.GetAttribute(ctx, p, &value)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return false
+		}
+
+		if value != nil && value.IsUnknown() {
+			tflog.Info(ctx, "Deferring change until value is known", map[string]any{"path": p.String()})
+			resp.Deferred = &resource.Deferred{Reason: resource.DeferredReasonResourceConfigUnknown}
+			return true
+		}
+	}
+
+	return false
+}
+
+// DeferPlanIfAbsent defers the planned change of a resource when diags hold
+// errors reporting that an object it depends on is not available yet, such as
+// a cloud account whose registration has not completed, and Terraform
+// supports deferred changes. The warnings of diags are kept once the change is
+// deferred. It reports whether the change was deferred; otherwise diags are
+// left for the caller to return.
+func DeferPlanIfAbsent(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	diags diag.Diagnostics,
+) bool {
+	if !diags.HasError() || !req.ClientCapabilities.DeferralAllowed || req.Plan.Raw.IsNull() {
+		return false
+	}
+
+	for _, d := range diags.Errors() {
+		tflog.Info(ctx, "Deferring change until prerequisite is available", map[string]any{
+			"summary": d.Summary(),
+			"detail":  d.Detail(),
+		})
+	}
+	resp.Diagnostics.Append(diags.Warnings()...)
+	resp.Deferred = &resource.Deferred{Reason: resource.DeferredReasonAbsentPrereq}
+
+	return true
+}
@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func modifyPlanRequest(t *testing.T, deferralAllowed bool, accountID any) resource.ModifyPlanRequest {
+	t.Helper()
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{Required: true},
+		},
+	}
+	typ := s.Type().TerraformType(ctx)
+
+	plan := tftypes.NewValue(typ, nil)
+	if accountID != nil {
+		plan = tftypes.NewValue(typ, map[string]tftypes.Value{
+			"account_id": tftypes.NewValue(tftypes.String, accountID),
+		})
+	}
+
+	return resource.ModifyPlanRequest{
+		ClientCapabilities: resource.ModifyPlanClientCapabilities{DeferralAllowed: deferralAllowed},
+		Plan:               tfsdk.Plan{Schema: s, Raw: plan},
+	}
+}
+
+func TestDeferPlanIfUnknown(t *testing.T) {
+	ctx := context.Background()
+	accountID := path.Root("account_id")
+
+	tests := map[string]struct {
+		deferralAllowed bool
+		accountID       any
+		want            bool
+	}{
+		"unknown":             {deferralAllowed: true, accountID: tftypes.UnknownValue, want: true},
+		"known":               {deferralAllowed: true, accountID: "123456789012"},
+		"deferral disallowed": {accountID: tftypes.UnknownValue},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &resource.ModifyPlanResponse{}
+			got := DeferPlanIfUnknown(ctx, modifyPlanRequest(t, tt.deferralAllowed, tt.accountID), resp, accountID)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tt.want, got)
+
+			if tt.want {
+				require.NotNil(t, resp.Deferred)
+				assert.Equal(t, resource.DeferredReasonResourceConfigUnknown, resp.Deferred.Reason)
+			} else {
+				assert.Nil(t, resp.Deferred)
+			}
+		})
+	}
+}
+
+func TestDeferPlanIfAbsent(t *testing.T) {
+	ctx := context.Background()
+
+	var absent diag.Diagnostics
+	absent.AddWarning("Slow Registration", "registration is taking longer than usual")
+	absent.AddError("Resource Not Found", "account not registered")
+
+	resp := &resource.ModifyPlanResponse{}
+	require.True(t, DeferPlanIfAbsent(ctx, modifyPlanRequest(t, true, "123456789012"), resp, absent))
+	require.NotNil(t, resp.Deferred)
+	assert.Equal(t, resource.DeferredReasonAbsentPrereq, resp.Deferred.Reason)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+
+	resp = &resource.ModifyPlanResponse{}
+	assert.False(t, DeferPlanIfAbsent(ctx, modifyPlanRequest(t, false, "123456789012"), resp, absent))
+	assert.Nil(t, resp.Deferred)
+	assert.Empty(t, resp.Diagnostics)
+
+	resp = &resource.ModifyPlanResponse{}
+	assert.False(t, DeferPlanIfAbsent(ctx, modifyPlanRequest(t, true, nil), resp, absent))
+	assert.Nil(t, resp.Deferred)
+
+	resp = &resource.ModifyPlanResponse{}
+	assert.False(t, DeferPlanIfAbsent(ctx, modifyPlanRequest(t, true, "123456789012"), resp, nil))
+	assert.Nil(t, resp.Deferred)
+}
@@ -0,0 +1,15 @@
+package utils
+
+import "strings"
+
+var fqlEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// FQLEscape escapes v for use inside a quoted Falcon Query Language string value.
+func FQLEscape(v string) string {
+	return fqlEscaper.Replace(v)
+}
+
+// FQLQuote quotes v as a Falcon Query Language string value.
+func FQLQuote(v string) string {
+	return "'" + FQLEscape(v) + "'"
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IDIdentityModel is the identity of a resource identified by the ID the API assigned to it.
+type IDIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// IDIdentitySchema returns the identity schema of resources identified by the ID
+// the API assigned to them. Names are not part of the identity as they can change.
+func IDIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID the CrowdStrike API assigned to the resource.",
+			},
+		},
+	}
+}
+
+// SetIDIdentity sets the identity of a resource identified by id. It does
+// nothing when id is not known yet.
+func SetIDIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if identity == nil || !IsKnown(id) {
+		return nil
+	}
+
+	return identity.Set(ctx, IDIdentityModel{ID: id})
+}
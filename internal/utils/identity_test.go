@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newIDIdentity() *tfsdk.ResourceIdentity {
+	s := IDIdentitySchema()
+	return &tfsdk.ResourceIdentity{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(context.Background()), nil),
+	}
+}
+
+func TestSetIDIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	identity := newIDIdentity()
+	diags := SetIDIdentity(ctx, identity, types.StringValue("abc123"))
+	require.False(t, diags.HasError(), diags)
+
+	var got IDIdentityModel
+	diags = identity.Get(ctx, &got)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "abc123", got.ID.ValueString())
+}
+
+func TestSetIDIdentity_Unknown(t *testing.T) {
+	ctx := context.Background()
+
+	identity := newIDIdentity()
+	assert.Nil(t, SetIDIdentity(ctx, identity, types.StringUnknown()))
+	assert.Nil(t, SetIDIdentity(ctx, identity, types.StringNull()))
+	assert.True(t, identity.Raw.IsNull(), "identity must not be set without an ID")
+
+	assert.Nil(t, SetIDIdentity(ctx, nil, types.StringValue("abc123")))
+}
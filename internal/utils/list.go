@@ -0,0 +1,152 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/crowdstrike/gofalcon/falcon/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ListPageSize is the number of objects requested per page by list resources.
+const ListPageSize = int64(500)
+
+// ListFilterModel is the configuration of list resources filtered with FQL.
+type ListFilterModel struct {
+	Filter types.String `tfsdk:"filter"`
+}
+
+// ListFilterSchema returns the configuration schema of list resources that
+// enumerate objects matching an optional FQL filter.
+func ListFilterSchema(objects string) listschema.Schema {
+	return listschema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists %s so they can be imported with `terraform query`.", objects),
+		Attributes: map[string]listschema.Attribute{
+			"filter": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("FQL filter limiting the %s returned. All %s are returned when omitted.", objects, objects),
+			},
+		},
+	}
+}
+
+// ListFilter returns the FQL filter selecting the objects managed by a
+// resource, restricted by base and the filter configured on its list resource.
+func ListFilter(base string, config ListFilterModel) *string {
+	filter := config.Filter.ValueString()
+	switch {
+	case base == "" && filter == "":
+		return nil
+	case base == "":
+		return &filter
+	case filter == "":
+		return &base
+	}
+
+	filter = fmt.Sprintf("%s+(%s)", base, filter)
+	return &filter
+}
+
+// ListPage fetches the page of objects starting at offset, and returns the
+// objects with the total number of objects available, or -1 when the total is
+// not known.
+type ListPage[T any] func(ctx context.Context, offset int64) ([]T, int64, diag.Diagnostics)
+
+// ListTotal returns the total number of objects reported in the pagination
+// metadata of an API response, or -1 when the response has no total.
+func ListTotal(meta *models.MsaMetaInfo) int64 {
+	if meta == nil || meta.Pagination == nil || meta.Pagination.Total == nil {
+		return -1
+	}
+	return *meta.Pagination.Total
+}
+
+// ListResults returns the results of a list request, fetching pages with
+// fetch as results are consumed and converting each object with result.
+// No more than req.Limit results are returned.
+func ListResults[T any](
+	ctx context.Context,
+	req list.ListRequest,
+	fetch ListPage[T],
+	result func(T) list.ListResult,
+) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var offset, pushed int64
+
+		for {
+			objects, total, diags := fetch(ctx, offset)
+			if diags.HasError() {
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			for _, object := range objects {
+				if req.Limit > 0 && pushed >= req.Limit {
+					return
+				}
+				if !push(result(object)) {
+					return
+				}
+				pushed++
+			}
+
+			offset += int64(len(objects))
+			if len(objects) == 0 || (total >= 0 && offset >= total) {
+				return
+			}
+		}
+	}
+}
+
+// NewListResult returns the list result of the object identified by id. When
+// the request includes resources, populate is called with a resource model
+// whose attributes are all null to fill in the state of the object.
+func NewListResult[T any](
+	ctx context.Context,
+	req list.ListRequest,
+	id types.String,
+	displayName string,
+	populate func(*T) diag.Diagnostics,
+) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+	result.Diagnostics.Append(SetIDIdentity(ctx, result.Identity, id)...)
+
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	objectType, ok := req.ResourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		result.Diagnostics.AddError(
+			"Unexpected resource schema type",
+			fmt.Sprintf("Expected an object type, got %T. Please report this issue to the provider developers.", objectType),
+		)
+		return result
+	}
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	result.Resource.Raw = tftypes.NewValue(objectType, attributes)
+
+	var model T
+	result.Diagnostics.Append(result.Resource.Get(ctx, &model)...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	result.Diagnostics.Append(populate(&model)...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+	return result
+}
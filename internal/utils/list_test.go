@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		base   string
+		filter types.String
+		want   *string
+	}{
+		{name: "no filter", filter: types.StringNull()},
+		{name: "base only", base: "platform_name:'Linux'", filter: types.StringNull(), want: Addr("platform_name:'Linux'")},
+		{name: "configured only", filter: types.StringValue("name:'a'"), want: Addr("name:'a'")},
+		{name: "both", base: "platform_name:'Linux'", filter: types.StringValue("name:'a',name:'b'"), want: Addr("platform_name:'Linux'+(name:'a',name:'b')")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ListFilter(tt.base, ListFilterModel{Filter: tt.filter}))
+		})
+	}
+}
+
+func TestListResults(t *testing.T) {
+	pages := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}
+
+	tests := []struct {
+		name      string
+		limit     int64
+		total     int64
+		want      []string
+		wantPages int
+	}{
+		{name: "all pages", total: 5, want: []string{"a", "b", "c", "d", "e"}, wantPages: 3},
+		{name: "unknown total", total: -1, want: []string{"a", "b", "c", "d", "e"}, wantPages: 4},
+		{name: "limit stops paging", limit: 3, total: 5, want: []string{"a", "b", "c"}, wantPages: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetched := 0
+			fetch := func(_ context.Context, offset int64) ([]string, int64, diag.Diagnostics) {
+				fetched++
+				for _, page := range pages {
+					if offset == 0 {
+						return page, tt.total, nil
+					}
+					offset -= int64(len(page))
+				}
+				return nil, tt.total, nil
+			}
+
+			var got []string
+			results := ListResults(context.Background(), list.ListRequest{Limit: tt.limit}, fetch, func(s string) list.ListResult {
+				return list.ListResult{DisplayName: s}
+			})
+			for result := range results {
+				got = append(got, result.DisplayName)
+			}
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantPages, fetched)
+		})
+	}
+}
+
+func TestListResults_Error(t *testing.T) {
+	fetch := func(_ context.Context, _ int64) ([]string, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		diags.AddError("boom", "request failed")
+		return nil, 0, diags
+	}
+
+	var got []list.ListResult
+	for result := range ListResults(context.Background(), list.ListRequest{}, fetch, nil) {
+		got = append(got, result)
+	}
+
+	require.Len(t, got, 1)
+	assert.True(t, got[0].Diagnostics.HasError())
+}
+
+func TestNewListResult(t *testing.T) {
+	ctx := context.Background()
+
+	type model struct {
+		ID       types.String   `tfsdk:"id"`
+		Name     types.String   `tfsdk:"name"`
+		Tags     types.Set      `tfsdk:"tags"`
+		Timeouts timeouts.Value `tfsdk:"timeouts"`
+	}
+
+	req := list.ListRequest{
+		IncludeResource: true,
+		ResourceSchema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id":   schema.StringAttribute{Computed: true},
+				"name": schema.StringAttribute{Required: true},
+				"tags": schema.SetAttribute{Optional: true, ElementType: types.StringType},
+			},
+			Blocks: map[string]schema.Block{
+				"timeouts": TimeoutsBlock(ctx),
+			},
+		},
+		ResourceIdentitySchema: IDIdentitySchema(),
+	}
+
+	result := NewListResult(ctx, req, types.StringValue("abc123"), "example", func(m *model) diag.Diagnostics {
+		assert.True(t, m.Tags.IsNull())
+		assert.True(t, m.Timeouts.IsNull())
+
+		m.ID = types.StringValue("abc123")
+		m.Name = types.StringValue("example")
+		return nil
+	})
+	require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+	assert.Equal(t, "example", result.DisplayName)
+
+	var identity IDIdentityModel
+	require.False(t, result.Identity.Get(ctx, &identity).HasError())
+	assert.Equal(t, "abc123", identity.ID.ValueString())
+
+	var got model
+	require.False(t, result.Resource.Get(ctx, &got).HasError())
+	assert.Equal(t, "example", got.Name.ValueString())
+	assert.True(t, got.Tags.IsNull())
+
+	req.IncludeResource = false
+	result = NewListResult(ctx, req, types.StringValue("abc123"), "example", func(m *model) diag.Diagnostics {
+		t.Fatal("resource must not be populated")
+		return nil
+	})
+	require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+	assert.True(t, result.Resource.Raw.IsNull())
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// providerSuffix is the namespace and type of this provider, which ends the
+// address of the source provider of state moves.
+const providerSuffix = "/crowdstrike/crowdstrike"
+
+// MovedGroups maps an attribute of a policy resource holding group IDs to the
+// attribute of its attachment resource holding the same groups.
+type MovedGroups struct {
+	// Source is the name of the attribute in the policy resource.
+	Source string
+	// List is true when the attribute in the policy resource is a list rather than a set.
+	List bool
+	// Target is the name of the set attribute in the attachment resource.
+	Target string
+}
+
+// AttachmentStateMover returns a StateMover moving the state of the policy
+// resource sourceType to its attachment resource, so configurations can
+// migrate with a moved block instead of recreating the policy. The attachment
+// takes over the policy ID and groups with exclusive ownership, as the policy
+// resource owned all of them.
+func AttachmentStateMover(sourceType string, groups ...MovedGroups) resource.StateMover {
+	attributes := map[string]schema.Attribute{
+		"id":  schema.StringAttribute{Computed: true},
+		"cid": schema.StringAttribute{Optional: true},
+	}
+	for _, g := range groups {
+		if g.List {
+			attributes[g.Source] = schema.ListAttribute{ElementType: types.StringType, Optional: true}
+		} else {
+			attributes[g.Source] = schema.SetAttribute{ElementType: types.StringType, Optional: true}
+		}
+	}
+
+	return resource.StateMover{
+		SourceSchema: &schema.Schema{Attributes: attributes},
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != sourceType || !strings.HasSuffix(req.SourceProviderAddress, providerSuffix) {
+				return
+			}
+
+			if req.SourceState == nil {
+				resp.Diagnostics.AddError(
+					"Unable to move resource state",
+					fmt.Sprintf(
+						"The state of the %s resource could not be read. Please report this issue to the provider developers.",
+						sourceType,
+					),
+				)
+				return
+			}
+
+			var id, cid types.String
+			resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root("id"), &id)...)
+			resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root("cid"), &cid)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			// Attachment resources manage the objects of the provider CID only.
+			if cid.ValueString() != "" {
+				resp.Diagnostics.AddError(
+					"Unable to move resource state",
+					fmt.Sprintf(
+						"The %s resource manages an object of the member CID %s, which attachment resources cannot manage.",
+						sourceType,
+						cid.ValueString(),
+					),
+				)
+				return
+			}
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), id)...)
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("exclusive"), true)...)
+
+			for _, g := range groups {
+				var value attr.Value
+				resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root(g.Source), &value)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root(g.Target), movedSet(value))...)
+			}
+		},
+	}
+}
+
+// movedSet returns the IDs held by a list or set value as a set, or a null set
+// when there are none, matching how attachment resources store no groups.
+func movedSet(value attr.Value) types.Set {
+	var values []attr.Value
+	switch v := value.(type) {
+	case types.Set:
+		values = v.Elements()
+	case types.List:
+		values = v.Elements()
+	}
+
+	var elements []attr.Value
+	for _, v := range values {
+		if !slices.ContainsFunc(elements, v.Equal) {
+			elements = append(elements, v)
+		}
+	}
+
+	if len(elements) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
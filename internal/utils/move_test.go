@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type movedAttachmentModel struct {
+	ID         types.String `tfsdk:"id"`
+	HostGroups types.Set    `tfsdk:"host_groups"`
+	RuleGroups types.Set    `tfsdk:"ioa_rule_groups"`
+	Exclusive  types.Bool   `tfsdk:"exclusive"`
+}
+
+var movedAttachmentSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id":              schema.StringAttribute{Required: true},
+		"host_groups":     schema.SetAttribute{ElementType: types.StringType, Optional: true},
+		"ioa_rule_groups": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+		"exclusive":       schema.BoolAttribute{Optional: true},
+	},
+}
+
+func moveState(
+	t *testing.T,
+	mover resource.StateMover,
+	sourceType string,
+	source map[string]tftypes.Value,
+) (*resource.MoveStateResponse, movedAttachmentModel) {
+	t.Helper()
+	ctx := context.Background()
+
+	sourceObjectType := mover.SourceSchema.Type().TerraformType(ctx)
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io" + providerSuffix,
+		SourceTypeName:        sourceType,
+		SourceState: &tfsdk.State{
+			Schema: *mover.SourceSchema,
+			Raw:    tftypes.NewValue(sourceObjectType, source),
+		},
+	}
+	resp := &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: movedAttachmentSchema,
+			Raw:    tftypes.NewValue(movedAttachmentSchema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	mover.StateMover(ctx, req, resp)
+
+	var got movedAttachmentModel
+	if !resp.TargetState.Raw.IsNull() {
+		diags := resp.TargetState.Get(ctx, &got)
+		require.False(t, diags.HasError(), diags)
+	}
+
+	return resp, got
+}
+
+func stringsValue(typ tftypes.Type, values ...string) tftypes.Value {
+	var elements []tftypes.Value
+	for _, v := range values {
+		elements = append(elements, tftypes.NewValue(tftypes.String, v))
+	}
+	return tftypes.NewValue(typ, elements)
+}
+
+func TestAttachmentStateMover(t *testing.T) {
+	setType := tftypes.Set{ElementType: tftypes.String}
+	listType := tftypes.List{ElementType: tftypes.String}
+
+	mover := AttachmentStateMover(
+		"crowdstrike_policy",
+		MovedGroups{Source: "host_groups", Target: "host_groups"},
+		MovedGroups{Source: "rule_groups", List: true, Target: "ioa_rule_groups"},
+	)
+
+	resp, got := moveState(t, mover, "crowdstrike_policy", map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "policy1"),
+		"cid":         tftypes.NewValue(tftypes.String, nil),
+		"host_groups": stringsValue(setType, "hg1", "hg2"),
+		"rule_groups": stringsValue(listType, "rg1", "rg1"),
+	})
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	assert.Equal(t, "policy1", got.ID.ValueString())
+	assert.True(t, got.Exclusive.ValueBool())
+	assert.ElementsMatch(t, []types.String{types.StringValue("hg1"), types.StringValue("hg2")}, toStrings(got.HostGroups))
+	assert.Equal(t, []types.String{types.StringValue("rg1")}, toStrings(got.RuleGroups))
+}
+
+func TestAttachmentStateMover_NoGroups(t *testing.T) {
+	mover := AttachmentStateMover(
+		"crowdstrike_policy",
+		MovedGroups{Source: "host_groups", Target: "host_groups"},
+	)
+
+	resp, got := moveState(t, mover, "crowdstrike_policy", map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "policy1"),
+		"cid":         tftypes.NewValue(tftypes.String, nil),
+		"host_groups": stringsValue(tftypes.Set{ElementType: tftypes.String}),
+	})
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	assert.Equal(t, "policy1", got.ID.ValueString())
+	assert.True(t, got.HostGroups.IsNull())
+	assert.True(t, got.RuleGroups.IsNull())
+}
+
+func TestAttachmentStateMover_OtherSource(t *testing.T) {
+	mover := AttachmentStateMover("crowdstrike_policy")
+
+	resp, _ := moveState(t, mover, "crowdstrike_other", map[string]tftypes.Value{
+		"id":  tftypes.NewValue(tftypes.String, "policy1"),
+		"cid": tftypes.NewValue(tftypes.String, nil),
+	})
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.True(t, resp.TargetState.Raw.IsNull())
+}
+
+func TestAttachmentStateMover_MemberCID(t *testing.T) {
+	mover := AttachmentStateMover("crowdstrike_policy")
+
+	resp, _ := moveState(t, mover, "crowdstrike_policy", map[string]tftypes.Value{
+		"id":  tftypes.NewValue(tftypes.String, "policy1"),
+		"cid": tftypes.NewValue(tftypes.String, "member"),
+	})
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func toStrings(set types.Set) []types.String {
+	var values []types.String
+	for _, v := range set.Elements() {
+		values = append(values, v.(types.String))
+	}
+	return values
+}
@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultMaxItems is the number of objects returned by data sources querying
+// objects page by page when max_items is not set.
+const DefaultMaxItems = 10000
+
+// Pagination is the page size and the limit on the number of objects of a
+// data source querying objects page by page, configured by its limit and
+// max_items attributes.
+type Pagination struct {
+	Limit    int64
+	MaxItems int64
+}
+
+// NewPagination returns the pagination configured by limit and maxItems,
+// requesting defaultLimit objects per page and returning DefaultMaxItems
+// objects when they are not set.
+func NewPagination(limit, maxItems types.Int64, defaultLimit int64) Pagination {
+	p := Pagination{Limit: defaultLimit, MaxItems: DefaultMaxItems}
+	if IsKnown(limit) {
+		p.Limit = limit.ValueInt64()
+	}
+	if IsKnown(maxItems) {
+		p.MaxItems = maxItems.ValueInt64()
+	}
+	return p
+}
+
+// PageLimitAttribute returns the limit attribute setting the number of objects
+// requested per page, up to maxLimit, the largest page size of the API.
+func PageLimitAttribute(objects string, defaultLimit, maxLimit int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		MarkdownDescription: fmt.Sprintf(
+			"The number of %s requested per API call. Every page is fetched up to `max_items`, so this only tunes the number and size of the requests. Defaults to `%d`.",
+			objects,
+			defaultLimit,
+		),
+		Validators: []validator.Int64{
+			int64validator.Between(1, maxLimit),
+		},
+	}
+}
+
+// MaxItemsAttribute returns the max_items attribute limiting the number of
+// objects returned by a data source.
+func MaxItemsAttribute(objects string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		MarkdownDescription: fmt.Sprintf(
+			"The maximum number of %s to return. A warning is raised when more %s match. Defaults to `%d`.",
+			objects,
+			objects,
+			DefaultMaxItems,
+		),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// QueryPage fetches at most limit objects starting at offset, and returns them
+// with the total number of objects matching the query, or -1 when it is not
+// known. Queries paginated by cursor ignore offset and report the number of
+// objects fetched as the total once they reach the last page.
+type QueryPage[T any] func(offset, limit int64) ([]T, int64, diag.Diagnostics)
+
+// QueryPages returns the objects of every page fetched with fetch, up to
+// p.MaxItems objects, and the total number of objects matching the query,
+// which is at least the number of objects returned.
+func QueryPages[T any](p Pagination, fetch QueryPage[T]) ([]T, int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	objects := []T{}
+	total := int64(-1)
+
+	for int64(len(objects)) < p.MaxItems {
+		page, pageTotal, pageDiags := fetch(int64(len(objects)), min(p.Limit, p.MaxItems-int64(len(objects))))
+		diags.Append(pageDiags...)
+		if diags.HasError() {
+			return nil, 0, diags
+		}
+
+		objects = append(objects, page...)
+		total = pageTotal
+
+		if len(page) == 0 || (total >= 0 && int64(len(objects)) >= total) {
+			break
+		}
+	}
+
+	return objects, max(total, int64(len(objects))), diags
+}
+
+// Truncate returns the first maxItems objects and the number of objects before
+// truncation. Data sources matching objects after fetching every page apply
+// max_items to the matching objects with it.
+func Truncate[T any](objects []T, maxItems int64) ([]T, int64) {
+	total := int64(len(objects))
+	return objects[:min(total, maxItems)], total
+}
+
+// TruncatedWarning returns a warning when fewer than total objects were
+// returned because of max_items.
+func TruncatedWarning(objects string, returned int, total int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if total > int64(returned) {
+		diags.AddWarning(
+			fmt.Sprintf("Results truncated to %d %s", returned, objects),
+			fmt.Sprintf(
+				"%d %s match the query but only the first %d were returned. Narrow the filter or increase max_items.",
+				total,
+				objects,
+				returned,
+			),
+		)
+	}
+
+	return diags
+}
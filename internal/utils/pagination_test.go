@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPagination(t *testing.T) {
+	assert.Equal(t, Pagination{Limit: 100, MaxItems: DefaultMaxItems}, NewPagination(types.Int64Null(), types.Int64Null(), 100))
+	assert.Equal(t, Pagination{Limit: 10, MaxItems: 50}, NewPagination(types.Int64Value(10), types.Int64Value(50), 100))
+}
+
+func TestQueryPages(t *testing.T) {
+	objects := make([]int, 25)
+	for i := range objects {
+		objects[i] = i
+	}
+
+	// fetchOffset pages through objects by offset, reporting the total when
+	// withTotal is set.
+	fetchOffset := func(withTotal bool, calls *int) QueryPage[int] {
+		return func(offset, limit int64) ([]int, int64, diag.Diagnostics) {
+			*calls++
+			end := min(offset+limit, int64(len(objects)))
+			total := int64(-1)
+			if withTotal {
+				total = int64(len(objects))
+			}
+			return objects[min(offset, end):end], total, nil
+		}
+	}
+
+	tests := map[string]struct {
+		pagination Pagination
+		withTotal  bool
+		want       int
+		wantTotal  int64
+		wantCalls  int
+	}{
+		"every page":           {pagination: Pagination{Limit: 10, MaxItems: 100}, withTotal: true, want: 25, wantTotal: 25, wantCalls: 3},
+		"unknown total":        {pagination: Pagination{Limit: 10, MaxItems: 100}, want: 25, wantTotal: 25, wantCalls: 4},
+		"truncated":            {pagination: Pagination{Limit: 10, MaxItems: 15}, withTotal: true, want: 15, wantTotal: 25, wantCalls: 2},
+		"truncated mid page":   {pagination: Pagination{Limit: 10, MaxItems: 5}, withTotal: true, want: 5, wantTotal: 25, wantCalls: 1},
+		"max items page align": {pagination: Pagination{Limit: 5, MaxItems: 25}, withTotal: true, want: 25, wantTotal: 25, wantCalls: 5},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			got, total, diags := QueryPages(tt.pagination, fetchOffset(tt.withTotal, &calls))
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, objects[:tt.want], got)
+			assert.Equal(t, tt.wantTotal, total)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestQueryPagesError(t *testing.T) {
+	_, _, diags := QueryPages(Pagination{Limit: 10, MaxItems: 100}, func(offset, limit int64) ([]int, int64, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		if offset > 0 {
+			diags.AddError("Query failed", "second page failed")
+			return nil, 0, diags
+		}
+		return make([]int, limit), -1, diags
+	})
+	assert.True(t, diags.HasError())
+}
+
+func TestTruncate(t *testing.T) {
+	got, total := Truncate([]int{1, 2, 3}, 2)
+	assert.Equal(t, []int{1, 2}, got)
+	assert.Equal(t, int64(3), total)
+
+	got, total = Truncate([]int{1, 2}, 5)
+	assert.Equal(t, []int{1, 2}, got)
+	assert.Equal(t, int64(2), total)
+}
+
+func TestTruncatedWarning(t *testing.T) {
+	assert.Empty(t, TruncatedWarning("hosts", 10, 10))
+
+	diags := TruncatedWarning("hosts", 10, 25)
+	require.Equal(t, 1, diags.WarningsCount())
+	assert.Contains(t, diags[0].Detail(), "25 hosts match")
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RollbackOnErrorAttribute returns the rollback_on_error attribute of resources
+// that create several objects with separate API calls in a single apply.
+func RollbackOnErrorAttribute(objects string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		MarkdownDescription: fmt.Sprintf(
+			"When true, the %s created during an apply are deleted when a later API call of the apply fails, instead of being left in place until the next apply. Defaults to `false`.",
+			objects,
+		),
+	}
+}
+
+// Rollback records how to delete the objects created during an apply, so they
+// can be deleted when a later API call fails.
+type Rollback struct {
+	enabled bool
+	mu      sync.Mutex
+	undo    []func(context.Context) diag.Diagnostics
+}
+
+// NewRollback returns a Rollback that deletes objects only when enabled is true.
+func NewRollback(enabled types.Bool) *Rollback {
+	return &Rollback{enabled: enabled.ValueBool()}
+}
+
+// Add records undo as the way to delete objects created during the apply. It
+// may be called concurrently by objects created concurrently.
+func (r *Rollback) Add(undo func(context.Context) diag.Diagnostics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.undo = append(r.undo, undo)
+}
+
+// Run deletes the recorded objects in the reverse order they were created when
+// rollback is enabled and diags has an error, and reports whether all of them
+// were deleted. Failures to delete are added to diags as warnings, so the error
+// that caused the rollback remains the one reported. Objects are deleted even
+// when the operation timed out.
+func (r *Rollback) Run(ctx context.Context, diags *diag.Diagnostics) bool {
+	if !r.enabled || !diags.HasError() || len(r.undo) == 0 {
+		return false
+	}
+
+	tflog.Info(ctx, "Rolling back objects created before the error", map[string]any{"objects": len(r.undo)})
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), DefaultDeleteTimeout)
+	defer cancel()
+
+	ok := true
+	for _, undo := range slices.Backward(r.undo) {
+		for _, d := range undo(ctx) {
+			if d.Severity() == diag.SeverityError {
+				ok = false
+				diags.AddWarning("Rollback failed: "+d.Summary(), d.Detail())
+				continue
+			}
+			diags.Append(d)
+		}
+	}
+	r.undo = nil
+
+	return ok
+}
@@ -0,0 +1,118 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func failed() diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddError("Create failed", "boom")
+	return diags
+}
+
+func TestRollbackRun(t *testing.T) {
+	var deleted []string
+	rollback := NewRollback(types.BoolValue(true))
+	for _, id := range []string{"a", "b"} {
+		rollback.Add(func(context.Context) diag.Diagnostics {
+			deleted = append(deleted, id)
+			return nil
+		})
+	}
+
+	diags := failed()
+	assert.True(t, rollback.Run(context.Background(), &diags))
+	assert.Equal(t, []string{"b", "a"}, deleted)
+	assert.Equal(t, failed(), diags)
+
+	assert.False(t, rollback.Run(context.Background(), &diags), "objects are only deleted once")
+	assert.Equal(t, []string{"b", "a"}, deleted)
+}
+
+func TestRollbackAdd_Concurrent(t *testing.T) {
+	var deleted atomic.Int64
+	rollback := NewRollback(types.BoolValue(true))
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rollback.Add(func(context.Context) diag.Diagnostics {
+				deleted.Add(1)
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+
+	diags := failed()
+	assert.True(t, rollback.Run(context.Background(), &diags))
+	assert.Equal(t, int64(50), deleted.Load())
+}
+
+func TestRollbackRun_Disabled(t *testing.T) {
+	for _, enabled := range []types.Bool{types.BoolValue(false), types.BoolNull()} {
+		called := false
+		rollback := NewRollback(enabled)
+		rollback.Add(func(context.Context) diag.Diagnostics {
+			called = true
+			return nil
+		})
+
+		diags := failed()
+		assert.False(t, rollback.Run(context.Background(), &diags))
+		assert.False(t, called)
+	}
+}
+
+func TestRollbackRun_NoError(t *testing.T) {
+	called := false
+	rollback := NewRollback(types.BoolValue(true))
+	rollback.Add(func(context.Context) diag.Diagnostics {
+		called = true
+		return nil
+	})
+
+	var diags diag.Diagnostics
+	assert.False(t, rollback.Run(context.Background(), &diags))
+	assert.False(t, called)
+}
+
+func TestRollbackRun_DeleteFails(t *testing.T) {
+	rollback := NewRollback(types.BoolValue(true))
+	rollback.Add(func(context.Context) diag.Diagnostics {
+		var diags diag.Diagnostics
+		diags.AddError("Delete failed", "not allowed")
+		return diags
+	})
+
+	diags := failed()
+	assert.False(t, rollback.Run(context.Background(), &diags))
+	assert.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, 1, diags.WarningsCount())
+	assert.Equal(t, "Rollback failed: Delete failed", diags.Warnings()[0].Summary())
+}
+
+func TestRollbackRun_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var undoErr error
+	rollback := NewRollback(types.BoolValue(true))
+	rollback.Add(func(ctx context.Context) diag.Diagnostics {
+		undoErr = ctx.Err()
+		return nil
+	})
+
+	diags := failed()
+	assert.True(t, rollback.Run(ctx, &diags))
+	assert.NoError(t, undoErr)
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Default timeouts of resource operations, used when the timeouts block does not set one.
+const (
+	DefaultCreateTimeout = 20 * time.Minute
+	DefaultReadTimeout   = 10 * time.Minute
+	DefaultUpdateTimeout = 20 * time.Minute
+	DefaultDeleteTimeout = 20 * time.Minute
+)
+
+// TimeoutsBlock returns the timeouts block supported by every resource.
+func TimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: timeoutDescription("creating", DefaultCreateTimeout),
+		ReadDescription:   timeoutDescription("reading", DefaultReadTimeout),
+		UpdateDescription: timeoutDescription("updating", DefaultUpdateTimeout),
+		DeleteDescription: timeoutDescription("deleting", DefaultDeleteTimeout),
+	})
+}
+
+func timeoutDescription(operation string, def time.Duration) string {
+	return fmt.Sprintf(
+		"The maximum time allowed for %s the resource, as a duration such as `30s` or `1h`. Defaults to `%dm`.",
+		operation,
+		int(def.Minutes()),
+	)
+}
+
+// CreateTimeout returns a context that is cancelled when the create timeout configured in t elapses.
+func CreateTimeout(ctx context.Context, t timeouts.Value, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	timeout, d := t.Create(ctx, DefaultCreateTimeout)
+	diags.Append(d...)
+	return context.WithTimeout(ctx, timeout)
+}
+
+// ReadTimeout returns a context that is cancelled when the read timeout configured in t elapses.
+func ReadTimeout(ctx context.Context, t timeouts.Value, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	timeout, d := t.Read(ctx, DefaultReadTimeout)
+	diags.Append(d...)
+	return context.WithTimeout(ctx, timeout)
+}
+
+// UpdateTimeout returns a context that is cancelled when the update timeout configured in t elapses.
+func UpdateTimeout(ctx context.Context, t timeouts.Value, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	timeout, d := t.Update(ctx, DefaultUpdateTimeout)
+	diags.Append(d...)
+	return context.WithTimeout(ctx, timeout)
+}
+
+// DeleteTimeout returns a context that is cancelled when the delete timeout configured in t elapses.
+func DeleteTimeout(ctx context.Context, t timeouts.Value, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	timeout, d := t.Delete(ctx, DefaultDeleteTimeout)
+	diags.Append(d...)
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCreateTimeout(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	}
+
+	tests := []struct {
+		name  string
+		value timeouts.Value
+		want  time.Duration
+	}{
+		{
+			name:  "not configured",
+			value: timeouts.Value{Object: types.ObjectNull(attrTypes)},
+			want:  DefaultCreateTimeout,
+		},
+		{
+			name: "configured",
+			value: timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"create": types.StringValue("1h"),
+				"read":   types.StringNull(),
+				"update": types.StringNull(),
+				"delete": types.StringNull(),
+			})},
+			want: time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			start := time.Now()
+
+			ctx, cancel := CreateTimeout(context.Background(), tt.value, &diags)
+			defer cancel()
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Fatal("context has no deadline")
+			}
+
+			if got := deadline.Sub(start); got < tt.want || got > tt.want+time.Minute {
+				t.Errorf("deadline in %s, want %s", got, tt.want)
+			}
+		})
+	}
+}